@@ -10,6 +10,8 @@ import (
 
 	"pickfighter.com/auth/internal/controller/auth"
 	grpchandler "pickfighter.com/auth/internal/handler/grpc"
+	"pickfighter.com/auth/internal/mailer"
+	"pickfighter.com/auth/internal/oidc"
 	"pickfighter.com/auth/internal/repository/psql"
 	service "pickfighter.com/auth/internal/service/auth"
 	"pickfighter.com/pkg/discovery"
@@ -102,7 +104,46 @@ func runServe(cmd *cobra.Command, args []string) {
 	}
 	defer repo.GracefulShutdown()
 
-	ctl := auth.New(repo)
+	var mailCfg mailer.Config
+	if err := viper.UnmarshalKey("mail", &mailCfg); err != nil {
+		logs.Errorf("Unable to read mailer config: %s", err)
+		return
+	}
+	// the sender's Gmail account and app password were the only SMTP settings before
+	if mailCfg.SMTP.Username == "" {
+		mailCfg.SMTP.Username = viper.GetString("mail.sender_address")
+	}
+	if mailCfg.SMTP.Password == "" {
+		mailCfg.SMTP.Password = viper.GetString("mail.app_password")
+	}
+
+	m, err := mailer.New(mailCfg)
+	if err != nil {
+		logs.Errorf("Unable to create mailer: %s", err)
+		return
+	}
+
+	templates, err := mailer.NewTemplates(viper.GetString("mail.locale"))
+	if err != nil {
+		logs.Errorf("Unable to parse email templates: %s", err)
+		return
+	}
+
+	var providerCfgs map[string]oidc.ProviderConfig
+	if err := viper.UnmarshalKey("oidc.providers", &providerCfgs); err != nil {
+		logs.Errorf("Unable to read OIDC providers config: %s", err)
+		return
+	}
+
+	providers, err := oidc.New(ctx, providerCfgs)
+	if err != nil {
+		logs.Errorf("Unable to create OIDC providers: %s", err)
+		return
+	}
+	logs.Infof("OIDC providers: %v", providers.Names())
+
+	ctl := auth.New(repo, m, templates, providers)
+	go ctl.RunOutbox(ctx)
 	h := grpchandler.New(ctl)
 
 	err = app.Init(h)
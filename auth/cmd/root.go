@@ -6,7 +6,9 @@ import (
 	"os"
 	"time"
 
+	"pickfighter.com/auth/internal/mailer"
 	"pickfighter.com/auth/pkg/logger"
+	"pickfighter.com/auth/pkg/password"
 	"pickfighter.com/auth/pkg/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -106,6 +108,58 @@ func setConfigDefaults() {
 	viper.SetDefault("postgres.main.name", "postgres")
 	viper.SetDefault("postgres.main.user", "postgres")
 
+	// tokens: short-lived access tokens renewed with refresh tokens, which live longer with "remember me"
+	viper.SetDefault("auth.jwt.access_ttl", 15*time.Minute)
+	viper.SetDefault("auth.refresh.ttl", 24*time.Hour)
+	viper.SetDefault("auth.refresh.remember_ttl", 7*24*time.Hour)
+
+	// OpenID Connect logins: providers are configured under `oidc.providers.<name>`
+	viper.SetDefault("auth.oidc.state_ttl", 10*time.Minute)
+
+	// two-factor authentication: codes of `skew` neighbouring 30s steps are accepted,
+	// `max_attempts` wrong codes in a row lock the authenticator for `lockout`
+	viper.SetDefault("auth.totp.issuer", "Pickfighter")
+	viper.SetDefault("auth.totp.skew", 1)
+	viper.SetDefault("auth.totp.challenge_ttl", 5*time.Minute)
+	viper.SetDefault("auth.totp.max_attempts", 5)
+	viper.SetDefault("auth.totp.lockout", 15*time.Minute)
+	viper.SetDefault("auth.totp.recovery_codes", 10)
+	viper.SetDefault("auth.totp.require_for_admins", false)
+	// lifetime of the confirmation link sent to the new address of an email change
+	viper.SetDefault("auth.email_change.ttl", 24*time.Hour)
+	// deleted accounts can be restored by logging in again within the grace period, they are anonymized after it
+	viper.SetDefault("auth.account.deletion_grace", 30*24*time.Hour)
+	// due accounts claimed by an anonymizer are not handed to another one before the lease passes
+	viper.SetDefault("auth.account.anonymize_lease", 10*time.Minute)
+
+	// password hashing of new and rehashed passwords: argon2id or bcrypt, legacy hashes are rehashed on login
+	viper.SetDefault("password.algorithm", password.DefaultParams.Algorithm)
+	viper.SetDefault("password.argon2.memory", password.DefaultParams.Argon2.Memory)
+	viper.SetDefault("password.argon2.iterations", password.DefaultParams.Argon2.Iterations)
+	viper.SetDefault("password.argon2.parallelism", password.DefaultParams.Argon2.Parallelism)
+	viper.SetDefault("password.argon2.salt_length", password.DefaultParams.Argon2.SaltLength)
+	viper.SetDefault("password.argon2.key_length", password.DefaultParams.Argon2.KeyLength)
+	viper.SetDefault("password.bcrypt_cost", password.DefaultParams.BcryptCost)
+
+	// mail: smtp, file, console or memory driver, emails are rendered in the default locale
+	// when there are no templates in the recipient's one
+	viper.SetDefault("mail.driver", mailer.DriverSMTP)
+	viper.SetDefault("mail.locale", mailer.DefaultLocale)
+	viper.SetDefault("mail.sender_name", "Pickfighter")
+	viper.SetDefault("mail.smtp.host", "smtp.gmail.com")
+	viper.SetDefault("mail.smtp.port", 587)
+	viper.SetDefault("mail.smtp.tls", mailer.TLSStartTLS)
+	viper.SetDefault("mail.smtp.insecure_skip_verify", false)
+	viper.SetDefault("mail.file_path", "logs/mail")
+
+	// email outbox worker: failed emails are retried with a doubling delay and dead-lettered after max attempts
+	viper.SetDefault("mail.outbox.poll_interval", 5*time.Second)
+	viper.SetDefault("mail.outbox.batch_size", 20)
+	viper.SetDefault("mail.outbox.max_attempts", 8)
+	viper.SetDefault("mail.outbox.retry_delay", 30*time.Second)
+	viper.SetDefault("mail.outbox.max_retry_delay", time.Hour)
+	viper.SetDefault("mail.outbox.lease", 2*time.Minute)
+
 	// web
 	viper.SetDefault("web.host", "http://localhost")
 	viper.SetDefault("web.port", "4200")
@@ -0,0 +1,894 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/controller/auth/controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/controller/auth/controller.go -destination=./gen/mocks/mock_auth.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+	gomock "go.uber.org/mock/gomock"
+	model "pickfighter.com/auth/pkg/model"
+)
+
+// MockauthRepository is a mock of authRepository interface.
+type MockauthRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthRepositoryMockRecorder
+}
+
+// MockauthRepositoryMockRecorder is the mock recorder for MockauthRepository.
+type MockauthRepositoryMockRecorder struct {
+	mock *MockauthRepository
+}
+
+// NewMockauthRepository creates a new mock instance.
+func NewMockauthRepository(ctrl *gomock.Controller) *MockauthRepository {
+	mock := &MockauthRepository{ctrl: ctrl}
+	mock.recorder = &MockauthRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthRepository) EXPECT() *MockauthRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BeginTx mocks base method.
+func (m *MockauthRepository) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTx", ctx, txOptions)
+	ret0, _ := ret[0].(pgx.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTx indicates an expected call of BeginTx.
+func (mr *MockauthRepositoryMockRecorder) BeginTx(ctx, txOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTx", reflect.TypeOf((*MockauthRepository)(nil).BeginTx), ctx, txOptions)
+}
+
+// ClaimDueUserDeletions mocks base method.
+func (m *MockauthRepository) ClaimDueUserDeletions(ctx context.Context, now, leaseUntil int64, limit int32) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueUserDeletions", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueUserDeletions indicates an expected call of ClaimDueUserDeletions.
+func (mr *MockauthRepositoryMockRecorder) ClaimDueUserDeletions(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueUserDeletions", reflect.TypeOf((*MockauthRepository)(nil).ClaimDueUserDeletions), ctx, now, leaseUntil, limit)
+}
+
+// ClaimOutboxEmails mocks base method.
+func (m *MockauthRepository) ClaimOutboxEmails(ctx context.Context, now, leaseUntil int64, limit int32) ([]*model.OutboxEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOutboxEmails", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]*model.OutboxEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOutboxEmails indicates an expected call of ClaimOutboxEmails.
+func (mr *MockauthRepositoryMockRecorder) ClaimOutboxEmails(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOutboxEmails", reflect.TypeOf((*MockauthRepository)(nil).ClaimOutboxEmails), ctx, now, leaseUntil, limit)
+}
+
+// ConfirmCredentialsToken mocks base method.
+func (m *MockauthRepository) ConfirmCredentialsToken(ctx context.Context, tx pgx.Tx, req model.UserCredentialsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmCredentialsToken", ctx, tx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmCredentialsToken indicates an expected call of ConfirmCredentialsToken.
+func (mr *MockauthRepositoryMockRecorder) ConfirmCredentialsToken(ctx, tx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmCredentialsToken", reflect.TypeOf((*MockauthRepository)(nil).ConfirmCredentialsToken), ctx, tx, req)
+}
+
+// ConnectDBPool mocks base method.
+func (m *MockauthRepository) ConnectDBPool(ctx context.Context) (*pgxpool.Pool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectDBPool", ctx)
+	ret0, _ := ret[0].(*pgxpool.Pool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectDBPool indicates an expected call of ConnectDBPool.
+func (mr *MockauthRepositoryMockRecorder) ConnectDBPool(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectDBPool", reflect.TypeOf((*MockauthRepository)(nil).ConnectDBPool), ctx)
+}
+
+// ConsumeOAuthState mocks base method.
+func (m *MockauthRepository) ConsumeOAuthState(ctx context.Context, state string) (model.OAuthState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeOAuthState", ctx, state)
+	ret0, _ := ret[0].(model.OAuthState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeOAuthState indicates an expected call of ConsumeOAuthState.
+func (mr *MockauthRepositoryMockRecorder) ConsumeOAuthState(ctx, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOAuthState", reflect.TypeOf((*MockauthRepository)(nil).ConsumeOAuthState), ctx, state)
+}
+
+// DebugLogSqlErr mocks base method.
+func (m *MockauthRepository) DebugLogSqlErr(q string, err error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DebugLogSqlErr", q, err)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DebugLogSqlErr indicates an expected call of DebugLogSqlErr.
+func (mr *MockauthRepositoryMockRecorder) DebugLogSqlErr(q, err any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugLogSqlErr", reflect.TypeOf((*MockauthRepository)(nil).DebugLogSqlErr), q, err)
+}
+
+// DeleteRecords mocks base method.
+func (m *MockauthRepository) DeleteRecords(ctx context.Context, tableName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecords", ctx, tableName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecords indicates an expected call of DeleteRecords.
+func (mr *MockauthRepositoryMockRecorder) DeleteRecords(ctx, tableName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecords", reflect.TypeOf((*MockauthRepository)(nil).DeleteRecords), ctx, tableName)
+}
+
+// FindTokenRevocation mocks base method.
+func (m *MockauthRepository) FindTokenRevocation(ctx context.Context, tokenId, sessionId string, userId int32) (bool, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTokenRevocation", ctx, tokenId, sessionId, userId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTokenRevocation indicates an expected call of FindTokenRevocation.
+func (mr *MockauthRepositoryMockRecorder) FindTokenRevocation(ctx, tokenId, sessionId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTokenRevocation", reflect.TypeOf((*MockauthRepository)(nil).FindTokenRevocation), ctx, tokenId, sessionId, userId)
+}
+
+// FindUser mocks base method.
+func (m *MockauthRepository) FindUser(ctx context.Context, req *model.UserRequest) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUser", ctx, req)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUser indicates an expected call of FindUser.
+func (mr *MockauthRepositoryMockRecorder) FindUser(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUser", reflect.TypeOf((*MockauthRepository)(nil).FindUser), ctx, req)
+}
+
+// FindUserCredentials mocks base method.
+func (m *MockauthRepository) FindUserCredentials(ctx context.Context, req model.UserCredentialsRequest) (model.UserCredentials, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserCredentials", ctx, req)
+	ret0, _ := ret[0].(model.UserCredentials)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserCredentials indicates an expected call of FindUserCredentials.
+func (mr *MockauthRepositoryMockRecorder) FindUserCredentials(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserCredentials", reflect.TypeOf((*MockauthRepository)(nil).FindUserCredentials), ctx, req)
+}
+
+// GetPool mocks base method.
+func (m *MockauthRepository) GetPool() *pgxpool.Pool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPool")
+	ret0, _ := ret[0].(*pgxpool.Pool)
+	return ret0
+}
+
+// GetPool indicates an expected call of GetPool.
+func (mr *MockauthRepositoryMockRecorder) GetPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockauthRepository)(nil).GetPool))
+}
+
+// GetPoolConfig mocks base method.
+func (m *MockauthRepository) GetPoolConfig() (*pgxpool.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolConfig")
+	ret0, _ := ret[0].(*pgxpool.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolConfig indicates an expected call of GetPoolConfig.
+func (mr *MockauthRepositoryMockRecorder) GetPoolConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolConfig", reflect.TypeOf((*MockauthRepository)(nil).GetPoolConfig))
+}
+
+// GracefulShutdown mocks base method.
+func (m *MockauthRepository) GracefulShutdown() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GracefulShutdown")
+}
+
+// GracefulShutdown indicates an expected call of GracefulShutdown.
+func (mr *MockauthRepositoryMockRecorder) GracefulShutdown() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GracefulShutdown", reflect.TypeOf((*MockauthRepository)(nil).GracefulShutdown))
+}
+
+// NewOAuthState mocks base method.
+func (m *MockauthRepository) NewOAuthState(ctx context.Context, s model.OAuthState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOAuthState", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewOAuthState indicates an expected call of NewOAuthState.
+func (mr *MockauthRepositoryMockRecorder) NewOAuthState(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOAuthState", reflect.TypeOf((*MockauthRepository)(nil).NewOAuthState), ctx, s)
+}
+
+// NewTwoFactorChallenge mocks base method.
+func (m *MockauthRepository) NewTwoFactorChallenge(ctx context.Context, c model.TwoFactorChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTwoFactorChallenge", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewTwoFactorChallenge indicates an expected call of NewTwoFactorChallenge.
+func (mr *MockauthRepositoryMockRecorder) NewTwoFactorChallenge(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTwoFactorChallenge", reflect.TypeOf((*MockauthRepository)(nil).NewTwoFactorChallenge), ctx, c)
+}
+
+// PerformUsersRequestQuery mocks base method.
+func (m *MockauthRepository) PerformUsersRequestQuery(req *model.UsersRequest) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PerformUsersRequestQuery", req)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// PerformUsersRequestQuery indicates an expected call of PerformUsersRequestQuery.
+func (mr *MockauthRepositoryMockRecorder) PerformUsersRequestQuery(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PerformUsersRequestQuery", reflect.TypeOf((*MockauthRepository)(nil).PerformUsersRequestQuery), req)
+}
+
+// PurgeRevokedTokens mocks base method.
+func (m *MockauthRepository) PurgeRevokedTokens(ctx context.Context, now int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeRevokedTokens", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeRevokedTokens indicates an expected call of PurgeRevokedTokens.
+func (mr *MockauthRepositoryMockRecorder) PurgeRevokedTokens(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRevokedTokens", reflect.TypeOf((*MockauthRepository)(nil).PurgeRevokedTokens), ctx, now)
+}
+
+// ResetPassword mocks base method.
+func (m *MockauthRepository) ResetPassword(ctx context.Context, tx pgx.Tx, req *model.UserCredentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, tx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockauthRepositoryMockRecorder) ResetPassword(ctx, tx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockauthRepository)(nil).ResetPassword), ctx, tx, req)
+}
+
+// SanitizeString mocks base method.
+func (m *MockauthRepository) SanitizeString(s string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SanitizeString", s)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SanitizeString indicates an expected call of SanitizeString.
+func (mr *MockauthRepositoryMockRecorder) SanitizeString(s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SanitizeString", reflect.TypeOf((*MockauthRepository)(nil).SanitizeString), s)
+}
+
+// SearchOutboxEmails mocks base method.
+func (m *MockauthRepository) SearchOutboxEmails(ctx context.Context, status string, limit int32) ([]*model.OutboxEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchOutboxEmails", ctx, status, limit)
+	ret0, _ := ret[0].([]*model.OutboxEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchOutboxEmails indicates an expected call of SearchOutboxEmails.
+func (mr *MockauthRepositoryMockRecorder) SearchOutboxEmails(ctx, status, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOutboxEmails", reflect.TypeOf((*MockauthRepository)(nil).SearchOutboxEmails), ctx, status, limit)
+}
+
+// SearchRoleChanges mocks base method.
+func (m *MockauthRepository) SearchRoleChanges(ctx context.Context, userId, limit int32) ([]*model.RoleChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchRoleChanges", ctx, userId, limit)
+	ret0, _ := ret[0].([]*model.RoleChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchRoleChanges indicates an expected call of SearchRoleChanges.
+func (mr *MockauthRepositoryMockRecorder) SearchRoleChanges(ctx, userId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRoleChanges", reflect.TypeOf((*MockauthRepository)(nil).SearchRoleChanges), ctx, userId, limit)
+}
+
+// SearchUserIdentities mocks base method.
+func (m *MockauthRepository) SearchUserIdentities(ctx context.Context, userId int32) ([]model.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUserIdentities", ctx, userId)
+	ret0, _ := ret[0].([]model.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUserIdentities indicates an expected call of SearchUserIdentities.
+func (mr *MockauthRepositoryMockRecorder) SearchUserIdentities(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUserIdentities", reflect.TypeOf((*MockauthRepository)(nil).SearchUserIdentities), ctx, userId)
+}
+
+// SearchUsers mocks base method.
+func (m *MockauthRepository) SearchUsers(ctx context.Context, req *model.UsersRequest) ([]*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, req)
+	ret0, _ := ret[0].([]*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockauthRepositoryMockRecorder) SearchUsers(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockauthRepository)(nil).SearchUsers), ctx, req)
+}
+
+// TxAnonymizeUser mocks base method.
+func (m *MockauthRepository) TxAnonymizeUser(ctx context.Context, tx pgx.Tx, userId int32, pseudonym string, now int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxAnonymizeUser", ctx, tx, userId, pseudonym, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxAnonymizeUser indicates an expected call of TxAnonymizeUser.
+func (mr *MockauthRepositoryMockRecorder) TxAnonymizeUser(ctx, tx, userId, pseudonym, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxAnonymizeUser", reflect.TypeOf((*MockauthRepository)(nil).TxAnonymizeUser), ctx, tx, userId, pseudonym, now)
+}
+
+// TxCancelUserDeletion mocks base method.
+func (m *MockauthRepository) TxCancelUserDeletion(ctx context.Context, tx pgx.Tx, userId int32, now int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxCancelUserDeletion", ctx, tx, userId, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxCancelUserDeletion indicates an expected call of TxCancelUserDeletion.
+func (mr *MockauthRepositoryMockRecorder) TxCancelUserDeletion(ctx, tx, userId, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxCancelUserDeletion", reflect.TypeOf((*MockauthRepository)(nil).TxCancelUserDeletion), ctx, tx, userId, now)
+}
+
+// TxCreateUser mocks base method.
+func (m *MockauthRepository) TxCreateUser(ctx context.Context, tx pgx.Tx, u model.User) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxCreateUser", ctx, tx, u)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxCreateUser indicates an expected call of TxCreateUser.
+func (mr *MockauthRepositoryMockRecorder) TxCreateUser(ctx, tx, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxCreateUser", reflect.TypeOf((*MockauthRepository)(nil).TxCreateUser), ctx, tx, u)
+}
+
+// TxDeleteEmailChange mocks base method.
+func (m *MockauthRepository) TxDeleteEmailChange(ctx context.Context, tx pgx.Tx, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxDeleteEmailChange", ctx, tx, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxDeleteEmailChange indicates an expected call of TxDeleteEmailChange.
+func (mr *MockauthRepositoryMockRecorder) TxDeleteEmailChange(ctx, tx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxDeleteEmailChange", reflect.TypeOf((*MockauthRepository)(nil).TxDeleteEmailChange), ctx, tx, tokenHash)
+}
+
+// TxDeleteTwoFactorChallenge mocks base method.
+func (m *MockauthRepository) TxDeleteTwoFactorChallenge(ctx context.Context, tx pgx.Tx, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxDeleteTwoFactorChallenge", ctx, tx, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxDeleteTwoFactorChallenge indicates an expected call of TxDeleteTwoFactorChallenge.
+func (mr *MockauthRepositoryMockRecorder) TxDeleteTwoFactorChallenge(ctx, tx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxDeleteTwoFactorChallenge", reflect.TypeOf((*MockauthRepository)(nil).TxDeleteTwoFactorChallenge), ctx, tx, tokenHash)
+}
+
+// TxDeleteUserData mocks base method.
+func (m *MockauthRepository) TxDeleteUserData(ctx context.Context, tx pgx.Tx, userId int32, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxDeleteUserData", ctx, tx, userId, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxDeleteUserData indicates an expected call of TxDeleteUserData.
+func (mr *MockauthRepositoryMockRecorder) TxDeleteUserData(ctx, tx, userId, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxDeleteUserData", reflect.TypeOf((*MockauthRepository)(nil).TxDeleteUserData), ctx, tx, userId, email)
+}
+
+// TxDeleteUserTOTP mocks base method.
+func (m *MockauthRepository) TxDeleteUserTOTP(ctx context.Context, tx pgx.Tx, userId int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxDeleteUserTOTP", ctx, tx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxDeleteUserTOTP indicates an expected call of TxDeleteUserTOTP.
+func (mr *MockauthRepositoryMockRecorder) TxDeleteUserTOTP(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxDeleteUserTOTP", reflect.TypeOf((*MockauthRepository)(nil).TxDeleteUserTOTP), ctx, tx, userId)
+}
+
+// TxFindEmailChange mocks base method.
+func (m *MockauthRepository) TxFindEmailChange(ctx context.Context, tx pgx.Tx, tokenHash string) (model.EmailChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxFindEmailChange", ctx, tx, tokenHash)
+	ret0, _ := ret[0].(model.EmailChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxFindEmailChange indicates an expected call of TxFindEmailChange.
+func (mr *MockauthRepositoryMockRecorder) TxFindEmailChange(ctx, tx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxFindEmailChange", reflect.TypeOf((*MockauthRepository)(nil).TxFindEmailChange), ctx, tx, tokenHash)
+}
+
+// TxFindOutboxEmail mocks base method.
+func (m *MockauthRepository) TxFindOutboxEmail(ctx context.Context, tx pgx.Tx, emailId int64) (*model.OutboxEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxFindOutboxEmail", ctx, tx, emailId)
+	ret0, _ := ret[0].(*model.OutboxEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxFindOutboxEmail indicates an expected call of TxFindOutboxEmail.
+func (mr *MockauthRepositoryMockRecorder) TxFindOutboxEmail(ctx, tx, emailId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxFindOutboxEmail", reflect.TypeOf((*MockauthRepository)(nil).TxFindOutboxEmail), ctx, tx, emailId)
+}
+
+// TxFindRefreshToken mocks base method.
+func (m *MockauthRepository) TxFindRefreshToken(ctx context.Context, tx pgx.Tx, tokenHash string) (model.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxFindRefreshToken", ctx, tx, tokenHash)
+	ret0, _ := ret[0].(model.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxFindRefreshToken indicates an expected call of TxFindRefreshToken.
+func (mr *MockauthRepositoryMockRecorder) TxFindRefreshToken(ctx, tx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxFindRefreshToken", reflect.TypeOf((*MockauthRepository)(nil).TxFindRefreshToken), ctx, tx, tokenHash)
+}
+
+// TxFindTwoFactorChallenge mocks base method.
+func (m *MockauthRepository) TxFindTwoFactorChallenge(ctx context.Context, tx pgx.Tx, tokenHash string) (model.TwoFactorChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxFindTwoFactorChallenge", ctx, tx, tokenHash)
+	ret0, _ := ret[0].(model.TwoFactorChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxFindTwoFactorChallenge indicates an expected call of TxFindTwoFactorChallenge.
+func (mr *MockauthRepositoryMockRecorder) TxFindTwoFactorChallenge(ctx, tx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxFindTwoFactorChallenge", reflect.TypeOf((*MockauthRepository)(nil).TxFindTwoFactorChallenge), ctx, tx, tokenHash)
+}
+
+// TxFindUserIdentity mocks base method.
+func (m *MockauthRepository) TxFindUserIdentity(ctx context.Context, tx pgx.Tx, provider, subject string) (model.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxFindUserIdentity", ctx, tx, provider, subject)
+	ret0, _ := ret[0].(model.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxFindUserIdentity indicates an expected call of TxFindUserIdentity.
+func (mr *MockauthRepositoryMockRecorder) TxFindUserIdentity(ctx, tx, provider, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxFindUserIdentity", reflect.TypeOf((*MockauthRepository)(nil).TxFindUserIdentity), ctx, tx, provider, subject)
+}
+
+// TxFindUserRoles mocks base method.
+func (m *MockauthRepository) TxFindUserRoles(ctx context.Context, tx pgx.Tx, userId int32) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxFindUserRoles", ctx, tx, userId)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxFindUserRoles indicates an expected call of TxFindUserRoles.
+func (mr *MockauthRepositoryMockRecorder) TxFindUserRoles(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxFindUserRoles", reflect.TypeOf((*MockauthRepository)(nil).TxFindUserRoles), ctx, tx, userId)
+}
+
+// TxFindUserTOTP mocks base method.
+func (m *MockauthRepository) TxFindUserTOTP(ctx context.Context, tx pgx.Tx, userId int32) (model.UserTOTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxFindUserTOTP", ctx, tx, userId)
+	ret0, _ := ret[0].(model.UserTOTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxFindUserTOTP indicates an expected call of TxFindUserTOTP.
+func (mr *MockauthRepositoryMockRecorder) TxFindUserTOTP(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxFindUserTOTP", reflect.TypeOf((*MockauthRepository)(nil).TxFindUserTOTP), ctx, tx, userId)
+}
+
+// TxNewAuthCredentials mocks base method.
+func (m *MockauthRepository) TxNewAuthCredentials(ctx context.Context, tx pgx.Tx, uc model.UserCredentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxNewAuthCredentials", ctx, tx, uc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxNewAuthCredentials indicates an expected call of TxNewAuthCredentials.
+func (mr *MockauthRepositoryMockRecorder) TxNewAuthCredentials(ctx, tx, uc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxNewAuthCredentials", reflect.TypeOf((*MockauthRepository)(nil).TxNewAuthCredentials), ctx, tx, uc)
+}
+
+// TxNewEmailChange mocks base method.
+func (m *MockauthRepository) TxNewEmailChange(ctx context.Context, tx pgx.Tx, c model.EmailChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxNewEmailChange", ctx, tx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxNewEmailChange indicates an expected call of TxNewEmailChange.
+func (mr *MockauthRepositoryMockRecorder) TxNewEmailChange(ctx, tx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxNewEmailChange", reflect.TypeOf((*MockauthRepository)(nil).TxNewEmailChange), ctx, tx, c)
+}
+
+// TxNewOutboxEmail mocks base method.
+func (m *MockauthRepository) TxNewOutboxEmail(ctx context.Context, tx pgx.Tx, data *model.EmailData, now int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxNewOutboxEmail", ctx, tx, data, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxNewOutboxEmail indicates an expected call of TxNewOutboxEmail.
+func (mr *MockauthRepositoryMockRecorder) TxNewOutboxEmail(ctx, tx, data, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxNewOutboxEmail", reflect.TypeOf((*MockauthRepository)(nil).TxNewOutboxEmail), ctx, tx, data, now)
+}
+
+// TxNewRefreshToken mocks base method.
+func (m *MockauthRepository) TxNewRefreshToken(ctx context.Context, tx pgx.Tx, rt model.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxNewRefreshToken", ctx, tx, rt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxNewRefreshToken indicates an expected call of TxNewRefreshToken.
+func (mr *MockauthRepositoryMockRecorder) TxNewRefreshToken(ctx, tx, rt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxNewRefreshToken", reflect.TypeOf((*MockauthRepository)(nil).TxNewRefreshToken), ctx, tx, rt)
+}
+
+// TxNewRoleChange mocks base method.
+func (m *MockauthRepository) TxNewRoleChange(ctx context.Context, tx pgx.Tx, c *model.RoleChange, roles uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxNewRoleChange", ctx, tx, c, roles)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxNewRoleChange indicates an expected call of TxNewRoleChange.
+func (mr *MockauthRepositoryMockRecorder) TxNewRoleChange(ctx, tx, c, roles any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxNewRoleChange", reflect.TypeOf((*MockauthRepository)(nil).TxNewRoleChange), ctx, tx, c, roles)
+}
+
+// TxNewUserIdentity mocks base method.
+func (m *MockauthRepository) TxNewUserIdentity(ctx context.Context, tx pgx.Tx, i model.UserIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxNewUserIdentity", ctx, tx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxNewUserIdentity indicates an expected call of TxNewUserIdentity.
+func (mr *MockauthRepositoryMockRecorder) TxNewUserIdentity(ctx, tx, i any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxNewUserIdentity", reflect.TypeOf((*MockauthRepository)(nil).TxNewUserIdentity), ctx, tx, i)
+}
+
+// TxReplaceRecoveryCodes mocks base method.
+func (m *MockauthRepository) TxReplaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userId int32, hashes []string, now int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxReplaceRecoveryCodes", ctx, tx, userId, hashes, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxReplaceRecoveryCodes indicates an expected call of TxReplaceRecoveryCodes.
+func (mr *MockauthRepositoryMockRecorder) TxReplaceRecoveryCodes(ctx, tx, userId, hashes, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxReplaceRecoveryCodes", reflect.TypeOf((*MockauthRepository)(nil).TxReplaceRecoveryCodes), ctx, tx, userId, hashes, now)
+}
+
+// TxRevokeOtherRefreshFamilies mocks base method.
+func (m *MockauthRepository) TxRevokeOtherRefreshFamilies(ctx context.Context, tx pgx.Tx, userId int32, keepFamilyId string, revokedAt int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxRevokeOtherRefreshFamilies", ctx, tx, userId, keepFamilyId, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxRevokeOtherRefreshFamilies indicates an expected call of TxRevokeOtherRefreshFamilies.
+func (mr *MockauthRepositoryMockRecorder) TxRevokeOtherRefreshFamilies(ctx, tx, userId, keepFamilyId, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxRevokeOtherRefreshFamilies", reflect.TypeOf((*MockauthRepository)(nil).TxRevokeOtherRefreshFamilies), ctx, tx, userId, keepFamilyId, revokedAt)
+}
+
+// TxRevokeRefreshFamily mocks base method.
+func (m *MockauthRepository) TxRevokeRefreshFamily(ctx context.Context, tx pgx.Tx, familyId string, revokedAt int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxRevokeRefreshFamily", ctx, tx, familyId, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxRevokeRefreshFamily indicates an expected call of TxRevokeRefreshFamily.
+func (mr *MockauthRepositoryMockRecorder) TxRevokeRefreshFamily(ctx, tx, familyId, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxRevokeRefreshFamily", reflect.TypeOf((*MockauthRepository)(nil).TxRevokeRefreshFamily), ctx, tx, familyId, revokedAt)
+}
+
+// TxRevokeToken mocks base method.
+func (m *MockauthRepository) TxRevokeToken(ctx context.Context, tx pgx.Tx, tokenId string, userId int32, expiresAt, revokedAt int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxRevokeToken", ctx, tx, tokenId, userId, expiresAt, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxRevokeToken indicates an expected call of TxRevokeToken.
+func (mr *MockauthRepositoryMockRecorder) TxRevokeToken(ctx, tx, tokenId, userId, expiresAt, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxRevokeToken", reflect.TypeOf((*MockauthRepository)(nil).TxRevokeToken), ctx, tx, tokenId, userId, expiresAt, revokedAt)
+}
+
+// TxRevokeUserRefreshFamilies mocks base method.
+func (m *MockauthRepository) TxRevokeUserRefreshFamilies(ctx context.Context, tx pgx.Tx, userId int32, before, revokedAt int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxRevokeUserRefreshFamilies", ctx, tx, userId, before, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxRevokeUserRefreshFamilies indicates an expected call of TxRevokeUserRefreshFamilies.
+func (mr *MockauthRepositoryMockRecorder) TxRevokeUserRefreshFamilies(ctx, tx, userId, before, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxRevokeUserRefreshFamilies", reflect.TypeOf((*MockauthRepository)(nil).TxRevokeUserRefreshFamilies), ctx, tx, userId, before, revokedAt)
+}
+
+// TxRevokeUserTokens mocks base method.
+func (m *MockauthRepository) TxRevokeUserTokens(ctx context.Context, tx pgx.Tx, userId int32, before int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxRevokeUserTokens", ctx, tx, userId, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxRevokeUserTokens indicates an expected call of TxRevokeUserTokens.
+func (mr *MockauthRepositoryMockRecorder) TxRevokeUserTokens(ctx, tx, userId, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxRevokeUserTokens", reflect.TypeOf((*MockauthRepository)(nil).TxRevokeUserTokens), ctx, tx, userId, before)
+}
+
+// TxSaveUserTOTP mocks base method.
+func (m *MockauthRepository) TxSaveUserTOTP(ctx context.Context, tx pgx.Tx, t model.UserTOTP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxSaveUserTOTP", ctx, tx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxSaveUserTOTP indicates an expected call of TxSaveUserTOTP.
+func (mr *MockauthRepositoryMockRecorder) TxSaveUserTOTP(ctx, tx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxSaveUserTOTP", reflect.TypeOf((*MockauthRepository)(nil).TxSaveUserTOTP), ctx, tx, t)
+}
+
+// TxScheduleUserDeletion mocks base method.
+func (m *MockauthRepository) TxScheduleUserDeletion(ctx context.Context, tx pgx.Tx, userId int32, deleteAfter, now int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxScheduleUserDeletion", ctx, tx, userId, deleteAfter, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxScheduleUserDeletion indicates an expected call of TxScheduleUserDeletion.
+func (mr *MockauthRepositoryMockRecorder) TxScheduleUserDeletion(ctx, tx, userId, deleteAfter, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxScheduleUserDeletion", reflect.TypeOf((*MockauthRepository)(nil).TxScheduleUserDeletion), ctx, tx, userId, deleteAfter, now)
+}
+
+// TxUpdateCredentialsEmail mocks base method.
+func (m *MockauthRepository) TxUpdateCredentialsEmail(ctx context.Context, tx pgx.Tx, userId int32, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxUpdateCredentialsEmail", ctx, tx, userId, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxUpdateCredentialsEmail indicates an expected call of TxUpdateCredentialsEmail.
+func (mr *MockauthRepositoryMockRecorder) TxUpdateCredentialsEmail(ctx, tx, userId, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxUpdateCredentialsEmail", reflect.TypeOf((*MockauthRepository)(nil).TxUpdateCredentialsEmail), ctx, tx, userId, email)
+}
+
+// TxUpdateOutboxEmail mocks base method.
+func (m *MockauthRepository) TxUpdateOutboxEmail(ctx context.Context, tx pgx.Tx, e *model.OutboxEmail, now int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxUpdateOutboxEmail", ctx, tx, e, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxUpdateOutboxEmail indicates an expected call of TxUpdateOutboxEmail.
+func (mr *MockauthRepositoryMockRecorder) TxUpdateOutboxEmail(ctx, tx, e, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxUpdateOutboxEmail", reflect.TypeOf((*MockauthRepository)(nil).TxUpdateOutboxEmail), ctx, tx, e, now)
+}
+
+// TxUpdateUserName mocks base method.
+func (m *MockauthRepository) TxUpdateUserName(ctx context.Context, tx pgx.Tx, userId int32, name string, now int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxUpdateUserName", ctx, tx, userId, name, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxUpdateUserName indicates an expected call of TxUpdateUserName.
+func (mr *MockauthRepositoryMockRecorder) TxUpdateUserName(ctx, tx, userId, name, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxUpdateUserName", reflect.TypeOf((*MockauthRepository)(nil).TxUpdateUserName), ctx, tx, userId, name, now)
+}
+
+// TxUpdateUserRoles mocks base method.
+func (m *MockauthRepository) TxUpdateUserRoles(ctx context.Context, tx pgx.Tx, userId int32, roles uint64, now int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxUpdateUserRoles", ctx, tx, userId, roles, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxUpdateUserRoles indicates an expected call of TxUpdateUserRoles.
+func (mr *MockauthRepositoryMockRecorder) TxUpdateUserRoles(ctx, tx, userId, roles, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxUpdateUserRoles", reflect.TypeOf((*MockauthRepository)(nil).TxUpdateUserRoles), ctx, tx, userId, roles, now)
+}
+
+// TxUseRecoveryCode mocks base method.
+func (m *MockauthRepository) TxUseRecoveryCode(ctx context.Context, tx pgx.Tx, userId int32, codeHash string, usedAt int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxUseRecoveryCode", ctx, tx, userId, codeHash, usedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxUseRecoveryCode indicates an expected call of TxUseRecoveryCode.
+func (mr *MockauthRepositoryMockRecorder) TxUseRecoveryCode(ctx, tx, userId, codeHash, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxUseRecoveryCode", reflect.TypeOf((*MockauthRepository)(nil).TxUseRecoveryCode), ctx, tx, userId, codeHash, usedAt)
+}
+
+// TxUseRefreshToken mocks base method.
+func (m *MockauthRepository) TxUseRefreshToken(ctx context.Context, tx pgx.Tx, tokenId, usedAt int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxUseRefreshToken", ctx, tx, tokenId, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxUseRefreshToken indicates an expected call of TxUseRefreshToken.
+func (mr *MockauthRepositoryMockRecorder) TxUseRefreshToken(ctx, tx, tokenId, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxUseRefreshToken", reflect.TypeOf((*MockauthRepository)(nil).TxUseRefreshToken), ctx, tx, tokenId, usedAt)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockauthRepository) UpdatePassword(ctx context.Context, tx pgx.Tx, req model.UserCredentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, tx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockauthRepositoryMockRecorder) UpdatePassword(ctx, tx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockauthRepository)(nil).UpdatePassword), ctx, tx, req)
+}
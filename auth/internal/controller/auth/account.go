@@ -0,0 +1,323 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/spf13/viper"
+	internalErr "pickfighter.com/auth/pkg/errors"
+	"pickfighter.com/auth/pkg/model"
+	"pickfighter.com/auth/pkg/password"
+	"pickfighter.com/auth/pkg/utils"
+	logs "pickfighter.com/pkg/logger"
+)
+
+const (
+	// maxNameLength is the maximum number of characters of a display name.
+	maxNameLength = 64
+	// minPasswordLength is the minimum number of characters of a password, as checked at registration.
+	minPasswordLength = 6
+	// emailChangeTokenLength is the number of random bytes of an email change token.
+	emailChangeTokenLength = 32
+)
+
+var (
+	// ErrInvalidName is returned for empty or too long display names.
+	ErrInvalidName = errors.New("name is empty or too long")
+	// ErrNameTaken is returned when another user has the display name.
+	ErrNameTaken = errors.New("name is already taken")
+	// ErrWrongPassword is returned when the current password of the user does not match.
+	ErrWrongPassword = errors.New("password is wrong")
+	// ErrInvalidPassword is returned for new passwords shorter than the minimum length.
+	ErrInvalidPassword = errors.New("password is empty or less than 6 symbols")
+	// ErrInvalidEmail is returned for malformed email addresses and the current email of the user.
+	ErrInvalidEmail = errors.New("email address is invalid")
+	// ErrEmailTaken is returned when another user has the email address.
+	ErrEmailTaken = errors.New("email is already taken")
+	// ErrInvalidEmailToken is returned for unknown or expired email change tokens.
+	ErrInvalidEmailToken = errors.New("email change token is invalid or expired")
+)
+
+// isUniqueViolation tells whether err is a unique constraint violation of the database.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation
+}
+
+// verifyCurrentPassword checks the password of the user. Users without a password, created by
+// a provider login, must set one with a password reset first.
+func verifyCurrentPassword(creds *model.UserCredentials, plain string) error {
+	if creds.Password == "" || plain == "" {
+		return ErrWrongPassword
+	}
+
+	ok, err := password.Verify(plain, credentialsHash(creds))
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrWrongPassword
+	}
+
+	return nil
+}
+
+// UpdateProfile sets the display name of the user and returns the updated profile.
+// It returns ErrInvalidName, ErrNameTaken or an error wrapping ErrNotFound for unknown users.
+func (c *Controller) UpdateProfile(ctx context.Context, req *model.UserRequest) (*model.User, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return nil, ErrInvalidName
+	}
+
+	err := c.repo.TxUpdateUserName(ctx, nil, req.UserId, name, time.Now().Unix())
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("user %d: %w", req.UserId, ErrNotFound)
+	case isUniqueViolation(err):
+		return nil, fmt.Errorf("%w: %q", ErrNameTaken, name)
+	case err != nil:
+		logs.Errorf("Failed to update name of User [%d]: %s", req.UserId, err)
+		return nil, internalErr.New(internalErr.AccountProfile, err, 1301)
+	}
+
+	return c.Profile(ctx, &model.UserRequest{UserId: req.UserId})
+}
+
+// ChangePassword sets a new password after verifying the current one. All other sessions of the user
+// are logged out: their refresh tokens are revoked and so are their access tokens, which carry the session.
+// The session of the request stays logged in. It returns ErrWrongPassword or ErrInvalidPassword.
+func (c *Controller) ChangePassword(ctx context.Context, req *model.ChangePasswordRequest) error {
+	if len(req.NewPassword) < minPasswordLength {
+		return ErrInvalidPassword
+	}
+
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
+		UserId: req.UserId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("user %d: %w", req.UserId, ErrNotFound)
+	} else if err != nil {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return internalErr.New(internalErr.UserCredentials, err, 427)
+	}
+
+	if err := verifyCurrentPassword(&creds, req.OldPassword); errors.Is(err, ErrWrongPassword) {
+		return err
+	} else if err != nil {
+		logs.Errorf("Failed to verify password of User [%d]: %s", creds.UserId, err)
+		return internalErr.New(internalErr.AccountPassword, err, 1302)
+	}
+
+	if err := setPassword(&creds, req.NewPassword); err != nil {
+		logs.Errorf("Failed to hash password of User [%d]: %s", creds.UserId, err)
+		return internalErr.New(internalErr.AccountPassword, err, 1303)
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return internalErr.New(internalErr.Tx, err, 131)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	if err := c.repo.UpdatePassword(ctx, tx, creds); err != nil {
+		rollback()
+		logs.Errorf("Failed to update password of User [%d]: %s", creds.UserId, err)
+		return internalErr.New(internalErr.AccountPassword, err, 1304)
+	}
+
+	if err := c.repo.TxRevokeOtherRefreshFamilies(ctx, tx, creds.UserId, req.SessionId, time.Now().Unix()); err != nil {
+		rollback()
+		logs.Errorf("Failed to revoke other sessions of User [%d]: %s", creds.UserId, err)
+		return internalErr.New(internalErr.AccountPassword, err, 1305)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return internalErr.New(internalErr.TxCommit, err, 132)
+	}
+
+	logs.Infof("User [%d] changed the password", creds.UserId)
+
+	return nil
+}
+
+// ChangeEmail starts an email change proven by the password: a confirmation link valid for
+// `auth.email_change.ttl` is sent to the new address, the email is swapped by ConfirmEmailChange.
+// It returns ErrWrongPassword, ErrInvalidEmail or ErrEmailTaken.
+func (c *Controller) ChangeEmail(ctx context.Context, req *model.ChangeEmailRequest) (*model.ChangeEmailResult, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, ErrInvalidEmail
+	}
+
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
+		UserId: req.UserId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("user %d: %w", req.UserId, ErrNotFound)
+	} else if err != nil {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return nil, internalErr.New(internalErr.UserCredentials, err, 428)
+	}
+
+	if email == creds.Email {
+		return nil, fmt.Errorf("%w: %s is the current email", ErrInvalidEmail, email)
+	}
+
+	if err := verifyCurrentPassword(&creds, req.Password); errors.Is(err, ErrWrongPassword) {
+		return nil, err
+	} else if err != nil {
+		logs.Errorf("Failed to verify password of User [%d]: %s", creds.UserId, err)
+		return nil, internalErr.New(internalErr.AccountEmail, err, 1306)
+	}
+
+	if _, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{Email: email}); err == nil {
+		return nil, ErrEmailTaken
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return nil, internalErr.New(internalErr.UserCredentials, err, 429)
+	}
+
+	user, err := c.repo.FindUser(ctx, &model.UserRequest{UserId: req.UserId})
+	if err != nil {
+		logs.Errorf("Failed to get user: %s", err)
+		return nil, internalErr.New(internalErr.DBGetUser, err, 805)
+	}
+
+	token, err := utils.GetSecureToken(emailChangeTokenLength)
+	if err != nil {
+		return nil, internalErr.New(internalErr.AccountEmail, err, 1307)
+	}
+
+	now := time.Now()
+	change := model.EmailChange{
+		TokenHash: utils.GenerateHashFromString(token),
+		UserId:    req.UserId,
+		Email:     email,
+		CreatedAt: now.Unix(),
+		ExpiresAt: now.Add(viper.GetDuration("auth.email_change.ttl")).Unix(),
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return nil, internalErr.New(internalErr.Tx, err, 133)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	if err := c.repo.TxNewEmailChange(ctx, tx, change); err != nil {
+		rollback()
+		logs.Errorf("Failed to store email change: %s", err)
+		return nil, internalErr.New(internalErr.AccountEmail, err, 1308)
+	}
+
+	err = c.queueEmail(ctx, tx, &model.EmailData{
+		Subject: model.EmailChangeEmail,
+		Recipient: model.EmailAddrSpec{
+			Email: email,
+			Name:  user.Name,
+		},
+		Token:  token,
+		Locale: req.Locale,
+	})
+	if err != nil {
+		rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return nil, internalErr.New(internalErr.TxCommit, err, 134)
+	}
+
+	c.notifyOutbox()
+
+	return &model.ChangeEmailResult{Email: email, ExpiresAt: change.ExpiresAt}, nil
+}
+
+// ConfirmEmailChange swaps the email of the user for the new address the token was sent to.
+// Every token works once. It returns ErrInvalidEmailToken or ErrEmailTaken if another user
+// took the address in the meantime.
+func (c *Controller) ConfirmEmailChange(ctx context.Context, req *model.ConfirmEmailChangeRequest) (*model.EmailChange, error) {
+	if req.Token == "" {
+		return nil, ErrInvalidEmailToken
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return nil, internalErr.New(internalErr.Tx, err, 135)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	change, err := c.repo.TxFindEmailChange(ctx, tx, utils.GenerateHashFromString(req.Token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return nil, ErrInvalidEmailToken
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to find email change: %s", err)
+		return nil, internalErr.New(internalErr.AccountEmail, err, 1309)
+	}
+
+	if time.Now().Unix() > change.ExpiresAt {
+		rollback()
+		return nil, ErrInvalidEmailToken
+	}
+
+	if err := c.repo.TxUpdateCredentialsEmail(ctx, tx, change.UserId, change.Email); isUniqueViolation(err) {
+		rollback()
+		return nil, ErrEmailTaken
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to update email of User [%d]: %s", change.UserId, err)
+		return nil, internalErr.New(internalErr.AccountEmail, err, 1310)
+	}
+
+	if err := c.repo.TxDeleteEmailChange(ctx, tx, change.TokenHash); err != nil {
+		rollback()
+		logs.Errorf("Failed to delete email change: %s", err)
+		return nil, internalErr.New(internalErr.AccountEmail, err, 1311)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return nil, internalErr.New(internalErr.TxCommit, err, 136)
+	}
+
+	logs.Infof("User [%d] changed the email", change.UserId)
+
+	return &change, nil
+}
@@ -0,0 +1,250 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"pickfighter.com/auth/pkg/model"
+	"pickfighter.com/auth/pkg/password"
+	"pickfighter.com/auth/pkg/utils"
+)
+
+// newTestCredentials returns the credentials of user 7 with the password hashed by the default parameters.
+func newTestCredentials(t *testing.T, plain string) model.UserCredentials {
+	t.Helper()
+
+	creds := model.UserCredentials{UserId: 7, Email: "jon@example.com", Active: true}
+	require.NoError(t, setPassword(&creds, plain))
+
+	return creds
+}
+
+func TestChangePassword(t *testing.T) {
+	ctx := context.Background()
+	creds := newTestCredentials(t, "secret1")
+
+	t.Run("Wrong old password", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil)
+
+		// nothing is written, the sessions are kept
+		err := c.ChangePassword(ctx, &model.ChangePasswordRequest{UserId: 7, SessionId: "session-1", OldPassword: "wrong1", NewPassword: "secret2"})
+		assert.ErrorIs(t, err, ErrWrongPassword)
+	})
+
+	t.Run("No password", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+
+		// a provider login without a password cannot prove it with an empty one
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).
+			Return(model.UserCredentials{UserId: 7, Email: "jon@example.com"}, nil)
+
+		err := c.ChangePassword(ctx, &model.ChangePasswordRequest{UserId: 7, NewPassword: "secret2"})
+		assert.ErrorIs(t, err, ErrWrongPassword)
+	})
+
+	t.Run("Short new password", func(t *testing.T) {
+		c, _, _ := newTestController(t)
+
+		err := c.ChangePassword(ctx, &model.ChangePasswordRequest{UserId: 7, OldPassword: "secret1", NewPassword: "abc"})
+		assert.ErrorIs(t, err, ErrInvalidPassword)
+	})
+
+	t.Run("Revokes other sessions", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil)
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().UpdatePassword(ctx, tx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ pgx.Tx, updated model.UserCredentials) error {
+				ok, err := password.Verify("secret2", credentialsHash(&updated))
+				require.NoError(t, err)
+				assert.True(t, ok, "the new password is stored")
+				return nil
+			})
+		// the family of the session changing the password stays logged in
+		mockRepo.EXPECT().TxRevokeOtherRefreshFamilies(ctx, tx, int32(7), "session-1", gomock.Any()).Return(nil)
+
+		err := c.ChangePassword(ctx, &model.ChangePasswordRequest{UserId: 7, SessionId: "session-1", OldPassword: "secret1", NewPassword: "secret2"})
+		require.NoError(t, err)
+		assert.True(t, tx.committed)
+	})
+
+	t.Run("Revoke error", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil)
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().UpdatePassword(ctx, tx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().TxRevokeOtherRefreshFamilies(ctx, tx, int32(7), "session-1", gomock.Any()).Return(pgx.ErrTxClosed)
+
+		// the password is not changed while other sessions stay logged in
+		err := c.ChangePassword(ctx, &model.ChangePasswordRequest{UserId: 7, SessionId: "session-1", OldPassword: "secret1", NewPassword: "secret2"})
+		assert.Error(t, err)
+		assert.True(t, tx.rolledBack)
+	})
+}
+
+func TestChangeEmail(t *testing.T) {
+	ctx := context.Background()
+	creds := newTestCredentials(t, "secret1")
+
+	t.Run("Sends confirmation", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		var change model.EmailChange
+		var queued *model.EmailData
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil)
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{Email: "new@example.com"}).
+			Return(model.UserCredentials{}, pgx.ErrNoRows)
+		mockRepo.EXPECT().FindUser(ctx, &model.UserRequest{UserId: 7}).Return(&model.User{UserId: 7, Name: "Jon"}, nil)
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxNewEmailChange(ctx, tx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ pgx.Tx, ch model.EmailChange) error {
+				change = ch
+				return nil
+			})
+		mockRepo.EXPECT().TxNewOutboxEmail(ctx, tx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ pgx.Tx, data *model.EmailData, _ int64) (int64, error) {
+				queued = data
+				return 1, nil
+			})
+
+		res, err := c.ChangeEmail(ctx, &model.ChangeEmailRequest{UserId: 7, Email: " New@Example.com ", Password: "secret1", Locale: "de"})
+		require.NoError(t, err)
+		assert.True(t, tx.committed)
+
+		assert.Equal(t, "new@example.com", res.Email)
+		assert.Equal(t, change.ExpiresAt, res.ExpiresAt)
+
+		// the link goes to the new address, only the hash of its token is stored
+		require.NotNil(t, queued)
+		assert.Equal(t, model.EmailChangeEmail, queued.Subject)
+		assert.Equal(t, "new@example.com", queued.Recipient.Email)
+		assert.Equal(t, "de", queued.Locale)
+		assert.Equal(t, utils.GenerateHashFromString(queued.Token), change.TokenHash)
+		assert.Equal(t, int32(7), change.UserId)
+		assert.Equal(t, "new@example.com", change.Email)
+	})
+
+	t.Run("Wrong password", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil)
+
+		_, err := c.ChangeEmail(ctx, &model.ChangeEmailRequest{UserId: 7, Email: "new@example.com", Password: "wrong1"})
+		assert.ErrorIs(t, err, ErrWrongPassword)
+	})
+
+	t.Run("Current email", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil)
+
+		_, err := c.ChangeEmail(ctx, &model.ChangeEmailRequest{UserId: 7, Email: "Jon@example.com", Password: "secret1"})
+		assert.ErrorIs(t, err, ErrInvalidEmail)
+	})
+
+	t.Run("Email taken", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil)
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{Email: "new@example.com"}).
+			Return(model.UserCredentials{UserId: 8, Email: "new@example.com"}, nil)
+
+		_, err := c.ChangeEmail(ctx, &model.ChangeEmailRequest{UserId: 7, Email: "new@example.com", Password: "secret1"})
+		assert.ErrorIs(t, err, ErrEmailTaken)
+	})
+
+	t.Run("Invalid email", func(t *testing.T) {
+		c, _, _ := newTestController(t)
+
+		for _, email := range []string{"", "jon", "Jon <new@example.com>"} {
+			_, err := c.ChangeEmail(ctx, &model.ChangeEmailRequest{UserId: 7, Email: email, Password: "secret1"})
+			assert.ErrorIs(t, err, ErrInvalidEmail, "email %q", email)
+		}
+	})
+}
+
+func TestConfirmEmailChange(t *testing.T) {
+	ctx := context.Background()
+	change := model.EmailChange{
+		TokenHash: utils.GenerateHashFromString("token"),
+		UserId:    7,
+		Email:     "new@example.com",
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	}
+
+	t.Run("Single use", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+		retry := &fakeTx{}
+
+		gomock.InOrder(
+			mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil),
+			mockRepo.EXPECT().TxFindEmailChange(ctx, tx, change.TokenHash).Return(change, nil),
+			mockRepo.EXPECT().TxUpdateCredentialsEmail(ctx, tx, int32(7), "new@example.com").Return(nil),
+			mockRepo.EXPECT().TxDeleteEmailChange(ctx, tx, change.TokenHash).Return(nil),
+			// the confirmed change is deleted, the token is unknown afterwards
+			mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(retry, nil),
+			mockRepo.EXPECT().TxFindEmailChange(ctx, retry, change.TokenHash).Return(model.EmailChange{}, pgx.ErrNoRows),
+		)
+
+		confirmed, err := c.ConfirmEmailChange(ctx, &model.ConfirmEmailChangeRequest{Token: "token"})
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", confirmed.Email)
+		assert.True(t, tx.committed)
+
+		_, err = c.ConfirmEmailChange(ctx, &model.ConfirmEmailChangeRequest{Token: "token"})
+		assert.ErrorIs(t, err, ErrInvalidEmailToken)
+		assert.True(t, retry.rolledBack)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		expired := change
+		expired.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxFindEmailChange(ctx, tx, change.TokenHash).Return(expired, nil)
+
+		_, err := c.ConfirmEmailChange(ctx, &model.ConfirmEmailChangeRequest{Token: "token"})
+		assert.ErrorIs(t, err, ErrInvalidEmailToken)
+		assert.True(t, tx.rolledBack)
+	})
+
+	t.Run("Email taken", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		// another user registered the address after the change was requested
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxFindEmailChange(ctx, tx, change.TokenHash).Return(change, nil)
+		mockRepo.EXPECT().TxUpdateCredentialsEmail(ctx, tx, int32(7), "new@example.com").
+			Return(&pgconn.PgError{Code: pgerrcode.UniqueViolation, ConstraintName: "pf_user_credentials_email_key"})
+
+		_, err := c.ConfirmEmailChange(ctx, &model.ConfirmEmailChangeRequest{Token: "token"})
+		assert.ErrorIs(t, err, ErrEmailTaken)
+		assert.True(t, tx.rolledBack)
+	})
+
+	t.Run("Empty token", func(t *testing.T) {
+		c, _, _ := newTestController(t)
+
+		_, err := c.ConfirmEmailChange(ctx, &model.ConfirmEmailChangeRequest{})
+		assert.ErrorIs(t, err, ErrInvalidEmailToken)
+	})
+}
@@ -7,12 +7,12 @@ import (
 	"github.com/jackc/pgx/v5"
 	internalErr "pickfighter.com/auth/pkg/errors"
 	"pickfighter.com/auth/pkg/model"
-	"pickfighter.com/auth/pkg/utils"
+	"pickfighter.com/auth/pkg/password"
 	logs "pickfighter.com/pkg/logger"
 )
 
-// Register creates user credentials in a transactional context,
-// commits the transaction upon success, and asynchronously sends an email confirmation.
+// Register creates user credentials in a transactional context and queues an email confirmation
+// in the same transaction, so it is sent by the outbox worker once the transaction is committed.
 // It returns the user ID upon successful registration.
 func (c *Controller) Register(ctx context.Context, req *model.RegisterRequest) (int32, error) {
 	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
@@ -33,21 +33,29 @@ func (c *Controller) Register(ctx context.Context, req *model.RegisterRequest) (
 		return 0, err
 	}
 
-	if err = tx.Commit(ctx); err != nil {
-		logs.Errorf("Unable to commit transaction: %s", err)
-		cErr := internalErr.New(internalErr.TxCommit, err, 102)
-		return 0, cErr
-	}
-
-	// TODO
-	go c.HandleEmailEvent(ctx, &model.EmailData{
+	err = c.queueEmail(ctx, tx, &model.EmailData{
 		Subject: model.EmailRegistration,
 		Recipient: model.EmailAddrSpec{
 			Email: req.Email,
 			Name:  req.Name,
 		},
-		Token: credentials.Token,
+		Token:  credentials.Token,
+		Locale: req.Locale,
 	})
+	if err != nil {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		cErr := internalErr.New(internalErr.TxCommit, err, 102)
+		return 0, cErr
+	}
+
+	c.notifyOutbox()
 
 	return credentials.UserId, nil
 }
@@ -84,8 +92,32 @@ func (c *Controller) RegisterConfirm(ctx context.Context, req *model.UserCredent
 	return true, nil
 }
 
+// rehashPassword hashes the verified password again if it is stored with a legacy algorithm
+// or outdated parameters. Failures are logged only, the old hash keeps working.
+func (c *Controller) rehashPassword(ctx context.Context, creds *model.UserCredentials, plain string) {
+	if !passwordParams().NeedsRehash(credentialsHash(creds)) {
+		return
+	}
+
+	rehashed := *creds
+	if err := setPassword(&rehashed, plain); err != nil {
+		logs.Errorf("Failed to rehash password of User [%d]: %s", creds.UserId, err)
+		return
+	}
+
+	if err := c.repo.UpdatePassword(ctx, nil, rehashed); err != nil {
+		logs.Errorf("Failed to store rehashed password of User [%d]: %s", creds.UserId, err)
+		return
+	}
+
+	logs.Debugf("Password of User [%d] rehashed with %s", creds.UserId, rehashed.PasswordAlgorithm)
+	*creds = rehashed
+}
+
 // Login verifies user credentials by email and password,
-// generates a JWT token for authentication, and returns it.
+// generates a short-lived JWT token for authentication and a refresh token starting a new token family,
+// and returns them. The refresh token lives longer if the user asks to be remembered.
+// Users with two-factor authentication get a challenge to complete with TwoFactorLogin instead.
 // Returns an error if credentials are invalid or token generation fails.
 func (c *Controller) Login(ctx context.Context, req *model.AuthenticateRequest) (*model.AuthenticateResult, error) {
 	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
@@ -106,22 +138,22 @@ func (c *Controller) Login(ctx context.Context, req *model.AuthenticateRequest)
 		return nil, internalErr.NewDefault(internalErr.UserCredentialsIsNotActive, 406)
 	}
 
-	p := utils.GenerateSaltedHash(req.Password, creds.Salt)
-	if p != creds.Password {
+	// users created by a provider login have no password
+	if creds.Password == "" {
 		return nil, internalErr.NewDefault(internalErr.AuthFormPasswordWrong, 204)
 	}
 
-	if req.RememberMe {
-		req.ExpiresIn = 60 * 60 * 24 * 7
-	} else {
-		req.ExpiresIn = 60 * 60 * 24
+	ok, err := password.Verify(req.Password, credentialsHash(&creds))
+	if err != nil {
+		logs.Errorf("Failed to verify password of User [%d]: %s", creds.UserId, err)
+		return nil, internalErr.New(internalErr.UserCredentials, err, 417)
 	}
 
-	token, err := c.createJWTToken(ctx, &creds, req)
-	if err != nil {
-		logs.Errorf("Unable to create session for google JWT: %s", err)
-		return nil, internalErr.New(internalErr.Token, err, 602)
+	if !ok {
+		return nil, internalErr.NewDefault(internalErr.AuthFormPasswordWrong, 204)
 	}
 
-	return token, nil
+	c.rehashPassword(ctx, &creds, req.Password)
+
+	return c.login(ctx, &creds, req)
 }
@@ -8,17 +8,52 @@ import (
 
 	internalErr "pickfighter.com/auth/pkg/errors"
 	"pickfighter.com/auth/pkg/model"
+	"pickfighter.com/auth/pkg/password"
 	"pickfighter.com/auth/pkg/utils"
 	logs "pickfighter.com/pkg/logger"
+	"pickfighter.com/pkg/rbac"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
 	"github.com/spf13/viper"
 )
 
-const (
-	saltLength = 32
-)
+// passwordParams returns the algorithm and parameters of new password hashes from the password config values.
+func passwordParams() password.Params {
+	p := password.DefaultParams
+	if err := viper.UnmarshalKey("password", &p); err != nil {
+		logs.Errorf("Invalid password hashing config, using defaults: %s", err)
+		return password.DefaultParams
+	}
+
+	return p
+}
+
+// credentialsHash returns the stored password hash of the credentials.
+func credentialsHash(creds *model.UserCredentials) password.Hash {
+	return password.Hash{
+		Algorithm: creds.PasswordAlgorithm,
+		Params:    creds.PasswordParams,
+		Salt:      creds.Salt,
+		Hash:      creds.Password,
+	}
+}
+
+// setPassword hashes the plain password with the configured algorithm and sets the hash,
+// its salt, algorithm and parameters in the credentials.
+func setPassword(creds *model.UserCredentials, plain string) error {
+	h, err := passwordParams().Hash(plain)
+	if err != nil {
+		return err
+	}
+
+	creds.Password = h.Hash
+	creds.Salt = h.Salt
+	creds.PasswordAlgorithm = h.Algorithm
+	creds.PasswordParams = h.Params
+
+	return nil
+}
 
 // createUserCredentials creates user credentials during the user registration process.
 // It validates the provided email and password, creates a new user, generates a salted hash
@@ -42,6 +77,7 @@ func (c *Controller) createUserCredentials(ctx context.Context, tx pgx.Tx, req *
 
 	user := model.User{
 		Name:      req.Name,
+		Roles:     uint64(rbac.RoleUser),
 		CreatedAt: time.Now().Unix(),
 	}
 
@@ -59,21 +95,24 @@ func (c *Controller) createUserCredentials(ctx context.Context, tx pgx.Tx, req *
 		}
 	}
 
-	salt := utils.GetRandomString(saltLength)
-	password := utils.GenerateSaltedHash(req.Password, salt)
-
 	activationDisabled := !viper.GetBool("auth.require_verification")
 
 	userCredentials := model.UserCredentials{
-		UserId:   userId,
-		Email:    req.Email,
-		Password: password,
-		Salt:     salt,
-		Active:   activationDisabled,
+		UserId: userId,
+		Email:  req.Email,
+		Active: activationDisabled,
+	}
+
+	if err := setPassword(&userCredentials, req.Password); err != nil {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+		logs.Errorf("Failed to hash password during registration: %s", err)
+		return nil, internalErr.New(internalErr.UserCredentialsCreate, err, 415)
 	}
 
 	if !activationDisabled {
-		userCredentials.Token = utils.GenerateHashFromString(req.Email + password + salt + req.Name)
+		userCredentials.Token = utils.GenerateHashFromString(req.Email + userCredentials.Password + userCredentials.Salt + req.Name)
 		userCredentials.TokenExpire = time.Now().Unix() + 60*60*48
 		userCredentials.TokenType = model.TokenConfirmation
 	}
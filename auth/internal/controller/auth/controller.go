@@ -8,6 +8,8 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/spf13/viper"
+	"pickfighter.com/auth/internal/mailer"
+	"pickfighter.com/auth/internal/oidc"
 	"pickfighter.com/auth/pkg/model"
 	"pickfighter.com/auth/pkg/version"
 	"pickfighter.com/pkg/pgxs"
@@ -22,10 +24,60 @@ type authRepository interface {
 	TxNewAuthCredentials(ctx context.Context, tx pgx.Tx, uc model.UserCredentials) error
 	ConfirmCredentialsToken(ctx context.Context, tx pgx.Tx, req model.UserCredentialsRequest) error
 
-	ResetPassword(ctx context.Context, req *model.UserCredentials) error
+	ResetPassword(ctx context.Context, tx pgx.Tx, req *model.UserCredentials) error
 	UpdatePassword(ctx context.Context, tx pgx.Tx, req model.UserCredentials) error
 	TxCreateUser(ctx context.Context, tx pgx.Tx, u model.User) (int32, error)
 
+	TxNewRefreshToken(ctx context.Context, tx pgx.Tx, rt model.RefreshToken) error
+	TxFindRefreshToken(ctx context.Context, tx pgx.Tx, tokenHash string) (model.RefreshToken, error)
+	TxUseRefreshToken(ctx context.Context, tx pgx.Tx, tokenId int64, usedAt int64) error
+	TxRevokeRefreshFamily(ctx context.Context, tx pgx.Tx, familyId string, revokedAt int64) error
+
+	TxRevokeToken(ctx context.Context, tx pgx.Tx, tokenId string, userId int32, expiresAt, revokedAt int64) error
+	PurgeRevokedTokens(ctx context.Context, now int64) (int64, error)
+	TxRevokeUserTokens(ctx context.Context, tx pgx.Tx, userId int32, before int64) error
+	TxRevokeUserRefreshFamilies(ctx context.Context, tx pgx.Tx, userId int32, before, revokedAt int64) error
+	FindTokenRevocation(ctx context.Context, tokenId, sessionId string, userId int32) (bool, int64, error)
+	TxRevokeOtherRefreshFamilies(ctx context.Context, tx pgx.Tx, userId int32, keepFamilyId string, revokedAt int64) error
+
+	TxNewOutboxEmail(ctx context.Context, tx pgx.Tx, data *model.EmailData, now int64) (int64, error)
+	ClaimOutboxEmails(ctx context.Context, now, leaseUntil int64, limit int32) ([]*model.OutboxEmail, error)
+	TxUpdateOutboxEmail(ctx context.Context, tx pgx.Tx, e *model.OutboxEmail, now int64) error
+	SearchOutboxEmails(ctx context.Context, status string, limit int32) ([]*model.OutboxEmail, error)
+	TxFindOutboxEmail(ctx context.Context, tx pgx.Tx, emailId int64) (*model.OutboxEmail, error)
+
+	NewOAuthState(ctx context.Context, s model.OAuthState) error
+	ConsumeOAuthState(ctx context.Context, state string) (model.OAuthState, error)
+	TxFindUserIdentity(ctx context.Context, tx pgx.Tx, provider, subject string) (model.UserIdentity, error)
+	TxNewUserIdentity(ctx context.Context, tx pgx.Tx, i model.UserIdentity) error
+
+	TxFindUserTOTP(ctx context.Context, tx pgx.Tx, userId int32) (model.UserTOTP, error)
+	TxSaveUserTOTP(ctx context.Context, tx pgx.Tx, t model.UserTOTP) error
+	TxDeleteUserTOTP(ctx context.Context, tx pgx.Tx, userId int32) error
+	TxReplaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userId int32, hashes []string, now int64) error
+	TxUseRecoveryCode(ctx context.Context, tx pgx.Tx, userId int32, codeHash string, usedAt int64) (bool, error)
+	NewTwoFactorChallenge(ctx context.Context, c model.TwoFactorChallenge) error
+	TxFindTwoFactorChallenge(ctx context.Context, tx pgx.Tx, tokenHash string) (model.TwoFactorChallenge, error)
+	TxDeleteTwoFactorChallenge(ctx context.Context, tx pgx.Tx, tokenHash string) error
+
+	TxFindUserRoles(ctx context.Context, tx pgx.Tx, userId int32) (uint64, error)
+	TxUpdateUserRoles(ctx context.Context, tx pgx.Tx, userId int32, roles uint64, now int64) error
+	TxNewRoleChange(ctx context.Context, tx pgx.Tx, c *model.RoleChange, roles uint64) (int64, error)
+	SearchRoleChanges(ctx context.Context, userId int32, limit int32) ([]*model.RoleChange, error)
+
+	TxUpdateUserName(ctx context.Context, tx pgx.Tx, userId int32, name string, now int64) error
+	TxUpdateCredentialsEmail(ctx context.Context, tx pgx.Tx, userId int32, email string) error
+	TxNewEmailChange(ctx context.Context, tx pgx.Tx, c model.EmailChange) error
+	TxFindEmailChange(ctx context.Context, tx pgx.Tx, tokenHash string) (model.EmailChange, error)
+	TxDeleteEmailChange(ctx context.Context, tx pgx.Tx, tokenHash string) error
+
+	SearchUserIdentities(ctx context.Context, userId int32) ([]model.UserIdentity, error)
+	TxScheduleUserDeletion(ctx context.Context, tx pgx.Tx, userId int32, deleteAfter, now int64) (int64, error)
+	TxCancelUserDeletion(ctx context.Context, tx pgx.Tx, userId int32, now int64) error
+	ClaimDueUserDeletions(ctx context.Context, now, leaseUntil int64, limit int32) ([]int32, error)
+	TxAnonymizeUser(ctx context.Context, tx pgx.Tx, userId int32, pseudonym string, now int64) error
+	TxDeleteUserData(ctx context.Context, tx pgx.Tx, userId int32, email string) error
+
 	FindUser(ctx context.Context, req *model.UserRequest) (*model.User, error)
 	SearchUsers(ctx context.Context, req *model.UsersRequest) ([]*model.User, error)
 	PerformUsersRequestQuery(req *model.UsersRequest) []string
@@ -33,13 +85,24 @@ type authRepository interface {
 
 // Controller defines a metadata service controller.
 type Controller struct {
-	repo authRepository
+	repo      authRepository
+	mailer    mailer.Mailer
+	templates *mailer.Templates
+	providers *oidc.Providers
+
+	// outboxWake wakes the outbox worker up when emails are queued
+	outboxWake chan struct{}
 }
 
-// New creates a Auth service controller.
-func New(repo authRepository) *Controller {
+// New creates a Auth service controller sending emails rendered from the templates with the mailer
+// and logging users in with the OpenID Connect providers.
+func New(repo authRepository, m mailer.Mailer, templates *mailer.Templates, providers *oidc.Providers) *Controller {
 	return &Controller{
-		repo: repo,
+		repo:       repo,
+		mailer:     m,
+		templates:  templates,
+		providers:  providers,
+		outboxWake: make(chan struct{}, 1),
 	}
 }
 
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"pickfighter.com/auth/gen/mocks"
+	"pickfighter.com/auth/internal/mailer"
+)
+
+// newTestController creates a controller with a mocked repository sending emails to memory.
+func newTestController(t *testing.T) (*Controller, *mocks.MockauthRepository, *mailer.Memory) {
+	t.Helper()
+
+	templates, err := mailer.NewTemplates("")
+	require.NoError(t, err)
+
+	mockRepo := mocks.NewMockauthRepository(gomock.NewController(t))
+	mem := mailer.NewMemory()
+
+	return New(mockRepo, mem, templates, nil), mockRepo, mem
+}
+
+// fakeTx is a transaction stub recording how the transaction was finished.
+type fakeTx struct {
+	pgx.Tx
+	committed  bool
+	rolledBack bool
+}
+
+func (tx *fakeTx) Commit(ctx context.Context) error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback(ctx context.Context) error {
+	if !tx.committed {
+		tx.rolledBack = true
+	}
+	return nil
+}
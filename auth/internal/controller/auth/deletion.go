@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+	internalErr "pickfighter.com/auth/pkg/errors"
+	"pickfighter.com/auth/pkg/model"
+	"pickfighter.com/auth/pkg/utils"
+	logs "pickfighter.com/pkg/logger"
+)
+
+const (
+	defaultDueDeletionsLimit int32 = 50
+	maxDueDeletionsLimit     int32 = 500
+	// pseudonymTokenLength is the number of random bytes of the name given to anonymized users.
+	pseudonymTokenLength = 12
+)
+
+var (
+	// ErrDeletionNotScheduled is returned when cancelling the deletion of an account which is not scheduled
+	// or whose grace period is over.
+	ErrDeletionNotScheduled = errors.New("account deletion is not scheduled")
+	// ErrDeletionNotDue is returned when anonymizing an account before its grace period is over.
+	ErrDeletionNotDue = errors.New("account deletion is not due")
+)
+
+// ExportAccount collects the personal data kept about the user: the profile with the login email,
+// linked provider identities, the two-factor state and the changes of the user's roles.
+func (c *Controller) ExportAccount(ctx context.Context, req *model.UserRequest) (*model.AccountExport, error) {
+	user, err := c.repo.FindUser(ctx, &model.UserRequest{UserId: req.UserId})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("user %d: %w", req.UserId, ErrNotFound)
+	} else if err != nil {
+		logs.Errorf("Failed to get user: %s", err)
+		return nil, internalErr.New(internalErr.DBGetUser, err, 806)
+	}
+
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: req.UserId})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return nil, internalErr.New(internalErr.UserCredentials, err, 430)
+	}
+	user.Email = creds.Email
+
+	identities, err := c.repo.SearchUserIdentities(ctx, req.UserId)
+	if err != nil {
+		logs.Errorf("Failed to find identities of User [%d]: %s", req.UserId, err)
+		return nil, internalErr.New(internalErr.AccountExport, err, 1312)
+	}
+
+	export := &model.AccountExport{
+		User:       *user,
+		Identities: identities,
+	}
+
+	t, err := c.repo.TxFindUserTOTP(ctx, nil, req.UserId)
+	if err == nil {
+		export.TwoFactorEnabled = t.Enabled
+		export.TwoFactorEnabledAt = t.EnabledAt
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		logs.Errorf("Failed to find authenticator of User [%d]: %s", req.UserId, err)
+		return nil, internalErr.New(internalErr.AccountExport, err, 1313)
+	}
+
+	export.RoleChanges, err = c.repo.SearchRoleChanges(ctx, req.UserId, maxRoleAuditLimit)
+	if err != nil {
+		logs.Errorf("Failed to search role changes: %s", err)
+		return nil, internalErr.New(internalErr.AccountExport, err, 1314)
+	}
+
+	return export, nil
+}
+
+// DeleteAccount schedules the deletion of the user's account after `auth.account.deletion_grace`
+// and logs the user out everywhere. The password is required if the user has one. Until the
+// deletion is due the user may log in again and cancel it, after that the account is anonymized
+// by AnonymizeAccount. It returns ErrWrongPassword or an error wrapping ErrNotFound.
+func (c *Controller) DeleteAccount(ctx context.Context, req *model.DeleteAccountRequest) (*model.AccountDeletion, error) {
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
+		UserId: req.UserId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("user %d: %w", req.UserId, ErrNotFound)
+	} else if err != nil {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return nil, internalErr.New(internalErr.UserCredentials, err, 431)
+	}
+
+	if creds.Password != "" {
+		if err := verifyCurrentPassword(&creds, req.Password); errors.Is(err, ErrWrongPassword) {
+			return nil, err
+		} else if err != nil {
+			logs.Errorf("Failed to verify password of User [%d]: %s", creds.UserId, err)
+			return nil, internalErr.New(internalErr.AccountDeletion, err, 1316)
+		}
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return nil, internalErr.New(internalErr.Tx, err, 137)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	now := time.Now()
+	deleteAfter, err := c.repo.TxScheduleUserDeletion(ctx, tx, req.UserId,
+		now.Add(viper.GetDuration("auth.account.deletion_grace")).Unix(), now.Unix())
+	if errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return nil, fmt.Errorf("user %d: %w", req.UserId, ErrNotFound)
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to schedule deletion of User [%d]: %s", req.UserId, err)
+		return nil, internalErr.New(internalErr.AccountDeletion, err, 1317)
+	}
+
+	if err := c.repo.TxRevokeUserTokens(ctx, tx, req.UserId, now.Unix()); err != nil {
+		rollback()
+		logs.Errorf("Failed to revoke user tokens: %s", err)
+		return nil, internalErr.New(internalErr.AccountDeletion, err, 1318)
+	}
+
+	if err := c.repo.TxRevokeUserRefreshFamilies(ctx, tx, req.UserId, now.Unix(), now.Unix()); err != nil {
+		rollback()
+		logs.Errorf("Failed to revoke user refresh tokens: %s", err)
+		return nil, internalErr.New(internalErr.AccountDeletion, err, 1319)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return nil, internalErr.New(internalErr.TxCommit, err, 138)
+	}
+
+	logs.Infof("User [%d] scheduled the account deletion after %s", req.UserId, time.Unix(deleteAfter, 0).Format(time.RFC3339))
+
+	return &model.AccountDeletion{DeleteAfter: deleteAfter}, nil
+}
+
+// CancelAccountDeletion keeps the account of the user whose deletion is scheduled and still in the grace period.
+// It returns ErrDeletionNotScheduled.
+func (c *Controller) CancelAccountDeletion(ctx context.Context, req *model.UserRequest) error {
+	err := c.repo.TxCancelUserDeletion(ctx, nil, req.UserId, time.Now().Unix())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("user %d: %w", req.UserId, ErrDeletionNotScheduled)
+	} else if err != nil {
+		logs.Errorf("Failed to cancel deletion of User [%d]: %s", req.UserId, err)
+		return internalErr.New(internalErr.AccountDeletion, err, 1320)
+	}
+
+	logs.Infof("User [%d] cancelled the account deletion", req.UserId)
+
+	return nil
+}
+
+// DueAccountDeletions claims up to limit users whose grace period is over and who are to be anonymized.
+// Claimed users are not returned again for `auth.account.anonymize_lease`, so anonymizers running
+// at the same time never get the same users, and the users of an anonymizer which died are retried after it.
+func (c *Controller) DueAccountDeletions(ctx context.Context, limit int32) ([]int32, error) {
+	if limit <= 0 {
+		limit = defaultDueDeletionsLimit
+	}
+	limit = min(limit, maxDueDeletionsLimit)
+
+	now := time.Now()
+	userIds, err := c.repo.ClaimDueUserDeletions(ctx, now.Unix(), now.Add(viper.GetDuration("auth.account.anonymize_lease")).Unix(), limit)
+	if err != nil {
+		logs.Errorf("Failed to search due account deletions: %s", err)
+		return nil, internalErr.New(internalErr.AccountDeletion, err, 1321)
+	}
+
+	return userIds, nil
+}
+
+// AnonymizeAccount deletes the credentials and the other identifying data of a user whose deletion is due.
+// The user row stays with a random pseudonym as the name, so the roles audit keeps pointing to a user.
+// Bets are moved to a pseudonym by the event service. It returns ErrDeletionNotDue.
+func (c *Controller) AnonymizeAccount(ctx context.Context, req *model.UserRequest) error {
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: req.UserId})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return internalErr.New(internalErr.UserCredentials, err, 432)
+	}
+
+	token, err := utils.GetSecureToken(pseudonymTokenLength)
+	if err != nil {
+		return internalErr.New(internalErr.AccountDeletion, err, 1322)
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return internalErr.New(internalErr.Tx, err, 139)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	if err := c.repo.TxAnonymizeUser(ctx, tx, req.UserId, "deleted-"+token, time.Now().Unix()); errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return fmt.Errorf("user %d: %w", req.UserId, ErrDeletionNotDue)
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to anonymize User [%d]: %s", req.UserId, err)
+		return internalErr.New(internalErr.AccountDeletion, err, 1323)
+	}
+
+	if err := c.repo.TxDeleteUserData(ctx, tx, req.UserId, creds.Email); err != nil {
+		rollback()
+		logs.Errorf("Failed to delete data of User [%d]: %s", req.UserId, err)
+		return internalErr.New(internalErr.AccountDeletion, err, 1324)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return internalErr.New(internalErr.TxCommit, err, 140)
+	}
+
+	logs.Infof("User [%d] is anonymized", req.UserId)
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"pickfighter.com/auth/pkg/model"
+)
+
+func TestDeleteAccount(t *testing.T) {
+	ctx := context.Background()
+	viper.Set("auth.account.deletion_grace", 30*24*time.Hour)
+	t.Cleanup(viper.Reset)
+
+	c, mockRepo, _ := newTestController(t)
+	tx := &fakeTx{}
+	creds := newTestCredentials(t, "secret1")
+
+	var deleteAfter int64
+	mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil)
+	mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+	mockRepo.EXPECT().TxScheduleUserDeletion(ctx, tx, int32(7), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ pgx.Tx, _ int32, after, now int64) (int64, error) {
+			assert.Equal(t, now+int64((30*24*time.Hour).Seconds()), after)
+			deleteAfter = after
+			return after, nil
+		})
+	mockRepo.EXPECT().TxRevokeUserTokens(ctx, tx, int32(7), gomock.Any()).Return(nil)
+	mockRepo.EXPECT().TxRevokeUserRefreshFamilies(ctx, tx, int32(7), gomock.Any(), gomock.Any()).Return(nil)
+
+	deletion, err := c.DeleteAccount(ctx, &model.DeleteAccountRequest{UserId: 7, Password: "secret1"})
+	require.NoError(t, err)
+	assert.Equal(t, deleteAfter, deletion.DeleteAfter)
+	assert.True(t, tx.committed)
+}
+
+func TestCancelAccountDeletion(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name        string
+		deleteAfter int64
+		err         error
+	}{
+		{name: "Within the grace period", deleteAfter: now + 60},
+		{name: "Due now", deleteAfter: now, err: ErrDeletionNotScheduled},
+		{name: "Overdue", deleteAfter: now - 60, err: ErrDeletionNotScheduled},
+		{name: "Not scheduled", err: ErrDeletionNotScheduled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, mockRepo, _ := newTestController(t)
+
+			// the repository keeps a deletion only while it is due after the given time
+			mockRepo.EXPECT().TxCancelUserDeletion(ctx, nil, int32(7), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, _ int32, at int64) error {
+					assert.GreaterOrEqual(t, at, now)
+					if tt.deleteAfter == 0 || tt.deleteAfter <= at {
+						return pgx.ErrNoRows
+					}
+					return nil
+				})
+
+			err := c.CancelAccountDeletion(ctx, &model.UserRequest{UserId: 7})
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDueAccountDeletions(t *testing.T) {
+	ctx := context.Background()
+	viper.Set("auth.account.anonymize_lease", 10*time.Minute)
+	t.Cleanup(viper.Reset)
+
+	for limit, claimed := range map[int32]int32{0: defaultDueDeletionsLimit, 20: 20, 1000: maxDueDeletionsLimit} {
+		c, mockRepo, _ := newTestController(t)
+
+		// claimed users are leased, so another anonymizer does not get them at the same time
+		mockRepo.EXPECT().ClaimDueUserDeletions(ctx, gomock.Any(), gomock.Any(), claimed).
+			DoAndReturn(func(_ context.Context, now, leaseUntil int64, _ int32) ([]int32, error) {
+				assert.Equal(t, now+int64((10*time.Minute).Seconds()), leaseUntil)
+				return []int32{7}, nil
+			})
+
+		userIds, err := c.DueAccountDeletions(ctx, limit)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{7}, userIds)
+	}
+}
+
+func TestAnonymizeAccount(t *testing.T) {
+	ctx := context.Background()
+	creds := model.UserCredentials{UserId: 7, Email: "jon@example.com"}
+
+	t.Run("Due", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		gomock.InOrder(
+			mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil),
+			mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil),
+			mockRepo.EXPECT().TxAnonymizeUser(ctx, tx, int32(7), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, _ int32, pseudonym string, _ int64) error {
+					assert.True(t, strings.HasPrefix(pseudonym, "deleted-"))
+					return nil
+				}),
+			// the outbox emails are found by the address of the deleted credentials
+			mockRepo.EXPECT().TxDeleteUserData(ctx, tx, int32(7), "jon@example.com").Return(nil),
+		)
+
+		require.NoError(t, c.AnonymizeAccount(ctx, &model.UserRequest{UserId: 7}))
+		assert.True(t, tx.committed)
+	})
+
+	t.Run("Not due", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		// a cancelled deletion or an account anonymized by an earlier run keeps its data
+		mockRepo.EXPECT().FindUserCredentials(ctx, model.UserCredentialsRequest{UserId: 7}).Return(creds, nil)
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxAnonymizeUser(ctx, tx, int32(7), gomock.Any(), gomock.Any()).Return(pgx.ErrNoRows)
+
+		err := c.AnonymizeAccount(ctx, &model.UserRequest{UserId: 7})
+		assert.ErrorIs(t, err, ErrDeletionNotDue)
+		assert.True(t, tx.rolledBack)
+	})
+}
@@ -3,72 +3,51 @@ package auth
 import (
 	"context"
 	"fmt"
-	"log"
+	"net/url"
 
-	"pickfighter.com/auth/pkg/model"
 	"github.com/spf13/viper"
-	"gopkg.in/gomail.v2"
+	"pickfighter.com/auth/pkg/model"
+	logs "pickfighter.com/pkg/logger"
 )
 
-// HandleEmailEvent processes different email events based on the provided EmailData.
-// It uses the gomail package to send emails through an SMTP server. The email content
-// and recipient details are determined by the event type, such as registration or
-// password reset.
-func (c *Controller) HandleEmailEvent(ctx context.Context, data *model.EmailData) {
-	d := gomail.NewDialer("smtp.gmail.com", 587, viper.GetString("mail.sender_address"), viper.GetString("mail.app_password"))
-	host := viper.GetString("web.host")
-	port := viper.GetString("web.port")
-
-	var m *gomail.Message
+// emailPaths are the web app pages the links of emails lead to, the token is added as a query parameter.
+var emailPaths = map[string]string{
+	model.EmailRegistration:  "/register/confirm",
+	model.EmailResetPassword: "/password/recover",
+	model.EmailChangeEmail:   "/email/confirm",
+}
 
-	switch data.Subject {
-	case model.EmailRegistration:
-		m = getVerificationMessage(data, host, port)
-	case model.EmailResetPassword:
-		m = getPasswordRecoveryMessage(data, host, port)
-	default:
-		fmt.Println("Unexpected subject")
+// HandleEmailEvent renders the email of data.Subject in the recipient's locale and sends it with the mailer.
+// The sender defaults to the configured address and the link to the web app page of the subject.
+// Failures are logged and returned, they never stop the service.
+func (c *Controller) HandleEmailEvent(ctx context.Context, data *model.EmailData) error {
+	if data.Sender.Email == "" {
+		data.Sender = model.EmailAddrSpec{
+			Email: viper.GetString("mail.sender_address"),
+			Name:  viper.GetString("mail.sender_name"),
+		}
 	}
 
-	if err := d.DialAndSend(m); err != nil {
-		fmt.Println("Unable to send your email")
-		log.Fatal(err)
+	if data.Url == "" {
+		data.Url = emailLink(data.Subject, data.Token)
 	}
-}
-
-// getVerificationMessage generates a verification email message.
-// It utilizes the provided EmailData and server information (host, port) to create
-// a message with a verification link. The sender, recipient, subject, and body are
-// set accordingly in the gomail.Message.
-func getVerificationMessage(data *model.EmailData, host, port string) *gomail.Message {
-	m := gomail.NewMessage()
-
-	text := fmt.Sprintf("Hello, here is your verification link: %s:%s/register/confirm?token=%s", host, port, data.Token)
 
-	m.SetHeader("From", viper.GetString("mail.sender_address"))
-	m.SetHeader("To", data.Recipient.Email)
-	m.SetHeader("Subject", "Please, Verify your email.")
+	m, err := c.templates.Render(data)
+	if err != nil {
+		logs.Errorf("Unable to render %q email: %s", data.Subject, err)
+		return err
+	}
 
-	m.SetBody("text/plain", text)
+	if err := c.mailer.Send(ctx, m); err != nil {
+		logs.Errorf("Unable to send %q email: %s", data.Subject, err)
+		return err
+	}
 
-	return m
+	return nil
 }
 
-// getPasswordRecoveryMessage generates a password recovery email message using the provided
-// EmailData and server information (host, port). It constructs a message with a recovery link
-// containing the host, port, and token. The email sender and recipient addresses, as well as
-// the subject, are set in the message headers. The message body is a plain text representation
-// containing the recovery link.
-func getPasswordRecoveryMessage(data *model.EmailData, host, port string) *gomail.Message {
-	m := gomail.NewMessage()
-
-	text := fmt.Sprintf("Hello, here you can change your password: %s:%s/password/recover?token=%s", host, port, data.Token)
-
-	m.SetHeader("From", viper.GetString("mail.sender_address"))
-	m.SetHeader("To", data.Recipient.Email)
-	m.SetHeader("Subject", "Please, Set a new password")
-
-	m.SetBody("text/plain", text)
-
-	return m
+// emailLink returns the web app link of the email subject with the token.
+func emailLink(subject, token string) string {
+	return fmt.Sprintf("%s:%s%s?token=%s",
+		viper.GetString("web.host"), viper.GetString("web.port"), emailPaths[subject], url.QueryEscape(token))
 }
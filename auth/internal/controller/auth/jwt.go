@@ -7,6 +7,7 @@ import (
 	authmodel "pickfighter.com/auth/pkg/model"
 	logs "pickfighter.com/pkg/logger"
 	"pickfighter.com/pkg/model"
+	"pickfighter.com/pkg/rbac"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -18,7 +19,7 @@ import (
 )
 
 // createJWTToken generates a JWT token for the provided user credentials and authentication request.
-// It includes user-specific claims such as user ID, user roles, and audience.
+// It includes user-specific claims such as user ID, user roles with the permissions they grant, and audience.
 // The token is signed using RS256 algorithm with the configured signing key.
 // Function returns resulting authentication token information (model.AuthenticateRequest),
 // including token ID, access token, and expiration time.
@@ -62,6 +63,13 @@ func (c *Controller) createJWTToken(ctx context.Context, creds *authmodel.UserCr
 		return nil, err
 	}
 
+	if req.SessionId != "" {
+		if err := t.Set(string(model.ContextSessionId), req.SessionId); err != nil {
+			logs.Errorf("Unable to set JWT token session id: %s", err)
+			return nil, err
+		}
+	}
+
 	if u.Flags > 0 {
 		if err := t.Set(string(model.ContextFlags), u.Flags); err != nil {
 			logs.Errorf("Unable to set JWT token private claim key: %s", err)
@@ -69,6 +77,19 @@ func (c *Controller) createJWTToken(ctx context.Context, creds *authmodel.UserCr
 		}
 	}
 
+	roles := rbac.Set(u.Roles)
+	if names := roles.Names(); len(names) > 0 {
+		if err := t.Set(string(model.ContextRoles), names); err != nil {
+			logs.Errorf("Unable to set JWT token roles: %s", err)
+			return nil, err
+		}
+
+		if err := t.Set(string(model.ContextPermissions), roles.PermissionNames()); err != nil {
+			logs.Errorf("Unable to set JWT token permissions: %s", err)
+			return nil, err
+		}
+	}
+
 	// buf, err := json.MarshalIndent(t, "", "  ")
 	// if err != nil {
 	// 	s.Logger.Errorf("Failed to generate JWT Token JSON: %s", err)
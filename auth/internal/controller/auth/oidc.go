@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+	"golang.org/x/oauth2"
+	"pickfighter.com/auth/internal/oidc"
+	internalErr "pickfighter.com/auth/pkg/errors"
+	"pickfighter.com/auth/pkg/model"
+	"pickfighter.com/auth/pkg/utils"
+	logs "pickfighter.com/pkg/logger"
+	"pickfighter.com/pkg/rbac"
+)
+
+// oauthSecretLength is the number of random bytes of OAuth states and nonces.
+const oauthSecretLength = 32
+
+var (
+	// ErrInvalidOAuthState is returned for unknown, expired or already used login states.
+	ErrInvalidOAuthState = errors.New("OAuth state is invalid or expired")
+	// ErrEmailNotVerified is returned when a provider did not verify the email of a user
+	// who is not linked yet, the email can't be trusted to find or create the account.
+	ErrEmailNotVerified = errors.New("email is not verified by the provider")
+)
+
+// OAuthLogin starts a login with an OpenID Connect provider. It stores the state, nonce and PKCE
+// code verifier of the login for `auth.oidc.state_ttl` and returns the provider's login page URL.
+// It returns an error wrapping oidc.ErrUnknownProvider for providers which are not configured.
+func (c *Controller) OAuthLogin(ctx context.Context, req *model.OAuthLoginRequest) (*model.OAuthLoginResult, error) {
+	p, err := c.providers.Get(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := utils.GetSecureToken(oauthSecretLength)
+	if err != nil {
+		return nil, internalErr.New(internalErr.OAuthLogin, err, 1001)
+	}
+
+	nonce, err := utils.GetSecureToken(oauthSecretLength)
+	if err != nil {
+		return nil, internalErr.New(internalErr.OAuthLogin, err, 1002)
+	}
+
+	verifier := oauth2.GenerateVerifier()
+
+	authUrl, err := p.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		logs.Errorf("Unable to start %s login: %s", p.Name(), err)
+		return nil, internalErr.New(internalErr.OAuthLogin, err, 1003)
+	}
+
+	now := time.Now()
+	s := model.OAuthState{
+		State:        state,
+		Provider:     p.Name(),
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		RememberMe:   req.RememberMe,
+		CreatedAt:    now.Unix(),
+		ExpiresAt:    now.Add(viper.GetDuration("auth.oidc.state_ttl")).Unix(),
+	}
+
+	if err := c.repo.NewOAuthState(ctx, s); err != nil {
+		logs.Errorf("Failed to store OAuth state: %s", err)
+		return nil, internalErr.New(internalErr.OAuthLogin, err, 1004)
+	}
+
+	return &model.OAuthLoginResult{
+		AuthUrl:   authUrl,
+		State:     state,
+		ExpiresAt: s.ExpiresAt,
+	}, nil
+}
+
+// OAuthCallback completes a login with an OpenID Connect provider: the state is used up, the code is
+// exchanged with the PKCE verifier and the ID token is verified with the nonce of the login.
+// The user linked to the provider's subject is logged in, a user with the verified email is linked,
+// otherwise a new user is created. It issues tokens or a two-factor challenge like Login.
+//
+// It returns ErrInvalidOAuthState, ErrEmailNotVerified or errors wrapping oidc.ErrInvalidIDToken
+// and oidc.ErrExchange when the login can't be trusted.
+func (c *Controller) OAuthCallback(ctx context.Context, req *model.OAuthCallbackRequest) (*model.AuthenticateResult, error) {
+	if req.State == "" || req.Code == "" {
+		return nil, ErrInvalidOAuthState
+	}
+
+	s, err := c.repo.ConsumeOAuthState(ctx, req.State)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidOAuthState
+	} else if err != nil {
+		logs.Errorf("Failed to find OAuth state: %s", err)
+		return nil, internalErr.New(internalErr.OAuthCallback, err, 1005)
+	}
+
+	if s.Provider != req.Provider || time.Now().Unix() > s.ExpiresAt {
+		return nil, ErrInvalidOAuthState
+	}
+
+	p, err := c.providers.Get(s.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := p.Exchange(ctx, req.Code, s.CodeVerifier, s.Nonce)
+	if err != nil {
+		logs.Warnf("Failed %s login: %s", p.Name(), err)
+		if errors.Is(err, oidc.ErrInvalidIDToken) || errors.Is(err, oidc.ErrExchange) {
+			return nil, err
+		}
+		return nil, internalErr.New(internalErr.OAuthCallback, err, 1006)
+	}
+
+	userId, err := c.oauthUser(ctx, p.Name(), claims)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return nil, internalErr.New(internalErr.UserCredentials, err, 418)
+	}
+
+	return c.login(ctx, &creds, &model.AuthenticateRequest{
+		Email:      creds.Email,
+		RememberMe: s.RememberMe,
+		UserAgent:  req.UserAgent,
+		IpAddress:  req.IpAddress,
+	})
+}
+
+// oauthUser returns the user linked to the provider's subject. A subject which is not linked yet
+// is linked to the user with the verified email or to a new user. Linking to a user who never
+// confirmed the email activates them and drops the password, which was never proven to be the owner's.
+func (c *Controller) oauthUser(ctx context.Context, provider string, claims *oidc.Claims) (int32, error) {
+	identity, err := c.repo.TxFindUserIdentity(ctx, nil, provider, claims.Subject)
+	if err == nil {
+		return identity.UserId, nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		logs.Errorf("Failed to find user identity: %s", err)
+		return 0, internalErr.New(internalErr.OAuthCallback, err, 1007)
+	}
+
+	if !claims.EmailVerified || claims.Email == "" {
+		return 0, fmt.Errorf("%w: %s subject %s", ErrEmailNotVerified, provider, claims.Subject)
+	}
+
+	email := strings.ToLower(claims.Email)
+
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
+		Email: email,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return 0, internalErr.New(internalErr.UserCredentials, err, 419)
+	}
+	found := err == nil
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return 0, internalErr.New(internalErr.Tx, err, 121)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	now := time.Now().Unix()
+
+	switch {
+	case !found:
+		userId, err := c.repo.TxCreateUser(ctx, tx, model.User{
+			Name:      claims.Name,
+			Roles:     uint64(rbac.RoleUser),
+			CreatedAt: now,
+		})
+		if err != nil {
+			rollback()
+			logs.Errorf("Failed to create user for %s login: %s", provider, err)
+			return 0, internalErr.New(internalErr.UserCredentialsCreate, err, 420)
+		}
+
+		creds = model.UserCredentials{
+			UserId: userId,
+			Email:  email,
+			Active: true,
+		}
+
+		if err := c.repo.TxNewAuthCredentials(ctx, tx, creds); err != nil {
+			rollback()
+			logs.Errorf("Failed to create user credentials for %s login: %s", provider, err)
+			return 0, internalErr.New(internalErr.UserCredentialsCreate, err, 421)
+		}
+	case !creds.Active:
+		if err := c.repo.ConfirmCredentialsToken(ctx, tx, model.UserCredentialsRequest{UserId: creds.UserId}); err != nil {
+			rollback()
+			logs.Errorf("Failed to activate user credentials: %s", err)
+			return 0, internalErr.New(internalErr.UserCredentialsUpdate, err, 422)
+		}
+
+		if err := c.repo.UpdatePassword(ctx, tx, model.UserCredentials{UserId: creds.UserId}); err != nil {
+			rollback()
+			logs.Errorf("Failed to drop unconfirmed password: %s", err)
+			return 0, internalErr.New(internalErr.UserCredentialsUpdate, err, 423)
+		}
+	}
+
+	err = c.repo.TxNewUserIdentity(ctx, tx, model.UserIdentity{
+		Provider:  provider,
+		Subject:   claims.Subject,
+		UserId:    creds.UserId,
+		Email:     email,
+		CreatedAt: now,
+	})
+	if err != nil {
+		rollback()
+		logs.Errorf("Failed to link user identity: %s", err)
+		return 0, internalErr.New(internalErr.OAuthCallback, err, 1008)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return 0, internalErr.New(internalErr.TxCommit, err, 122)
+	}
+
+	logs.Infof("User [%d] linked to %s subject %s", creds.UserId, provider, claims.Subject)
+
+	return creds.UserId, nil
+}
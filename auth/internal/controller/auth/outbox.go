@@ -0,0 +1,231 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+	"pickfighter.com/auth/internal/mailer"
+	internalErr "pickfighter.com/auth/pkg/errors"
+	"pickfighter.com/auth/pkg/model"
+	logs "pickfighter.com/pkg/logger"
+)
+
+// ErrEmailSent is returned when resending an email which was already sent.
+var ErrEmailSent = errors.New("email is already sent")
+
+const (
+	defaultOutboxLimit = 50
+	maxOutboxLimit     = 500
+)
+
+// outboxConfig holds the outbox worker settings, read from the `mail.outbox.*` config values.
+type outboxConfig struct {
+	pollInterval  time.Duration
+	batchSize     int32
+	maxAttempts   int32
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+	lease         time.Duration
+}
+
+func outboxSettings() outboxConfig {
+	return outboxConfig{
+		pollInterval:  viper.GetDuration("mail.outbox.poll_interval"),
+		batchSize:     viper.GetInt32("mail.outbox.batch_size"),
+		maxAttempts:   viper.GetInt32("mail.outbox.max_attempts"),
+		retryDelay:    viper.GetDuration("mail.outbox.retry_delay"),
+		maxRetryDelay: viper.GetDuration("mail.outbox.max_retry_delay"),
+		lease:         viper.GetDuration("mail.outbox.lease"),
+	}
+}
+
+// retryDelayAfter returns the delay before the next attempt after the given number of failed ones,
+// it doubles with every attempt up to maxRetryDelay.
+func (cfg outboxConfig) retryDelayAfter(attempts int32) time.Duration {
+	d := cfg.retryDelay
+	for i := int32(1); i < attempts && d < cfg.maxRetryDelay; i++ {
+		d *= 2
+	}
+
+	return min(d, cfg.maxRetryDelay)
+}
+
+// queueEmail queues the email in the outbox within the transaction, it is sent by the outbox worker
+// once the transaction is committed.
+func (c *Controller) queueEmail(ctx context.Context, tx pgx.Tx, data *model.EmailData) error {
+	if _, err := c.repo.TxNewOutboxEmail(ctx, tx, data, time.Now().Unix()); err != nil {
+		logs.Errorf("Failed to queue %q email: %s", data.Subject, err)
+		return internalErr.New(internalErr.EmailOutbox, err, 901)
+	}
+
+	return nil
+}
+
+// notifyOutbox wakes the outbox worker up to send emails queued by a committed transaction
+// without waiting for the next poll.
+func (c *Controller) notifyOutbox() {
+	select {
+	case c.outboxWake <- struct{}{}:
+	default:
+	}
+}
+
+// RunOutbox sends queued emails until ctx is done. It polls the outbox every `mail.outbox.poll_interval`
+// and right after emails are queued. Several instances of the service may run it at the same time.
+func (c *Controller) RunOutbox(ctx context.Context) {
+	cfg := outboxSettings()
+
+	ticker := time.NewTicker(cfg.pollInterval)
+	defer ticker.Stop()
+
+	logs.Infof("Email outbox worker started, polling every %s", cfg.pollInterval)
+
+	for {
+		if _, err := c.DeliverOutbox(ctx); err != nil {
+			logs.Errorf("Failed to deliver outbox emails: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			logs.Info("Email outbox worker stopped")
+			return
+		case <-ticker.C:
+		case <-c.outboxWake:
+		}
+	}
+}
+
+// DeliverOutbox sends the emails due now in batches and returns the number of sent ones.
+// A failed email is retried with an exponentially growing delay and dead-lettered after
+// `mail.outbox.max_attempts` attempts or if it can't be rendered.
+func (c *Controller) DeliverOutbox(ctx context.Context) (int, error) {
+	cfg := outboxSettings()
+
+	var sent int
+	for ctx.Err() == nil {
+		now := time.Now()
+
+		emails, err := c.repo.ClaimOutboxEmails(ctx, now.Unix(), now.Add(cfg.lease).Unix(), cfg.batchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, e := range emails {
+			if c.deliverOutboxEmail(ctx, cfg, e) {
+				sent++
+			}
+		}
+
+		if int32(len(emails)) < cfg.batchSize {
+			break
+		}
+	}
+
+	return sent, nil
+}
+
+// deliverOutboxEmail sends a claimed email and stores the outcome, it tells whether the email was sent.
+func (c *Controller) deliverOutboxEmail(ctx context.Context, cfg outboxConfig, e *model.OutboxEmail) bool {
+	err := c.HandleEmailEvent(ctx, e.Data)
+	if err != nil && ctx.Err() != nil {
+		// stopped on the way, the email is due again when the lease passes
+		return false
+	}
+
+	now := time.Now()
+	e.Attempts++
+
+	switch {
+	case err == nil:
+		e.Status = model.OutboxSent
+		e.SentAt = now.Unix()
+		e.LastError = ""
+	case e.Attempts >= cfg.maxAttempts || errors.Is(err, mailer.ErrNoTemplate):
+		e.Status = model.OutboxDead
+		e.LastError = err.Error()
+		logs.Warnf("Email %d dead-lettered after %d attempts: %s", e.EmailId, e.Attempts, err)
+	default:
+		e.NextAttemptAt = now.Add(cfg.retryDelayAfter(e.Attempts)).Unix()
+		e.LastError = err.Error()
+	}
+
+	if err := c.repo.TxUpdateOutboxEmail(ctx, nil, e, now.Unix()); err != nil {
+		logs.Errorf("Failed to update outbox email %d: %s", e.EmailId, err)
+	}
+
+	return e.Status == model.OutboxSent
+}
+
+// EmailOutbox returns outbox emails with the given status, the newest first.
+func (c *Controller) EmailOutbox(ctx context.Context, req *model.EmailOutboxRequest) ([]*model.OutboxEmail, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultOutboxLimit
+	}
+	limit = min(limit, maxOutboxLimit)
+
+	emails, err := c.repo.SearchOutboxEmails(ctx, req.Status, limit)
+	if err != nil {
+		logs.Errorf("Failed to search outbox emails: %s", err)
+		return nil, internalErr.New(internalErr.EmailOutbox, err, 902)
+	}
+
+	return emails, nil
+}
+
+// ResendEmail queues a pending or dead email to be sent now with a fresh number of attempts.
+// It returns ErrNotFound for an unknown email and ErrEmailSent if it was already sent.
+func (c *Controller) ResendEmail(ctx context.Context, req *model.ResendEmailRequest) (*model.OutboxEmail, error) {
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return nil, internalErr.New(internalErr.Tx, err, 119)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	e, err := c.repo.TxFindOutboxEmail(ctx, tx, req.EmailId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return nil, fmt.Errorf("email %d: %w", req.EmailId, ErrNotFound)
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to find outbox email: %s", err)
+		return nil, internalErr.New(internalErr.EmailResend, err, 903)
+	}
+
+	if e.Status == model.OutboxSent {
+		rollback()
+		return nil, fmt.Errorf("email %d: %w", req.EmailId, ErrEmailSent)
+	}
+
+	now := time.Now().Unix()
+	e.Status = model.OutboxPending
+	e.Attempts = 0
+	e.NextAttemptAt = now
+
+	if err := c.repo.TxUpdateOutboxEmail(ctx, tx, e, now); err != nil {
+		rollback()
+		logs.Errorf("Failed to update outbox email: %s", err)
+		return nil, internalErr.New(internalErr.EmailResend, err, 904)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return nil, internalErr.New(internalErr.TxCommit, err, 120)
+	}
+
+	c.notifyOutbox()
+
+	return e, nil
+}
@@ -0,0 +1,227 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"pickfighter.com/auth/internal/mailer"
+	"pickfighter.com/auth/pkg/model"
+)
+
+func setOutboxConfig(t *testing.T, batchSize int) {
+	t.Helper()
+
+	viper.Set("mail.outbox.batch_size", batchSize)
+	viper.Set("mail.outbox.max_attempts", 3)
+	viper.Set("mail.outbox.retry_delay", time.Minute)
+	viper.Set("mail.outbox.max_retry_delay", time.Hour)
+	viper.Set("mail.outbox.lease", 5*time.Minute)
+	viper.Set("mail.sender_address", "noreply@pickfighter.com")
+	t.Cleanup(viper.Reset)
+}
+
+func outboxEmail(emailId int64, subject string, attempts int32) *model.OutboxEmail {
+	return &model.OutboxEmail{
+		EmailId:  emailId,
+		Subject:  subject,
+		Status:   model.OutboxPending,
+		Attempts: attempts,
+		Data: &model.EmailData{
+			Recipient: model.EmailAddrSpec{Email: "user@example.com", Name: "Jon"},
+			Subject:   subject,
+			Token:     "token123",
+			Locale:    "es-MX",
+		},
+	}
+}
+
+func TestRetryDelayAfter(t *testing.T) {
+	cfg := outboxConfig{retryDelay: time.Minute, maxRetryDelay: time.Hour}
+
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 0, want: time.Minute},
+		{attempts: 1, want: time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 3, want: 4 * time.Minute},
+		{attempts: 6, want: 32 * time.Minute},
+		{attempts: 7, want: time.Hour},
+		{attempts: 1000, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, cfg.retryDelayAfter(tt.attempts), "attempts %d", tt.attempts)
+	}
+
+	// a first delay longer than the max one is capped too
+	cfg.retryDelay = 2 * time.Hour
+	assert.Equal(t, time.Hour, cfg.retryDelayAfter(1))
+}
+
+func TestDeliverOutbox(t *testing.T) {
+	ctx := context.Background()
+
+	// deliver claims a single email and returns it as stored after the attempt
+	deliver := func(t *testing.T, e *model.OutboxEmail, sendErr error) (int, *model.OutboxEmail, []mailer.Message) {
+		setOutboxConfig(t, 10)
+		c, mockRepo, mem := newTestController(t)
+		mem.Err = sendErr
+
+		var stored model.OutboxEmail
+		mockRepo.EXPECT().ClaimOutboxEmails(ctx, gomock.Any(), gomock.Any(), int32(10)).Return([]*model.OutboxEmail{e}, nil)
+		mockRepo.EXPECT().TxUpdateOutboxEmail(ctx, nil, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ pgx.Tx, e *model.OutboxEmail, _ int64) error {
+				stored = *e
+				return nil
+			})
+
+		sent, err := c.DeliverOutbox(ctx)
+		require.NoError(t, err)
+
+		return sent, &stored, mem.Messages()
+	}
+
+	t.Run("Sent", func(t *testing.T) {
+		sent, e, messages := deliver(t, outboxEmail(1, model.EmailRegistration, 0), nil)
+
+		assert.Equal(t, 1, sent)
+		require.Len(t, messages, 1)
+		assert.Equal(t, "Verifica tu correo electrónico", messages[0].Subject, "es-MX falls back to es")
+		assert.Equal(t, "noreply@pickfighter.com", messages[0].From.Email)
+
+		assert.Equal(t, model.OutboxSent, e.Status)
+		assert.Equal(t, int32(1), e.Attempts)
+		assert.NotZero(t, e.SentAt)
+		assert.Empty(t, e.LastError)
+	})
+
+	t.Run("Retried", func(t *testing.T) {
+		before := time.Now()
+		sent, e, _ := deliver(t, outboxEmail(1, model.EmailRegistration, 1), errors.New("connection refused"))
+
+		assert.Equal(t, 0, sent)
+		assert.Equal(t, model.OutboxPending, e.Status)
+		assert.Equal(t, int32(2), e.Attempts)
+		assert.Equal(t, "connection refused", e.LastError)
+		assert.InDelta(t, before.Add(2*time.Minute).Unix(), e.NextAttemptAt, 1)
+	})
+
+	t.Run("Dead after max attempts", func(t *testing.T) {
+		sent, e, _ := deliver(t, outboxEmail(1, model.EmailRegistration, 2), errors.New("connection refused"))
+
+		assert.Equal(t, 0, sent)
+		assert.Equal(t, model.OutboxDead, e.Status)
+		assert.Equal(t, int32(3), e.Attempts)
+		assert.Equal(t, "connection refused", e.LastError)
+	})
+
+	t.Run("Dead without template", func(t *testing.T) {
+		sent, e, messages := deliver(t, outboxEmail(1, "newsletter", 0), nil)
+
+		assert.Equal(t, 0, sent)
+		assert.Equal(t, model.OutboxDead, e.Status)
+		assert.Equal(t, int32(1), e.Attempts)
+		assert.Contains(t, e.LastError, "no email template")
+		assert.Empty(t, messages)
+	})
+
+	t.Run("Batches", func(t *testing.T) {
+		setOutboxConfig(t, 2)
+		c, mockRepo, mem := newTestController(t)
+
+		first := []*model.OutboxEmail{
+			outboxEmail(1, model.EmailRegistration, 0),
+			outboxEmail(2, model.EmailResetPassword, 0),
+		}
+
+		// a full batch is followed by another claim, a partial one ends the delivery
+		gomock.InOrder(
+			mockRepo.EXPECT().ClaimOutboxEmails(ctx, gomock.Any(), gomock.Any(), int32(2)).Return(first, nil),
+			mockRepo.EXPECT().ClaimOutboxEmails(ctx, gomock.Any(), gomock.Any(), int32(2)).
+				Return([]*model.OutboxEmail{outboxEmail(3, model.EmailChangeEmail, 0)}, nil),
+		)
+		mockRepo.EXPECT().TxUpdateOutboxEmail(ctx, nil, gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+		sent, err := c.DeliverOutbox(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, sent)
+		assert.Len(t, mem.Messages(), 3)
+	})
+
+	t.Run("Claim error", func(t *testing.T) {
+		setOutboxConfig(t, 10)
+		c, mockRepo, _ := newTestController(t)
+
+		mockRepo.EXPECT().ClaimOutboxEmails(ctx, gomock.Any(), gomock.Any(), int32(10)).Return(nil, errors.New("database error"))
+
+		_, err := c.DeliverOutbox(ctx)
+		assert.EqualError(t, err, "database error")
+	})
+}
+
+func TestResendEmail(t *testing.T) {
+	ctx := context.Background()
+	req := &model.ResendEmailRequest{EmailId: 7}
+
+	t.Run("Dead", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		dead := outboxEmail(7, model.EmailRegistration, 3)
+		dead.Status = model.OutboxDead
+		dead.LastError = "connection refused"
+
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxFindOutboxEmail(ctx, tx, int64(7)).Return(dead, nil)
+		mockRepo.EXPECT().TxUpdateOutboxEmail(ctx, tx, dead, gomock.Any()).Return(nil)
+
+		e, err := c.ResendEmail(ctx, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, model.OutboxPending, e.Status)
+		assert.Equal(t, int32(0), e.Attempts)
+		assert.InDelta(t, time.Now().Unix(), e.NextAttemptAt, 1)
+		assert.True(t, tx.committed)
+
+		// the outbox worker is woken up
+		assert.Len(t, c.outboxWake, 1)
+	})
+
+	t.Run("Sent", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		sent := outboxEmail(7, model.EmailRegistration, 1)
+		sent.Status = model.OutboxSent
+
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxFindOutboxEmail(ctx, tx, int64(7)).Return(sent, nil)
+
+		_, err := c.ResendEmail(ctx, req)
+		assert.ErrorIs(t, err, ErrEmailSent)
+		assert.True(t, tx.rolledBack)
+		assert.Empty(t, c.outboxWake)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		tx := &fakeTx{}
+
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxFindOutboxEmail(ctx, tx, int64(7)).Return(nil, pgx.ErrNoRows)
+
+		_, err := c.ResendEmail(ctx, req)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.True(t, tx.rolledBack)
+	})
+}
@@ -14,7 +14,7 @@ import (
 )
 
 // PasswordReset resets the password for a user identified by email.
-// It generates a reset token, updates user credentials, and sends an email notification.
+// It generates a reset token, updates user credentials, and queues an email notification in the same transaction.
 // Returns true on successful password reset; otherwise returns an error.
 func (c *Controller) PasswordReset(ctx context.Context, req *model.ResetPasswordRequest) (bool, error) {
 	credentials, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
@@ -58,7 +58,7 @@ func (c *Controller) PasswordReset(ctx context.Context, req *model.ResetPassword
 	credentials.Token = token
 	credentials.TokenExpire = tokenExpire
 
-	if err := c.repo.ResetPassword(ctx, &credentials); err != nil {
+	if err := c.repo.ResetPassword(ctx, tx, &credentials); err != nil {
 		// internal error
 		logs.Errorf("Failed to reset user credentials: %s", err)
 		if txErr := tx.Rollback(ctx); txErr != nil {
@@ -67,21 +67,29 @@ func (c *Controller) PasswordReset(ctx context.Context, req *model.ResetPassword
 		return false, internalErr.New(internalErr.TxCommit, err, 108)
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		// bad request error
-		logs.Errorf("Failed to commit registration transaction: %s", err)
-		return false, internalErr.New(internalErr.TxCommit, err, 109)
-	}
-
-	// TODO
-	go c.HandleEmailEvent(ctx, &model.EmailData{
+	err = c.queueEmail(ctx, tx, &model.EmailData{
 		Subject: model.EmailResetPassword,
 		Recipient: model.EmailAddrSpec{
 			Email: credentials.Email,
 			Name:  user.Name,
 		},
-		Token: credentials.Token,
+		Token:  credentials.Token,
+		Locale: req.Locale,
 	})
+	if err != nil {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		// bad request error
+		logs.Errorf("Failed to commit registration transaction: %s", err)
+		return false, internalErr.New(internalErr.TxCommit, err, 109)
+	}
+
+	c.notifyOutbox()
 
 	return true, nil
 }
@@ -113,11 +121,13 @@ func (c *Controller) PasswordRecover(ctx context.Context, req *model.RecoverPass
 		return false, internalErr.New(internalErr.Tx, err, 110)
 	}
 
-	salt := utils.GetRandomString(saltLength)
-	password := utils.GenerateSaltedHash(req.Password, salt)
-
-	credentials.Password = password
-	credentials.Salt = salt
+	if err := setPassword(&credentials, req.Password); err != nil {
+		logs.Errorf("Failed to hash user password: %s", err)
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+		return false, internalErr.New(internalErr.UserCredentialsReset, err, 416)
+	}
 
 	if err := c.repo.ConfirmCredentialsToken(ctx, tx, model.UserCredentialsRequest{
 		UserId: credentials.UserId,
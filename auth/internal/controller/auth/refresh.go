@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+	internalErr "pickfighter.com/auth/pkg/errors"
+	"pickfighter.com/auth/pkg/model"
+	"pickfighter.com/auth/pkg/utils"
+	logs "pickfighter.com/pkg/logger"
+)
+
+// refreshTokenLength is the number of random bytes of a refresh token.
+const refreshTokenLength = 32
+
+var (
+	// ErrInvalidRefreshToken is returned for unknown, expired or revoked refresh tokens.
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid or expired")
+	// ErrRefreshTokenReused is returned when an already rotated refresh token is presented again,
+	// the whole token family is revoked then.
+	ErrRefreshTokenReused = errors.New("refresh token was already used")
+)
+
+// accessTokenExpiresIn returns the lifetime of access tokens in seconds.
+func accessTokenExpiresIn() int64 {
+	return int64(viper.GetDuration("auth.jwt.access_ttl").Seconds())
+}
+
+// refreshTokenTTL returns the lifetime of refresh tokens issued at login,
+// it is longer for users asking to be remembered.
+func refreshTokenTTL(rememberMe bool) time.Duration {
+	if rememberMe {
+		return viper.GetDuration("auth.refresh.remember_ttl")
+	}
+
+	return viper.GetDuration("auth.refresh.ttl")
+}
+
+// newTokenFamily starts a refresh token family for a login of the user.
+func newTokenFamily(userId int32, req *model.AuthenticateRequest) (model.RefreshToken, error) {
+	familyId, err := uuid.NewV4()
+	if err != nil {
+		return model.RefreshToken{}, err
+	}
+
+	return model.RefreshToken{
+		FamilyId:  familyId.String(),
+		UserId:    userId,
+		UserAgent: req.UserAgent,
+		IpAddress: req.IpAddress,
+	}, nil
+}
+
+// issueRefreshToken generates a refresh token of the family valid for ttl, stores its hash
+// and adds the token to the authentication result. The first token of a family sets its absolute expiry,
+// tokens issued by rotation never outlive it.
+func (c *Controller) issueRefreshToken(ctx context.Context, tx pgx.Tx, result *model.AuthenticateResult, rt model.RefreshToken, ttl time.Duration) error {
+	token, err := utils.GetSecureToken(refreshTokenLength)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expires := now.Add(ttl)
+
+	if rt.FamilyExpiresAt == 0 {
+		rt.FamilyExpiresAt = expires.Unix()
+	} else if familyExpires := time.Unix(rt.FamilyExpiresAt, 0); familyExpires.Before(expires) {
+		expires = familyExpires
+	}
+
+	rt.TokenHash = utils.GenerateHashFromString(token)
+	rt.CreatedAt = now.Unix()
+	rt.ExpiresAt = expires.Unix()
+
+	if err := c.repo.TxNewRefreshToken(ctx, tx, rt); err != nil {
+		return err
+	}
+
+	result.RefreshToken = token
+	result.RefreshExpirationTime = expires
+
+	return nil
+}
+
+// RefreshToken exchanges a refresh token for a new access token and a new refresh token of the same family.
+// Every refresh token can be used once, the new one gets the lifetime of the token issued at login
+// capped at the absolute expiry of the family, so rotation can't keep a login alive forever.
+// Presenting a used token again means it leaked, so the whole family is revoked and ErrRefreshTokenReused
+// is returned. Unknown, expired and revoked tokens return ErrInvalidRefreshToken.
+func (c *Controller) RefreshToken(ctx context.Context, req *model.RefreshTokenRequest) (*model.AuthenticateResult, error) {
+	if req.RefreshToken == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return nil, internalErr.New(internalErr.Tx, err, 112)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	rt, err := c.repo.TxFindRefreshToken(ctx, tx, utils.GenerateHashFromString(req.RefreshToken))
+	if err != nil {
+		rollback()
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidRefreshToken
+		}
+		logs.Errorf("Failed to find refresh token: %s", err)
+		return nil, internalErr.New(internalErr.TokenRefresh, err, 603)
+	}
+
+	now := time.Now()
+
+	if rt.UsedAt > 0 {
+		logs.Warnf("Refresh token reuse for User [%d], revoking token family %s", rt.UserId, rt.FamilyId)
+
+		if err := c.repo.TxRevokeRefreshFamily(ctx, tx, rt.FamilyId, now.Unix()); err != nil {
+			rollback()
+			logs.Errorf("Failed to revoke refresh token family: %s", err)
+			return nil, internalErr.New(internalErr.TokenRefresh, err, 604)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			logs.Errorf("Unable to commit transaction: %s", err)
+			return nil, internalErr.New(internalErr.TxCommit, err, 113)
+		}
+
+		return nil, ErrRefreshTokenReused
+	}
+
+	if rt.RevokedAt > 0 || now.Unix() >= rt.ExpiresAt {
+		rollback()
+		return nil, ErrInvalidRefreshToken
+	}
+
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
+		UserId: rt.UserId,
+	})
+	if err != nil {
+		rollback()
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return nil, internalErr.New(internalErr.UserCredentials, err, 413)
+	}
+
+	if !creds.Active {
+		rollback()
+		return nil, fmt.Errorf("%w: user is not active", ErrInvalidRefreshToken)
+	}
+
+	if err := c.repo.TxUseRefreshToken(ctx, tx, rt.TokenId, now.Unix()); err != nil {
+		rollback()
+		logs.Errorf("Failed to use refresh token: %s", err)
+		return nil, internalErr.New(internalErr.TokenRefresh, err, 605)
+	}
+
+	result, err := c.createJWTToken(ctx, &creds, &model.AuthenticateRequest{
+		UserAgent: req.UserAgent,
+		IpAddress: req.IpAddress,
+		ExpiresIn: accessTokenExpiresIn(),
+		SessionId: rt.FamilyId,
+	})
+	if err != nil {
+		rollback()
+		return nil, internalErr.New(internalErr.Token, err, 606)
+	}
+
+	next := model.RefreshToken{
+		FamilyId:        rt.FamilyId,
+		UserId:          rt.UserId,
+		UserAgent:       req.UserAgent,
+		IpAddress:       req.IpAddress,
+		FamilyExpiresAt: rt.FamilyExpiresAt,
+	}
+	ttl := time.Duration(rt.ExpiresAt-rt.CreatedAt) * time.Second
+
+	if err := c.issueRefreshToken(ctx, tx, result, next, ttl); err != nil {
+		rollback()
+		logs.Errorf("Failed to issue refresh token: %s", err)
+		return nil, internalErr.New(internalErr.TokenRefresh, err, 607)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return nil, internalErr.New(internalErr.TxCommit, err, 114)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	internalErr "pickfighter.com/auth/pkg/errors"
+	"pickfighter.com/auth/pkg/model"
+	logs "pickfighter.com/pkg/logger"
+)
+
+// RevokeToken revokes an access token by its JWT ID until it expires, so a logged out or stolen token
+// is rejected before its expiration. Refresh tokens of the session the token was issued to are revoked too.
+// Records of expired revoked tokens are purged on the way.
+func (c *Controller) RevokeToken(ctx context.Context, req *model.RevokeTokenRequest) error {
+	if req.TokenId == "" {
+		return internalErr.NewDefault(internalErr.TokenEmpty, 610)
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return internalErr.New(internalErr.Tx, err, 115)
+	}
+
+	now := time.Now().Unix()
+
+	if err := c.repo.TxRevokeToken(ctx, tx, req.TokenId, req.UserId, req.ExpiresAt, now); err != nil {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+		logs.Errorf("Failed to revoke token: %s", err)
+		return internalErr.New(internalErr.TokenRevoke, err, 611)
+	}
+
+	if req.SessionId != "" {
+		if err := c.repo.TxRevokeRefreshFamily(ctx, tx, req.SessionId, now); err != nil {
+			if txErr := tx.Rollback(ctx); txErr != nil {
+				logs.Errorf("Unable to rollback transaction: %s", txErr)
+			}
+			logs.Errorf("Failed to revoke refresh token family: %s", err)
+			return internalErr.New(internalErr.TokenRevoke, err, 612)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return internalErr.New(internalErr.TxCommit, err, 116)
+	}
+
+	if n, err := c.repo.PurgeRevokedTokens(ctx, now); err != nil {
+		logs.Errorf("Failed to purge expired revoked tokens: %s", err)
+	} else if n > 0 {
+		logs.Debugf("Purged %d expired revoked tokens", n)
+	}
+
+	return nil
+}
+
+// RevokeUserTokens logs the user out everywhere: access tokens issued before req.Before are revoked
+// and so are refresh tokens of logins made before then. Zero or future Before is now.
+func (c *Controller) RevokeUserTokens(ctx context.Context, req *model.RevokeUserTokensRequest) error {
+	if req.UserId == 0 {
+		return internalErr.NewDefault(internalErr.UserCredentialsNotExists, 414)
+	}
+
+	now := time.Now().Unix()
+
+	before := req.Before
+	if before <= 0 || before > now {
+		before = now
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return internalErr.New(internalErr.Tx, err, 117)
+	}
+
+	if err := c.repo.TxRevokeUserTokens(ctx, tx, req.UserId, before); err != nil {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+		logs.Errorf("Failed to revoke user tokens: %s", err)
+		return internalErr.New(internalErr.TokenRevoke, err, 613)
+	}
+
+	if err := c.repo.TxRevokeUserRefreshFamilies(ctx, tx, req.UserId, before, now); err != nil {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+		logs.Errorf("Failed to revoke user refresh tokens: %s", err)
+		return internalErr.New(internalErr.TokenRevoke, err, 614)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return internalErr.New(internalErr.TxCommit, err, 118)
+	}
+
+	return nil
+}
+
+// TokenStatus tells whether an access token was revoked by its JWT ID, with its session
+// or by logging its user out everywhere. Times are in seconds, so a token issued in the second of the revocation
+// is kept, otherwise a login right after a logout everywhere or a role change would be rejected.
+func (c *Controller) TokenStatus(ctx context.Context, req *model.TokenStatusRequest) (*model.TokenStatus, error) {
+	revoked, revokedBefore, err := c.repo.FindTokenRevocation(ctx, req.TokenId, req.SessionId, req.UserId)
+	if err != nil {
+		logs.Errorf("Failed to find token revocation: %s", err)
+		return nil, internalErr.New(internalErr.TokenRevoke, err, 615)
+	}
+
+	return &model.TokenStatus{
+		Revoked:       revoked || (revokedBefore > 0 && req.IssuedAt < revokedBefore),
+		RevokedBefore: revokedBefore,
+	}, nil
+}
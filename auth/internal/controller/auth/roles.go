@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	internalErr "pickfighter.com/auth/pkg/errors"
+	"pickfighter.com/auth/pkg/model"
+	logs "pickfighter.com/pkg/logger"
+	"pickfighter.com/pkg/rbac"
+)
+
+const (
+	defaultRoleAuditLimit int32 = 50
+	maxRoleAuditLimit     int32 = 500
+)
+
+var (
+	// ErrForbidden is returned when the actor of a request lacks the permission it needs.
+	ErrForbidden = errors.New("permission denied")
+	// ErrOwnRoles is returned when actors try to grant or revoke their own roles.
+	ErrOwnRoles = errors.New("own roles can't be changed")
+	// ErrRoleUnchanged is returned when granting a role the user has or revoking one the user lacks.
+	ErrRoleUnchanged = errors.New("role is unchanged")
+)
+
+// GrantRole grants a role to a user and records the change in the roles audit. The user gets
+// the permissions of the role with the next access token, after a login or a token refresh.
+// It returns errors wrapping rbac.ErrUnknownRole, ErrForbidden, ErrOwnRoles, ErrNotFound or ErrRoleUnchanged.
+func (c *Controller) GrantRole(ctx context.Context, req *model.RoleChangeRequest) (*model.RoleChange, error) {
+	return c.changeRole(ctx, req, model.RoleGranted)
+}
+
+// RevokeRole revokes a role of a user and records the change in the roles audit. All tokens
+// of the user are revoked as well, so the permissions of the role are not kept until they expire.
+// It returns the same errors as GrantRole.
+func (c *Controller) RevokeRole(ctx context.Context, req *model.RoleChangeRequest) (*model.RoleChange, error) {
+	return c.changeRole(ctx, req, model.RoleRevoked)
+}
+
+// changeRole grants or revokes a role in a transaction with its audit record. The permission of the actor
+// is checked against the stored roles, the roles claim of the actor's access token may be outdated.
+func (c *Controller) changeRole(ctx context.Context, req *model.RoleChangeRequest, action string) (*model.RoleChange, error) {
+	role, err := rbac.ParseRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ActorId == req.UserId {
+		return nil, fmt.Errorf("user %d: %w", req.UserId, ErrOwnRoles)
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return nil, internalErr.New(internalErr.Tx, err, 129)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	actorRoles, err := c.repo.TxFindUserRoles(ctx, tx, req.ActorId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return nil, fmt.Errorf("actor %d: %w", req.ActorId, ErrForbidden)
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to find roles of User [%d]: %s", req.ActorId, err)
+		return nil, internalErr.New(internalErr.RolesChange, err, 1201)
+	}
+
+	if !rbac.Set(actorRoles).Can(rbac.PermRoleManage) {
+		rollback()
+		return nil, fmt.Errorf("actor %d: %w", req.ActorId, ErrForbidden)
+	}
+
+	userRoles, err := c.repo.TxFindUserRoles(ctx, tx, req.UserId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return nil, fmt.Errorf("user %d: %w", req.UserId, ErrNotFound)
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to find roles of User [%d]: %s", req.UserId, err)
+		return nil, internalErr.New(internalErr.RolesChange, err, 1202)
+	}
+
+	roles := rbac.Set(userRoles)
+	if roles.Has(role) == (action == model.RoleGranted) {
+		rollback()
+		return nil, fmt.Errorf("user %d %s %s: %w", req.UserId, action, role, ErrRoleUnchanged)
+	}
+
+	if action == model.RoleGranted {
+		roles = roles.With(role)
+	} else {
+		roles = roles.Without(role)
+	}
+
+	now := time.Now().Unix()
+
+	if err := c.repo.TxUpdateUserRoles(ctx, tx, req.UserId, uint64(roles), now); err != nil {
+		rollback()
+		logs.Errorf("Failed to update roles of User [%d]: %s", req.UserId, err)
+		return nil, internalErr.New(internalErr.RolesChange, err, 1203)
+	}
+
+	change := &model.RoleChange{
+		UserId:    req.UserId,
+		ActorId:   req.ActorId,
+		Action:    action,
+		Role:      role.String(),
+		Reason:    req.Reason,
+		Roles:     roles.Names(),
+		CreatedAt: now,
+	}
+
+	change.AuditId, err = c.repo.TxNewRoleChange(ctx, tx, change, uint64(roles))
+	if err != nil {
+		rollback()
+		logs.Errorf("Failed to audit role change: %s", err)
+		return nil, internalErr.New(internalErr.RolesChange, err, 1204)
+	}
+
+	if action == model.RoleRevoked {
+		if err := c.repo.TxRevokeUserTokens(ctx, tx, req.UserId, now); err != nil {
+			rollback()
+			logs.Errorf("Failed to revoke user tokens: %s", err)
+			return nil, internalErr.New(internalErr.RolesChange, err, 1205)
+		}
+
+		if err := c.repo.TxRevokeUserRefreshFamilies(ctx, tx, req.UserId, now, now); err != nil {
+			rollback()
+			logs.Errorf("Failed to revoke user refresh tokens: %s", err)
+			return nil, internalErr.New(internalErr.RolesChange, err, 1206)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return nil, internalErr.New(internalErr.TxCommit, err, 130)
+	}
+
+	logs.Infof("User [%d] %s role %s of User [%d]: %s", req.ActorId, action, role, req.UserId, req.Reason)
+
+	return change, nil
+}
+
+// RoleAudit returns role changes, of a single user if it is given, the newest first.
+func (c *Controller) RoleAudit(ctx context.Context, req *model.RoleAuditRequest) ([]*model.RoleChange, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultRoleAuditLimit
+	}
+	limit = min(limit, maxRoleAuditLimit)
+
+	changes, err := c.repo.SearchRoleChanges(ctx, req.UserId, limit)
+	if err != nil {
+		logs.Errorf("Failed to search role changes: %s", err)
+		return nil, internalErr.New(internalErr.RolesAudit, err, 1207)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,595 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+	internalErr "pickfighter.com/auth/pkg/errors"
+	"pickfighter.com/auth/pkg/model"
+	"pickfighter.com/auth/pkg/totp"
+	"pickfighter.com/auth/pkg/utils"
+	logs "pickfighter.com/pkg/logger"
+	"pickfighter.com/pkg/rbac"
+)
+
+// challengeTokenLength is the number of random bytes of a two-factor login challenge token.
+const challengeTokenLength = 32
+
+var (
+	// ErrInvalidChallenge is returned for unknown or expired two-factor login challenges.
+	ErrInvalidChallenge = errors.New("two-factor challenge is invalid or expired")
+	// ErrInvalidCode is returned for wrong, reused and expired TOTP codes and unknown or used recovery codes.
+	ErrInvalidCode = errors.New("two-factor code is invalid")
+	// ErrTOTPLocked is returned while codes are not checked after too many wrong ones.
+	ErrTOTPLocked = errors.New("too many invalid two-factor codes, try again later")
+	// ErrTOTPEnabled is returned when enrolling or activating an authenticator while one is enabled.
+	ErrTOTPEnabled = errors.New("two-factor authentication is already enabled")
+	// ErrTOTPNotEnrolled is returned when the user has no authenticator to activate, disable or check.
+	ErrTOTPNotEnrolled = errors.New("two-factor authentication is not enrolled")
+	// ErrTOTPRequired is returned when an admin disables two-factor authentication required by the policy.
+	ErrTOTPRequired = errors.New("two-factor authentication is required for admins")
+)
+
+// totpConfig holds the two-factor authentication settings, read from the `auth.totp.*` config values.
+type totpConfig struct {
+	issuer           string
+	skew             int64
+	challengeTTL     time.Duration
+	maxAttempts      int32
+	lockout          time.Duration
+	recoveryCodes    int
+	requireForAdmins bool
+}
+
+func totpSettings() totpConfig {
+	return totpConfig{
+		issuer:           viper.GetString("auth.totp.issuer"),
+		skew:             viper.GetInt64("auth.totp.skew"),
+		challengeTTL:     viper.GetDuration("auth.totp.challenge_ttl"),
+		maxAttempts:      viper.GetInt32("auth.totp.max_attempts"),
+		lockout:          viper.GetDuration("auth.totp.lockout"),
+		recoveryCodes:    viper.GetInt("auth.totp.recovery_codes"),
+		requireForAdmins: viper.GetBool("auth.totp.require_for_admins"),
+	}
+}
+
+// isAdmin tells whether the user has a staff role, any role above the user role grants admin permissions.
+func isAdmin(u *model.User) bool {
+	return rbac.Set(u.Roles).IsStaff()
+}
+
+// login finishes a login of a user who proved the first factor. Users with an enabled authenticator,
+// and admins without one when `auth.totp.require_for_admins` is set, get a challenge
+// to complete with TwoFactorLogin instead of tokens.
+func (c *Controller) login(ctx context.Context, creds *model.UserCredentials, req *model.AuthenticateRequest) (*model.AuthenticateResult, error) {
+	t, err := c.repo.TxFindUserTOTP(ctx, nil, creds.UserId)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logs.Errorf("Failed to find authenticator of User [%d]: %s", creds.UserId, err)
+		return nil, internalErr.New(internalErr.TwoFactor, err, 1101)
+	}
+
+	if t.Enabled {
+		return c.newTwoFactorChallenge(ctx, creds.UserId, req, false)
+	}
+
+	if totpSettings().requireForAdmins {
+		u, err := c.repo.FindUser(ctx, &model.UserRequest{UserId: creds.UserId})
+		if err != nil {
+			logs.Errorf("Failed to get user: %s", err)
+			return nil, internalErr.New(internalErr.DBGetUser, err, 803)
+		}
+
+		if isAdmin(u) {
+			return c.newTwoFactorChallenge(ctx, creds.UserId, req, true)
+		}
+	}
+
+	return c.issueSession(ctx, creds, req)
+}
+
+// issueSession starts a new session of the user: a refresh token family, an access token of it
+// and its first refresh token, which lives longer if the user asks to be remembered.
+func (c *Controller) issueSession(ctx context.Context, creds *model.UserCredentials, req *model.AuthenticateRequest) (*model.AuthenticateResult, error) {
+	family, err := newTokenFamily(creds.UserId, req)
+	if err != nil {
+		logs.Errorf("Unable to generate token family id: %s", err)
+		return nil, internalErr.New(internalErr.TokenRefresh, err, 608)
+	}
+
+	req.ExpiresIn = accessTokenExpiresIn()
+	req.SessionId = family.FamilyId
+
+	token, err := c.createJWTToken(ctx, creds, req)
+	if err != nil {
+		logs.Errorf("Unable to create session for User [%d]: %s", creds.UserId, err)
+		return nil, internalErr.New(internalErr.Token, err, 602)
+	}
+
+	if err := c.issueRefreshToken(ctx, nil, token, family, refreshTokenTTL(req.RememberMe)); err != nil {
+		logs.Errorf("Unable to issue refresh token: %s", err)
+		return nil, internalErr.New(internalErr.TokenRefresh, err, 609)
+	}
+
+	return token, nil
+}
+
+// newTwoFactorChallenge stores a login waiting for the second factor for `auth.totp.challenge_ttl`
+// and returns its token, only the hash of which is stored.
+func (c *Controller) newTwoFactorChallenge(ctx context.Context, userId int32, req *model.AuthenticateRequest, enroll bool) (*model.AuthenticateResult, error) {
+	token, err := utils.GetSecureToken(challengeTokenLength)
+	if err != nil {
+		return nil, internalErr.New(internalErr.TwoFactorLogin, err, 1102)
+	}
+
+	now := time.Now()
+	expires := now.Add(totpSettings().challengeTTL)
+
+	err = c.repo.NewTwoFactorChallenge(ctx, model.TwoFactorChallenge{
+		TokenHash:  utils.GenerateHashFromString(token),
+		UserId:     userId,
+		RememberMe: req.RememberMe,
+		UserAgent:  req.UserAgent,
+		IpAddress:  req.IpAddress,
+		Enroll:     enroll,
+		CreatedAt:  now.Unix(),
+		ExpiresAt:  expires.Unix(),
+	})
+	if err != nil {
+		logs.Errorf("Failed to store two-factor challenge: %s", err)
+		return nil, internalErr.New(internalErr.TwoFactorLogin, err, 1103)
+	}
+
+	return &model.AuthenticateResult{
+		UserId: userId,
+		Challenge: &model.TwoFactorChallengeResult{
+			Token:              token,
+			ExpirationTime:     expires,
+			EnrollmentRequired: enroll,
+		},
+	}, nil
+}
+
+// checkCode checks a TOTP code or a recovery code of the user's authenticator within the transaction.
+// Recovery codes are accepted only by enabled authenticators. An accepted TOTP code moves LastStep forward,
+// `auth.totp.max_attempts` wrong codes in a row lock the authenticator for `auth.totp.lockout`.
+// The caller stores t whatever the outcome and commits the transaction, also when the code is rejected.
+func (c *Controller) checkCode(ctx context.Context, tx pgx.Tx, cfg totpConfig, t *model.UserTOTP, code string, now time.Time) error {
+	if now.Unix() < t.LockedUntil {
+		return ErrTOTPLocked
+	}
+
+	var ok bool
+	if totp.IsCode(code) {
+		step, valid, err := totp.Validate(t.Secret, code, now, cfg.skew)
+		if err != nil {
+			return err
+		}
+
+		if valid && step > t.LastStep {
+			t.LastStep = step
+			ok = true
+		}
+	} else if t.Enabled {
+		used, err := c.repo.TxUseRecoveryCode(ctx, tx, t.UserId,
+			utils.GenerateHashFromString(totp.NormalizeRecoveryCode(code)), now.Unix())
+		if err != nil {
+			return err
+		}
+		ok = used
+	}
+
+	if ok {
+		t.FailedAttempts = 0
+		return nil
+	}
+
+	t.FailedAttempts++
+	if t.FailedAttempts >= cfg.maxAttempts {
+		logs.Warnf("Authenticator of User [%d] locked after %d invalid codes", t.UserId, t.FailedAttempts)
+		t.FailedAttempts = 0
+		t.LockedUntil = now.Add(cfg.lockout).Unix()
+	}
+
+	return ErrInvalidCode
+}
+
+// issueRecoveryCodes replaces the recovery codes of the user with `auth.totp.recovery_codes` new ones
+// and returns them, only their hashes are stored.
+func (c *Controller) issueRecoveryCodes(ctx context.Context, tx pgx.Tx, cfg totpConfig, userId int32, now time.Time) ([]string, error) {
+	codes, err := totp.GenerateRecoveryCodes(cfg.recoveryCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = utils.GenerateHashFromString(code)
+	}
+
+	if err := c.repo.TxReplaceRecoveryCodes(ctx, tx, userId, hashes, now.Unix()); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// TwoFactorLogin completes a login challenge with a TOTP code or a recovery code and issues tokens like Login.
+// A challenge requiring enrollment is completed with a code of the authenticator enrolled with TOTPEnroll,
+// which activates it, the result carries the new recovery codes then.
+//
+// It returns ErrInvalidChallenge, ErrInvalidCode, ErrTOTPLocked or ErrTOTPNotEnrolled when the login can't be completed.
+func (c *Controller) TwoFactorLogin(ctx context.Context, req *model.TwoFactorLoginRequest) (*model.AuthenticateResult, error) {
+	if req.ChallengeToken == "" {
+		return nil, ErrInvalidChallenge
+	}
+
+	cfg := totpSettings()
+	now := time.Now()
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return nil, internalErr.New(internalErr.Tx, err, 123)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	challenge, err := c.repo.TxFindTwoFactorChallenge(ctx, tx, utils.GenerateHashFromString(req.ChallengeToken))
+	if errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return nil, ErrInvalidChallenge
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to find two-factor challenge: %s", err)
+		return nil, internalErr.New(internalErr.TwoFactorLogin, err, 1104)
+	}
+
+	if now.Unix() >= challenge.ExpiresAt {
+		rollback()
+		return nil, ErrInvalidChallenge
+	}
+
+	t, err := c.repo.TxFindUserTOTP(ctx, tx, challenge.UserId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return nil, ErrTOTPNotEnrolled
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to find authenticator of User [%d]: %s", challenge.UserId, err)
+		return nil, internalErr.New(internalErr.TwoFactor, err, 1105)
+	}
+
+	// the authenticator was disabled since the challenge
+	if !t.Enabled && !challenge.Enroll {
+		rollback()
+		return nil, ErrInvalidChallenge
+	}
+
+	codeErr := c.checkCode(ctx, tx, cfg, &t, req.Code, now)
+	if codeErr != nil && !errors.Is(codeErr, ErrInvalidCode) && !errors.Is(codeErr, ErrTOTPLocked) {
+		rollback()
+		logs.Errorf("Failed to check two-factor code of User [%d]: %s", t.UserId, codeErr)
+		return nil, internalErr.New(internalErr.TwoFactor, codeErr, 1106)
+	}
+
+	var recoveryCodes []string
+	if codeErr == nil {
+		if !t.Enabled {
+			t.Enabled = true
+			t.EnabledAt = now.Unix()
+
+			recoveryCodes, err = c.issueRecoveryCodes(ctx, tx, cfg, t.UserId, now)
+			if err != nil {
+				rollback()
+				logs.Errorf("Failed to issue recovery codes: %s", err)
+				return nil, internalErr.New(internalErr.TwoFactor, err, 1107)
+			}
+		}
+
+		if err := c.repo.TxDeleteTwoFactorChallenge(ctx, tx, challenge.TokenHash); err != nil {
+			rollback()
+			logs.Errorf("Failed to delete two-factor challenge: %s", err)
+			return nil, internalErr.New(internalErr.TwoFactorLogin, err, 1108)
+		}
+	}
+
+	if err := c.repo.TxSaveUserTOTP(ctx, tx, t); err != nil {
+		rollback()
+		logs.Errorf("Failed to update authenticator of User [%d]: %s", t.UserId, err)
+		return nil, internalErr.New(internalErr.TwoFactor, err, 1109)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return nil, internalErr.New(internalErr.TxCommit, err, 124)
+	}
+
+	if codeErr != nil {
+		return nil, codeErr
+	}
+
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
+		UserId: challenge.UserId,
+	})
+	if err != nil {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return nil, internalErr.New(internalErr.UserCredentials, err, 424)
+	}
+
+	if !creds.Active {
+		return nil, internalErr.NewDefault(internalErr.UserCredentialsIsNotActive, 425)
+	}
+
+	token, err := c.issueSession(ctx, &creds, &model.AuthenticateRequest{
+		Email:      creds.Email,
+		RememberMe: challenge.RememberMe,
+		UserAgent:  req.UserAgent,
+		IpAddress:  req.IpAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	token.RecoveryCodes = recoveryCodes
+
+	return token, nil
+}
+
+// TOTPEnroll generates a new authenticator secret for a logged in user or for the user of a login
+// challenge requiring enrollment. The authenticator is not used until a code of it is verified
+// by TOTPActivate or TwoFactorLogin, enrolling again replaces a pending secret.
+// It returns ErrTOTPEnabled if the user has an enabled authenticator.
+func (c *Controller) TOTPEnroll(ctx context.Context, req *model.TOTPEnrollRequest) (*model.TOTPEnrollment, error) {
+	userId := req.UserId
+	now := time.Now()
+
+	if req.ChallengeToken != "" {
+		challenge, err := c.repo.TxFindTwoFactorChallenge(ctx, nil, utils.GenerateHashFromString(req.ChallengeToken))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidChallenge
+		} else if err != nil {
+			logs.Errorf("Failed to find two-factor challenge: %s", err)
+			return nil, internalErr.New(internalErr.TwoFactorEnroll, err, 1110)
+		}
+
+		if !challenge.Enroll || now.Unix() >= challenge.ExpiresAt {
+			return nil, ErrInvalidChallenge
+		}
+		userId = challenge.UserId
+	}
+
+	if userId <= 0 {
+		return nil, ErrInvalidChallenge
+	}
+
+	creds, err := c.repo.FindUserCredentials(ctx, model.UserCredentialsRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		logs.Errorf("Failed to get user credentials: %s", err)
+		return nil, internalErr.New(internalErr.UserCredentials, err, 426)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, internalErr.New(internalErr.TwoFactorEnroll, err, 1111)
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return nil, internalErr.New(internalErr.Tx, err, 125)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	t, err := c.repo.TxFindUserTOTP(ctx, tx, userId)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		logs.Errorf("Failed to find authenticator of User [%d]: %s", userId, err)
+		return nil, internalErr.New(internalErr.TwoFactorEnroll, err, 1112)
+	}
+
+	if t.Enabled {
+		rollback()
+		return nil, ErrTOTPEnabled
+	}
+
+	// a pending authenticator keeps its lockout, enrolling again must not reset it
+	err = c.repo.TxSaveUserTOTP(ctx, tx, model.UserTOTP{
+		UserId:         userId,
+		Secret:         secret,
+		FailedAttempts: t.FailedAttempts,
+		LockedUntil:    t.LockedUntil,
+		CreatedAt:      now.Unix(),
+	})
+	if err != nil {
+		rollback()
+		logs.Errorf("Failed to store authenticator of User [%d]: %s", userId, err)
+		return nil, internalErr.New(internalErr.TwoFactorEnroll, err, 1113)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return nil, internalErr.New(internalErr.TxCommit, err, 126)
+	}
+
+	return &model.TOTPEnrollment{
+		Secret: secret,
+		Uri:    totp.URI(totpSettings().issuer, creds.Email, secret),
+	}, nil
+}
+
+// TOTPActivate enables the enrolled authenticator of the user once a TOTP code of it is verified
+// and returns new recovery codes, which are shown only once.
+// It returns ErrTOTPNotEnrolled, ErrTOTPEnabled, ErrInvalidCode or ErrTOTPLocked when it can't be enabled.
+func (c *Controller) TOTPActivate(ctx context.Context, req *model.TOTPCodeRequest) ([]string, error) {
+	var codes []string
+
+	err := c.withAuthenticator(ctx, req, func(tx pgx.Tx, cfg totpConfig, t *model.UserTOTP, now time.Time) (bool, error) {
+		if t.Enabled {
+			return false, ErrTOTPEnabled
+		}
+
+		if err := c.checkCode(ctx, tx, cfg, t, req.Code, now); err != nil {
+			return false, err
+		}
+
+		t.Enabled = true
+		t.EnabledAt = now.Unix()
+
+		var err error
+		codes, err = c.issueRecoveryCodes(ctx, tx, cfg, t.UserId, now)
+		return false, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logs.Infof("Two-factor authentication enabled for User [%d]", req.UserId)
+
+	return codes, nil
+}
+
+// TOTPDisable removes the enabled authenticator and the recovery codes of the user after a TOTP code
+// or a recovery code is verified. Admins can't disable it while `auth.totp.require_for_admins` is set.
+// It returns ErrTOTPNotEnrolled, ErrTOTPRequired, ErrInvalidCode or ErrTOTPLocked when it can't be disabled.
+func (c *Controller) TOTPDisable(ctx context.Context, req *model.TOTPCodeRequest) error {
+	if totpSettings().requireForAdmins {
+		u, err := c.repo.FindUser(ctx, &model.UserRequest{UserId: req.UserId})
+		if err != nil {
+			logs.Errorf("Failed to get user: %s", err)
+			return internalErr.New(internalErr.DBGetUser, err, 804)
+		}
+
+		if isAdmin(u) {
+			return ErrTOTPRequired
+		}
+	}
+
+	err := c.withAuthenticator(ctx, req, func(tx pgx.Tx, cfg totpConfig, t *model.UserTOTP, now time.Time) (bool, error) {
+		if !t.Enabled {
+			return false, ErrTOTPNotEnrolled
+		}
+
+		if err := c.checkCode(ctx, tx, cfg, t, req.Code, now); err != nil {
+			return false, err
+		}
+
+		return true, c.repo.TxDeleteUserTOTP(ctx, tx, t.UserId)
+	})
+	if err != nil {
+		return err
+	}
+
+	logs.Infof("Two-factor authentication disabled for User [%d]", req.UserId)
+
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the enabled authenticator of the user after
+// a TOTP code or a recovery code is verified and returns the new ones.
+// It returns ErrTOTPNotEnrolled, ErrInvalidCode or ErrTOTPLocked when the codes can't be replaced.
+func (c *Controller) RegenerateRecoveryCodes(ctx context.Context, req *model.TOTPCodeRequest) ([]string, error) {
+	var codes []string
+
+	err := c.withAuthenticator(ctx, req, func(tx pgx.Tx, cfg totpConfig, t *model.UserTOTP, now time.Time) (bool, error) {
+		if !t.Enabled {
+			return false, ErrTOTPNotEnrolled
+		}
+
+		if err := c.checkCode(ctx, tx, cfg, t, req.Code, now); err != nil {
+			return false, err
+		}
+
+		var err error
+		codes, err = c.issueRecoveryCodes(ctx, tx, cfg, t.UserId, now)
+		return false, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// withAuthenticator runs fn with the locked authenticator of the user in a transaction and stores it after fn,
+// unless fn tells it deleted it. A rejected code is a failed attempt, so the transaction is committed
+// for ErrInvalidCode too and the error is returned after the commit, other errors roll it back.
+func (c *Controller) withAuthenticator(ctx context.Context, req *model.TOTPCodeRequest,
+	fn func(tx pgx.Tx, cfg totpConfig, t *model.UserTOTP, now time.Time) (bool, error),
+) error {
+	cfg := totpSettings()
+	now := time.Now()
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return internalErr.New(internalErr.Tx, err, 127)
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	t, err := c.repo.TxFindUserTOTP(ctx, tx, req.UserId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return ErrTOTPNotEnrolled
+	} else if err != nil {
+		rollback()
+		logs.Errorf("Failed to find authenticator of User [%d]: %s", req.UserId, err)
+		return internalErr.New(internalErr.TwoFactorVerify, err, 1114)
+	}
+
+	deleted, fnErr := fn(tx, cfg, &t, now)
+	switch {
+	case errors.Is(fnErr, ErrInvalidCode):
+	case errors.Is(fnErr, ErrTOTPLocked), errors.Is(fnErr, ErrTOTPEnabled),
+		errors.Is(fnErr, ErrTOTPNotEnrolled):
+		rollback()
+		return fnErr
+	case fnErr != nil:
+		rollback()
+		logs.Errorf("Failed to update authenticator of User [%d]: %s", req.UserId, fnErr)
+		return internalErr.New(internalErr.TwoFactorVerify, fnErr, 1115)
+	}
+
+	if !deleted {
+		if err := c.repo.TxSaveUserTOTP(ctx, tx, t); err != nil {
+			rollback()
+			logs.Errorf("Failed to update authenticator of User [%d]: %s", req.UserId, err)
+			return internalErr.New(internalErr.TwoFactorVerify, err, 1116)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return internalErr.New(internalErr.TxCommit, err, 128)
+	}
+
+	if fnErr != nil {
+		return fmt.Errorf("user %d: %w", req.UserId, fnErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"pickfighter.com/auth/pkg/model"
+	"pickfighter.com/auth/pkg/totp"
+	"pickfighter.com/auth/pkg/utils"
+	"pickfighter.com/pkg/rbac"
+)
+
+var testTOTPConfig = totpConfig{
+	issuer:        "Pickfighter",
+	skew:          1,
+	challengeTTL:  5 * time.Minute,
+	maxAttempts:   3,
+	lockout:       15 * time.Minute,
+	recoveryCodes: 10,
+}
+
+func setTOTPConfig(t *testing.T, requireForAdmins bool) {
+	t.Helper()
+
+	viper.Set("auth.totp.skew", testTOTPConfig.skew)
+	viper.Set("auth.totp.challenge_ttl", testTOTPConfig.challengeTTL)
+	viper.Set("auth.totp.max_attempts", testTOTPConfig.maxAttempts)
+	viper.Set("auth.totp.lockout", testTOTPConfig.lockout)
+	viper.Set("auth.totp.recovery_codes", testTOTPConfig.recoveryCodes)
+	viper.Set("auth.totp.require_for_admins", requireForAdmins)
+	t.Cleanup(viper.Reset)
+}
+
+func newTestTOTP(t *testing.T, enabled bool) *model.UserTOTP {
+	t.Helper()
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	return &model.UserTOTP{UserId: 7, Secret: secret, Enabled: enabled}
+}
+
+// codeAt returns the code of the authenticator at the step of now moved by the given number of steps.
+func codeAt(t *testing.T, a *model.UserTOTP, now time.Time, steps int64) string {
+	t.Helper()
+
+	c, err := totp.Code(a.Secret, totp.Step(now)+steps)
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestCheckCode(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("Skew window", func(t *testing.T) {
+		c, _, _ := newTestController(t)
+
+		for steps := int64(-1); steps <= 1; steps++ {
+			a := newTestTOTP(t, true)
+
+			err := c.checkCode(ctx, nil, testTOTPConfig, a, codeAt(t, a, now, steps), now)
+			require.NoError(t, err, "step %+d", steps)
+			assert.Equal(t, totp.Step(now)+steps, a.LastStep)
+		}
+
+		for _, steps := range []int64{-2, 2} {
+			a := newTestTOTP(t, true)
+
+			err := c.checkCode(ctx, nil, testTOTPConfig, a, codeAt(t, a, now, steps), now)
+			assert.ErrorIs(t, err, ErrInvalidCode, "step %+d", steps)
+			assert.Zero(t, a.LastStep)
+		}
+	})
+
+	t.Run("Reused step", func(t *testing.T) {
+		c, _, _ := newTestController(t)
+		a := newTestTOTP(t, true)
+
+		code := codeAt(t, a, now, 0)
+		require.NoError(t, c.checkCode(ctx, nil, testTOTPConfig, a, code, now))
+
+		// a code is accepted once, codes of earlier steps within the skew window neither
+		assert.ErrorIs(t, c.checkCode(ctx, nil, testTOTPConfig, a, code, now), ErrInvalidCode)
+		assert.ErrorIs(t, c.checkCode(ctx, nil, testTOTPConfig, a, codeAt(t, a, now, -1), now), ErrInvalidCode)
+		assert.Equal(t, int32(2), a.FailedAttempts)
+		assert.Equal(t, totp.Step(now), a.LastStep)
+
+		// a later step is accepted and resets the failed attempts
+		require.NoError(t, c.checkCode(ctx, nil, testTOTPConfig, a, codeAt(t, a, now, 1), now))
+		assert.Equal(t, totp.Step(now)+1, a.LastStep)
+		assert.Zero(t, a.FailedAttempts)
+	})
+
+	t.Run("Lockout", func(t *testing.T) {
+		c, _, _ := newTestController(t)
+		a := newTestTOTP(t, true)
+
+		for i := int32(1); i < testTOTPConfig.maxAttempts; i++ {
+			assert.ErrorIs(t, c.checkCode(ctx, nil, testTOTPConfig, a, "000000", now), ErrInvalidCode)
+			assert.Equal(t, i, a.FailedAttempts)
+			assert.Zero(t, a.LockedUntil)
+		}
+
+		assert.ErrorIs(t, c.checkCode(ctx, nil, testTOTPConfig, a, "000000", now), ErrInvalidCode)
+		assert.Equal(t, now.Add(testTOTPConfig.lockout).Unix(), a.LockedUntil)
+		assert.Zero(t, a.FailedAttempts)
+
+		// a valid code is not checked while locked
+		assert.ErrorIs(t, c.checkCode(ctx, nil, testTOTPConfig, a, codeAt(t, a, now, 0), now), ErrTOTPLocked)
+		assert.Zero(t, a.LastStep)
+
+		later := now.Add(testTOTPConfig.lockout)
+		assert.NoError(t, c.checkCode(ctx, nil, testTOTPConfig, a, codeAt(t, a, later, 0), later))
+	})
+
+	t.Run("Recovery code used once", func(t *testing.T) {
+		c, mockRepo, _ := newTestController(t)
+		a := newTestTOTP(t, true)
+		tx := &fakeTx{}
+
+		hash := utils.GenerateHashFromString("abcde-fghij")
+		gomock.InOrder(
+			mockRepo.EXPECT().TxUseRecoveryCode(ctx, tx, int32(7), hash, now.Unix()).Return(true, nil),
+			mockRepo.EXPECT().TxUseRecoveryCode(ctx, tx, int32(7), hash, now.Unix()).Return(false, nil),
+		)
+
+		require.NoError(t, c.checkCode(ctx, tx, testTOTPConfig, a, "ABCDE FGHIJ", now))
+		assert.ErrorIs(t, c.checkCode(ctx, tx, testTOTPConfig, a, "abcde-fghij", now), ErrInvalidCode)
+		assert.Equal(t, int32(1), a.FailedAttempts)
+	})
+
+	t.Run("Recovery code of a pending authenticator", func(t *testing.T) {
+		c, _, _ := newTestController(t)
+		a := newTestTOTP(t, false)
+
+		// recovery codes are issued on activation, the repository is not asked
+		assert.ErrorIs(t, c.checkCode(ctx, nil, testTOTPConfig, a, "abcde-fghij", now), ErrInvalidCode)
+	})
+}
+
+func TestTwoFactorLoginInvalidCode(t *testing.T) {
+	setTOTPConfig(t, false)
+	ctx := context.Background()
+	c, mockRepo, _ := newTestController(t)
+	tx := &fakeTx{}
+	a := newTestTOTP(t, true)
+	a.FailedAttempts = testTOTPConfig.maxAttempts - 1
+
+	challenge := model.TwoFactorChallenge{
+		TokenHash: utils.GenerateHashFromString("challenge"),
+		UserId:    7,
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+	}
+
+	mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+	mockRepo.EXPECT().TxFindTwoFactorChallenge(ctx, tx, challenge.TokenHash).Return(challenge, nil)
+	mockRepo.EXPECT().TxFindUserTOTP(ctx, tx, int32(7)).Return(*a, nil)
+	mockRepo.EXPECT().TxSaveUserTOTP(ctx, tx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ pgx.Tx, saved model.UserTOTP) error {
+			assert.NotZero(t, saved.LockedUntil, "the last allowed attempt locks the authenticator")
+			return nil
+		})
+
+	// the failed attempt is stored, the challenge is kept for another code
+	_, err := c.TwoFactorLogin(ctx, &model.TwoFactorLoginRequest{ChallengeToken: "challenge", Code: "000000"})
+	assert.ErrorIs(t, err, ErrInvalidCode)
+	assert.True(t, tx.committed)
+}
+
+func TestLoginTwoFactorChallenge(t *testing.T) {
+	ctx := context.Background()
+	creds := &model.UserCredentials{UserId: 7}
+	admin := &model.User{UserId: 7, Roles: uint64(rbac.RoleUser | rbac.RoleAdmin)}
+
+	tests := []struct {
+		name             string
+		requireForAdmins bool
+		totp             *model.UserTOTP
+		user             *model.User
+		enroll           bool
+	}{
+		{
+			name: "Enabled authenticator",
+			totp: &model.UserTOTP{UserId: 7, Enabled: true},
+		},
+		{
+			name:             "Enabled authenticator of an admin",
+			requireForAdmins: true,
+			totp:             &model.UserTOTP{UserId: 7, Enabled: true},
+		},
+		{
+			name:             "Admin without authenticator",
+			requireForAdmins: true,
+			user:             admin,
+			enroll:           true,
+		},
+		{
+			name:             "Admin with a pending authenticator",
+			requireForAdmins: true,
+			totp:             &model.UserTOTP{UserId: 7},
+			user:             admin,
+			enroll:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTOTPConfig(t, tt.requireForAdmins)
+			c, mockRepo, _ := newTestController(t)
+
+			if tt.totp != nil {
+				mockRepo.EXPECT().TxFindUserTOTP(ctx, nil, int32(7)).Return(*tt.totp, nil)
+			} else {
+				mockRepo.EXPECT().TxFindUserTOTP(ctx, nil, int32(7)).Return(model.UserTOTP{}, pgx.ErrNoRows)
+			}
+			if tt.user != nil {
+				mockRepo.EXPECT().FindUser(ctx, &model.UserRequest{UserId: 7}).Return(tt.user, nil)
+			}
+
+			var stored model.TwoFactorChallenge
+			mockRepo.EXPECT().NewTwoFactorChallenge(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, ch model.TwoFactorChallenge) error {
+					stored = ch
+					return nil
+				})
+
+			res, err := c.login(ctx, creds, &model.AuthenticateRequest{RememberMe: true, IpAddress: "127.0.0.1"})
+			require.NoError(t, err)
+
+			// no tokens are issued before the second factor
+			assert.Empty(t, res.AccessToken)
+			assert.Empty(t, res.RefreshToken)
+			require.NotNil(t, res.Challenge)
+			assert.Equal(t, tt.enroll, res.Challenge.EnrollmentRequired)
+
+			assert.Equal(t, utils.GenerateHashFromString(res.Challenge.Token), stored.TokenHash)
+			assert.Equal(t, int32(7), stored.UserId)
+			assert.Equal(t, tt.enroll, stored.Enroll)
+			assert.True(t, stored.RememberMe)
+			assert.Equal(t, "127.0.0.1", stored.IpAddress)
+			assert.Equal(t, stored.CreatedAt+int64(testTOTPConfig.challengeTTL.Seconds()), stored.ExpiresAt)
+		})
+	}
+}
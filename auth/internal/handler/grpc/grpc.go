@@ -2,10 +2,13 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"pickfighter.com/auth/internal/controller/auth"
+	"pickfighter.com/auth/internal/oidc"
 	"pickfighter.com/auth/pkg/model"
 	"pickfighter.com/gen"
+	"pickfighter.com/pkg/rbac"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -71,6 +74,68 @@ func (h *Handler) Login(ctx context.Context, req *gen.AuthenticateRequest) (*gen
 	return model.AuthenticateResultToProto(resp), nil
 }
 
+// RefreshToken handles the gRPC request to exchange a refresh token for new access and refresh tokens.
+// Invalid, expired, revoked and reused refresh tokens are reported as unauthenticated.
+func (h *Handler) RefreshToken(ctx context.Context, req *gen.RefreshTokenRequest) (*gen.AuthenticateResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	resp, err := h.ctrl.RefreshToken(ctx, model.RefreshTokenRequestFromProto(req))
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidRefreshToken) || errors.Is(err, auth.ErrRefreshTokenReused) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return model.AuthenticateResultToProto(resp), nil
+}
+
+// RevokeToken handles the gRPC request to revoke an access token by its JWT ID.
+func (h *Handler) RevokeToken(ctx context.Context, req *gen.RevokeTokenRequest) (*gen.RevokeTokenResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	if err := h.ctrl.RevokeToken(ctx, model.RevokeTokenRequestFromProto(req)); err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.RevokeTokenResponse{}, nil
+}
+
+// RevokeUserTokens handles the gRPC request to revoke all tokens issued to a user before a timestamp.
+func (h *Handler) RevokeUserTokens(ctx context.Context, req *gen.RevokeUserTokensRequest) (*gen.RevokeTokenResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request has no user id")
+	}
+
+	if err := h.ctrl.RevokeUserTokens(ctx, model.RevokeUserTokensRequestFromProto(req)); err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.RevokeTokenResponse{}, nil
+}
+
+// TokenStatus handles the gRPC request to check whether an access token is revoked.
+func (h *Handler) TokenStatus(ctx context.Context, req *gen.TokenStatusRequest) (*gen.TokenStatusResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	resp, err := h.ctrl.TokenStatus(ctx, model.TokenStatusRequestFromProto(req))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return model.TokenStatusToProto(resp), nil
+}
+
 // PasswordReset handles the gRPC request to reset a user's password.
 // It verifies the request, converts it to internal format, delegates to the controller,
 // and returns success or an error if the password reset fails.
@@ -79,7 +144,7 @@ func (h *Handler) PasswordReset(ctx context.Context, req *gen.PasswordResetReque
 		return nil, status.Errorf(codes.InvalidArgument, "nil request")
 	}
 
-	resetPasswordReq := &model.ResetPasswordRequest{Email: req.Email}
+	resetPasswordReq := &model.ResetPasswordRequest{Email: req.Email, Locale: req.Locale}
 	_, err := h.ctrl.PasswordReset(ctx, resetPasswordReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
@@ -122,3 +187,388 @@ func (h *Handler) Profile(ctx context.Context, req *gen.ProfileRequest) (*gen.Pr
 
 	return model.UserToProto(user), nil
 }
+
+// EmailOutbox handles the gRPC request to list queued emails with their delivery status.
+func (h *Handler) EmailOutbox(ctx context.Context, req *gen.EmailOutboxRequest) (*gen.EmailOutboxResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	emails, err := h.ctrl.EmailOutbox(ctx, &model.EmailOutboxRequest{Status: req.Status, Limit: req.Limit})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.EmailOutboxResponse{Emails: model.OutboxEmailsToProto(emails)}, nil
+}
+
+// ResendEmail handles the gRPC request to send a pending or dead outbox email again.
+func (h *Handler) ResendEmail(ctx context.Context, req *gen.ResendEmailRequest) (*gen.ResendEmailResponse, error) {
+	if req == nil || req.EmailId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "email id should be specified")
+	}
+
+	e, err := h.ctrl.ResendEmail(ctx, &model.ResendEmailRequest{EmailId: req.EmailId})
+	switch {
+	case errors.Is(err, auth.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	case errors.Is(err, auth.ErrEmailSent):
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.ResendEmailResponse{Email: model.OutboxEmailToProto(e)}, nil
+}
+
+// OAuthLogin handles the gRPC request to start a login with an OpenID Connect provider,
+// it returns the provider's login page URL and the state of the login.
+func (h *Handler) OAuthLogin(ctx context.Context, req *gen.OAuthLoginRequest) (*gen.OAuthLoginResponse, error) {
+	if req == nil || req.Provider == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "provider should be specified")
+	}
+
+	res, err := h.ctrl.OAuthLogin(ctx, &model.OAuthLoginRequest{Provider: req.Provider, RememberMe: req.RememberMe})
+	switch {
+	case errors.Is(err, oidc.ErrUnknownProvider):
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return model.OAuthLoginResultToProto(res), nil
+}
+
+// OAuthCallback handles the gRPC request to complete a login with an OpenID Connect provider.
+// Invalid states, rejected codes, invalid ID tokens and unverified emails are reported as unauthenticated.
+func (h *Handler) OAuthCallback(ctx context.Context, req *gen.OAuthCallbackRequest) (*gen.AuthenticateResponse, error) {
+	if req == nil || req.Provider == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "provider should be specified")
+	}
+
+	resp, err := h.ctrl.OAuthCallback(ctx, model.OAuthCallbackRequestFromProto(req))
+	switch {
+	case errors.Is(err, oidc.ErrUnknownProvider):
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	case errors.Is(err, auth.ErrInvalidOAuthState),
+		errors.Is(err, auth.ErrEmailNotVerified),
+		errors.Is(err, oidc.ErrInvalidIDToken),
+		errors.Is(err, oidc.ErrExchange):
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return model.AuthenticateResultToProto(resp), nil
+}
+
+// twoFactorStatus converts two-factor errors of the controller to gRPC statuses:
+// rejected challenges and codes are unauthenticated, a locked authenticator is exhausted
+// and requests not matching the authenticator's state are failed preconditions.
+func twoFactorStatus(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrInvalidChallenge), errors.Is(err, auth.ErrInvalidCode):
+		return status.Errorf(codes.Unauthenticated, err.Error())
+	case errors.Is(err, auth.ErrTOTPLocked):
+		return status.Errorf(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, auth.ErrTOTPEnabled), errors.Is(err, auth.ErrTOTPNotEnrolled),
+		errors.Is(err, auth.ErrTOTPRequired):
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, err.Error())
+	}
+}
+
+// TwoFactorLogin handles the gRPC request to complete a login challenge with a TOTP code or a recovery code.
+func (h *Handler) TwoFactorLogin(ctx context.Context, req *gen.TwoFactorLoginRequest) (*gen.AuthenticateResponse, error) {
+	if req == nil || req.ChallengeToken == "" || req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "challenge token and code should be specified")
+	}
+
+	resp, err := h.ctrl.TwoFactorLogin(ctx, model.TwoFactorLoginRequestFromProto(req))
+	if err != nil {
+		return nil, twoFactorStatus(err)
+	}
+
+	return model.AuthenticateResultToProto(resp), nil
+}
+
+// TOTPEnroll handles the gRPC request to enroll an authenticator for a logged in user
+// or for the user of a login challenge requiring enrollment.
+func (h *Handler) TOTPEnroll(ctx context.Context, req *gen.TOTPEnrollRequest) (*gen.TOTPEnrollResponse, error) {
+	if req == nil || (req.UserId <= 0 && req.ChallengeToken == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "user id or challenge token should be specified")
+	}
+
+	e, err := h.ctrl.TOTPEnroll(ctx, &model.TOTPEnrollRequest{UserId: req.UserId, ChallengeToken: req.ChallengeToken})
+	if err != nil {
+		return nil, twoFactorStatus(err)
+	}
+
+	return &gen.TOTPEnrollResponse{Secret: e.Secret, Uri: e.Uri}, nil
+}
+
+// TOTPActivate handles the gRPC request to enable an enrolled authenticator with a TOTP code of it.
+func (h *Handler) TOTPActivate(ctx context.Context, req *gen.TOTPCodeRequest) (*gen.RecoveryCodesResponse, error) {
+	if req == nil || req.UserId <= 0 || req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user id and code should be specified")
+	}
+
+	recoveryCodes, err := h.ctrl.TOTPActivate(ctx, &model.TOTPCodeRequest{UserId: req.UserId, Code: req.Code})
+	if err != nil {
+		return nil, twoFactorStatus(err)
+	}
+
+	return &gen.RecoveryCodesResponse{Codes: recoveryCodes}, nil
+}
+
+// TOTPDisable handles the gRPC request to disable two-factor authentication with a TOTP code or a recovery code.
+func (h *Handler) TOTPDisable(ctx context.Context, req *gen.TOTPCodeRequest) (*gen.TOTPDisableResponse, error) {
+	if req == nil || req.UserId <= 0 || req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user id and code should be specified")
+	}
+
+	if err := h.ctrl.TOTPDisable(ctx, &model.TOTPCodeRequest{UserId: req.UserId, Code: req.Code}); err != nil {
+		return nil, twoFactorStatus(err)
+	}
+
+	return &gen.TOTPDisableResponse{}, nil
+}
+
+// RegenerateRecoveryCodes handles the gRPC request to replace the recovery codes of an enabled authenticator.
+func (h *Handler) RegenerateRecoveryCodes(ctx context.Context, req *gen.TOTPCodeRequest) (*gen.RecoveryCodesResponse, error) {
+	if req == nil || req.UserId <= 0 || req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user id and code should be specified")
+	}
+
+	recoveryCodes, err := h.ctrl.RegenerateRecoveryCodes(ctx, &model.TOTPCodeRequest{UserId: req.UserId, Code: req.Code})
+	if err != nil {
+		return nil, twoFactorStatus(err)
+	}
+
+	return &gen.RecoveryCodesResponse{Codes: recoveryCodes}, nil
+}
+
+// roleStatus converts role change errors of the controller to gRPC statuses.
+func roleStatus(err error) error {
+	switch {
+	case errors.Is(err, rbac.ErrUnknownRole):
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	case errors.Is(err, auth.ErrForbidden), errors.Is(err, auth.ErrOwnRoles):
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	case errors.Is(err, auth.ErrNotFound):
+		return status.Errorf(codes.NotFound, err.Error())
+	case errors.Is(err, auth.ErrRoleUnchanged):
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, err.Error())
+	}
+}
+
+// GrantRole handles the gRPC request to grant a role to a user on behalf of an actor.
+func (h *Handler) GrantRole(ctx context.Context, req *gen.RoleChangeRequest) (*gen.RoleChangeResponse, error) {
+	if req == nil || req.UserId <= 0 || req.ActorId <= 0 || req.Role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user id, actor id and role should be specified")
+	}
+
+	change, err := h.ctrl.GrantRole(ctx, model.RoleChangeRequestFromProto(req))
+	if err != nil {
+		return nil, roleStatus(err)
+	}
+
+	return &gen.RoleChangeResponse{Change: model.RoleChangeToProto(change)}, nil
+}
+
+// RevokeRole handles the gRPC request to revoke a role of a user on behalf of an actor.
+func (h *Handler) RevokeRole(ctx context.Context, req *gen.RoleChangeRequest) (*gen.RoleChangeResponse, error) {
+	if req == nil || req.UserId <= 0 || req.ActorId <= 0 || req.Role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user id, actor id and role should be specified")
+	}
+
+	change, err := h.ctrl.RevokeRole(ctx, model.RoleChangeRequestFromProto(req))
+	if err != nil {
+		return nil, roleStatus(err)
+	}
+
+	return &gen.RoleChangeResponse{Change: model.RoleChangeToProto(change)}, nil
+}
+
+// RoleAudit handles the gRPC request to list role changes, the newest first.
+func (h *Handler) RoleAudit(ctx context.Context, req *gen.RoleAuditRequest) (*gen.RoleAuditResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	changes, err := h.ctrl.RoleAudit(ctx, &model.RoleAuditRequest{UserId: req.UserId, Limit: req.Limit})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.RoleAuditResponse{Changes: model.RoleChangesToProto(changes)}, nil
+}
+
+// accountStatus converts profile, password and email change errors of the controller to gRPC statuses.
+func accountStatus(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrInvalidName), errors.Is(err, auth.ErrInvalidPassword),
+		errors.Is(err, auth.ErrInvalidEmail):
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	case errors.Is(err, auth.ErrWrongPassword):
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	case errors.Is(err, auth.ErrNameTaken), errors.Is(err, auth.ErrEmailTaken):
+		return status.Errorf(codes.AlreadyExists, err.Error())
+	case errors.Is(err, auth.ErrNotFound), errors.Is(err, auth.ErrInvalidEmailToken):
+		return status.Errorf(codes.NotFound, err.Error())
+	default:
+		return status.Errorf(codes.Internal, err.Error())
+	}
+}
+
+// UpdateProfile handles the gRPC request to change the display name of a user.
+func (h *Handler) UpdateProfile(ctx context.Context, req *gen.ProfileRequest) (*gen.ProfileResponse, error) {
+	if req == nil || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request has no id")
+	}
+
+	user, err := h.ctrl.UpdateProfile(ctx, &model.UserRequest{UserId: req.UserId, Name: req.Name})
+	if err != nil {
+		return nil, accountStatus(err)
+	}
+
+	return model.UserToProto(user), nil
+}
+
+// ChangePassword handles the gRPC request to change the password of a user,
+// logging out all sessions of the user except the given one.
+func (h *Handler) ChangePassword(ctx context.Context, req *gen.ChangePasswordRequest) (*gen.ChangePasswordResponse, error) {
+	if req == nil || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request has no id")
+	}
+
+	err := h.ctrl.ChangePassword(ctx, &model.ChangePasswordRequest{
+		UserId:      req.UserId,
+		SessionId:   req.SessionId,
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		return nil, accountStatus(err)
+	}
+
+	return &gen.ChangePasswordResponse{}, nil
+}
+
+// ChangeEmail handles the gRPC request to send a confirmation of an email change to the new address.
+func (h *Handler) ChangeEmail(ctx context.Context, req *gen.ChangeEmailRequest) (*gen.ChangeEmailResponse, error) {
+	if req == nil || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request has no id")
+	}
+
+	res, err := h.ctrl.ChangeEmail(ctx, &model.ChangeEmailRequest{
+		UserId:   req.UserId,
+		Email:    req.Email,
+		Password: req.Password,
+		Locale:   req.Locale,
+	})
+	if err != nil {
+		return nil, accountStatus(err)
+	}
+
+	return &gen.ChangeEmailResponse{Email: res.Email, ExpiresAt: res.ExpiresAt}, nil
+}
+
+// ConfirmEmailChange handles the gRPC request to swap the email of a user with a confirmation token.
+func (h *Handler) ConfirmEmailChange(ctx context.Context, req *gen.ConfirmEmailChangeRequest) (*gen.ConfirmEmailChangeResponse, error) {
+	if req == nil || req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token should be specified")
+	}
+
+	change, err := h.ctrl.ConfirmEmailChange(ctx, &model.ConfirmEmailChangeRequest{Token: req.Token})
+	if err != nil {
+		return nil, accountStatus(err)
+	}
+
+	return &gen.ConfirmEmailChangeResponse{UserId: change.UserId, Email: change.Email}, nil
+}
+
+// accountDeletionStatus converts account export and deletion errors of the controller to gRPC statuses.
+func accountDeletionStatus(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrWrongPassword):
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	case errors.Is(err, auth.ErrNotFound):
+		return status.Errorf(codes.NotFound, err.Error())
+	case errors.Is(err, auth.ErrDeletionNotScheduled), errors.Is(err, auth.ErrDeletionNotDue):
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, err.Error())
+	}
+}
+
+// ExportAccount handles the gRPC request to collect the personal data kept about a user.
+func (h *Handler) ExportAccount(ctx context.Context, req *gen.AccountRequest) (*gen.AccountExportResponse, error) {
+	if req == nil || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request has no id")
+	}
+
+	export, err := h.ctrl.ExportAccount(ctx, &model.UserRequest{UserId: req.UserId})
+	if err != nil {
+		return nil, accountDeletionStatus(err)
+	}
+
+	return model.AccountExportToProto(export), nil
+}
+
+// DeleteAccount handles the gRPC request to schedule the deletion of a user's account after the grace period.
+func (h *Handler) DeleteAccount(ctx context.Context, req *gen.DeleteAccountRequest) (*gen.DeleteAccountResponse, error) {
+	if req == nil || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request has no id")
+	}
+
+	deletion, err := h.ctrl.DeleteAccount(ctx, &model.DeleteAccountRequest{UserId: req.UserId, Password: req.Password})
+	if err != nil {
+		return nil, accountDeletionStatus(err)
+	}
+
+	return &gen.DeleteAccountResponse{DeleteAfter: deletion.DeleteAfter}, nil
+}
+
+// CancelAccountDeletion handles the gRPC request to keep an account whose deletion is scheduled.
+func (h *Handler) CancelAccountDeletion(ctx context.Context, req *gen.AccountRequest) (*gen.CancelAccountDeletionResponse, error) {
+	if req == nil || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request has no id")
+	}
+
+	if err := h.ctrl.CancelAccountDeletion(ctx, &model.UserRequest{UserId: req.UserId}); err != nil {
+		return nil, accountDeletionStatus(err)
+	}
+
+	return &gen.CancelAccountDeletionResponse{}, nil
+}
+
+// DueAccountDeletions handles the gRPC request to claim users whose accounts are to be anonymized.
+func (h *Handler) DueAccountDeletions(ctx context.Context, req *gen.DueAccountDeletionsRequest) (*gen.DueAccountDeletionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	userIds, err := h.ctrl.DueAccountDeletions(ctx, req.Limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.DueAccountDeletionsResponse{UserIds: userIds}, nil
+}
+
+// AnonymizeAccount handles the gRPC request to anonymize a user whose account deletion is due.
+func (h *Handler) AnonymizeAccount(ctx context.Context, req *gen.AccountRequest) (*gen.AnonymizeAccountResponse, error) {
+	if req == nil || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request has no id")
+	}
+
+	if err := h.ctrl.AnonymizeAccount(ctx, &model.UserRequest{UserId: req.UserId}); err != nil {
+		return nil, accountDeletionStatus(err)
+	}
+
+	return &gen.AnonymizeAccountResponse{}, nil
+}
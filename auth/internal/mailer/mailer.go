@@ -0,0 +1,62 @@
+// Package mailer renders and sends emails of the auth service. Messages are rendered from templates
+// per email subject and locale and sent with a Mailer: an SMTP server in production, a file or the console
+// in development and memory in tests.
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"pickfighter.com/auth/pkg/model"
+)
+
+// Mailer drivers.
+const (
+	DriverSMTP    = "smtp"
+	DriverFile    = "file"
+	DriverConsole = "console"
+	DriverMemory  = "memory"
+)
+
+var ErrUnknownDriver = errors.New("unknown mailer driver")
+
+// Message is a rendered email ready to be sent, HTML is optional.
+type Message struct {
+	From    model.EmailAddrSpec
+	To      model.EmailAddrSpec
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Mailer sends messages. Send returns an error if the message was not accepted,
+// it is up to the caller to log or retry it.
+type Mailer interface {
+	Send(ctx context.Context, m Message) error
+}
+
+// Config selects the mailer driver and holds settings of all drivers.
+type Config struct {
+	Driver string     `mapstructure:"driver"`
+	SMTP   SMTPConfig `mapstructure:"smtp"`
+	// FilePath is the directory the file driver writes messages to.
+	FilePath string `mapstructure:"file_path"`
+}
+
+// New creates the mailer of the configured driver.
+func New(cfg Config) (Mailer, error) {
+	switch cfg.Driver {
+	case DriverSMTP:
+		return NewSMTP(cfg.SMTP), nil
+	case DriverFile:
+		return NewFile(cfg.FilePath)
+	case DriverConsole:
+		return NewWriter(os.Stdout), nil
+	case DriverMemory:
+		return NewMemory(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownDriver, cfg.Driver)
+	}
+}
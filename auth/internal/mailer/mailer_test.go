@@ -0,0 +1,104 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pickfighter.com/auth/pkg/model"
+)
+
+func testMessage() Message {
+	return Message{
+		From:    model.EmailAddrSpec{Email: "noreply@pickfighter.com", Name: "Pickfighter"},
+		To:      model.EmailAddrSpec{Email: "user@example.com", Name: "Jon"},
+		Subject: "Please verify your email",
+		Text:    "Follow the link\n",
+		HTML:    "<p>Follow the link</p>",
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, driver := range []string{DriverConsole, DriverMemory, DriverSMTP} {
+		m, err := New(Config{Driver: driver})
+		require.NoError(t, err, driver)
+		assert.NotNil(t, m, driver)
+	}
+
+	_, err := New(Config{Driver: "pigeon"})
+	assert.ErrorIs(t, err, ErrUnknownDriver)
+
+	_, err = New(Config{Driver: DriverFile})
+	assert.Error(t, err, "the file driver requires a directory")
+}
+
+func TestWriterSend(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	require.NoError(t, w.Send(context.Background(), testMessage()))
+
+	out := buf.String()
+	assert.Contains(t, out, "Subject: Please verify your email")
+	assert.Contains(t, out, "To: \"Jon\" <user@example.com>")
+	assert.Contains(t, out, "From: \"Pickfighter\" <noreply@pickfighter.com>")
+	assert.Contains(t, out, "text/plain")
+	assert.Contains(t, out, "text/html")
+	assert.Contains(t, out, "Follow the link")
+
+	// text only messages have no HTML part
+	buf.Reset()
+	m := testMessage()
+	m.HTML = ""
+
+	require.NoError(t, w.Send(context.Background(), m))
+	assert.NotContains(t, buf.String(), "text/html")
+}
+
+func TestFileSend(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mails")
+
+	f, err := NewFile(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, f.Send(context.Background(), testMessage()))
+	require.NoError(t, f.Send(context.Background(), testMessage()))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	b, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "Subject: Please verify your email")
+}
+
+func TestMemorySend(t *testing.T) {
+	m := NewMemory()
+	ctx := context.Background()
+
+	first, second := testMessage(), testMessage()
+	second.Subject = "Set a new password"
+
+	require.NoError(t, m.Send(ctx, first))
+	require.NoError(t, m.Send(ctx, second))
+	assert.Equal(t, []Message{first, second}, m.Messages())
+
+	// the returned slice is a copy
+	m.Messages()[0].Subject = "changed"
+	assert.Equal(t, first.Subject, m.Messages()[0].Subject)
+
+	m.Reset()
+	assert.Empty(t, m.Messages())
+
+	errSend := errors.New("mailbox unavailable")
+	m.Err = errSend
+
+	assert.ErrorIs(t, m.Send(ctx, first), errSend)
+	assert.Empty(t, m.Messages())
+}
@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+)
+
+// Memory keeps sent messages in memory, tests read them with Messages.
+type Memory struct {
+	mu       sync.Mutex
+	messages []Message
+	// Err is returned by Send instead of keeping the message when set.
+	Err error
+}
+
+// NewMemory creates an empty in-memory mailer.
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Send keeps the message.
+func (m *Memory) Send(ctx context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.Err != nil {
+		return m.Err
+	}
+
+	m.messages = append(m.messages, msg)
+
+	return nil
+}
+
+// Messages returns a copy of the sent messages in the order they were sent.
+func (m *Memory) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Message(nil), m.messages...)
+}
+
+// Reset forgets the sent messages.
+func (m *Memory) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.messages = nil
+}
@@ -0,0 +1,70 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+
+	"gopkg.in/gomail.v2"
+)
+
+// SMTP TLS modes.
+const (
+	// TLSStartTLS upgrades the connection with STARTTLS when the server supports it.
+	TLSStartTLS = "starttls"
+	// TLSImplicit connects over TLS, usually to port 465.
+	TLSImplicit = "tls"
+)
+
+// SMTPConfig holds the SMTP server address, credentials and TLS mode.
+type SMTPConfig struct {
+	Host               string `mapstructure:"host"`
+	Port               int    `mapstructure:"port"`
+	Username           string `mapstructure:"username"`
+	Password           string `mapstructure:"password"`
+	TLS                string `mapstructure:"tls"`
+	InsecureSkipVerify bool   `mapstructure:"insecure_skip_verify"`
+}
+
+// SMTP sends messages through an SMTP server, a connection is opened for every message.
+type SMTP struct {
+	cfg SMTPConfig
+}
+
+// NewSMTP creates a mailer sending through the configured SMTP server.
+func NewSMTP(cfg SMTPConfig) *SMTP {
+	return &SMTP{
+		cfg: cfg,
+	}
+}
+
+// Send delivers the message to the SMTP server.
+func (s *SMTP) Send(ctx context.Context, m Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// the dialer caches the auth mechanism of the server, so it is not shared between sends
+	d := gomail.NewDialer(s.cfg.Host, s.cfg.Port, s.cfg.Username, s.cfg.Password)
+	d.SSL = s.cfg.TLS == TLSImplicit
+	d.TLSConfig = &tls.Config{
+		ServerName:         s.cfg.Host,
+		InsecureSkipVerify: s.cfg.InsecureSkipVerify,
+	}
+
+	return d.DialAndSend(gomailMessage(m))
+}
+
+func gomailMessage(m Message) *gomail.Message {
+	msg := gomail.NewMessage()
+
+	msg.SetAddressHeader("From", m.From.Email, m.From.Name)
+	msg.SetAddressHeader("To", m.To.Email, m.To.Name)
+	msg.SetHeader("Subject", m.Subject)
+
+	msg.SetBody("text/plain", m.Text)
+	if m.HTML != "" {
+		msg.AddAlternative("text/html", m.HTML)
+	}
+
+	return msg
+}
@@ -0,0 +1,144 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"pickfighter.com/auth/pkg/model"
+)
+
+// DefaultLocale is used when the recipient's locale has no templates.
+const DefaultLocale = "en"
+
+var ErrNoTemplate = errors.New("no email template")
+
+// Subjects have a "<subject>.txt" template defining the "subject" and "text" blocks
+// and an optional "<subject>.html" template per locale directory.
+//
+//go:embed templates
+var templatesFS embed.FS
+
+// Templates render messages per email subject and locale.
+type Templates struct {
+	defaultLocale string
+	text          map[string]*texttemplate.Template
+	html          map[string]*htmltemplate.Template
+}
+
+// NewTemplates parses the embedded templates. Every subject must have a template in the default locale,
+// which is used for locales without one.
+func NewTemplates(defaultLocale string) (*Templates, error) {
+	if defaultLocale == "" {
+		defaultLocale = DefaultLocale
+	}
+
+	t := &Templates{
+		defaultLocale: defaultLocale,
+		text:          make(map[string]*texttemplate.Template),
+		html:          make(map[string]*htmltemplate.Template),
+	}
+
+	err := fs.WalkDir(templatesFS, "templates", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		locale := path.Base(path.Dir(p))
+		name := path.Base(p)
+		key := templateKey(locale, strings.TrimSuffix(name, path.Ext(name)))
+
+		switch path.Ext(name) {
+		case ".txt":
+			tmpl, err := texttemplate.ParseFS(templatesFS, p)
+			if err != nil {
+				return err
+			}
+			t.text[key] = tmpl
+		case ".html":
+			tmpl, err := htmltemplate.ParseFS(templatesFS, p)
+			if err != nil {
+				return err
+			}
+			t.html[key] = tmpl
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subject := range model.EmailSubjects {
+		if _, ok := t.text[templateKey(defaultLocale, subject)]; !ok {
+			return nil, fmt.Errorf("%w: %s/%s", ErrNoTemplate, defaultLocale, subject)
+		}
+	}
+
+	return t, nil
+}
+
+// Render renders the message of data.Subject in data.Locale. A regional locale like "es-MX" falls back
+// to its language and then to the default locale.
+func (t *Templates) Render(data *model.EmailData) (Message, error) {
+	locale := t.locale(data.Locale, data.Subject)
+	if locale == "" {
+		return Message{}, fmt.Errorf("%w: %q", ErrNoTemplate, data.Subject)
+	}
+
+	key := templateKey(locale, data.Subject)
+
+	var subject, text bytes.Buffer
+	if err := t.text[key].ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	if err := t.text[key].ExecuteTemplate(&text, "text", data); err != nil {
+		return Message{}, err
+	}
+
+	m := Message{
+		From:    data.Sender,
+		To:      data.Recipient,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+	}
+
+	if tmpl, ok := t.html[key]; ok {
+		var html bytes.Buffer
+		if err := tmpl.Execute(&html, data); err != nil {
+			return Message{}, err
+		}
+		m.HTML = html.String()
+	}
+
+	return m, nil
+}
+
+// locale returns the first locale having a template of the subject, empty if there is none.
+func (t *Templates) locale(locale, subject string) string {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+
+	candidates := []string{locale}
+	if lang, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, lang)
+	}
+	candidates = append(candidates, t.defaultLocale)
+
+	for _, l := range candidates {
+		if _, ok := t.text[templateKey(l, subject)]; ok && l != "" {
+			return l
+		}
+	}
+
+	return ""
+}
+
+func templateKey(locale, subject string) string {
+	return locale + "/" + subject
+}
@@ -0,0 +1,114 @@
+package mailer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pickfighter.com/auth/pkg/model"
+)
+
+func testData(subject, locale string) *model.EmailData {
+	return &model.EmailData{
+		Sender:    model.EmailAddrSpec{Email: "noreply@pickfighter.com", Name: "Pickfighter"},
+		Recipient: model.EmailAddrSpec{Email: "user@example.com", Name: "Jon"},
+		Subject:   subject,
+		Token:     "token123",
+		Url:       "https://pickfighter.com/confirm?token=token123",
+		Locale:    locale,
+	}
+}
+
+func TestRender(t *testing.T) {
+	templates, err := NewTemplates("")
+	require.NoError(t, err)
+
+	for _, locale := range []string{"en", "es"} {
+		for _, subject := range model.EmailSubjects {
+			t.Run(locale+"/"+subject, func(t *testing.T) {
+				data := testData(subject, locale)
+
+				m, err := templates.Render(data)
+				require.NoError(t, err)
+
+				assert.Equal(t, data.Sender, m.From)
+				assert.Equal(t, data.Recipient, m.To)
+				assert.NotEmpty(t, m.Subject)
+				assert.NotContains(t, m.Subject, "\n")
+				assert.Contains(t, m.Text, data.Url)
+				assert.Contains(t, m.Text, "Jon")
+				assert.Contains(t, m.HTML, `href="https://pickfighter.com/confirm?token=token123"`)
+				assert.Contains(t, m.HTML, `lang="`+locale+`"`)
+			})
+		}
+	}
+}
+
+func TestRenderEscapesHTML(t *testing.T) {
+	templates, err := NewTemplates("")
+	require.NoError(t, err)
+
+	data := testData(model.EmailRegistration, "en")
+	data.Recipient.Name = "<b>Jon</b>"
+
+	m, err := templates.Render(data)
+	require.NoError(t, err)
+
+	assert.Contains(t, m.HTML, "&lt;b&gt;Jon&lt;/b&gt;")
+	assert.Contains(t, m.Text, "<b>Jon</b>")
+}
+
+func TestRenderLocaleFallback(t *testing.T) {
+	templates, err := NewTemplates("")
+	require.NoError(t, err)
+
+	es, err := templates.Render(testData(model.EmailResetPassword, "es"))
+	require.NoError(t, err)
+	en, err := templates.Render(testData(model.EmailResetPassword, "en"))
+	require.NoError(t, err)
+	require.NotEqual(t, en.Subject, es.Subject)
+
+	tests := []struct {
+		locale string
+		want   Message
+	}{
+		{locale: "es-MX", want: es},
+		{locale: "es_MX", want: es},
+		{locale: "ES", want: es},
+		{locale: "fr", want: en},
+		{locale: "fr-CA", want: en},
+		{locale: "", want: en},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			m, err := templates.Render(testData(model.EmailResetPassword, tt.locale))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, m)
+		})
+	}
+}
+
+func TestRenderDefaultLocale(t *testing.T) {
+	templates, err := NewTemplates("es")
+	require.NoError(t, err)
+
+	m, err := templates.Render(testData(model.EmailChangeEmail, "fr"))
+	require.NoError(t, err)
+
+	es, err := templates.Render(testData(model.EmailChangeEmail, "es"))
+	require.NoError(t, err)
+	assert.Equal(t, es, m)
+
+	_, err = NewTemplates("fr")
+	assert.ErrorIs(t, err, ErrNoTemplate)
+}
+
+func TestRenderUnknownSubject(t *testing.T) {
+	templates, err := NewTemplates("")
+	require.NoError(t, err)
+
+	_, err = templates.Render(testData("newsletter", "en"))
+	assert.ErrorIs(t, err, ErrNoTemplate)
+}
@@ -0,0 +1,77 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Writer writes messages as MIME documents to an io.Writer, the console driver writes to stdout.
+type Writer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriter creates a mailer writing messages to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		w: w,
+	}
+}
+
+// Send writes the message followed by a blank line.
+func (w *Writer) Send(ctx context.Context, m Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, err := gomailMessage(m).WriteTo(w.w); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w.w, "\r\n\r\n")
+
+	return err
+}
+
+// File writes every message to its own .eml file in a directory, so it can be opened with a mail client.
+type File struct {
+	dir string
+	seq atomic.Int64
+}
+
+// NewFile creates a mailer writing messages to the directory, creating it if needed.
+func NewFile(dir string) (*File, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("mailer file path is empty")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &File{
+		dir: dir,
+	}, nil
+}
+
+// Send writes the message to a file named after the send time.
+func (f *File) Send(ctx context.Context, m Message) error {
+	name := fmt.Sprintf("%s-%d.eml", time.Now().UTC().Format("20060102T150405.000000000"), f.seq.Add(1))
+
+	file, err := os.OpenFile(filepath.Join(f.dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := gomailMessage(m).WriteTo(file); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
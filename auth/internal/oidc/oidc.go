@@ -0,0 +1,345 @@
+// Package oidc logs users in with OpenID Connect providers using the authorization code flow with PKCE.
+// Provider endpoints are discovered from the issuer unless configured, ID tokens are verified
+// against the provider's JWKS, which is cached and refreshed when the provider rotates its keys.
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// jwksRefreshInterval limits refreshing the JWKS for tokens signed with unknown keys.
+	jwksRefreshInterval = time.Minute
+	// clockSkew is the accepted difference between the provider's clock and ours.
+	clockSkew = time.Minute
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown OIDC provider")
+	ErrInvalidIDToken  = errors.New("invalid ID token")
+	// ErrExchange is returned when the provider rejects the authorization code.
+	ErrExchange = errors.New("authorization code exchange failed")
+)
+
+// ProviderConfig configures a provider, the endpoints are discovered from the issuer when empty.
+type ProviderConfig struct {
+	Issuer       string   `mapstructure:"issuer"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+
+	AuthURL  string `mapstructure:"auth_url"`
+	TokenURL string `mapstructure:"token_url"`
+	JWKSURL  string `mapstructure:"jwks_url"`
+}
+
+// Claims are the claims of a verified ID token identifying the user.
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Providers holds the configured providers by name.
+type Providers struct {
+	providers map[string]*Provider
+}
+
+// New creates the providers, the JWKS cache lives as long as ctx.
+func New(ctx context.Context, cfgs map[string]ProviderConfig) (*Providers, error) {
+	jwks := jwk.NewCache(ctx)
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	ps := &Providers{
+		providers: make(map[string]*Provider, len(cfgs)),
+	}
+
+	for name, cfg := range cfgs {
+		if cfg.Issuer == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+			return nil, fmt.Errorf("oidc provider %q: issuer, client_id and redirect_url are required", name)
+		}
+
+		if len(cfg.Scopes) == 0 {
+			cfg.Scopes = []string{"openid", "email", "profile"}
+		}
+
+		ps.providers[name] = &Provider{
+			name:   name,
+			cfg:    cfg,
+			client: client,
+			jwks:   jwks,
+		}
+	}
+
+	return ps, nil
+}
+
+// Get returns the provider with the name or ErrUnknownProvider.
+func (ps *Providers) Get(name string) (*Provider, error) {
+	p, ok := ps.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, name)
+	}
+
+	return p, nil
+}
+
+// Names returns the sorted names of the providers.
+func (ps *Providers) Names() []string {
+	names := make([]string, 0, len(ps.providers))
+	for name := range ps.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Provider is an OpenID Connect provider.
+type Provider struct {
+	name   string
+	cfg    ProviderConfig
+	client *http.Client
+	jwks   *jwk.Cache
+
+	mu          sync.Mutex
+	oauth       *oauth2.Config
+	jwksURL     string
+	jwksRefresh time.Time
+}
+
+// Name returns the configured name of the provider.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// AuthCodeURL returns the URL of the provider's login page. The state and nonce are echoed back
+// in the callback and the ID token, the verifier's challenge binds the code to the verifier.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	conf, err := p.config(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return conf.AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce), oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// Exchange exchanges the authorization code for tokens and returns the claims of the verified ID token.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Claims, error) {
+	conf, err := p.config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tok, err := conf.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code, oauth2.VerifierOption(verifier))
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		return nil, fmt.Errorf("%w: oidc provider %q: %w", ErrExchange, p.name, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("oidc provider %q: code exchange: %w", p.name, err)
+	}
+
+	raw, ok := tok.Extra("id_token").(string)
+	if !ok || raw == "" {
+		return nil, fmt.Errorf("%w: no ID token in the token response", ErrInvalidIDToken)
+	}
+
+	return p.verify(ctx, raw, nonce)
+}
+
+// verify checks the ID token signature against the JWKS, its issuer, audience, lifetime and nonce.
+func (p *Provider) verify(ctx context.Context, raw, nonce string) (*Claims, error) {
+	t, err := p.parse(ctx, raw, false)
+	if err != nil && p.canRefreshJWKS() {
+		// the provider may have rotated its keys
+		t, err = p.parse(ctx, raw, true)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	if len(t.Audience()) > 1 {
+		if azp, _ := t.PrivateClaims()["azp"].(string); azp != p.cfg.ClientID {
+			return nil, fmt.Errorf("%w: authorized party %q", ErrInvalidIDToken, azp)
+		}
+	}
+
+	tokenNonce, _ := t.PrivateClaims()["nonce"].(string)
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	claims := &Claims{
+		Subject: t.Subject(),
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidIDToken)
+	}
+
+	claims.Email, _ = t.PrivateClaims()["email"].(string)
+	claims.Name, _ = t.PrivateClaims()["name"].(string)
+
+	// some providers send email_verified as a string
+	switch v := t.PrivateClaims()["email_verified"].(type) {
+	case bool:
+		claims.EmailVerified = v
+	case string:
+		claims.EmailVerified = v == "true"
+	}
+
+	return claims, nil
+}
+
+func (p *Provider) parse(ctx context.Context, raw string, refresh bool) (jwt.Token, error) {
+	var (
+		set jwk.Set
+		err error
+	)
+	if refresh {
+		set, err = p.jwks.Refresh(ctx, p.jwksURL)
+	} else {
+		set, err = p.jwks.Get(ctx, p.jwksURL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return jwt.Parse([]byte(raw),
+		jwt.WithKeySet(set, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithIssuer(p.cfg.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithAcceptableSkew(clockSkew),
+		jwt.WithValidate(true),
+	)
+}
+
+// canRefreshJWKS tells whether the JWKS may be fetched again, at most once per jwksRefreshInterval.
+func (p *Provider) canRefreshJWKS() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if time.Since(p.jwksRefresh) < jwksRefreshInterval {
+		return false
+	}
+	p.jwksRefresh = time.Now()
+
+	return true
+}
+
+// config returns the OAuth2 config of the provider, discovering its endpoints on the first use.
+// A failed discovery is retried on the next use.
+func (p *Provider) config(ctx context.Context) (*oauth2.Config, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth != nil {
+		return p.oauth, nil
+	}
+
+	endpoints := discovery{
+		Issuer:        p.cfg.Issuer,
+		AuthEndpoint:  p.cfg.AuthURL,
+		TokenEndpoint: p.cfg.TokenURL,
+		JWKSURI:       p.cfg.JWKSURL,
+	}
+
+	if endpoints.AuthEndpoint == "" || endpoints.TokenEndpoint == "" || endpoints.JWKSURI == "" {
+		d, err := p.discover(ctx)
+		if err != nil {
+			return nil, err
+		}
+		endpoints.merge(d)
+	}
+
+	if !p.jwks.IsRegistered(endpoints.JWKSURI) {
+		if err := p.jwks.Register(endpoints.JWKSURI, jwk.WithHTTPClient(p.client)); err != nil {
+			return nil, fmt.Errorf("oidc provider %q: jwks: %w", p.name, err)
+		}
+	}
+
+	p.jwksURL = endpoints.JWKSURI
+	p.oauth = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       p.cfg.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  endpoints.AuthEndpoint,
+			TokenURL: endpoints.TokenEndpoint,
+		},
+	}
+
+	return p.oauth, nil
+}
+
+// discovery is the part of the provider's OpenID configuration used for the login.
+type discovery struct {
+	Issuer        string `json:"issuer"`
+	AuthEndpoint  string `json:"authorization_endpoint"`
+	TokenEndpoint string `json:"token_endpoint"`
+	JWKSURI       string `json:"jwks_uri"`
+}
+
+// merge fills the endpoints which are not configured with the discovered ones.
+func (d *discovery) merge(discovered discovery) {
+	if d.AuthEndpoint == "" {
+		d.AuthEndpoint = discovered.AuthEndpoint
+	}
+	if d.TokenEndpoint == "" {
+		d.TokenEndpoint = discovered.TokenEndpoint
+	}
+	if d.JWKSURI == "" {
+		d.JWKSURI = discovered.JWKSURI
+	}
+}
+
+func (p *Provider) discover(ctx context.Context) (discovery, error) {
+	var d discovery
+
+	u := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return d, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return d, fmt.Errorf("oidc provider %q: discovery: %w", p.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return d, fmt.Errorf("oidc provider %q: discovery: %s", p.name, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&d); err != nil {
+		return d, fmt.Errorf("oidc provider %q: discovery: %w", p.name, err)
+	}
+
+	if d.Issuer != p.cfg.Issuer {
+		return d, fmt.Errorf("oidc provider %q: discovery: issuer %q does not match %q", p.name, d.Issuer, p.cfg.Issuer)
+	}
+
+	if d.AuthEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return d, fmt.Errorf("oidc provider %q: discovery: missing endpoints", p.name)
+	}
+
+	return d, nil
+}
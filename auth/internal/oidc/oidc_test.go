@@ -0,0 +1,386 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID = "pickfighter"
+	testNonce    = "nonce123"
+)
+
+// testServer is an OpenID Connect provider serving the discovery document, the JWKS
+// and a token endpoint returning the ID token set by the test.
+type testServer struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	issuer       string
+	keys         jwk.Set
+	idToken      string
+	jwksRequests int
+}
+
+func newTestServer(t *testing.T, keys ...jwk.Key) *testServer {
+	t.Helper()
+
+	s := &testServer{}
+	s.setKeys(t, keys...)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		json.NewEncoder(w).Encode(discovery{
+			Issuer:        s.issuer,
+			AuthEndpoint:  s.URL + "/auth",
+			TokenEndpoint: s.URL + "/token",
+			JWKSURI:       s.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.jwksRequests++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(s.keys)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if r.FormValue("code") != "code123" || r.FormValue("code_verifier") == "" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access123",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     s.idToken,
+		})
+	})
+
+	s.Server = httptest.NewServer(mux)
+	s.issuer = s.URL
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// setKeys replaces the keys of the JWKS with the public keys of the given ones.
+func (s *testServer) setKeys(t *testing.T, keys ...jwk.Key) {
+	t.Helper()
+
+	set := jwk.NewSet()
+	for _, key := range keys {
+		pub, err := key.PublicKey()
+		require.NoError(t, err)
+		require.NoError(t, set.AddKey(pub))
+	}
+
+	s.mu.Lock()
+	s.keys = set
+	s.mu.Unlock()
+}
+
+func (s *testServer) setIDToken(token string) {
+	s.mu.Lock()
+	s.idToken = token
+	s.mu.Unlock()
+}
+
+func (s *testServer) jwksFetches() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.jwksRequests
+}
+
+// provider creates the provider of the server.
+func (s *testServer) provider(t *testing.T) *Provider {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ps, err := New(ctx, map[string]ProviderConfig{
+		"test": {
+			Issuer:      s.URL,
+			ClientID:    testClientID,
+			RedirectURL: "https://pickfighter.com/oauth/test/callback",
+		},
+	})
+	require.NoError(t, err)
+
+	p, err := ps.Get("test")
+	require.NoError(t, err)
+
+	return p
+}
+
+func newKey(t *testing.T, kid string) jwk.Key {
+	t.Helper()
+
+	raw, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key, err := jwk.FromRaw(raw)
+	require.NoError(t, err)
+	require.NoError(t, key.Set(jwk.KeyIDKey, kid))
+	require.NoError(t, key.Set(jwk.AlgorithmKey, jwa.RS256))
+
+	return key
+}
+
+// signIDToken signs an ID token of the server's issuer for the test client, claims replace
+// the default ones and nil claims are left out.
+func signIDToken(t *testing.T, s *testServer, key jwk.Key, claims map[string]any) string {
+	t.Helper()
+
+	now := time.Now()
+	defaults := map[string]any{
+		jwt.IssuerKey:     s.URL,
+		jwt.AudienceKey:   []string{testClientID},
+		jwt.SubjectKey:    "user-1",
+		jwt.IssuedAtKey:   now,
+		jwt.ExpirationKey: now.Add(5 * time.Minute),
+		"nonce":           testNonce,
+		"email":           "jon@example.com",
+		"email_verified":  true,
+		"name":            "Jon",
+	}
+	for k, v := range claims {
+		defaults[k] = v
+	}
+
+	tok := jwt.New()
+	for k, v := range defaults {
+		if v != nil {
+			require.NoError(t, tok.Set(k, v))
+		}
+	}
+
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256, key))
+	require.NoError(t, err)
+
+	return string(signed)
+}
+
+// exchange logs in with the ID token, the auth URL is requested first like in the login flow.
+func exchange(t *testing.T, s *testServer, p *Provider, idToken string) (*Claims, error) {
+	t.Helper()
+
+	_, err := p.AuthCodeURL(context.Background(), "state123", testNonce, "verifier123")
+	require.NoError(t, err)
+
+	s.setIDToken(idToken)
+
+	return p.Exchange(context.Background(), "code123", "verifier123", testNonce)
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	s := newTestServer(t, newKey(t, "a"))
+	p := s.provider(t)
+
+	raw, err := p.AuthCodeURL(context.Background(), "state123", testNonce, "verifier123")
+	require.NoError(t, err)
+
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+
+	assert.Equal(t, s.URL+"/auth", u.Scheme+"://"+u.Host+u.Path)
+	assert.Equal(t, "state123", u.Query().Get("state"))
+	assert.Equal(t, testNonce, u.Query().Get("nonce"))
+	assert.Equal(t, testClientID, u.Query().Get("client_id"))
+	assert.Equal(t, "S256", u.Query().Get("code_challenge_method"))
+	assert.NotEmpty(t, u.Query().Get("code_challenge"))
+	assert.Equal(t, "openid email profile", u.Query().Get("scope"))
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	s := newTestServer(t, newKey(t, "a"))
+	p := s.provider(t)
+
+	s.mu.Lock()
+	s.issuer = "https://evil.example.com"
+	s.mu.Unlock()
+
+	_, err := p.AuthCodeURL(context.Background(), "state123", testNonce, "verifier123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match")
+
+	// a failed discovery is retried on the next use
+	s.mu.Lock()
+	s.issuer = s.URL
+	s.mu.Unlock()
+
+	_, err = p.AuthCodeURL(context.Background(), "state123", testNonce, "verifier123")
+	assert.NoError(t, err)
+}
+
+func TestExchange(t *testing.T) {
+	key := newKey(t, "a")
+	s := newTestServer(t, key)
+
+	t.Run("Verified", func(t *testing.T) {
+		p := s.provider(t)
+
+		claims, err := exchange(t, s, p, signIDToken(t, s, key, nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, &Claims{Subject: "user-1", Email: "jon@example.com", EmailVerified: true, Name: "Jon"}, claims)
+	})
+
+	t.Run("Rejected code", func(t *testing.T) {
+		p := s.provider(t)
+
+		_, err := p.AuthCodeURL(context.Background(), "state123", testNonce, "verifier123")
+		require.NoError(t, err)
+
+		_, err = p.Exchange(context.Background(), "wrong", "verifier123", testNonce)
+		assert.ErrorIs(t, err, ErrExchange)
+	})
+
+	t.Run("No ID token", func(t *testing.T) {
+		p := s.provider(t)
+
+		_, err := exchange(t, s, p, "")
+		assert.ErrorIs(t, err, ErrInvalidIDToken)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	key := newKey(t, "a")
+	s := newTestServer(t, key)
+
+	tests := []struct {
+		name   string
+		claims map[string]any
+		// signer signs the token instead of the provider's key when set
+		signer jwk.Key
+		want   *Claims
+	}{
+		{
+			name:   "Email verified as a string",
+			claims: map[string]any{"email_verified": "true"},
+			want:   &Claims{Subject: "user-1", Email: "jon@example.com", EmailVerified: true, Name: "Jon"},
+		},
+		{
+			name:   "Email not verified as a string",
+			claims: map[string]any{"email_verified": "false"},
+			want:   &Claims{Subject: "user-1", Email: "jon@example.com", Name: "Jon"},
+		},
+		{
+			name:   "Email verified missing",
+			claims: map[string]any{"email_verified": nil},
+			want:   &Claims{Subject: "user-1", Email: "jon@example.com", Name: "Jon"},
+		},
+		{
+			name:   "Authorized party of several audiences",
+			claims: map[string]any{jwt.AudienceKey: []string{testClientID, "other"}, "azp": testClientID},
+			want:   &Claims{Subject: "user-1", Email: "jon@example.com", EmailVerified: true, Name: "Jon"},
+		},
+		{
+			name:   "Other authorized party",
+			claims: map[string]any{jwt.AudienceKey: []string{testClientID, "other"}, "azp": "other"},
+		},
+		{
+			name:   "No authorized party of several audiences",
+			claims: map[string]any{jwt.AudienceKey: []string{testClientID, "other"}},
+		},
+		{
+			name:   "Other audience",
+			claims: map[string]any{jwt.AudienceKey: []string{"other"}},
+		},
+		{
+			name:   "Nonce mismatch",
+			claims: map[string]any{"nonce": "other"},
+		},
+		{
+			name:   "No nonce",
+			claims: map[string]any{"nonce": nil},
+		},
+		{
+			name:   "Other issuer",
+			claims: map[string]any{jwt.IssuerKey: "https://evil.example.com"},
+		},
+		{
+			name:   "Expired",
+			claims: map[string]any{jwt.ExpirationKey: time.Now().Add(-2 * clockSkew)},
+		},
+		{
+			name:   "No subject",
+			claims: map[string]any{jwt.SubjectKey: nil},
+		},
+		{
+			name:   "Unknown key",
+			signer: newKey(t, "b"),
+		},
+		{
+			name:   "Known key ID, other key",
+			signer: newKey(t, "a"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := key
+			if tt.signer != nil {
+				signer = tt.signer
+			}
+
+			claims, err := exchange(t, s, s.provider(t), signIDToken(t, s, signer, tt.claims))
+			if tt.want == nil {
+				assert.ErrorIs(t, err, ErrInvalidIDToken)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, claims)
+		})
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKey, rotatedKey := newKey(t, "a"), newKey(t, "b")
+	s := newTestServer(t, oldKey)
+	p := s.provider(t)
+
+	_, err := exchange(t, s, p, signIDToken(t, s, oldKey, nil))
+	require.NoError(t, err)
+	fetches := s.jwksFetches()
+
+	// the provider rotates its keys, the JWKS is fetched again for a token signed with the new key
+	s.setKeys(t, rotatedKey)
+
+	_, err = exchange(t, s, p, signIDToken(t, s, rotatedKey, nil))
+	require.NoError(t, err)
+	assert.Equal(t, fetches+1, s.jwksFetches())
+
+	// tokens signed with unknown keys refresh the JWKS at most once per interval
+	_, err = exchange(t, s, p, signIDToken(t, s, oldKey, nil))
+	assert.ErrorIs(t, err, ErrInvalidIDToken)
+	assert.Equal(t, fetches+1, s.jwksFetches())
+}
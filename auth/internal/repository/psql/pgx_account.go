@@ -0,0 +1,148 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// TxScheduleUserDeletion sets the time the user is anonymized after in the 'pf_users' table and returns it.
+// A deletion which is already scheduled keeps its time. It returns pgx.ErrNoRows if the user does not exist
+// or is anonymized.
+func (r *Repository) TxScheduleUserDeletion(ctx context.Context, tx pgx.Tx, userId int32, deleteAfter, now int64) (int64, error) {
+	q := `UPDATE public.pf_users
+		SET delete_after = COALESCE(delete_after, $2), updated_at = $3
+		WHERE user_id = $1 AND anonymized_at IS NULL
+		RETURNING delete_after`
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, q, userId, deleteAfter, now)
+	} else {
+		row = r.GetPool().QueryRow(ctx, q, userId, deleteAfter, now)
+	}
+
+	var scheduled int64
+	if err := row.Scan(&scheduled); err != nil {
+		return 0, r.DebugLogSqlErr(q, err)
+	}
+
+	return scheduled, nil
+}
+
+// TxCancelUserDeletion clears the scheduled deletion of the user in the 'pf_users' table.
+// It returns pgx.ErrNoRows if no deletion of the user is scheduled or if it is due at now,
+// since the data of the user may be anonymized by other services already.
+func (r *Repository) TxCancelUserDeletion(ctx context.Context, tx pgx.Tx, userId int32, now int64) error {
+	q := `UPDATE public.pf_users
+		SET delete_after = NULL, updated_at = $2
+		WHERE user_id = $1 AND delete_after > $2 AND anonymized_at IS NULL`
+
+	var (
+		tag pgconn.CommandTag
+		err error
+	)
+	if tx != nil {
+		tag, err = tx.Exec(ctx, q, userId, now)
+	} else {
+		tag, err = r.GetPool().Exec(ctx, q, userId, now)
+	}
+	if err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// ClaimDueUserDeletions takes up to limit users from the 'pf_users' table whose deletion is due at now
+// and who are not anonymized yet, the longest due first. Claimed users are not taken again until the lease
+// passes, so other anonymizers skip them while they are anonymized.
+func (r *Repository) ClaimDueUserDeletions(ctx context.Context, now, leaseUntil int64, limit int32) ([]int32, error) {
+	q := `UPDATE public.pf_users
+		SET anonymize_claimed_until = $2
+		WHERE user_id IN (
+			SELECT user_id FROM public.pf_users
+			WHERE delete_after <= $1 AND anonymized_at IS NULL AND anonymize_claimed_until <= $1
+			ORDER BY delete_after, user_id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING user_id`
+
+	rows, err := r.GetPool().Query(ctx, q, now, leaseUntil, limit)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+	defer rows.Close()
+
+	var userIds []int32
+	for rows.Next() {
+		var userId int32
+		if err := rows.Scan(&userId); err != nil {
+			return nil, r.DebugLogSqlErr(q, err)
+		}
+		userIds = append(userIds, userId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+
+	return userIds, nil
+}
+
+// TxAnonymizeUser replaces the name of the user with the given pseudonym and drops the claim, rank, flags
+// and roles in the 'pf_users' table. The row is kept, so audit records still point to a user.
+// It returns pgx.ErrNoRows unless the deletion of the user is due at now.
+func (r *Repository) TxAnonymizeUser(ctx context.Context, tx pgx.Tx, userId int32, pseudonym string, now int64) error {
+	q := `UPDATE public.pf_users
+		SET name = $2, claim = NULL, rank = NULL, flags = 0, roles = 0,
+			delete_after = NULL, anonymized_at = $3, updated_at = $3
+		WHERE user_id = $1 AND delete_after <= $3 AND anonymized_at IS NULL`
+
+	tag, err := tx.Exec(ctx, q, userId, pseudonym, now)
+	if err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// TxDeleteUserData deletes the credentials of the user with everything else identifying the user:
+// provider identities, the authenticator, sessions, pending logins and email changes, and the emails
+// sent to the given address from the outbox.
+func (r *Repository) TxDeleteUserData(ctx context.Context, tx pgx.Tx, userId int32, email string) error {
+	for _, q := range []string{
+		`DELETE FROM public.pf_email_changes WHERE user_id = $1`,
+		`DELETE FROM public.pf_two_factor_challenges WHERE user_id = $1`,
+		`DELETE FROM public.pf_user_recovery_codes WHERE user_id = $1`,
+		`DELETE FROM public.pf_user_totp WHERE user_id = $1`,
+		`DELETE FROM public.pf_user_identities WHERE user_id = $1`,
+		`DELETE FROM public.pf_refresh_tokens WHERE user_id = $1`,
+		`DELETE FROM public.pf_user_credentials WHERE user_id = $1`,
+	} {
+		if _, err := tx.Exec(ctx, q, userId); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	if email == "" {
+		return nil
+	}
+
+	q := `DELETE FROM public.pf_email_outbox WHERE recipient_email = $1`
+	if _, err := tx.Exec(ctx, q, email); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	return nil
+}
@@ -15,12 +15,14 @@ import (
 // The method returns an error if the database operation encounters any issues.
 func (r *Repository) TxNewAuthCredentials(ctx context.Context, tx pgx.Tx, uc model.UserCredentials) error {
 	query := `INSERT INTO
-		public.pf_user_credentials(user_id, email, password_hash, salt, token, token_type, token_expire, active)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		public.pf_user_credentials(user_id, email, password_hash, salt, password_algorithm, password_params,
+			token, token_type, token_expire, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
 
 	args := []any{
 		uc.UserId, uc.Email, uc.Password,
-		uc.Salt, uc.Token, uc.TokenType, uc.TokenExpire,
+		uc.Salt, uc.PasswordAlgorithm, uc.PasswordParams,
+		uc.Token, uc.TokenType, uc.TokenExpire,
 		uc.Active,
 	}
 
@@ -42,7 +44,9 @@ func (r *Repository) TxNewAuthCredentials(ctx context.Context, tx pgx.Tx, uc mod
 // executes the query using the repository's connection pool, and returns the user credentials if found.
 // If the query parameters are invalid or no matching credentials are found, an error is returned.
 func (r *Repository) FindUserCredentials(ctx context.Context, req model.UserCredentialsRequest) (model.UserCredentials, error) {
-	q := `SELECT user_id, email, password_hash, salt, token, token_type, token_expire, active
+	q := `SELECT user_id, email, password_hash, salt,
+		COALESCE(password_algorithm, ''), COALESCE(password_params, ''),
+		token, token_type, token_expire, active
 		FROM public.pf_user_credentials`
 
 	if req.Email != "" {
@@ -59,7 +63,8 @@ func (r *Repository) FindUserCredentials(ctx context.Context, req model.UserCred
 	var currentToken, tokenType pgtype.Varchar
 	var tokenExpire pgtype.Int8
 
-	err := r.GetPool().QueryRow(ctx, q).Scan(&c.UserId, &c.Email, &c.Password, &c.Salt, &currentToken, &tokenType, &tokenExpire, &c.Active)
+	err := r.GetPool().QueryRow(ctx, q).Scan(&c.UserId, &c.Email, &c.Password, &c.Salt,
+		&c.PasswordAlgorithm, &c.PasswordParams, &currentToken, &tokenType, &tokenExpire, &c.Active)
 	if err != nil {
 		return c, r.DebugLogSqlErr(q, err)
 	}
@@ -105,38 +110,58 @@ func (r *Repository) ConfirmCredentialsToken(ctx context.Context, tx pgx.Tx, req
 
 // ResetPassword updates the 'pf_user_credentials' table to reset a user's password based on the provided user credentials.
 // It sets the 'active' flag to false, updates the token, token type, and token expiration based on the provided credentials.
-// The method is designed to be used when a user requests a password reset.
-func (r *Repository) ResetPassword(ctx context.Context, req *model.UserCredentials) error {
+// The method is designed to be used when a user requests a password reset, within a transaction (if provided).
+func (r *Repository) ResetPassword(ctx context.Context, tx pgx.Tx, req *model.UserCredentials) error {
 	q := `UPDATE public.pf_user_credentials
 		SET active = false, token = $2, token_type = $3, token_expire = $4
 		WHERE user_id = $1`
 
-	if _, err := r.GetPool().Exec(ctx, q, req.UserId, req.Token, req.TokenType, req.TokenExpire); err != nil {
-		return r.DebugLogSqlErr(q, err)
+	args := []any{req.UserId, req.Token, req.TokenType, req.TokenExpire}
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
 	}
 
 	return nil
 }
 
 // UpdatePassword updates the password-related fields of a user in the 'pf_user_credentials' table.
-// It is typically used when a user changes their password. The method takes a user's credentials, including the user ID,
-// the new hashed password, and the salt used for hashing. The update is performed within a transaction (if provided).
+// It is typically used when a user changes their password or the password is rehashed. The method takes a user's credentials,
+// including the user ID, the new hashed password, the salt, and the hashing algorithm and parameters.
+// The update is performed within a transaction (if provided).
 func (r *Repository) UpdatePassword(ctx context.Context, tx pgx.Tx, req model.UserCredentials) error {
 	q := `UPDATE public.pf_user_credentials
-		SET password_hash = $2, salt = $3
+		SET password_hash = $2, salt = $3, password_algorithm = $4, password_params = $5
 		WHERE user_id = $1`
 
 	if tx != nil {
 		if _, err := tx.Exec(ctx, q, req.UserId,
-			req.Password, req.Salt); err != nil {
+			req.Password, req.Salt, req.PasswordAlgorithm, req.PasswordParams); err != nil {
 			return r.DebugLogSqlErr(q, err)
 		}
 	} else {
 		if _, err := r.GetPool().Exec(ctx, q, req.UserId,
-			req.Password, req.Salt); err != nil {
+			req.Password, req.Salt, req.PasswordAlgorithm, req.PasswordParams); err != nil {
 			return r.DebugLogSqlErr(q, err)
 		}
 	}
 
 	return nil
 }
+
+// TxUpdateCredentialsEmail sets the login email of the user in the 'pf_user_credentials' table.
+func (r *Repository) TxUpdateCredentialsEmail(ctx context.Context, tx pgx.Tx, userId int32, email string) error {
+	q := `UPDATE public.pf_user_credentials SET email = $2 WHERE user_id = $1`
+
+	if _, err := tx.Exec(ctx, q, userId, email); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	return nil
+}
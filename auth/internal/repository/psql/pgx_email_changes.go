@@ -0,0 +1,59 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"pickfighter.com/auth/pkg/model"
+)
+
+// TxNewEmailChange stores an email change waiting for confirmation in the 'pf_email_changes' table.
+// An earlier pending change of the user is replaced, only the latest confirmation link works.
+func (r *Repository) TxNewEmailChange(ctx context.Context, tx pgx.Tx, c model.EmailChange) error {
+	q := `DELETE FROM public.pf_email_changes WHERE user_id = $1 OR expires_at < $2`
+	if _, err := tx.Exec(ctx, q, c.UserId, c.CreatedAt); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	q = `INSERT INTO
+		public.pf_email_changes(token_hash, user_id, email, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)`
+	if _, err := tx.Exec(ctx, q, c.TokenHash, c.UserId, c.Email, c.CreatedAt, c.ExpiresAt); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	return nil
+}
+
+// TxFindEmailChange retrieves an email change by its token hash from the 'pf_email_changes' table.
+// Within a transaction the row is locked until the end of it. It returns pgx.ErrNoRows if the change does not exist.
+func (r *Repository) TxFindEmailChange(ctx context.Context, tx pgx.Tx, tokenHash string) (model.EmailChange, error) {
+	q := `SELECT token_hash, user_id, email, created_at, expires_at
+		FROM public.pf_email_changes
+		WHERE token_hash = $1`
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, q+` FOR UPDATE`, tokenHash)
+	} else {
+		row = r.GetPool().QueryRow(ctx, q, tokenHash)
+	}
+
+	var c model.EmailChange
+	if err := row.Scan(&c.TokenHash, &c.UserId, &c.Email, &c.CreatedAt, &c.ExpiresAt); err != nil {
+		return c, r.DebugLogSqlErr(q, err)
+	}
+
+	return c, nil
+}
+
+// TxDeleteEmailChange deletes a confirmed email change from the 'pf_email_changes' table.
+func (r *Repository) TxDeleteEmailChange(ctx context.Context, tx pgx.Tx, tokenHash string) error {
+	q := `DELETE FROM public.pf_email_changes WHERE token_hash = $1`
+
+	if _, err := tx.Exec(ctx, q, tokenHash); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	return nil
+}
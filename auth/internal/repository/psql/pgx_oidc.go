@@ -0,0 +1,119 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"pickfighter.com/auth/pkg/model"
+)
+
+// NewOAuthState stores a pending OpenID Connect login in the 'pf_oauth_states' table
+// and deletes the expired ones, logins abandoned at the provider are never completed.
+func (r *Repository) NewOAuthState(ctx context.Context, s model.OAuthState) error {
+	q := `INSERT INTO
+		public.pf_oauth_states(state, provider, nonce, code_verifier, remember_me, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	args := []any{s.State, s.Provider, s.Nonce, s.CodeVerifier, s.RememberMe, s.CreatedAt, s.ExpiresAt}
+
+	if _, err := r.GetPool().Exec(ctx, q, args...); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	purge := `DELETE FROM public.pf_oauth_states WHERE expires_at < $1`
+	if _, err := r.GetPool().Exec(ctx, purge, s.CreatedAt); err != nil {
+		return r.DebugLogSqlErr(purge, err)
+	}
+
+	return nil
+}
+
+// ConsumeOAuthState deletes a pending login by its state from the 'pf_oauth_states' table and returns it,
+// so a state can complete only one login. It returns pgx.ErrNoRows if the state does not exist.
+func (r *Repository) ConsumeOAuthState(ctx context.Context, state string) (model.OAuthState, error) {
+	q := `DELETE FROM public.pf_oauth_states
+		WHERE state = $1
+		RETURNING state, provider, nonce, code_verifier, remember_me, created_at, expires_at`
+
+	var s model.OAuthState
+	err := r.GetPool().QueryRow(ctx, q, state).Scan(&s.State, &s.Provider, &s.Nonce, &s.CodeVerifier,
+		&s.RememberMe, &s.CreatedAt, &s.ExpiresAt)
+	if err != nil {
+		return s, r.DebugLogSqlErr(q, err)
+	}
+
+	return s, nil
+}
+
+// TxFindUserIdentity retrieves the user linked to the subject of the provider from the 'pf_user_identities' table.
+// It returns pgx.ErrNoRows if the subject is not linked.
+func (r *Repository) TxFindUserIdentity(ctx context.Context, tx pgx.Tx, provider, subject string) (model.UserIdentity, error) {
+	q := `SELECT provider, subject, user_id, email, created_at
+		FROM public.pf_user_identities
+		WHERE provider = $1 AND subject = $2`
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, q, provider, subject)
+	} else {
+		row = r.GetPool().QueryRow(ctx, q, provider, subject)
+	}
+
+	var i model.UserIdentity
+	if err := row.Scan(&i.Provider, &i.Subject, &i.UserId, &i.Email, &i.CreatedAt); err != nil {
+		return i, r.DebugLogSqlErr(q, err)
+	}
+
+	return i, nil
+}
+
+// TxNewUserIdentity links a user to the subject of the provider in the 'pf_user_identities' table.
+func (r *Repository) TxNewUserIdentity(ctx context.Context, tx pgx.Tx, i model.UserIdentity) error {
+	q := `INSERT INTO
+		public.pf_user_identities(provider, subject, user_id, email, created_at)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	args := []any{i.Provider, i.Subject, i.UserId, i.Email, i.CreatedAt}
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
+
+// SearchUserIdentities retrieves the provider identities linked to the user from the 'pf_user_identities' table,
+// the oldest first.
+func (r *Repository) SearchUserIdentities(ctx context.Context, userId int32) ([]model.UserIdentity, error) {
+	q := `SELECT provider, subject, user_id, email, created_at
+		FROM public.pf_user_identities
+		WHERE user_id = $1
+		ORDER BY created_at`
+
+	rows, err := r.GetPool().Query(ctx, q, userId)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+	defer rows.Close()
+
+	var identities []model.UserIdentity
+	for rows.Next() {
+		var i model.UserIdentity
+		if err := rows.Scan(&i.Provider, &i.Subject, &i.UserId, &i.Email, &i.CreatedAt); err != nil {
+			return nil, r.DebugLogSqlErr(q, err)
+		}
+		identities = append(identities, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+
+	return identities, nil
+}
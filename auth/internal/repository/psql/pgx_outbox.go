@@ -0,0 +1,181 @@
+package psql
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v5"
+	"pickfighter.com/auth/pkg/model"
+)
+
+// outboxColumns are columns of an outbox email scanned by scanOutboxEmail.
+const outboxColumns = `email_id, subject, recipient_email, recipient_name, locale, data,
+	status, attempts, next_attempt_at, last_error, created_at, sent_at`
+
+func scanOutboxEmail(row pgx.Row) (*model.OutboxEmail, error) {
+	var (
+		e         model.OutboxEmail
+		data      []byte
+		lastError pgtype.Varchar
+		sentAt    pgtype.Int8
+	)
+
+	err := row.Scan(&e.EmailId, &e.Subject, &e.Recipient.Email, &e.Recipient.Name, &e.Locale, &data,
+		&e.Status, &e.Attempts, &e.NextAttemptAt, &lastError, &e.CreatedAt, &sentAt)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Data = &model.EmailData{}
+	if err := json.Unmarshal(data, e.Data); err != nil {
+		return nil, err
+	}
+
+	e.LastError = lastError.String
+	e.SentAt = sentAt.Int
+
+	return &e, nil
+}
+
+// TxNewOutboxEmail queues an email in the 'pf_email_outbox' table to be sent from now on.
+// Queued in the transaction of the change the email is about, it is sent only if the change is committed.
+func (r *Repository) TxNewOutboxEmail(ctx context.Context, tx pgx.Tx, data *model.EmailData, now int64) (int64, error) {
+	q := `INSERT INTO
+		public.pf_email_outbox(subject, recipient_email, recipient_name, locale, data,
+			status, attempts, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, 0, $7, $7, $7)
+		RETURNING email_id`
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return 0, err
+	}
+
+	args := []any{data.Subject, data.Recipient.Email, data.Recipient.Name, data.Locale, b, model.OutboxPending, now}
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, q, args...)
+	} else {
+		row = r.GetPool().QueryRow(ctx, q, args...)
+	}
+
+	var emailId int64
+	if err := row.Scan(&emailId); err != nil {
+		return 0, r.DebugLogSqlErr(q, err)
+	}
+
+	return emailId, nil
+}
+
+// ClaimOutboxEmails takes up to limit pending emails due at now from the 'pf_email_outbox' table,
+// the oldest first. Claimed emails are not due again until the lease passes, so other workers skip them
+// while they are sent, and an email of a worker which died on the way is sent after it.
+func (r *Repository) ClaimOutboxEmails(ctx context.Context, now, leaseUntil int64, limit int32) ([]*model.OutboxEmail, error) {
+	q := `UPDATE public.pf_email_outbox
+		SET next_attempt_at = $2, updated_at = $1
+		WHERE email_id IN (
+			SELECT email_id FROM public.pf_email_outbox
+			WHERE status = $3 AND next_attempt_at <= $1
+			ORDER BY next_attempt_at, email_id
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + outboxColumns
+
+	rows, err := r.GetPool().Query(ctx, q, now, leaseUntil, model.OutboxPending, limit)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+	defer rows.Close()
+
+	var emails []*model.OutboxEmail
+	for rows.Next() {
+		e, err := scanOutboxEmail(rows)
+		if err != nil {
+			return nil, r.DebugLogSqlErr(q, err)
+		}
+		emails = append(emails, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+
+	return emails, nil
+}
+
+// TxUpdateOutboxEmail stores the delivery status of an email in the 'pf_email_outbox' table:
+// its status, attempts, next attempt time, last error and sending time.
+func (r *Repository) TxUpdateOutboxEmail(ctx context.Context, tx pgx.Tx, e *model.OutboxEmail, now int64) error {
+	q := `UPDATE public.pf_email_outbox
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_error = NULLIF($5, ''),
+			sent_at = NULLIF($6, 0), updated_at = $7
+		WHERE email_id = $1`
+
+	args := []any{e.EmailId, e.Status, e.Attempts, e.NextAttemptAt, e.LastError, e.SentAt, now}
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
+
+// SearchOutboxEmails retrieves emails with the given status, all emails if the status is empty,
+// from the 'pf_email_outbox' table, the newest first.
+func (r *Repository) SearchOutboxEmails(ctx context.Context, status string, limit int32) ([]*model.OutboxEmail, error) {
+	q := `SELECT ` + outboxColumns + `
+		FROM public.pf_email_outbox
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at DESC, email_id DESC
+		LIMIT $2`
+
+	rows, err := r.GetPool().Query(ctx, q, status, limit)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+	defer rows.Close()
+
+	var emails []*model.OutboxEmail
+	for rows.Next() {
+		e, err := scanOutboxEmail(rows)
+		if err != nil {
+			return nil, r.DebugLogSqlErr(q, err)
+		}
+		emails = append(emails, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+
+	return emails, nil
+}
+
+// TxFindOutboxEmail retrieves an email by ID from the 'pf_email_outbox' table.
+// Within a transaction the row is locked until the end of it. It returns pgx.ErrNoRows if the email does not exist.
+func (r *Repository) TxFindOutboxEmail(ctx context.Context, tx pgx.Tx, emailId int64) (*model.OutboxEmail, error) {
+	q := `SELECT ` + outboxColumns + ` FROM public.pf_email_outbox WHERE email_id = $1`
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, q+` FOR UPDATE`, emailId)
+	} else {
+		row = r.GetPool().QueryRow(ctx, q, emailId)
+	}
+
+	e, err := scanOutboxEmail(row)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,104 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v5"
+	"pickfighter.com/auth/pkg/model"
+)
+
+// TxNewRefreshToken stores a refresh token in the 'pf_refresh_tokens' table.
+// The family_expires_at column keeps the absolute expiry of the login, see auth/migrations/0001_refresh_tokens.sql.
+// If the transaction (tx) is provided, it executes the query within the transaction;
+// otherwise, it uses the repository's connection pool to execute the query.
+func (r *Repository) TxNewRefreshToken(ctx context.Context, tx pgx.Tx, rt model.RefreshToken) error {
+	q := `INSERT INTO
+		public.pf_refresh_tokens(family_id, user_id, token_hash, user_agent, ip_address, created_at, expires_at, family_expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	args := []any{
+		rt.FamilyId, rt.UserId, rt.TokenHash,
+		rt.UserAgent, rt.IpAddress, rt.CreatedAt, rt.ExpiresAt, rt.FamilyExpiresAt,
+	}
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
+
+// TxFindRefreshToken retrieves a refresh token by the hash of its value from the 'pf_refresh_tokens' table.
+// Within a transaction the row is locked until the end of it, so a token can be rotated only once.
+// It returns pgx.ErrNoRows if the token does not exist.
+func (r *Repository) TxFindRefreshToken(ctx context.Context, tx pgx.Tx, tokenHash string) (model.RefreshToken, error) {
+	q := `SELECT token_id, family_id, user_id, token_hash, user_agent, ip_address, created_at, expires_at, family_expires_at, used_at, revoked_at
+		FROM public.pf_refresh_tokens
+		WHERE token_hash = $1`
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, q+` FOR UPDATE`, tokenHash)
+	} else {
+		row = r.GetPool().QueryRow(ctx, q, tokenHash)
+	}
+
+	var rt model.RefreshToken
+	var userAgent, ipAddress pgtype.Varchar
+	var usedAt, revokedAt pgtype.Int8
+
+	err := row.Scan(&rt.TokenId, &rt.FamilyId, &rt.UserId, &rt.TokenHash, &userAgent, &ipAddress,
+		&rt.CreatedAt, &rt.ExpiresAt, &rt.FamilyExpiresAt, &usedAt, &revokedAt)
+	if err != nil {
+		return rt, r.DebugLogSqlErr(q, err)
+	}
+
+	rt.UserAgent = userAgent.String
+	rt.IpAddress = ipAddress.String
+	rt.UsedAt = usedAt.Int
+	rt.RevokedAt = revokedAt.Int
+
+	return rt, nil
+}
+
+// TxUseRefreshToken marks a rotated refresh token as used in the 'pf_refresh_tokens' table,
+// presenting it again is a reuse.
+func (r *Repository) TxUseRefreshToken(ctx context.Context, tx pgx.Tx, tokenId int64, usedAt int64) error {
+	q := `UPDATE public.pf_refresh_tokens SET used_at = $2 WHERE token_id = $1`
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, tokenId, usedAt); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, tokenId, usedAt); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
+
+// TxRevokeRefreshFamily revokes all not yet revoked refresh tokens of the family in the 'pf_refresh_tokens' table.
+func (r *Repository) TxRevokeRefreshFamily(ctx context.Context, tx pgx.Tx, familyId string, revokedAt int64) error {
+	q := `UPDATE public.pf_refresh_tokens SET revoked_at = $2 WHERE family_id = $1 AND revoked_at IS NULL`
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, familyId, revokedAt); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, familyId, revokedAt); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
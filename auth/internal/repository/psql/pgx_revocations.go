@@ -0,0 +1,123 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// TxRevokeToken records a revoked access token by its JWT ID in the 'pf_revoked_tokens' table.
+// The record is kept until the token expires, revoking a token twice keeps the first record.
+func (r *Repository) TxRevokeToken(ctx context.Context, tx pgx.Tx, tokenId string, userId int32, expiresAt, revokedAt int64) error {
+	q := `INSERT INTO
+		public.pf_revoked_tokens(token_id, user_id, expires_at, revoked_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (token_id) DO NOTHING`
+
+	args := []any{tokenId, userId, expiresAt, revokedAt}
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
+
+// PurgeRevokedTokens deletes records of revoked tokens expired before now from the 'pf_revoked_tokens' table,
+// expired tokens are rejected anyway. It returns the number of deleted records.
+func (r *Repository) PurgeRevokedTokens(ctx context.Context, now int64) (int64, error) {
+	q := `DELETE FROM public.pf_revoked_tokens WHERE expires_at < $1`
+
+	tag, err := r.GetPool().Exec(ctx, q, now)
+	if err != nil {
+		return 0, r.DebugLogSqlErr(q, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// TxRevokeUserTokens sets the time tokens of the user issued before it are revoked
+// in the 'pf_users' table. An earlier time never replaces a later one.
+func (r *Repository) TxRevokeUserTokens(ctx context.Context, tx pgx.Tx, userId int32, before int64) error {
+	q := `UPDATE public.pf_users
+		SET tokens_revoked_before = GREATEST(COALESCE(tokens_revoked_before, 0), $2)
+		WHERE user_id = $1`
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, userId, before); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, userId, before); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
+
+// TxRevokeUserRefreshFamilies revokes refresh token families of the user started by a login
+// before the given time in the 'pf_refresh_tokens' table.
+func (r *Repository) TxRevokeUserRefreshFamilies(ctx context.Context, tx pgx.Tx, userId int32, before, revokedAt int64) error {
+	q := `UPDATE public.pf_refresh_tokens
+		SET revoked_at = $3
+		WHERE revoked_at IS NULL AND family_id IN (
+			SELECT family_id FROM public.pf_refresh_tokens
+			WHERE user_id = $1
+			GROUP BY family_id
+			HAVING MIN(created_at) < $2
+		)`
+
+	args := []any{userId, before, revokedAt}
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
+
+// FindTokenRevocation tells whether the token with the JWT ID is recorded as revoked in the 'pf_revoked_tokens' table
+// or its session, the refresh token family, is revoked in the 'pf_refresh_tokens' table,
+// and returns the time tokens of the user issued before it are revoked, zero if there is none.
+func (r *Repository) FindTokenRevocation(ctx context.Context, tokenId, sessionId string, userId int32) (bool, int64, error) {
+	q := `SELECT
+		EXISTS (SELECT 1 FROM public.pf_revoked_tokens WHERE token_id = $1)
+			OR ($3 <> '' AND EXISTS (
+				SELECT 1 FROM public.pf_refresh_tokens WHERE family_id = $3 AND revoked_at IS NOT NULL)),
+		COALESCE((SELECT tokens_revoked_before FROM public.pf_users WHERE user_id = $2), 0)`
+
+	var revoked bool
+	var revokedBefore int64
+	if err := r.GetPool().QueryRow(ctx, q, tokenId, userId, sessionId).Scan(&revoked, &revokedBefore); err != nil {
+		return false, 0, r.DebugLogSqlErr(q, err)
+	}
+
+	return revoked, revokedBefore, nil
+}
+
+// TxRevokeOtherRefreshFamilies revokes the refresh token families of the user in the 'pf_refresh_tokens' table
+// except the given one, which is the session kept logged in.
+func (r *Repository) TxRevokeOtherRefreshFamilies(ctx context.Context, tx pgx.Tx, userId int32, keepFamilyId string, revokedAt int64) error {
+	q := `UPDATE public.pf_refresh_tokens
+		SET revoked_at = $3
+		WHERE user_id = $1 AND family_id <> $2 AND revoked_at IS NULL`
+
+	if _, err := tx.Exec(ctx, q, userId, keepFamilyId, revokedAt); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	return nil
+}
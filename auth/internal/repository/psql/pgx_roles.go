@@ -0,0 +1,99 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v5"
+	"pickfighter.com/auth/pkg/model"
+	"pickfighter.com/pkg/rbac"
+)
+
+// TxFindUserRoles retrieves the roles bitmask of the user from the 'pf_users' table.
+// Within a transaction the row is locked until the end of it. It returns pgx.ErrNoRows if the user does not exist.
+func (r *Repository) TxFindUserRoles(ctx context.Context, tx pgx.Tx, userId int32) (uint64, error) {
+	q := `SELECT roles FROM public.pf_users WHERE user_id = $1`
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, q+` FOR UPDATE`, userId)
+	} else {
+		row = r.GetPool().QueryRow(ctx, q, userId)
+	}
+
+	var roles pgtype.Int8
+	if err := row.Scan(&roles); err != nil {
+		return 0, r.DebugLogSqlErr(q, err)
+	}
+
+	return uint64(roles.Int), nil
+}
+
+// TxUpdateUserRoles sets the roles bitmask of the user in the 'pf_users' table.
+func (r *Repository) TxUpdateUserRoles(ctx context.Context, tx pgx.Tx, userId int32, roles uint64, now int64) error {
+	q := `UPDATE public.pf_users SET roles = $2, updated_at = $3 WHERE user_id = $1`
+
+	if _, err := tx.Exec(ctx, q, userId, int64(roles), now); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	return nil
+}
+
+// TxNewRoleChange records a granted or revoked role in the 'pf_role_audit' table
+// together with the roles of the user after the change.
+func (r *Repository) TxNewRoleChange(ctx context.Context, tx pgx.Tx, c *model.RoleChange, roles uint64) (int64, error) {
+	q := `INSERT INTO
+		public.pf_role_audit(user_id, actor_id, action, role, reason, roles, created_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7)
+		RETURNING audit_id`
+
+	var auditId int64
+	err := tx.QueryRow(ctx, q, c.UserId, c.ActorId, c.Action, c.Role, c.Reason, int64(roles), c.CreatedAt).
+		Scan(&auditId)
+	if err != nil {
+		return 0, r.DebugLogSqlErr(q, err)
+	}
+
+	return auditId, nil
+}
+
+// SearchRoleChanges retrieves up to limit role changes from the 'pf_role_audit' table, the newest first.
+// Only the changes of the given user are retrieved if userId is not 0.
+func (r *Repository) SearchRoleChanges(ctx context.Context, userId int32, limit int32) ([]*model.RoleChange, error) {
+	q := `SELECT audit_id, user_id, actor_id, action, role, reason, roles, created_at
+		FROM public.pf_role_audit
+		WHERE $1 = 0 OR user_id = $1
+		ORDER BY created_at DESC, audit_id DESC
+		LIMIT $2`
+
+	rows, err := r.GetPool().Query(ctx, q, userId, limit)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+	defer rows.Close()
+
+	var changes []*model.RoleChange
+	for rows.Next() {
+		var (
+			c      model.RoleChange
+			reason pgtype.Varchar
+			roles  int64
+		)
+
+		if err := rows.Scan(&c.AuditId, &c.UserId, &c.ActorId, &c.Action, &c.Role, &reason, &roles,
+			&c.CreatedAt); err != nil {
+			return nil, r.DebugLogSqlErr(q, err)
+		}
+		c.Reason = reason.String
+		c.Roles = rbac.Set(roles).Names()
+
+		changes = append(changes, &c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+
+	return changes, nil
+}
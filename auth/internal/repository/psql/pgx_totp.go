@@ -0,0 +1,167 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v5"
+	"pickfighter.com/auth/pkg/model"
+)
+
+// TxFindUserTOTP retrieves the authenticator of the user from the 'pf_user_totp' table.
+// Within a transaction the row is locked until the end of it. It returns pgx.ErrNoRows if the user has none.
+func (r *Repository) TxFindUserTOTP(ctx context.Context, tx pgx.Tx, userId int32) (model.UserTOTP, error) {
+	q := `SELECT user_id, secret, enabled, last_step, failed_attempts, locked_until, created_at, enabled_at
+		FROM public.pf_user_totp
+		WHERE user_id = $1`
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, q+` FOR UPDATE`, userId)
+	} else {
+		row = r.GetPool().QueryRow(ctx, q, userId)
+	}
+
+	var (
+		t         model.UserTOTP
+		enabledAt pgtype.Int8
+	)
+	if err := row.Scan(&t.UserId, &t.Secret, &t.Enabled, &t.LastStep, &t.FailedAttempts, &t.LockedUntil,
+		&t.CreatedAt, &enabledAt); err != nil {
+		return t, r.DebugLogSqlErr(q, err)
+	}
+	t.EnabledAt = enabledAt.Int
+
+	return t, nil
+}
+
+// TxSaveUserTOTP creates or replaces the authenticator of the user in the 'pf_user_totp' table.
+func (r *Repository) TxSaveUserTOTP(ctx context.Context, tx pgx.Tx, t model.UserTOTP) error {
+	q := `INSERT INTO
+		public.pf_user_totp(user_id, secret, enabled, last_step, failed_attempts, locked_until, created_at, enabled_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, 0))
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, enabled = EXCLUDED.enabled, last_step = EXCLUDED.last_step,
+			failed_attempts = EXCLUDED.failed_attempts, locked_until = EXCLUDED.locked_until,
+			created_at = EXCLUDED.created_at, enabled_at = EXCLUDED.enabled_at`
+
+	args := []any{t.UserId, t.Secret, t.Enabled, t.LastStep, t.FailedAttempts, t.LockedUntil, t.CreatedAt, t.EnabledAt}
+
+	if tx != nil {
+		if _, err := tx.Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	} else {
+		if _, err := r.GetPool().Exec(ctx, q, args...); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
+
+// TxDeleteUserTOTP deletes the authenticator and the recovery codes of the user
+// from the 'pf_user_totp' and 'pf_user_recovery_codes' tables.
+func (r *Repository) TxDeleteUserTOTP(ctx context.Context, tx pgx.Tx, userId int32) error {
+	for _, q := range []string{
+		`DELETE FROM public.pf_user_recovery_codes WHERE user_id = $1`,
+		`DELETE FROM public.pf_user_totp WHERE user_id = $1`,
+	} {
+		if _, err := tx.Exec(ctx, q, userId); err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+	}
+
+	return nil
+}
+
+// TxReplaceRecoveryCodes replaces the recovery codes of the user in the 'pf_user_recovery_codes' table
+// with the given code hashes, codes issued before stop working.
+func (r *Repository) TxReplaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userId int32, hashes []string, now int64) error {
+	q := `DELETE FROM public.pf_user_recovery_codes WHERE user_id = $1`
+	if _, err := tx.Exec(ctx, q, userId); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	q = `INSERT INTO
+		public.pf_user_recovery_codes(user_id, code_hash, created_at)
+		SELECT $1, UNNEST($2::text[]), $3`
+	if _, err := tx.Exec(ctx, q, userId, hashes, now); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	return nil
+}
+
+// TxUseRecoveryCode marks an unused recovery code of the user as used in the 'pf_user_recovery_codes' table.
+// It tells whether such a code existed.
+func (r *Repository) TxUseRecoveryCode(ctx context.Context, tx pgx.Tx, userId int32, codeHash string, usedAt int64) (bool, error) {
+	q := `UPDATE public.pf_user_recovery_codes
+		SET used_at = $3
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL`
+
+	tag, err := tx.Exec(ctx, q, userId, codeHash, usedAt)
+	if err != nil {
+		return false, r.DebugLogSqlErr(q, err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// NewTwoFactorChallenge stores a login waiting for the second factor in the 'pf_two_factor_challenges' table
+// and deletes the expired ones.
+func (r *Repository) NewTwoFactorChallenge(ctx context.Context, c model.TwoFactorChallenge) error {
+	q := `INSERT INTO
+		public.pf_two_factor_challenges(token_hash, user_id, remember_me, user_agent, ip_address, enroll,
+			created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	args := []any{c.TokenHash, c.UserId, c.RememberMe, c.UserAgent, c.IpAddress, c.Enroll, c.CreatedAt, c.ExpiresAt}
+
+	if _, err := r.GetPool().Exec(ctx, q, args...); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	purge := `DELETE FROM public.pf_two_factor_challenges WHERE expires_at < $1`
+	if _, err := r.GetPool().Exec(ctx, purge, c.CreatedAt); err != nil {
+		return r.DebugLogSqlErr(purge, err)
+	}
+
+	return nil
+}
+
+// TxFindTwoFactorChallenge retrieves a login challenge by its token hash from the 'pf_two_factor_challenges' table.
+// Within a transaction the row is locked until the end of it. It returns pgx.ErrNoRows if the challenge does not exist.
+func (r *Repository) TxFindTwoFactorChallenge(ctx context.Context, tx pgx.Tx, tokenHash string) (model.TwoFactorChallenge, error) {
+	q := `SELECT token_hash, user_id, remember_me, user_agent, ip_address, enroll, created_at, expires_at
+		FROM public.pf_two_factor_challenges
+		WHERE token_hash = $1`
+
+	var row pgx.Row
+	if tx != nil {
+		row = tx.QueryRow(ctx, q+` FOR UPDATE`, tokenHash)
+	} else {
+		row = r.GetPool().QueryRow(ctx, q, tokenHash)
+	}
+
+	var c model.TwoFactorChallenge
+	err := row.Scan(&c.TokenHash, &c.UserId, &c.RememberMe, &c.UserAgent, &c.IpAddress, &c.Enroll,
+		&c.CreatedAt, &c.ExpiresAt)
+	if err != nil {
+		return c, r.DebugLogSqlErr(q, err)
+	}
+
+	return c, nil
+}
+
+// TxDeleteTwoFactorChallenge deletes a completed login challenge
+// from the 'pf_two_factor_challenges' table.
+func (r *Repository) TxDeleteTwoFactorChallenge(ctx context.Context, tx pgx.Tx, tokenHash string) error {
+	q := `DELETE FROM public.pf_two_factor_challenges WHERE token_hash = $1`
+
+	if _, err := tx.Exec(ctx, q, tokenHash); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	return nil
+}
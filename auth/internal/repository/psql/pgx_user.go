@@ -7,6 +7,7 @@ import (
 	"pickfighter.com/auth/pkg/model"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 // TxCreateUser creates a new user in the 'pf_users' table.
@@ -16,8 +17,8 @@ import (
 // The method returns the newly created user's ID and an error, if any.
 func (r *Repository) TxCreateUser(ctx context.Context, tx pgx.Tx, u model.User) (int32, error) {
 	query := `INSERT INTO public.pf_users 
-		(name, claim, created_at)
-		VALUES ($1, $2, $3)
+		(name, claim, roles, created_at)
+		VALUES ($1, $2, $3, $4)
 		RETURNING user_id`
 
 	var role *string
@@ -27,7 +28,7 @@ func (r *Repository) TxCreateUser(ctx context.Context, tx pgx.Tx, u model.User)
 	}
 
 	args := []any{
-		u.Name, role, time.Now().Unix(),
+		u.Name, role, int64(u.Roles), time.Now().Unix(),
 	}
 
 	var userId int32
@@ -43,3 +44,28 @@ func (r *Repository) TxCreateUser(ctx context.Context, tx pgx.Tx, u model.User)
 
 	return userId, nil
 }
+
+// TxUpdateUserName sets the display name of the user in the 'pf_users' table.
+// It returns pgx.ErrNoRows if the user does not exist.
+func (r *Repository) TxUpdateUserName(ctx context.Context, tx pgx.Tx, userId int32, name string, now int64) error {
+	q := `UPDATE public.pf_users SET name = $2, updated_at = $3 WHERE user_id = $1`
+
+	var (
+		tag pgconn.CommandTag
+		err error
+	)
+	if tx != nil {
+		tag, err = tx.Exec(ctx, q, userId, name, now)
+	} else {
+		tag, err = r.GetPool().Exec(ctx, q, userId, name, now)
+	}
+	if err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
@@ -12,7 +12,8 @@ import (
 )
 
 const (
-	searchUsersQuery = `SELECT u.user_id, u.name, u.claim, u.rank, u.flags, u.created_at, u.updated_at
+	searchUsersQuery = `SELECT u.user_id, u.name, u.claim, u.rank, u.flags, u.roles, u.created_at, u.updated_at,
+	u.delete_after
 	FROM public.pf_users AS u`
 )
 
@@ -71,16 +72,19 @@ func (r *Repository) SearchUsers(ctx context.Context, req *model.UsersRequest) (
 
 	for rows.Next() {
 		var u model.User
-		var flags, updatedAt pgtype.Int8
+		var flags, roles, updatedAt, deleteAfter pgtype.Int8
 		var rootClaim, rank pgtype.Varchar
 
-		if err := rows.Scan(&u.UserId, &u.Name, &rootClaim, &rank, &flags, &u.CreatedAt, &updatedAt); err != nil {
+		if err := rows.Scan(&u.UserId, &u.Name, &rootClaim, &rank, &flags, &roles, &u.CreatedAt, &updatedAt,
+			&deleteAfter); err != nil {
 			return nil, r.DebugLogSqlErr(q, err)
 		}
 		u.Rank = rank.String
 		u.Claim = rootClaim.String
 		u.Flags = uint64(flags.Int)
+		u.Roles = uint64(roles.Int)
 		u.UpdatedAt = updatedAt.Int
+		u.DeleteAfter = deleteAfter.Int
 
 		res = append(res, &u)
 	}
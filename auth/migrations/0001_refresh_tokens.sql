@@ -0,0 +1,32 @@
+-- Rotating refresh tokens grouped in families, a family is a single login.
+-- Migrations are applied to the database of the service in the order of their numbers.
+
+--- pf_refresh_tokens table
+
+CREATE TABLE IF NOT EXISTS public.pf_refresh_tokens (
+    token_id bigserial NOT NULL,
+    family_id character varying(36) NOT NULL,
+    user_id integer NOT NULL,
+    token_hash character varying(255) NOT NULL,
+    user_agent text,
+    ip_address character varying(45),
+    created_at bigint NOT NULL,
+    expires_at bigint NOT NULL,
+    family_expires_at bigint NOT NULL,
+    used_at bigint,
+    revoked_at bigint
+);
+
+ALTER TABLE ONLY public.pf_refresh_tokens
+    ADD CONSTRAINT pf_refresh_tokens_pkey PRIMARY KEY (token_id);
+
+ALTER TABLE ONLY public.pf_refresh_tokens
+    ADD CONSTRAINT pf_refresh_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.pf_users(user_id) ON DELETE CASCADE;
+
+-- tokens are found by the hash of their value, a hash must identify a single token
+CREATE UNIQUE INDEX pf_refresh_tokens_token_hash_uindex ON public.pf_refresh_tokens USING btree (token_hash);
+
+-- families are revoked at once on reuse, logout and session listing
+CREATE INDEX pf_refresh_tokens_family_id_index ON public.pf_refresh_tokens USING btree (family_id);
+
+CREATE INDEX pf_refresh_tokens_user_id_index ON public.pf_refresh_tokens USING btree (user_id);
@@ -0,0 +1,22 @@
+-- Access tokens revoked by their JWT ID and logouts of a user everywhere.
+
+--- pf_revoked_tokens table
+
+CREATE TABLE IF NOT EXISTS public.pf_revoked_tokens (
+    token_id character varying(36) NOT NULL,
+    user_id integer NOT NULL,
+    expires_at bigint NOT NULL,
+    revoked_at bigint NOT NULL
+);
+
+-- TxRevokeToken keeps the first record of a token revoked twice with ON CONFLICT (token_id)
+ALTER TABLE ONLY public.pf_revoked_tokens
+    ADD CONSTRAINT pf_revoked_tokens_pkey PRIMARY KEY (token_id);
+
+-- expired records are purged by PurgeRevokedTokens
+CREATE INDEX pf_revoked_tokens_expires_at_index ON public.pf_revoked_tokens USING btree (expires_at);
+
+--- pf_users tokens_revoked_before column
+
+-- tokens of the user issued before the time are revoked, NULL if the user was never logged out everywhere
+ALTER TABLE public.pf_users ADD COLUMN IF NOT EXISTS tokens_revoked_before bigint;
@@ -0,0 +1,7 @@
+-- Algorithm and parameters of password hashes, see auth/pkg/password.
+
+--- pf_user_credentials password columns
+
+-- credentials without an algorithm keep the legacy salted SHA-256 hash until the next login rehashes them
+ALTER TABLE public.pf_user_credentials ADD COLUMN IF NOT EXISTS password_algorithm character varying(20);
+ALTER TABLE public.pf_user_credentials ADD COLUMN IF NOT EXISTS password_params character varying(100);
@@ -0,0 +1,30 @@
+-- Emails queued in the transaction of the change they are about and sent by the outbox worker.
+-- data holds the rendered template data with the token, it is never exposed by the admin API.
+
+--- pf_email_outbox table
+
+CREATE TABLE IF NOT EXISTS public.pf_email_outbox (
+    email_id bigserial NOT NULL,
+    subject character varying(50) NOT NULL,
+    recipient_email character varying(255) NOT NULL,
+    recipient_name character varying(255) NOT NULL DEFAULT '',
+    locale character varying(35) NOT NULL DEFAULT '',
+    data jsonb NOT NULL,
+    status character varying(10) NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at bigint NOT NULL,
+    last_error text,
+    created_at bigint NOT NULL,
+    updated_at bigint NOT NULL,
+    sent_at bigint,
+    CONSTRAINT pf_email_outbox_status_check CHECK (status IN ('pending', 'sent', 'dead'))
+);
+
+ALTER TABLE ONLY public.pf_email_outbox
+    ADD CONSTRAINT pf_email_outbox_pkey PRIMARY KEY (email_id);
+
+-- the worker claims pending emails due now in the order of their next attempt
+CREATE INDEX pf_email_outbox_status_next_attempt_at_index ON public.pf_email_outbox USING btree (status, next_attempt_at);
+
+-- the admin API lists emails by status, the newest first
+CREATE INDEX pf_email_outbox_status_created_at_index ON public.pf_email_outbox USING btree (status, created_at DESC);
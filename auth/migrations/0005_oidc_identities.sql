@@ -0,0 +1,41 @@
+-- Pending OpenID Connect logins and the provider identities linked to users.
+
+--- pf_oauth_states table
+
+CREATE TABLE IF NOT EXISTS public.pf_oauth_states (
+    state character varying(255) NOT NULL,
+    provider character varying(50) NOT NULL,
+    nonce character varying(255) NOT NULL,
+    code_verifier character varying(255) NOT NULL,
+    remember_me boolean NOT NULL DEFAULT false,
+    created_at bigint NOT NULL,
+    expires_at bigint NOT NULL
+);
+
+ALTER TABLE ONLY public.pf_oauth_states
+    ADD CONSTRAINT pf_oauth_states_pkey PRIMARY KEY (state);
+
+-- expired states are purged on every new login
+CREATE INDEX pf_oauth_states_expires_at_index ON public.pf_oauth_states USING btree (expires_at);
+
+--- pf_user_identities table
+
+CREATE TABLE IF NOT EXISTS public.pf_user_identities (
+    identity_id bigserial NOT NULL,
+    provider character varying(50) NOT NULL,
+    subject character varying(255) NOT NULL,
+    user_id integer NOT NULL,
+    email character varying(255) NOT NULL DEFAULT '',
+    created_at bigint NOT NULL
+);
+
+ALTER TABLE ONLY public.pf_user_identities
+    ADD CONSTRAINT pf_user_identities_pkey PRIMARY KEY (identity_id);
+
+ALTER TABLE ONLY public.pf_user_identities
+    ADD CONSTRAINT pf_user_identities_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.pf_users(user_id) ON DELETE CASCADE;
+
+-- a subject of a provider identifies a single user, logins find the user by it
+CREATE UNIQUE INDEX pf_user_identities_provider_subject_uindex ON public.pf_user_identities USING btree (provider, subject);
+
+CREATE INDEX pf_user_identities_user_id_index ON public.pf_user_identities USING btree (user_id);
@@ -0,0 +1,62 @@
+-- TOTP authenticators of users, their one-time recovery codes and logins waiting for the second factor.
+
+--- pf_user_totp table
+
+CREATE TABLE IF NOT EXISTS public.pf_user_totp (
+    user_id integer NOT NULL,
+    secret character varying(64) NOT NULL,
+    enabled boolean NOT NULL DEFAULT false,
+    last_step bigint NOT NULL DEFAULT 0,
+    failed_attempts integer NOT NULL DEFAULT 0,
+    locked_until bigint NOT NULL DEFAULT 0,
+    created_at bigint NOT NULL,
+    enabled_at bigint
+);
+
+-- a user has a single authenticator, enrolling again replaces a pending one
+ALTER TABLE ONLY public.pf_user_totp
+    ADD CONSTRAINT pf_user_totp_pkey PRIMARY KEY (user_id);
+
+ALTER TABLE ONLY public.pf_user_totp
+    ADD CONSTRAINT pf_user_totp_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.pf_users(user_id) ON DELETE CASCADE;
+
+--- pf_user_recovery_codes table
+
+CREATE TABLE IF NOT EXISTS public.pf_user_recovery_codes (
+    code_id bigserial NOT NULL,
+    user_id integer NOT NULL,
+    code_hash character varying(255) NOT NULL,
+    created_at bigint NOT NULL,
+    used_at bigint
+);
+
+ALTER TABLE ONLY public.pf_user_recovery_codes
+    ADD CONSTRAINT pf_user_recovery_codes_pkey PRIMARY KEY (code_id);
+
+ALTER TABLE ONLY public.pf_user_recovery_codes
+    ADD CONSTRAINT pf_user_recovery_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.pf_users(user_id) ON DELETE CASCADE;
+
+-- codes are used by the hash of their value, a code is used once
+CREATE UNIQUE INDEX pf_user_recovery_codes_user_id_code_hash_uindex ON public.pf_user_recovery_codes USING btree (user_id, code_hash);
+
+--- pf_two_factor_challenges table
+
+CREATE TABLE IF NOT EXISTS public.pf_two_factor_challenges (
+    token_hash character varying(255) NOT NULL,
+    user_id integer NOT NULL,
+    remember_me boolean NOT NULL DEFAULT false,
+    user_agent text NOT NULL DEFAULT '',
+    ip_address character varying(45) NOT NULL DEFAULT '',
+    enroll boolean NOT NULL DEFAULT false,
+    created_at bigint NOT NULL,
+    expires_at bigint NOT NULL
+);
+
+ALTER TABLE ONLY public.pf_two_factor_challenges
+    ADD CONSTRAINT pf_two_factor_challenges_pkey PRIMARY KEY (token_hash);
+
+ALTER TABLE ONLY public.pf_two_factor_challenges
+    ADD CONSTRAINT pf_two_factor_challenges_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.pf_users(user_id) ON DELETE CASCADE;
+
+-- expired challenges are purged on every new one
+CREATE INDEX pf_two_factor_challenges_expires_at_index ON public.pf_two_factor_challenges USING btree (expires_at);
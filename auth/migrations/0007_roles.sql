@@ -0,0 +1,29 @@
+-- Roles of users as a bit set of rbac.Role values and the audit log of role changes.
+-- Every user gets the user role (1), which grants bet:create, users flagged as admins (flags = 1)
+-- get the admin role (8) too.
+
+ALTER TABLE public.pf_users ADD COLUMN IF NOT EXISTS roles bigint NOT NULL DEFAULT 1;
+
+UPDATE public.pf_users SET roles = roles | 8 WHERE flags = 1;
+
+--- pf_role_audit table
+
+-- user_id and actor_id are not foreign keys, the log is kept after users are deleted
+CREATE TABLE IF NOT EXISTS public.pf_role_audit (
+    audit_id bigserial NOT NULL,
+    user_id integer NOT NULL,
+    actor_id integer NOT NULL,
+    action character varying(10) NOT NULL,
+    role character varying(50) NOT NULL,
+    reason text,
+    roles bigint NOT NULL,
+    created_at bigint NOT NULL,
+    CONSTRAINT pf_role_audit_action_check CHECK (action IN ('grant', 'revoke'))
+);
+
+ALTER TABLE ONLY public.pf_role_audit
+    ADD CONSTRAINT pf_role_audit_pkey PRIMARY KEY (audit_id);
+
+CREATE INDEX pf_role_audit_user_id_created_at_index ON public.pf_role_audit USING btree (user_id, created_at DESC);
+
+CREATE INDEX pf_role_audit_created_at_index ON public.pf_role_audit USING btree (created_at DESC);
@@ -0,0 +1,23 @@
+-- Email changes waiting for the confirmation link sent to the new address.
+
+--- pf_email_changes table
+
+CREATE TABLE IF NOT EXISTS public.pf_email_changes (
+    token_hash character varying(255) NOT NULL,
+    user_id integer NOT NULL,
+    email character varying(255) NOT NULL,
+    created_at bigint NOT NULL,
+    expires_at bigint NOT NULL
+);
+
+ALTER TABLE ONLY public.pf_email_changes
+    ADD CONSTRAINT pf_email_changes_pkey PRIMARY KEY (token_hash);
+
+ALTER TABLE ONLY public.pf_email_changes
+    ADD CONSTRAINT pf_email_changes_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.pf_users(user_id) ON DELETE CASCADE;
+
+-- a user has a single pending change, a new one replaces it and only the latest link works
+CREATE UNIQUE INDEX pf_email_changes_user_id_uindex ON public.pf_email_changes USING btree (user_id);
+
+-- expired changes are purged on every new one
+CREATE INDEX pf_email_changes_expires_at_index ON public.pf_email_changes USING btree (expires_at);
@@ -0,0 +1,10 @@
+-- Scheduled account deletions. A deleted account is kept for the grace period until delete_after,
+-- then claimed by an anonymizer until anonymize_claimed_until and anonymized at anonymized_at.
+
+ALTER TABLE public.pf_users ADD COLUMN IF NOT EXISTS delete_after bigint;
+ALTER TABLE public.pf_users ADD COLUMN IF NOT EXISTS anonymized_at bigint;
+ALTER TABLE public.pf_users ADD COLUMN IF NOT EXISTS anonymize_claimed_until bigint NOT NULL DEFAULT 0;
+
+-- due deletions are claimed the longest due first, only scheduled accounts are indexed
+CREATE INDEX IF NOT EXISTS pf_users_delete_after_index ON public.pf_users USING btree (delete_after, user_id)
+    WHERE delete_after IS NOT NULL AND anonymized_at IS NULL;
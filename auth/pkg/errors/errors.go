@@ -40,6 +40,8 @@ const (
 	Token        = 600
 	TokenEmpty   = 601
 	TokenExpired = 602
+	TokenRefresh = 603
+	TokenRevoke  = 604
 
 	JSON        = 700
 	JSONDecoder = 701
@@ -47,6 +49,30 @@ const (
 
 	DB        = 800
 	DBGetUser = 801
+
+	Email       = 900
+	EmailOutbox = 901
+	EmailResend = 902
+
+	OAuth         = 1000
+	OAuthLogin    = 1001
+	OAuthCallback = 1002
+
+	TwoFactor       = 1100
+	TwoFactorLogin  = 1101
+	TwoFactorEnroll = 1102
+	TwoFactorVerify = 1103
+
+	Roles       = 1200
+	RolesChange = 1201
+	RolesAudit  = 1202
+
+	Account         = 1300
+	AccountProfile  = 1301
+	AccountPassword = 1302
+	AccountEmail    = 1303
+	AccountExport   = 1304
+	AccountDeletion = 1305
 )
 
 var defaultErrors = DefaultMessagesList{
@@ -74,9 +100,30 @@ var defaultErrors = DefaultMessagesList{
 	Token:                      Error{ErrCode: Token, Message: "[Token]: Token unknown error"},
 	TokenEmpty:                 Error{ErrCode: TokenEmpty, Message: "[Token]: Token is empty"},
 	TokenExpired:               Error{ErrCode: TokenExpired, Message: "[Token]: Token expired, try to reset password"},
+	TokenRefresh:               Error{ErrCode: TokenRefresh, Message: "[Token]: Failed to refresh token"},
+	TokenRevoke:                Error{ErrCode: TokenRevoke, Message: "[Token]: Failed to revoke token"},
 	JSON:                       Error{ErrCode: JSON, Message: "[JSON]: JSON unknown error"},
 	JSONDecoder:                Error{ErrCode: JSONDecoder, Message: "[JSON]: Decoder error"},
 	DBGetUser:                  Error{ErrCode: DBGetUser, Message: "[DB]: Failed to get user"},
+	Email:                      Error{ErrCode: Email, Message: "[Email]: Email unknown error"},
+	EmailOutbox:                Error{ErrCode: EmailOutbox, Message: "[Email]: Failed to queue email"},
+	EmailResend:                Error{ErrCode: EmailResend, Message: "[Email]: Failed to resend email"},
+	OAuth:                      Error{ErrCode: OAuth, Message: "[OAuth]: OAuth unknown error"},
+	OAuthLogin:                 Error{ErrCode: OAuthLogin, Message: "[OAuth]: Failed to start provider login"},
+	OAuthCallback:              Error{ErrCode: OAuthCallback, Message: "[OAuth]: Failed to complete provider login"},
+	TwoFactor:                  Error{ErrCode: TwoFactor, Message: "[Two-Factor]: Two-factor authentication unknown error"},
+	TwoFactorLogin:             Error{ErrCode: TwoFactorLogin, Message: "[Two-Factor]: Failed to complete two-factor login"},
+	TwoFactorEnroll:            Error{ErrCode: TwoFactorEnroll, Message: "[Two-Factor]: Failed to enroll authenticator"},
+	TwoFactorVerify:            Error{ErrCode: TwoFactorVerify, Message: "[Two-Factor]: Failed to verify two-factor code"},
+	Roles:                      Error{ErrCode: Roles, Message: "[Roles]: Roles unknown error"},
+	RolesChange:                Error{ErrCode: RolesChange, Message: "[Roles]: Failed to change user roles"},
+	RolesAudit:                 Error{ErrCode: RolesAudit, Message: "[Roles]: Failed to get roles audit"},
+	Account:                    Error{ErrCode: Account, Message: "[Account]: Account unknown error"},
+	AccountProfile:             Error{ErrCode: AccountProfile, Message: "[Account]: Failed to update profile"},
+	AccountPassword:            Error{ErrCode: AccountPassword, Message: "[Account]: Failed to change password"},
+	AccountEmail:               Error{ErrCode: AccountEmail, Message: "[Account]: Failed to change email"},
+	AccountExport:              Error{ErrCode: AccountExport, Message: "[Account]: Failed to export account"},
+	AccountDeletion:            Error{ErrCode: AccountDeletion, Message: "[Account]: Failed to delete account"},
 }
 
 var unknownError = Error{ErrCode: 9999, Message: "Unknown Error"}
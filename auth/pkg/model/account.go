@@ -0,0 +1,23 @@
+package model
+
+// AccountExport holds the personal data the auth service keeps about a user:
+// the profile with the login email, linked provider identities, two-factor state and role changes.
+type AccountExport struct {
+	User               User           `json:"user"`
+	Identities         []UserIdentity `json:"identities"`
+	TwoFactorEnabled   bool           `json:"two_factor_enabled"`
+	TwoFactorEnabledAt int64          `json:"two_factor_enabled_at,omitempty"`
+	RoleChanges        []*RoleChange  `json:"role_changes"`
+}
+
+// DeleteAccountRequest represents a request to delete the user's account, proven by the password
+// if the user has one.
+type DeleteAccountRequest struct {
+	UserId   int32  `json:"-"`
+	Password string `json:"password"`
+}
+
+// AccountDeletion tells when a scheduled account deletion takes effect, until then it can be cancelled.
+type AccountDeletion struct {
+	DeleteAfter int64 `json:"delete_after"`
+}
@@ -10,6 +10,8 @@ type RegisterRequest struct {
 	Password string `json:"password"`
 	Name     string `json:"name"`
 	TermsOk  bool   `json:"terms_ok"`
+	// Locale selects the language of the confirmation email, e.g. "es-MX".
+	Locale string `json:"locale"`
 
 	Token string `json:"token"`
 }
@@ -28,6 +30,9 @@ type AuthenticateRequest struct {
 	Audience  []string `json:"audience"`
 
 	Method int `json:"method"`
+
+	// SessionId is the refresh token family of the login, it is set as the "sid" claim of access tokens.
+	SessionId string `json:"-"`
 }
 
 // AuthenticateResult represents the result of a successful authentication.
@@ -37,18 +42,30 @@ type AuthenticateResult struct {
 	Code           string    `json:"code" yaml:"code"`
 	AccessToken    string    `json:"access_token" yaml:"access_token"`
 	ExpirationTime time.Time `json:"expiration_time" yaml:"expiration_time"`
+
+	RefreshToken          string    `json:"refresh_token" yaml:"refresh_token"`
+	RefreshExpirationTime time.Time `json:"refresh_expiration_time" yaml:"refresh_expiration_time"`
+
+	// Challenge is set instead of the tokens when the login needs the second factor.
+	Challenge *TwoFactorChallengeResult `json:"challenge,omitempty" yaml:"challenge,omitempty"`
+	// RecoveryCodes are set when the login enrolled an authenticator, they are shown only once.
+	RecoveryCodes []string `json:"recovery_codes,omitempty" yaml:"recovery_codes,omitempty"`
 }
 
 // UserCredentials represents user authentication credentials and related information.
+// PasswordAlgorithm and PasswordParams are the hashing algorithm and parameters of Password,
+// they are empty for legacy salted SHA-256 hashes.
 type UserCredentials struct {
-	UserId      int32     `json:"user_id"`
-	Email       string    `json:"email"`
-	Password    string    `json:"-"`
-	Salt        string    `json:"-"`
-	Token       string    `json:"-"`
-	TokenType   TokenType `json:"token_type"`
-	TokenExpire int64     `json:"token_expire"`
-	Active      bool      `json:"active"`
+	UserId            int32     `json:"user_id"`
+	Email             string    `json:"email"`
+	Password          string    `json:"-"`
+	Salt              string    `json:"-"`
+	PasswordAlgorithm string    `json:"-"`
+	PasswordParams    string    `json:"-"`
+	Token             string    `json:"-"`
+	TokenType         TokenType `json:"token_type"`
+	TokenExpire       int64     `json:"token_expire"`
+	Active            bool      `json:"active"`
 }
 
 // UserCredentialsRequest represents a request for retrieving user authentication credentials.
@@ -62,7 +79,8 @@ type UserCredentialsRequest struct {
 
 // ResetPasswordRequest represents a request to initiate the password reset process.
 type ResetPasswordRequest struct {
-	Email string `json:"email"`
+	Email  string `json:"email"`
+	Locale string `json:"locale"`
 }
 
 // RecoverPasswordRequest represents a request to recover the password after initiating the reset process.
@@ -73,8 +91,40 @@ type RecoverPasswordRequest struct {
 }
 
 // ChangePasswordRequest represents a request to change the user's password.
+// SessionId is the session the request comes from, it stays logged in.
 type ChangePasswordRequest struct {
+	UserId         int32  `json:"-"`
+	SessionId      string `json:"-"`
 	OldPassword    string `json:"old_password"`
 	NewPassword    string `json:"new_password"`
 	RepeatPassword string `json:"repeat_password"`
 }
+
+// ChangeEmailRequest represents a request to change the user's email, proven by the password.
+type ChangeEmailRequest struct {
+	UserId   int32  `json:"-"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Locale   string `json:"locale"`
+}
+
+// ChangeEmailResult tells where the confirmation of an email change was sent and until when it is valid.
+type ChangeEmailResult struct {
+	Email     string `json:"email"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+// EmailChange is an email change waiting for the confirmation of the new address,
+// identified by the hash of the token sent to it.
+type EmailChange struct {
+	TokenHash string `json:"-"`
+	UserId    int32  `json:"user_id"`
+	Email     string `json:"email"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+	ExpiresAt int64  `json:"expires_at,omitempty"`
+}
+
+// ConfirmEmailChangeRequest represents a request to confirm an email change with the token sent to the new address.
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token"`
+}
@@ -4,8 +4,16 @@ package model
 const (
 	EmailRegistration  = "registration"
 	EmailResetPassword = "reset_password"
+	EmailChangeEmail   = "change_email"
 )
 
+// EmailSubjects lists all email types, each of them has a template.
+var EmailSubjects = []string{
+	EmailRegistration,
+	EmailResetPassword,
+	EmailChangeEmail,
+}
+
 // EmailAddrSpec represents an email address with an optional name.
 type EmailAddrSpec struct {
 	Email string `json:"email" yaml:"email"`
@@ -19,4 +27,40 @@ type EmailData struct {
 	Subject   string        `json:"subject" yaml:"subject"`
 	Token     string        `json:"token" yaml:"token"`
 	Url       string        `json:"url" yaml:"url"`
+	Locale    string        `json:"locale" yaml:"locale"`
+}
+
+// Outbox email statuses.
+const (
+	OutboxPending = "pending"
+	OutboxSent    = "sent"
+	OutboxDead    = "dead"
+)
+
+// OutboxEmail is an email queued in the outbox with its delivery status. A pending email is sent
+// at NextAttemptAt, it is dead after the last attempt failed. Data holds the token and is never exposed.
+type OutboxEmail struct {
+	EmailId       int64         `json:"email_id"`
+	Subject       string        `json:"subject"`
+	Recipient     EmailAddrSpec `json:"recipient"`
+	Locale        string        `json:"locale"`
+	Status        string        `json:"status"`
+	Attempts      int32         `json:"attempts"`
+	NextAttemptAt int64         `json:"next_attempt_at"`
+	LastError     string        `json:"last_error,omitempty"`
+	CreatedAt     int64         `json:"created_at"`
+	SentAt        int64         `json:"sent_at,omitempty"`
+
+	Data *EmailData `json:"-"`
+}
+
+// EmailOutboxRequest filters outbox emails by status, an empty status returns emails of all statuses.
+type EmailOutboxRequest struct {
+	Status string `json:"status"`
+	Limit  int32  `json:"limit"`
+}
+
+// ResendEmailRequest queues an outbox email to be sent again.
+type ResendEmailRequest struct {
+	EmailId int64 `json:"email_id"`
 }
@@ -12,6 +12,7 @@ func RegisterRequestFromProto(p *gen.RegisterRequest) *RegisterRequest {
 		Password: p.Password,
 		Token:    p.Token,
 		TermsOk:  p.TermsOk,
+		Locale:   p.Locale,
 	}
 }
 
@@ -22,6 +23,7 @@ func RegisterRequestToProto(req *RegisterRequest) *gen.RegisterRequest {
 		Password: req.Password,
 		Token:    req.Token,
 		TermsOk:  req.TermsOk,
+		Locale:   req.Locale,
 	}
 }
 
@@ -30,9 +32,8 @@ func AuthenticateRequestFromProto(p *gen.AuthenticateRequest) *AuthenticateReque
 		Email:    p.Email,
 		Password: p.Password,
 
-		RememberMe: p.RememberMe,
-		UserAgent:  p.UserAgent,
-		IpAddress:  p.IpAddress,
+		UserAgent: p.UserAgent,
+		IpAddress: p.IpAddress,
 
 		Subject:   p.Subject,
 		ExpiresIn: p.ExpiresIn,
@@ -47,9 +48,8 @@ func AuthenticateRequestToProto(req *AuthenticateRequest) *gen.AuthenticateReque
 		Email:    req.Email,
 		Password: req.Password,
 
-		RememberMe: req.RememberMe,
-		UserAgent:  req.UserAgent,
-		IpAddress:  req.IpAddress,
+		UserAgent: req.UserAgent,
+		IpAddress: req.IpAddress,
 
 		Subject:   req.Subject,
 		ExpiresIn: req.ExpiresIn,
@@ -66,6 +66,12 @@ func AuthenticateResultFromProto(p *gen.AuthenticateResponse) *AuthenticateResul
 		TokenId:        string(p.TokenId),
 		AccessToken:    p.AccessToken,
 		ExpirationTime: p.ExpirationTime.AsTime(),
+
+		RefreshToken:          p.RefreshToken,
+		RefreshExpirationTime: p.RefreshExpirationTime.AsTime(),
+
+		Challenge:     TwoFactorChallengeResultFromProto(p.Challenge),
+		RecoveryCodes: p.RecoveryCodes,
 	}
 }
 
@@ -76,6 +82,170 @@ func AuthenticateResultToProto(req *AuthenticateResult) *gen.AuthenticateRespons
 		TokenId:        req.TokenId,
 		AccessToken:    req.AccessToken,
 		ExpirationTime: timestamppb.New(req.ExpirationTime),
+
+		RefreshToken:          req.RefreshToken,
+		RefreshExpirationTime: timestamppb.New(req.RefreshExpirationTime),
+
+		Challenge:     TwoFactorChallengeResultToProto(req.Challenge),
+		RecoveryCodes: req.RecoveryCodes,
+	}
+}
+
+func TwoFactorChallengeResultFromProto(p *gen.TwoFactorChallenge) *TwoFactorChallengeResult {
+	if p == nil {
+		return nil
+	}
+
+	return &TwoFactorChallengeResult{
+		Token:              p.Token,
+		ExpirationTime:     p.ExpirationTime.AsTime(),
+		EnrollmentRequired: p.EnrollmentRequired,
+	}
+}
+
+func TwoFactorChallengeResultToProto(res *TwoFactorChallengeResult) *gen.TwoFactorChallenge {
+	if res == nil {
+		return nil
+	}
+
+	return &gen.TwoFactorChallenge{
+		Token:              res.Token,
+		ExpirationTime:     timestamppb.New(res.ExpirationTime),
+		EnrollmentRequired: res.EnrollmentRequired,
+	}
+}
+
+func TwoFactorLoginRequestFromProto(p *gen.TwoFactorLoginRequest) *TwoFactorLoginRequest {
+	return &TwoFactorLoginRequest{
+		ChallengeToken: p.ChallengeToken,
+		Code:           p.Code,
+		UserAgent:      p.UserAgent,
+		IpAddress:      p.IpAddress,
+	}
+}
+
+func TwoFactorLoginRequestToProto(req *TwoFactorLoginRequest) *gen.TwoFactorLoginRequest {
+	return &gen.TwoFactorLoginRequest{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+		UserAgent:      req.UserAgent,
+		IpAddress:      req.IpAddress,
+	}
+}
+
+func RefreshTokenRequestFromProto(p *gen.RefreshTokenRequest) *RefreshTokenRequest {
+	return &RefreshTokenRequest{
+		RefreshToken: p.RefreshToken,
+		UserAgent:    p.UserAgent,
+		IpAddress:    p.IpAddress,
+	}
+}
+
+func RefreshTokenRequestToProto(req *RefreshTokenRequest) *gen.RefreshTokenRequest {
+	return &gen.RefreshTokenRequest{
+		RefreshToken: req.RefreshToken,
+		UserAgent:    req.UserAgent,
+		IpAddress:    req.IpAddress,
+	}
+}
+
+func OAuthLoginResultFromProto(p *gen.OAuthLoginResponse) *OAuthLoginResult {
+	return &OAuthLoginResult{
+		AuthUrl:   p.AuthUrl,
+		State:     p.State,
+		ExpiresAt: p.ExpiresAt,
+	}
+}
+
+func OAuthLoginResultToProto(res *OAuthLoginResult) *gen.OAuthLoginResponse {
+	return &gen.OAuthLoginResponse{
+		AuthUrl:   res.AuthUrl,
+		State:     res.State,
+		ExpiresAt: res.ExpiresAt,
+	}
+}
+
+func OAuthCallbackRequestFromProto(p *gen.OAuthCallbackRequest) *OAuthCallbackRequest {
+	return &OAuthCallbackRequest{
+		Provider:  p.Provider,
+		Code:      p.Code,
+		State:     p.State,
+		UserAgent: p.UserAgent,
+		IpAddress: p.IpAddress,
+	}
+}
+
+func OAuthCallbackRequestToProto(req *OAuthCallbackRequest) *gen.OAuthCallbackRequest {
+	return &gen.OAuthCallbackRequest{
+		Provider:  req.Provider,
+		Code:      req.Code,
+		State:     req.State,
+		UserAgent: req.UserAgent,
+		IpAddress: req.IpAddress,
+	}
+}
+
+func RevokeTokenRequestFromProto(p *gen.RevokeTokenRequest) *RevokeTokenRequest {
+	return &RevokeTokenRequest{
+		TokenId:   p.TokenId,
+		UserId:    p.UserId,
+		SessionId: p.SessionId,
+		ExpiresAt: p.ExpiresAt,
+	}
+}
+
+func RevokeTokenRequestToProto(req *RevokeTokenRequest) *gen.RevokeTokenRequest {
+	return &gen.RevokeTokenRequest{
+		TokenId:   req.TokenId,
+		UserId:    req.UserId,
+		SessionId: req.SessionId,
+		ExpiresAt: req.ExpiresAt,
+	}
+}
+
+func RevokeUserTokensRequestFromProto(p *gen.RevokeUserTokensRequest) *RevokeUserTokensRequest {
+	return &RevokeUserTokensRequest{
+		UserId: p.UserId,
+		Before: p.Before,
+	}
+}
+
+func RevokeUserTokensRequestToProto(req *RevokeUserTokensRequest) *gen.RevokeUserTokensRequest {
+	return &gen.RevokeUserTokensRequest{
+		UserId: req.UserId,
+		Before: req.Before,
+	}
+}
+
+func TokenStatusRequestFromProto(p *gen.TokenStatusRequest) *TokenStatusRequest {
+	return &TokenStatusRequest{
+		TokenId:   p.TokenId,
+		UserId:    p.UserId,
+		IssuedAt:  p.IssuedAt,
+		SessionId: p.SessionId,
+	}
+}
+
+func TokenStatusRequestToProto(req *TokenStatusRequest) *gen.TokenStatusRequest {
+	return &gen.TokenStatusRequest{
+		TokenId:   req.TokenId,
+		UserId:    req.UserId,
+		IssuedAt:  req.IssuedAt,
+		SessionId: req.SessionId,
+	}
+}
+
+func TokenStatusFromProto(p *gen.TokenStatusResponse) *TokenStatus {
+	return &TokenStatus{
+		Revoked:       p.Revoked,
+		RevokedBefore: p.RevokedBefore,
+	}
+}
+
+func TokenStatusToProto(s *TokenStatus) *gen.TokenStatusResponse {
+	return &gen.TokenStatusResponse{
+		Revoked:       s.Revoked,
+		RevokedBefore: s.RevokedBefore,
 	}
 }
 
@@ -97,31 +267,208 @@ func PasswordRecoveryRequestToProto(req *RecoverPasswordRequest) *gen.PasswordRe
 
 func UserFromProto(p *gen.ProfileResponse) *User {
 	return &User{
-		UserId:    p.User.UserId,
-		Name:      p.User.Name,
-		Email:     p.User.Email,
-		Rank:      p.User.Rank,
-		Claim:     p.User.Claim,
-		Roles:     p.User.Roles,
-		Flags:     p.User.Flags,
-		CreatedAt: p.User.CreatedAt,
-		UpdatedAt: p.User.UpdatedAt,
+		UserId:      p.User.UserId,
+		Name:        p.User.Name,
+		Email:       p.User.Email,
+		Rank:        p.User.Rank,
+		Claim:       p.User.Claim,
+		Roles:       p.User.Roles,
+		Flags:       p.User.Flags,
+		CreatedAt:   p.User.CreatedAt,
+		UpdatedAt:   p.User.UpdatedAt,
+		DeleteAfter: p.User.DeleteAfter,
 	}
 }
 
 func UserToProto(u *User) *gen.ProfileResponse {
 	return &gen.ProfileResponse{
 		User: &gen.User{
-			UserId:    u.UserId,
-			Name:      u.Name,
-			Email:     u.Email,
-			Rank:      u.Rank,
-			Claim:     u.Claim,
-			Roles:     u.Roles,
-			Flags:     u.Flags,
-			CreatedAt: u.CreatedAt,
-			UpdatedAt: u.UpdatedAt,
+			UserId:      u.UserId,
+			Name:        u.Name,
+			Email:       u.Email,
+			Rank:        u.Rank,
+			Claim:       u.Claim,
+			Roles:       u.Roles,
+			Flags:       u.Flags,
+			CreatedAt:   u.CreatedAt,
+			UpdatedAt:   u.UpdatedAt,
+			DeleteAfter: u.DeleteAfter,
+		},
+	}
+}
+
+// OutboxEmailToProto converts OutboxEmail to gen.OutboxEmail
+func OutboxEmailToProto(e *OutboxEmail) *gen.OutboxEmail {
+	return &gen.OutboxEmail{
+		EmailId:        e.EmailId,
+		Subject:        e.Subject,
+		RecipientEmail: e.Recipient.Email,
+		RecipientName:  e.Recipient.Name,
+		Locale:         e.Locale,
+		Status:         e.Status,
+		Attempts:       e.Attempts,
+		NextAttemptAt:  e.NextAttemptAt,
+		LastError:      e.LastError,
+		CreatedAt:      e.CreatedAt,
+		SentAt:         e.SentAt,
+	}
+}
+
+// OutboxEmailFromProto converts gen.OutboxEmail to OutboxEmail
+func OutboxEmailFromProto(p *gen.OutboxEmail) *OutboxEmail {
+	if p == nil {
+		return nil
+	}
+
+	return &OutboxEmail{
+		EmailId: p.EmailId,
+		Subject: p.Subject,
+		Recipient: EmailAddrSpec{
+			Email: p.RecipientEmail,
+			Name:  p.RecipientName,
 		},
+		Locale:        p.Locale,
+		Status:        p.Status,
+		Attempts:      p.Attempts,
+		NextAttemptAt: p.NextAttemptAt,
+		LastError:     p.LastError,
+		CreatedAt:     p.CreatedAt,
+		SentAt:        p.SentAt,
+	}
+}
+
+// OutboxEmailsToProto converts OutboxEmail slice to gen.OutboxEmail slice
+func OutboxEmailsToProto(emails []*OutboxEmail) []*gen.OutboxEmail {
+	protoEmails := make([]*gen.OutboxEmail, len(emails))
+	for i, v := range emails {
+		protoEmails[i] = OutboxEmailToProto(v)
+	}
+
+	return protoEmails
+}
+
+// OutboxEmailsFromProto converts gen.OutboxEmail slice to OutboxEmail slice
+func OutboxEmailsFromProto(p []*gen.OutboxEmail) []*OutboxEmail {
+	emails := make([]*OutboxEmail, len(p))
+	for i, v := range p {
+		emails[i] = OutboxEmailFromProto(v)
+	}
+
+	return emails
+}
+
+// RoleChangeRequestToProto converts RoleChangeRequest to gen.RoleChangeRequest
+func RoleChangeRequestToProto(req *RoleChangeRequest) *gen.RoleChangeRequest {
+	return &gen.RoleChangeRequest{
+		UserId:  req.UserId,
+		Role:    req.Role,
+		ActorId: req.ActorId,
+		Reason:  req.Reason,
+	}
+}
+
+// RoleChangeRequestFromProto converts gen.RoleChangeRequest to RoleChangeRequest
+func RoleChangeRequestFromProto(p *gen.RoleChangeRequest) *RoleChangeRequest {
+	return &RoleChangeRequest{
+		UserId:  p.UserId,
+		Role:    p.Role,
+		ActorId: p.ActorId,
+		Reason:  p.Reason,
+	}
+}
+
+// RoleChangeToProto converts RoleChange to gen.RoleChange
+func RoleChangeToProto(c *RoleChange) *gen.RoleChange {
+	return &gen.RoleChange{
+		AuditId:   c.AuditId,
+		UserId:    c.UserId,
+		ActorId:   c.ActorId,
+		Action:    c.Action,
+		Role:      c.Role,
+		Reason:    c.Reason,
+		Roles:     c.Roles,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+// RoleChangeFromProto converts gen.RoleChange to RoleChange
+func RoleChangeFromProto(p *gen.RoleChange) *RoleChange {
+	if p == nil {
+		return nil
+	}
+
+	return &RoleChange{
+		AuditId:   p.AuditId,
+		UserId:    p.UserId,
+		ActorId:   p.ActorId,
+		Action:    p.Action,
+		Role:      p.Role,
+		Reason:    p.Reason,
+		Roles:     p.Roles,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+// RoleChangesToProto converts RoleChange slice to gen.RoleChange slice
+func RoleChangesToProto(changes []*RoleChange) []*gen.RoleChange {
+	protoChanges := make([]*gen.RoleChange, len(changes))
+	for i, v := range changes {
+		protoChanges[i] = RoleChangeToProto(v)
+	}
+
+	return protoChanges
+}
+
+// RoleChangesFromProto converts gen.RoleChange slice to RoleChange slice
+func RoleChangesFromProto(p []*gen.RoleChange) []*RoleChange {
+	changes := make([]*RoleChange, len(p))
+	for i, v := range p {
+		changes[i] = RoleChangeFromProto(v)
+	}
+
+	return changes
+}
+
+// AccountExportToProto converts AccountExport model to gen.AccountExportResponse
+func AccountExportToProto(e *AccountExport) *gen.AccountExportResponse {
+	identities := make([]*gen.UserIdentity, len(e.Identities))
+	for i, v := range e.Identities {
+		identities[i] = &gen.UserIdentity{
+			Provider:  v.Provider,
+			Subject:   v.Subject,
+			Email:     v.Email,
+			CreatedAt: v.CreatedAt,
+		}
+	}
+
+	return &gen.AccountExportResponse{
+		User:               UserToProto(&e.User).User,
+		Identities:         identities,
+		TwoFactorEnabled:   e.TwoFactorEnabled,
+		TwoFactorEnabledAt: e.TwoFactorEnabledAt,
+		RoleChanges:        RoleChangesToProto(e.RoleChanges),
+	}
+}
+
+// AccountExportFromProto converts gen.AccountExportResponse to AccountExport model
+func AccountExportFromProto(p *gen.AccountExportResponse) *AccountExport {
+	identities := make([]UserIdentity, len(p.Identities))
+	for i, v := range p.Identities {
+		identities[i] = UserIdentity{
+			Provider:  v.Provider,
+			Subject:   v.Subject,
+			UserId:    p.User.GetUserId(),
+			Email:     v.Email,
+			CreatedAt: v.CreatedAt,
+		}
+	}
+
+	return &AccountExport{
+		User:               *UserFromProto(&gen.ProfileResponse{User: p.User}),
+		Identities:         identities,
+		TwoFactorEnabled:   p.TwoFactorEnabled,
+		TwoFactorEnabledAt: p.TwoFactorEnabledAt,
+		RoleChanges:        RoleChangesFromProto(p.RoleChanges),
 	}
 }
 
@@ -0,0 +1,46 @@
+package model
+
+// OAuthState is a pending OpenID Connect login. The state is echoed back by the provider in the callback,
+// the nonce is in the ID token and the code verifier proves the code was requested by us (PKCE).
+type OAuthState struct {
+	State        string `json:"-"`
+	Provider     string `json:"provider"`
+	Nonce        string `json:"-"`
+	CodeVerifier string `json:"-"`
+	RememberMe   bool   `json:"remember_me"`
+	CreatedAt    int64  `json:"created_at"`
+	ExpiresAt    int64  `json:"expires_at"`
+}
+
+// UserIdentity links a user to the subject identifying them at an OpenID Connect provider.
+type UserIdentity struct {
+	Provider  string `json:"provider"`
+	Subject   string `json:"subject"`
+	UserId    int32  `json:"user_id"`
+	Email     string `json:"email"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// OAuthLoginRequest starts a login with an OpenID Connect provider.
+type OAuthLoginRequest struct {
+	Provider   string `json:"provider"`
+	RememberMe bool   `json:"remember_me"`
+}
+
+// OAuthLoginResult holds the provider's login page URL the user is redirected to
+// and the state the callback must echo back.
+type OAuthLoginResult struct {
+	AuthUrl   string `json:"auth_url"`
+	State     string `json:"state"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+// OAuthCallbackRequest completes a login with the authorization code the provider redirected back with.
+type OAuthCallbackRequest struct {
+	Provider string `json:"provider"`
+	Code     string `json:"code"`
+	State    string `json:"state"`
+
+	UserAgent string `json:"user_agent"`
+	IpAddress string `json:"ip_address"`
+}
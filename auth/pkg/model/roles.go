@@ -0,0 +1,35 @@
+package model
+
+// Actions of role changes recorded in the roles audit.
+const (
+	RoleGranted = "grant"
+	RoleRevoked = "revoke"
+)
+
+// RoleChangeRequest grants or revokes a role of a user on behalf of an actor,
+// the role is given by its name, e.g. "event_editor".
+type RoleChangeRequest struct {
+	UserId  int32  `json:"user_id"`
+	Role    string `json:"role"`
+	ActorId int32  `json:"actor_id"`
+	Reason  string `json:"reason"`
+}
+
+// RoleChange is an entry of the roles audit: who granted or revoked which role of a user and why.
+// Roles holds the names of the user's roles after the change.
+type RoleChange struct {
+	AuditId   int64    `json:"audit_id"`
+	UserId    int32    `json:"user_id"`
+	ActorId   int32    `json:"actor_id"`
+	Action    string   `json:"action"`
+	Role      string   `json:"role"`
+	Reason    string   `json:"reason,omitempty"`
+	Roles     []string `json:"roles"`
+	CreatedAt int64    `json:"created_at"`
+}
+
+// RoleAuditRequest lists role changes, of a single user if UserId is set.
+type RoleAuditRequest struct {
+	UserId int32 `json:"user_id"`
+	Limit  int32 `json:"limit"`
+}
@@ -9,3 +9,58 @@ const (
 	TokenResetPassword TokenType = "reset_password"
 	TokenSetPassword   TokenType = "set_password"
 )
+
+// RefreshToken represents a stored refresh token. Only the hash of the token is stored,
+// tokens issued by rotation share the family of the token issued at login and its absolute expiry.
+type RefreshToken struct {
+	TokenId         int64  `json:"token_id"`
+	FamilyId        string `json:"family_id"`
+	UserId          int32  `json:"user_id"`
+	TokenHash       string `json:"-"`
+	UserAgent       string `json:"user_agent"`
+	IpAddress       string `json:"ip_address"`
+	CreatedAt       int64  `json:"created_at"`
+	ExpiresAt       int64  `json:"expires_at"`
+	FamilyExpiresAt int64  `json:"family_expires_at"`
+	UsedAt          int64  `json:"used_at,omitempty"`
+	RevokedAt       int64  `json:"revoked_at,omitempty"`
+}
+
+// RevokeTokenRequest represents a request to revoke an access token by its JWT ID until it expires.
+// A set SessionId revokes the refresh tokens of the same login too.
+type RevokeTokenRequest struct {
+	TokenId   string `json:"token_id"`
+	UserId    int32  `json:"user_id"`
+	SessionId string `json:"session_id"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+// RevokeUserTokensRequest represents a request to revoke all tokens issued to the user before a timestamp,
+// zero Before is now.
+type RevokeUserTokensRequest struct {
+	UserId int32 `json:"user_id"`
+	Before int64 `json:"before"`
+}
+
+// TokenStatusRequest represents a request to check whether an access token is revoked.
+// SessionId is the refresh token family of the access token, if it has one.
+type TokenStatusRequest struct {
+	TokenId   string `json:"token_id"`
+	UserId    int32  `json:"user_id"`
+	IssuedAt  int64  `json:"issued_at"`
+	SessionId string `json:"session_id"`
+}
+
+// TokenStatus tells whether an access token is revoked, RevokedBefore is the time
+// tokens of the user issued before it are revoked.
+type TokenStatus struct {
+	Revoked       bool  `json:"revoked"`
+	RevokedBefore int64 `json:"revoked_before"`
+}
+
+// RefreshTokenRequest represents a request to exchange a refresh token for new access and refresh tokens.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+	UserAgent    string `json:"user_agent"`
+	IpAddress    string `json:"ip_address"`
+}
@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+// UserTOTP is the TOTP authenticator of a user. It is enrolled first and enabled once a code
+// generated from the secret is verified. LastStep is the time step of the last accepted code,
+// codes of earlier steps are rejected as replays. Codes are not checked until LockedUntil
+// after too many FailedAttempts in a row.
+type UserTOTP struct {
+	UserId         int32  `json:"user_id"`
+	Secret         string `json:"-"`
+	Enabled        bool   `json:"enabled"`
+	LastStep       int64  `json:"-"`
+	FailedAttempts int32  `json:"-"`
+	LockedUntil    int64  `json:"-"`
+	CreatedAt      int64  `json:"created_at"`
+	EnabledAt      int64  `json:"enabled_at"`
+}
+
+// TwoFactorChallenge is a login waiting for the second factor, identified by the hash of its token.
+// Enroll is set when the user must enroll an authenticator before logging in.
+type TwoFactorChallenge struct {
+	TokenHash  string `json:"-"`
+	UserId     int32  `json:"user_id"`
+	RememberMe bool   `json:"remember_me"`
+	UserAgent  string `json:"user_agent"`
+	IpAddress  string `json:"ip_address"`
+	Enroll     bool   `json:"enroll"`
+	CreatedAt  int64  `json:"created_at"`
+	ExpiresAt  int64  `json:"expires_at"`
+}
+
+// TOTPEnrollRequest starts the enrollment of an authenticator for a logged in user
+// or for the user of a login challenge requiring enrollment.
+type TOTPEnrollRequest struct {
+	UserId         int32  `json:"user_id"`
+	ChallengeToken string `json:"challenge_token"`
+}
+
+// TOTPEnrollment holds the secret of an enrolled authenticator and its otpauth:// URI for QR codes.
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+}
+
+// TOTPCodeRequest proves a user has the second factor with a TOTP code or a recovery code.
+type TOTPCodeRequest struct {
+	UserId int32  `json:"user_id"`
+	Code   string `json:"code"`
+}
+
+// TwoFactorLoginRequest completes a login challenge with a TOTP code or a recovery code.
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"`
+	UserAgent      string `json:"user_agent"`
+	IpAddress      string `json:"ip_address"`
+}
+
+// TwoFactorChallengeResult is returned instead of tokens by a login requiring the second factor.
+type TwoFactorChallengeResult struct {
+	Token              string    `json:"challenge_token"`
+	ExpirationTime     time.Time `json:"challenge_expiration_time"`
+	EnrollmentRequired bool      `json:"enrollment_required"`
+}
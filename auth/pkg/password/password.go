@@ -0,0 +1,224 @@
+// Package password hashes and verifies user passwords. New hashes use argon2id or bcrypt with tunable
+// parameters, the legacy salted SHA-256 hashes of utils.GenerateSaltedHash are still verified,
+// so existing accounts keep working until their passwords are rehashed.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"pickfighter.com/auth/pkg/utils"
+)
+
+// Hashing algorithms.
+const (
+	// AlgorithmLegacy is the salted SHA-256 of utils.GenerateSaltedHash, credentials without
+	// a stored algorithm use it.
+	AlgorithmLegacy   = "sha256"
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+var (
+	ErrUnknownAlgorithm = errors.New("unknown password hashing algorithm")
+	ErrInvalidParams    = errors.New("invalid password hashing parameters")
+)
+
+// Argon2Params are argon2id parameters, Memory is in KiB.
+type Argon2Params struct {
+	Memory      uint32 `mapstructure:"memory"`
+	Iterations  uint32 `mapstructure:"iterations"`
+	Parallelism uint8  `mapstructure:"parallelism"`
+	SaltLength  uint32 `mapstructure:"salt_length"`
+	KeyLength   uint32 `mapstructure:"key_length"`
+}
+
+// Params select the algorithm of new hashes and its parameters.
+type Params struct {
+	Algorithm  string       `mapstructure:"algorithm"`
+	Argon2     Argon2Params `mapstructure:"argon2"`
+	BcryptCost int          `mapstructure:"bcrypt_cost"`
+}
+
+// DefaultParams hash with argon2id using 64 MiB of memory, the second recommended option of RFC 9106
+// with 3 passes for a cheaper memory budget.
+var DefaultParams = Params{
+	Algorithm: AlgorithmArgon2id,
+	Argon2: Argon2Params{
+		Memory:      64 * 1024,
+		Iterations:  3,
+		Parallelism: 2,
+		SaltLength:  16,
+		KeyLength:   32,
+	},
+	BcryptCost: 12,
+}
+
+// Hash is a stored password hash with the algorithm and parameters it was made with.
+// Salt is empty for bcrypt, which keeps it in the hash.
+type Hash struct {
+	Algorithm string
+	Params    string
+	Salt      string
+	Hash      string
+}
+
+// Hash hashes the password with the algorithm and parameters of p.
+func (p Params) Hash(password string) (Hash, error) {
+	switch p.Algorithm {
+	case AlgorithmArgon2id:
+		a := p.Argon2
+		if a.Memory == 0 || a.Iterations == 0 || a.Parallelism == 0 || a.SaltLength == 0 || a.KeyLength == 0 {
+			return Hash{}, fmt.Errorf("%w: %+v", ErrInvalidParams, a)
+		}
+
+		salt := make([]byte, a.SaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return Hash{}, err
+		}
+
+		key := argon2.IDKey([]byte(password), salt, a.Iterations, a.Memory, a.Parallelism, a.KeyLength)
+
+		return Hash{
+			Algorithm: AlgorithmArgon2id,
+			Params:    a.encode(),
+			Salt:      base64.RawStdEncoding.EncodeToString(salt),
+			Hash:      base64.RawStdEncoding.EncodeToString(key),
+		}, nil
+	case AlgorithmBcrypt:
+		if p.BcryptCost < bcrypt.MinCost || p.BcryptCost > bcrypt.MaxCost {
+			return Hash{}, fmt.Errorf("%w: bcrypt cost %d", ErrInvalidParams, p.BcryptCost)
+		}
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), p.BcryptCost)
+		if err != nil {
+			return Hash{}, err
+		}
+
+		return Hash{
+			Algorithm: AlgorithmBcrypt,
+			Params:    "cost=" + strconv.Itoa(p.BcryptCost),
+			Hash:      string(hash),
+		}, nil
+	default:
+		return Hash{}, fmt.Errorf("%w: %q", ErrUnknownAlgorithm, p.Algorithm)
+	}
+}
+
+// NeedsRehash tells whether h was made with another algorithm or other parameters than p,
+// the password should be hashed again after it is verified.
+func (p Params) NeedsRehash(h Hash) bool {
+	if algorithm(h) != p.Algorithm {
+		return true
+	}
+
+	switch p.Algorithm {
+	case AlgorithmArgon2id:
+		a, err := decodeArgon2(h.Params)
+		return err != nil || a.Memory != p.Argon2.Memory || a.Iterations != p.Argon2.Iterations ||
+			a.Parallelism != p.Argon2.Parallelism || a.KeyLength != p.Argon2.KeyLength
+	case AlgorithmBcrypt:
+		cost, err := bcrypt.Cost([]byte(h.Hash))
+		return err != nil || cost != p.BcryptCost
+	}
+
+	return false
+}
+
+// Verify compares the password with the stored hash in constant time.
+// It returns an error only if the hash can't be checked, a wrong password is false.
+func Verify(password string, h Hash) (bool, error) {
+	switch algorithm(h) {
+	case AlgorithmLegacy:
+		sum := utils.GenerateSaltedHash(password, h.Salt)
+		return subtle.ConstantTimeCompare([]byte(sum), []byte(h.Hash)) == 1, nil
+	case AlgorithmArgon2id:
+		a, err := decodeArgon2(h.Params)
+		if err != nil {
+			return false, err
+		}
+
+		salt, err := base64.RawStdEncoding.DecodeString(h.Salt)
+		if err != nil {
+			return false, fmt.Errorf("%w: salt: %w", ErrInvalidParams, err)
+		}
+
+		want, err := base64.RawStdEncoding.DecodeString(h.Hash)
+		if err != nil {
+			return false, fmt.Errorf("%w: hash: %w", ErrInvalidParams, err)
+		}
+		if len(want) == 0 {
+			return false, fmt.Errorf("%w: empty hash", ErrInvalidParams)
+		}
+
+		key := argon2.IDKey([]byte(password), salt, a.Iterations, a.Memory, a.Parallelism, uint32(len(want)))
+
+		return subtle.ConstantTimeCompare(key, want) == 1, nil
+	case AlgorithmBcrypt:
+		err := bcrypt.CompareHashAndPassword([]byte(h.Hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+
+		return err == nil, err
+	default:
+		return false, fmt.Errorf("%w: %q", ErrUnknownAlgorithm, h.Algorithm)
+	}
+}
+
+// algorithm returns the algorithm of the hash, hashes stored before algorithms were recorded are legacy.
+func algorithm(h Hash) string {
+	if h.Algorithm == "" {
+		return AlgorithmLegacy
+	}
+
+	return h.Algorithm
+}
+
+// encode formats the parameters the way they are stored, e.g. "m=65536,t=3,p=2,l=32".
+func (a Argon2Params) encode() string {
+	return fmt.Sprintf("m=%d,t=%d,p=%d,l=%d", a.Memory, a.Iterations, a.Parallelism, a.KeyLength)
+}
+
+func decodeArgon2(s string) (Argon2Params, error) {
+	var a Argon2Params
+
+	for _, kv := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return a, fmt.Errorf("%w: %q", ErrInvalidParams, s)
+		}
+
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return a, fmt.Errorf("%w: %q", ErrInvalidParams, s)
+		}
+
+		switch k {
+		case "m":
+			a.Memory = uint32(n)
+		case "t":
+			a.Iterations = uint32(n)
+		case "p":
+			if n > 255 {
+				return a, fmt.Errorf("%w: %q", ErrInvalidParams, s)
+			}
+			a.Parallelism = uint8(n)
+		case "l":
+			a.KeyLength = uint32(n)
+		}
+	}
+
+	if a.Memory == 0 || a.Iterations == 0 || a.Parallelism == 0 {
+		return a, fmt.Errorf("%w: %q", ErrInvalidParams, s)
+	}
+
+	return a, nil
+}
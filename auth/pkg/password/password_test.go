@@ -0,0 +1,164 @@
+package password
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pickfighter.com/auth/pkg/utils"
+)
+
+// testParams are cheap parameters, so the tests don't spend DefaultParams memory and time.
+var testParams = Params{
+	Algorithm: AlgorithmArgon2id,
+	Argon2: Argon2Params{
+		Memory:      1024,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	},
+	BcryptCost: 4,
+}
+
+func TestHashVerify(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmArgon2id, AlgorithmBcrypt} {
+		t.Run(algorithm, func(t *testing.T) {
+			p := testParams
+			p.Algorithm = algorithm
+
+			h, err := p.Hash("correct horse battery staple")
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, h.Algorithm)
+			assert.NotEmpty(t, h.Params)
+
+			ok, err := Verify("correct horse battery staple", h)
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			ok, err = Verify("correct horse battery stable", h)
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			// every hash gets its own salt
+			other, err := p.Hash("correct horse battery staple")
+			require.NoError(t, err)
+			assert.NotEqual(t, h.Hash, other.Hash)
+		})
+	}
+}
+
+func TestHashInvalidParams(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params Params
+		err    error
+	}{
+		{"unknown algorithm", Params{Algorithm: "md5"}, ErrUnknownAlgorithm},
+		{"empty algorithm", Params{}, ErrUnknownAlgorithm},
+		{"argon2 without memory", Params{Algorithm: AlgorithmArgon2id, Argon2: Argon2Params{Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}}, ErrInvalidParams},
+		{"bcrypt cost too low", Params{Algorithm: AlgorithmBcrypt, BcryptCost: 1}, ErrInvalidParams},
+		{"bcrypt cost too high", Params{Algorithm: AlgorithmBcrypt, BcryptCost: 32}, ErrInvalidParams},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.params.Hash("secret")
+			assert.True(t, errors.Is(err, tc.err), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestVerifyLegacy(t *testing.T) {
+	h := Hash{Salt: "pepper", Hash: utils.GenerateSaltedHash("secret", "pepper")}
+
+	ok, err := Verify("secret", h)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = Verify("Secret", h)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	h.Algorithm = AlgorithmLegacy
+	ok, err = Verify("secret", h)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// a legacy hash is always rehashed
+	assert.True(t, testParams.NeedsRehash(h))
+}
+
+func TestNeedsRehash(t *testing.T) {
+	argon, err := testParams.Hash("secret")
+	require.NoError(t, err)
+
+	bcryptParams := testParams
+	bcryptParams.Algorithm = AlgorithmBcrypt
+	bcr, err := bcryptParams.Hash("secret")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		modify func(p *Params)
+		hash   Hash
+		rehash bool
+	}{
+		{"same argon2 params", func(p *Params) {}, argon, false},
+		{"argon2 salt length is not stored", func(p *Params) { p.Argon2.SaltLength = 32 }, argon, false},
+		{"argon2 memory", func(p *Params) { p.Argon2.Memory = 2048 }, argon, true},
+		{"argon2 iterations", func(p *Params) { p.Argon2.Iterations = 2 }, argon, true},
+		{"argon2 parallelism", func(p *Params) { p.Argon2.Parallelism = 2 }, argon, true},
+		{"argon2 key length", func(p *Params) { p.Argon2.KeyLength = 64 }, argon, true},
+		{"argon2 to bcrypt", func(p *Params) { p.Algorithm = AlgorithmBcrypt }, argon, true},
+		{"same bcrypt cost", func(p *Params) { p.Algorithm = AlgorithmBcrypt }, bcr, false},
+		{"bcrypt cost", func(p *Params) { p.Algorithm = AlgorithmBcrypt; p.BcryptCost = 5 }, bcr, true},
+		{"bcrypt to argon2", func(p *Params) {}, bcr, true},
+		{"malformed argon2 params", func(p *Params) {}, Hash{Algorithm: AlgorithmArgon2id, Params: "m=1024"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := testParams
+			tc.modify(&p)
+
+			assert.Equal(t, tc.rehash, p.NeedsRehash(tc.hash))
+		})
+	}
+}
+
+func TestVerifyMalformed(t *testing.T) {
+	argon, err := testParams.Hash("secret")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		hash Hash
+	}{
+		{"unknown algorithm", Hash{Algorithm: "md5", Hash: "5ebe2294ecd0e0f08eab7690d2a6ee69"}},
+		{"argon2 empty params", Hash{Algorithm: AlgorithmArgon2id, Salt: argon.Salt, Hash: argon.Hash}},
+		{"argon2 params without values", Hash{Algorithm: AlgorithmArgon2id, Params: "m,t,p", Salt: argon.Salt, Hash: argon.Hash}},
+		{"argon2 params not numbers", Hash{Algorithm: AlgorithmArgon2id, Params: "m=a,t=1,p=1,l=32", Salt: argon.Salt, Hash: argon.Hash}},
+		{"argon2 zero parallelism", Hash{Algorithm: AlgorithmArgon2id, Params: "m=1024,t=1,p=0,l=32", Salt: argon.Salt, Hash: argon.Hash}},
+		{"argon2 parallelism overflow", Hash{Algorithm: AlgorithmArgon2id, Params: "m=1024,t=1,p=256,l=32", Salt: argon.Salt, Hash: argon.Hash}},
+		{"argon2 salt not base64", Hash{Algorithm: AlgorithmArgon2id, Params: argon.Params, Salt: "%%%", Hash: argon.Hash}},
+		{"argon2 hash not base64", Hash{Algorithm: AlgorithmArgon2id, Params: argon.Params, Salt: argon.Salt, Hash: "%%%"}},
+		{"argon2 empty hash", Hash{Algorithm: AlgorithmArgon2id, Params: argon.Params, Salt: argon.Salt}},
+		{"bcrypt too short", Hash{Algorithm: AlgorithmBcrypt, Hash: "$2a$04$abc"}},
+		{"bcrypt empty", Hash{Algorithm: AlgorithmBcrypt}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var ok bool
+			var err error
+			require.NotPanics(t, func() {
+				ok, err = Verify("secret", tc.hash)
+			})
+
+			assert.Error(t, err)
+			assert.False(t, ok)
+		})
+	}
+}
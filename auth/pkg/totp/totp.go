@@ -0,0 +1,173 @@
+// Package totp implements RFC 6238 time-based one-time passwords as generated by authenticator apps:
+// HMAC-SHA1 over 30 second time steps truncated to 6 digits, and one-time recovery codes
+// used in place of a code when the authenticator is lost.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is the length of a time step.
+	Period = 30 * time.Second
+	// Digits is the number of digits of a code.
+	Digits = 6
+	// modulus truncates codes to Digits digits.
+	modulus = 1_000_000
+	// secretLength is the number of random bytes of a secret, the HMAC-SHA1 key length recommended by RFC 4226.
+	secretLength = 20
+	// recoveryCodeLength is the number of random bytes of a recovery code.
+	recoveryCodeLength = 10
+)
+
+var (
+	ErrInvalidSecret = errors.New("invalid TOTP secret")
+
+	encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// GenerateSecret returns a random base32 encoded secret.
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(b), nil
+}
+
+// URI returns the otpauth:// URI of the secret, shown as a QR code to add the account to an authenticator app.
+func URI(issuer, account, secret string) string {
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(Digits))
+	q.Set("period", fmt.Sprint(int(Period.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: q.Encode(),
+	}
+
+	return u.String()
+}
+
+// Step returns the time step of t.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// Code returns the code of the secret at the time step.
+func Code(secret string, step int64) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return code(key, step), nil
+}
+
+// Validate checks the code against the time step of t and skew steps before and after it,
+// so codes typed right before a step ends and small clock differences are accepted.
+// It returns the matched step, callers reject codes of steps not later than the last accepted one
+// to prevent replays.
+func Validate(secret, c string, t time.Time, skew int64) (int64, bool, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+
+	c = strings.TrimSpace(c)
+	if len(c) != Digits {
+		return 0, false, nil
+	}
+
+	now := Step(t)
+	for step := now - skew; step <= now+skew; step++ {
+		if subtle.ConstantTimeCompare([]byte(code(key, step)), []byte(c)) == 1 {
+			return step, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// IsCode tells whether s looks like a TOTP code rather than a recovery code.
+func IsCode(s string) bool {
+	s = strings.TrimSpace(s)
+	if len(s) != Digits {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GenerateRecoveryCodes returns n random recovery codes formatted as two dash-separated groups.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		b := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+
+		s := strings.ToLower(encoding.EncodeToString(b))
+		codes[i] = s[:len(s)/2] + "-" + s[len(s)/2:]
+	}
+
+	return codes, nil
+}
+
+// NormalizeRecoveryCode returns the recovery code the way it is generated, ignoring case, spaces and dashes,
+// so it can be hashed and looked up.
+func NormalizeRecoveryCode(s string) string {
+	s = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(s))
+	if len(s) < 2 {
+		return s
+	}
+
+	return s[:len(s)/2] + "-" + s[len(s)/2:]
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil || len(key) == 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSecret, err)
+	}
+
+	return key, nil
+}
+
+// code computes the HOTP value of RFC 4226 for the counter.
+func code(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, v%modulus)
+}
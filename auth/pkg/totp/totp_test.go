@@ -0,0 +1,146 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 seed of the RFC 6238 test vectors, "12345678901234567890" in base32.
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+// rfcVectors are the SHA1 test vectors of RFC 6238 Appendix B, truncated to the last Digits digits
+// of the 8 digit values of the RFC.
+var rfcVectors = []struct {
+	unix int64
+	code string
+}{
+	{unix: 59, code: "287082"},
+	{unix: 1111111109, code: "081804"},
+	{unix: 1111111111, code: "050471"},
+	{unix: 1234567890, code: "005924"},
+	{unix: 2000000000, code: "279037"},
+	{unix: 20000000000, code: "353130"},
+}
+
+func TestCode(t *testing.T) {
+	for _, v := range rfcVectors {
+		c, err := Code(rfcSecret, Step(time.Unix(v.unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, v.code, c, "time %d", v.unix)
+	}
+
+	// secrets are accepted lower case and padded
+	c, err := Code(strings.ToLower(rfcSecret)+"====", Step(time.Unix(59, 0)))
+	require.NoError(t, err)
+	assert.Equal(t, "287082", c)
+
+	for _, secret := range []string{"", "not base32!", "1"} {
+		_, err := Code(secret, 1)
+		assert.ErrorIs(t, err, ErrInvalidSecret, "secret %q", secret)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, v := range rfcVectors {
+		now := time.Unix(v.unix, 0)
+
+		step, ok, err := Validate(rfcSecret, v.code, now, 0)
+		require.NoError(t, err)
+		assert.True(t, ok, "time %d", v.unix)
+		assert.Equal(t, Step(now), step)
+	}
+
+	now := time.Unix(1111111111, 0)
+	prev, err := Code(rfcSecret, Step(now)-1)
+	require.NoError(t, err)
+	next, err := Code(rfcSecret, Step(now)+1)
+	require.NoError(t, err)
+	old, err := Code(rfcSecret, Step(now)-2)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		code string
+		skew int64
+		step int64
+		ok   bool
+	}{
+		{name: "Previous step within skew", code: prev, skew: 1, step: Step(now) - 1, ok: true},
+		{name: "Next step within skew", code: next, skew: 1, step: Step(now) + 1, ok: true},
+		{name: "Previous step without skew", code: prev, skew: 0},
+		{name: "Step out of skew", code: old, skew: 1},
+		{name: "Spaces around", code: " 050471 ", skew: 0, step: Step(now), ok: true},
+		{name: "Wrong code", code: "123456", skew: 1},
+		{name: "Short code", code: "05047", skew: 1},
+		{name: "Long code", code: "0504710", skew: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok, err := Validate(rfcSecret, tt.code, now, tt.skew)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.step, step)
+			}
+		})
+	}
+
+	_, _, err = Validate("not base32!", "050471", now, 1)
+	assert.ErrorIs(t, err, ErrInvalidSecret)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	other, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+
+	key, err := decodeSecret(secret)
+	require.NoError(t, err)
+	assert.Len(t, key, secretLength)
+	assert.NotContains(t, secret, "=")
+}
+
+func TestURI(t *testing.T) {
+	u, err := url.Parse(URI("Pickfighter", "jon@example.com", rfcSecret))
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Pickfighter:jon@example.com", u.Path)
+	assert.Equal(t, rfcSecret, u.Query().Get("secret"))
+	assert.Equal(t, "Pickfighter", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+	assert.Equal(t, "30", u.Query().Get("period"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	require.Len(t, codes, 10)
+
+	seen := make(map[string]bool)
+	for _, c := range codes {
+		assert.False(t, seen[c], "duplicate code %q", c)
+		seen[c] = true
+
+		assert.False(t, IsCode(c))
+		assert.Equal(t, c, NormalizeRecoveryCode(c))
+		assert.Equal(t, c, NormalizeRecoveryCode(strings.ToUpper(strings.ReplaceAll(c, "-", " "))))
+	}
+
+	assert.True(t, IsCode("050471"))
+	assert.True(t, IsCode(" 050471 "))
+	assert.False(t, IsCode("05047a"))
+	assert.False(t, IsCode("05047"))
+}
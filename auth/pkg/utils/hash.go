@@ -1,7 +1,9 @@
 package utils
 
 import (
+	crand "crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"io"
 	"math/rand"
@@ -22,6 +24,17 @@ func GetRandomString(n int) string {
 	return string(b)
 }
 
+// GetSecureToken returns a URL-safe token of n random bytes read from crypto/rand,
+// it is meant for secrets like refresh tokens, unlike GetRandomString.
+func GetSecureToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := crand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
 // GenerateSaltedHash generates a SHA-256 hash with a salt for the given string.
 // It returns the hash in hexadecimal format.
 func GenerateSaltedHash(str string, salt string) string {
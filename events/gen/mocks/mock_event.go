@@ -0,0 +1,437 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/controller/event/controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/controller/event/controller.go -destination=./gen/mocks/mock_event.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+	gomock "go.uber.org/mock/gomock"
+	model "pickfighter.com/events/pkg/model"
+)
+
+// MockeventRepository is a mock of eventRepository interface.
+type MockeventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventRepositoryMockRecorder
+}
+
+// MockeventRepositoryMockRecorder is the mock recorder for MockeventRepository.
+type MockeventRepositoryMockRecorder struct {
+	mock *MockeventRepository
+}
+
+// NewMockeventRepository creates a new mock instance.
+func NewMockeventRepository(ctrl *gomock.Controller) *MockeventRepository {
+	mock := &MockeventRepository{ctrl: ctrl}
+	mock.recorder = &MockeventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventRepository) EXPECT() *MockeventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BeginTx mocks base method.
+func (m *MockeventRepository) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTx", ctx, txOptions)
+	ret0, _ := ret[0].(pgx.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTx indicates an expected call of BeginTx.
+func (mr *MockeventRepositoryMockRecorder) BeginTx(ctx, txOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTx", reflect.TypeOf((*MockeventRepository)(nil).BeginTx), ctx, txOptions)
+}
+
+// ConnectDBPool mocks base method.
+func (m *MockeventRepository) ConnectDBPool(ctx context.Context) (*pgxpool.Pool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectDBPool", ctx)
+	ret0, _ := ret[0].(*pgxpool.Pool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConnectDBPool indicates an expected call of ConnectDBPool.
+func (mr *MockeventRepositoryMockRecorder) ConnectDBPool(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectDBPool", reflect.TypeOf((*MockeventRepository)(nil).ConnectDBPool), ctx)
+}
+
+// DebugLogSqlErr mocks base method.
+func (m *MockeventRepository) DebugLogSqlErr(q string, err error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DebugLogSqlErr", q, err)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DebugLogSqlErr indicates an expected call of DebugLogSqlErr.
+func (mr *MockeventRepositoryMockRecorder) DebugLogSqlErr(q, err any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugLogSqlErr", reflect.TypeOf((*MockeventRepository)(nil).DebugLogSqlErr), q, err)
+}
+
+// DeleteRecords mocks base method.
+func (m *MockeventRepository) DeleteRecords(ctx context.Context, tableName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecords", ctx, tableName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecords indicates an expected call of DeleteRecords.
+func (mr *MockeventRepositoryMockRecorder) DeleteRecords(ctx, tableName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecords", reflect.TypeOf((*MockeventRepository)(nil).DeleteRecords), ctx, tableName)
+}
+
+// FighterIdsByURLs mocks base method.
+func (m *MockeventRepository) FighterIdsByURLs(ctx context.Context, tx pgx.Tx, urls []string) (map[string]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FighterIdsByURLs", ctx, tx, urls)
+	ret0, _ := ret[0].(map[string]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FighterIdsByURLs indicates an expected call of FighterIdsByURLs.
+func (mr *MockeventRepositoryMockRecorder) FighterIdsByURLs(ctx, tx, urls any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FighterIdsByURLs", reflect.TypeOf((*MockeventRepository)(nil).FighterIdsByURLs), ctx, tx, urls)
+}
+
+// GetEventId mocks base method.
+func (m *MockeventRepository) GetEventId(ctx context.Context, tx pgx.Tx, fightId int32) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventId", ctx, tx, fightId)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventId indicates an expected call of GetEventId.
+func (mr *MockeventRepositoryMockRecorder) GetEventId(ctx, tx, fightId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventId", reflect.TypeOf((*MockeventRepository)(nil).GetEventId), ctx, tx, fightId)
+}
+
+// GetPool mocks base method.
+func (m *MockeventRepository) GetPool() *pgxpool.Pool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPool")
+	ret0, _ := ret[0].(*pgxpool.Pool)
+	return ret0
+}
+
+// GetPool indicates an expected call of GetPool.
+func (mr *MockeventRepositoryMockRecorder) GetPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockeventRepository)(nil).GetPool))
+}
+
+// GetPoolConfig mocks base method.
+func (m *MockeventRepository) GetPoolConfig() (*pgxpool.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolConfig")
+	ret0, _ := ret[0].(*pgxpool.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolConfig indicates an expected call of GetPoolConfig.
+func (mr *MockeventRepositoryMockRecorder) GetPoolConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolConfig", reflect.TypeOf((*MockeventRepository)(nil).GetPoolConfig))
+}
+
+// GetUndoneFightsCount mocks base method.
+func (m *MockeventRepository) GetUndoneFightsCount(ctx context.Context, tx pgx.Tx, eventId int32) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUndoneFightsCount", ctx, tx, eventId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUndoneFightsCount indicates an expected call of GetUndoneFightsCount.
+func (mr *MockeventRepositoryMockRecorder) GetUndoneFightsCount(ctx, tx, eventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUndoneFightsCount", reflect.TypeOf((*MockeventRepository)(nil).GetUndoneFightsCount), ctx, tx, eventId)
+}
+
+// GracefulShutdown mocks base method.
+func (m *MockeventRepository) GracefulShutdown() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GracefulShutdown")
+}
+
+// GracefulShutdown indicates an expected call of GracefulShutdown.
+func (mr *MockeventRepositoryMockRecorder) GracefulShutdown() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GracefulShutdown", reflect.TypeOf((*MockeventRepository)(nil).GracefulShutdown))
+}
+
+// MergeFighters mocks base method.
+func (m *MockeventRepository) MergeFighters(ctx context.Context, tx pgx.Tx, req *model.MergeFightersRequest) (*model.MergeFightersResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeFighters", ctx, tx, req)
+	ret0, _ := ret[0].(*model.MergeFightersResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeFighters indicates an expected call of MergeFighters.
+func (mr *MockeventRepositoryMockRecorder) MergeFighters(ctx, tx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeFighters", reflect.TypeOf((*MockeventRepository)(nil).MergeFighters), ctx, tx, req)
+}
+
+// SanitizeString mocks base method.
+func (m *MockeventRepository) SanitizeString(s string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SanitizeString", s)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SanitizeString indicates an expected call of SanitizeString.
+func (mr *MockeventRepositoryMockRecorder) SanitizeString(s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SanitizeString", reflect.TypeOf((*MockeventRepository)(nil).SanitizeString), s)
+}
+
+// SearchBets mocks base method.
+func (m *MockeventRepository) SearchBets(ctx context.Context, userId int32) ([]*model.Bet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchBets", ctx, userId)
+	ret0, _ := ret[0].([]*model.Bet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchBets indicates an expected call of SearchBets.
+func (mr *MockeventRepositoryMockRecorder) SearchBets(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBets", reflect.TypeOf((*MockeventRepository)(nil).SearchBets), ctx, userId)
+}
+
+// SearchBetsCount mocks base method.
+func (m *MockeventRepository) SearchBetsCount(ctx context.Context, userId int32) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchBetsCount", ctx, userId)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchBetsCount indicates an expected call of SearchBetsCount.
+func (mr *MockeventRepositoryMockRecorder) SearchBetsCount(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBetsCount", reflect.TypeOf((*MockeventRepository)(nil).SearchBetsCount), ctx, userId)
+}
+
+// SearchEvents mocks base method.
+func (m *MockeventRepository) SearchEvents(ctx context.Context) ([]*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEvents", ctx)
+	ret0, _ := ret[0].([]*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEvents indicates an expected call of SearchEvents.
+func (mr *MockeventRepositoryMockRecorder) SearchEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockeventRepository)(nil).SearchEvents), ctx)
+}
+
+// SearchEventsCount mocks base method.
+func (m *MockeventRepository) SearchEventsCount(ctx context.Context) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEventsCount", ctx)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEventsCount indicates an expected call of SearchEventsCount.
+func (mr *MockeventRepositoryMockRecorder) SearchEventsCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEventsCount", reflect.TypeOf((*MockeventRepository)(nil).SearchEventsCount), ctx)
+}
+
+// SearchFightsByFighters mocks base method.
+func (m *MockeventRepository) SearchFightsByFighters(ctx context.Context, tx pgx.Tx, firstId, secondId int32) ([]*model.FightMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFightsByFighters", ctx, tx, firstId, secondId)
+	ret0, _ := ret[0].([]*model.FightMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFightsByFighters indicates an expected call of SearchFightsByFighters.
+func (mr *MockeventRepositoryMockRecorder) SearchFightsByFighters(ctx, tx, firstId, secondId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFightsByFighters", reflect.TypeOf((*MockeventRepository)(nil).SearchFightsByFighters), ctx, tx, firstId, secondId)
+}
+
+// SearchResultReviews mocks base method.
+func (m *MockeventRepository) SearchResultReviews(ctx context.Context, status string, limit int32) ([]*model.ResultReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchResultReviews", ctx, status, limit)
+	ret0, _ := ret[0].([]*model.ResultReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchResultReviews indicates an expected call of SearchResultReviews.
+func (mr *MockeventRepositoryMockRecorder) SearchResultReviews(ctx, status, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResultReviews", reflect.TypeOf((*MockeventRepository)(nil).SearchResultReviews), ctx, status, limit)
+}
+
+// SetEventDone mocks base method.
+func (m *MockeventRepository) SetEventDone(ctx context.Context, tx pgx.Tx, eventId int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventDone", ctx, tx, eventId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEventDone indicates an expected call of SetEventDone.
+func (mr *MockeventRepositoryMockRecorder) SetEventDone(ctx, tx, eventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventDone", reflect.TypeOf((*MockeventRepository)(nil).SetEventDone), ctx, tx, eventId)
+}
+
+// SetFightResult mocks base method.
+func (m *MockeventRepository) SetFightResult(ctx context.Context, tx pgx.Tx, fr *model.FightResultRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFightResult", ctx, tx, fr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFightResult indicates an expected call of SetFightResult.
+func (mr *MockeventRepositoryMockRecorder) SetFightResult(ctx, tx, fr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFightResult", reflect.TypeOf((*MockeventRepository)(nil).SetFightResult), ctx, tx, fr)
+}
+
+// TxAnonymizeBets mocks base method.
+func (m *MockeventRepository) TxAnonymizeBets(ctx context.Context, tx pgx.Tx, userId, pseudonymId int32) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxAnonymizeBets", ctx, tx, userId, pseudonymId)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxAnonymizeBets indicates an expected call of TxAnonymizeBets.
+func (mr *MockeventRepositoryMockRecorder) TxAnonymizeBets(ctx, tx, userId, pseudonymId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxAnonymizeBets", reflect.TypeOf((*MockeventRepository)(nil).TxAnonymizeBets), ctx, tx, userId, pseudonymId)
+}
+
+// TxCreateBet mocks base method.
+func (m *MockeventRepository) TxCreateBet(ctx context.Context, tx pgx.Tx, req *model.Bet) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxCreateBet", ctx, tx, req)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxCreateBet indicates an expected call of TxCreateBet.
+func (mr *MockeventRepositoryMockRecorder) TxCreateBet(ctx, tx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxCreateBet", reflect.TypeOf((*MockeventRepository)(nil).TxCreateBet), ctx, tx, req)
+}
+
+// TxCreateEvent mocks base method.
+func (m *MockeventRepository) TxCreateEvent(ctx context.Context, tx pgx.Tx, e *model.EventRequest) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxCreateEvent", ctx, tx, e)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxCreateEvent indicates an expected call of TxCreateEvent.
+func (mr *MockeventRepositoryMockRecorder) TxCreateEvent(ctx, tx, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxCreateEvent", reflect.TypeOf((*MockeventRepository)(nil).TxCreateEvent), ctx, tx, e)
+}
+
+// TxCreateEventFight mocks base method.
+func (m *MockeventRepository) TxCreateEventFight(ctx context.Context, tx pgx.Tx, f model.Fight) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxCreateEventFight", ctx, tx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxCreateEventFight indicates an expected call of TxCreateEventFight.
+func (mr *MockeventRepositoryMockRecorder) TxCreateEventFight(ctx, tx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxCreateEventFight", reflect.TypeOf((*MockeventRepository)(nil).TxCreateEventFight), ctx, tx, f)
+}
+
+// TxGetResultReview mocks base method.
+func (m *MockeventRepository) TxGetResultReview(ctx context.Context, tx pgx.Tx, reviewId int32) (*model.ResultReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxGetResultReview", ctx, tx, reviewId)
+	ret0, _ := ret[0].(*model.ResultReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxGetResultReview indicates an expected call of TxGetResultReview.
+func (mr *MockeventRepositoryMockRecorder) TxGetResultReview(ctx, tx, reviewId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxGetResultReview", reflect.TypeOf((*MockeventRepository)(nil).TxGetResultReview), ctx, tx, reviewId)
+}
+
+// TxSetResultReviewStatus mocks base method.
+func (m *MockeventRepository) TxSetResultReviewStatus(ctx context.Context, tx pgx.Tx, reviewId int32, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxSetResultReviewStatus", ctx, tx, reviewId, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxSetResultReviewStatus indicates an expected call of TxSetResultReviewStatus.
+func (mr *MockeventRepositoryMockRecorder) TxSetResultReviewStatus(ctx, tx, reviewId, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxSetResultReviewStatus", reflect.TypeOf((*MockeventRepository)(nil).TxSetResultReviewStatus), ctx, tx, reviewId, status)
+}
+
+// TxUpsertResultReview mocks base method.
+func (m *MockeventRepository) TxUpsertResultReview(ctx context.Context, tx pgx.Tx, fr *model.FightResultRequest, sourceURL string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxUpsertResultReview", ctx, tx, fr, sourceURL)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxUpsertResultReview indicates an expected call of TxUpsertResultReview.
+func (mr *MockeventRepositoryMockRecorder) TxUpsertResultReview(ctx, tx, fr, sourceURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxUpsertResultReview", reflect.TypeOf((*MockeventRepository)(nil).TxUpsertResultReview), ctx, tx, fr, sourceURL)
+}
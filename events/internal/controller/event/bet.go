@@ -2,6 +2,10 @@ package event
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
 
 	internalErr "pickfighter.com/events/pkg/errors"
 	eventmodel "pickfighter.com/events/pkg/model"
@@ -60,3 +64,76 @@ func (c *Controller) GetBets(ctx context.Context, userId int32) (*eventmodel.Bet
 
 	return &eventmodel.BetsResponse{Bets: bets, Count: count}, nil
 }
+
+// maxPseudonymAttempts is the number of random pseudonyms tried for anonymized bets before giving up.
+const maxPseudonymAttempts = 5
+
+// ExportBets returns all bets of the user, no bets are not an error unlike with GetBets.
+func (c *Controller) ExportBets(ctx context.Context, userId int32) ([]*eventmodel.Bet, error) {
+	bets, err := c.repo.SearchBets(ctx, userId)
+	if err != nil {
+		logs.Errorf("Failed to find bets: %s", err)
+		return nil, internalErr.New(internalErr.Bets, err, 1204)
+	}
+
+	return bets, nil
+}
+
+// AnonymizeBets moves all bets of a deleted user to a random negative user id, which no account has.
+// The bets of the user stay together under the pseudonym, so leaderboards and fight statistics are
+// unchanged while nothing links them to the user. It returns the number of anonymized bets.
+func (c *Controller) AnonymizeBets(ctx context.Context, userId int32) (int32, error) {
+	pseudonymId, err := c.newBetsPseudonym(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return 0, internalErr.New(internalErr.Tx, err, 120)
+	}
+
+	bets, err := c.repo.TxAnonymizeBets(ctx, tx, userId, pseudonymId)
+	if err != nil {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+		return 0, internalErr.New(internalErr.BetsAnonymize, err, 1205)
+	}
+
+	if txErr := tx.Commit(ctx); txErr != nil {
+		logs.Errorf("Unable to commit transaction: %s", txErr)
+		return 0, internalErr.New(internalErr.TxCommit, txErr, 121)
+	}
+
+	logs.Infof("Anonymized %d bets of User [%d]", bets, userId)
+
+	return bets, nil
+}
+
+// newBetsPseudonym picks a random negative user id no bets are placed with yet.
+func (c *Controller) newBetsPseudonym(ctx context.Context) (int32, error) {
+	b := make([]byte, 4)
+	for i := 0; i < maxPseudonymAttempts; i++ {
+		if _, err := rand.Read(b); err != nil {
+			return 0, internalErr.New(internalErr.BetsAnonymize, err, 1206)
+		}
+		pseudonymId := -int32(binary.BigEndian.Uint32(b)%math.MaxInt32) - 1
+
+		count, err := c.repo.SearchBetsCount(ctx, pseudonymId)
+		if err != nil {
+			logs.Errorf("Failed to get bets count: %s", err)
+			return 0, internalErr.New(internalErr.BetsCount, err, 1207)
+		}
+
+		if count == 0 {
+			return pseudonymId, nil
+		}
+	}
+
+	err := fmt.Errorf("no free pseudonym after %d attempts", maxPseudonymAttempts)
+	return 0, internalErr.New(internalErr.BetsAnonymize, err, 1208)
+}
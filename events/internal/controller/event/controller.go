@@ -27,10 +27,18 @@ type eventRepository interface {
 	TxCreateBet(ctx context.Context, tx pgx.Tx, req *eventmodel.Bet) (int32, error)
 	SearchBetsCount(ctx context.Context, userId int32) (int32, error)
 	SearchBets(ctx context.Context, userId int32) ([]*eventmodel.Bet, error)
+	TxAnonymizeBets(ctx context.Context, tx pgx.Tx, userId, pseudonymId int32) (int32, error)
 	SetFightResult(ctx context.Context, tx pgx.Tx, fr *eventmodel.FightResultRequest) error
 	GetEventId(ctx context.Context, tx pgx.Tx, fightId int32) (int32, error)
 	GetUndoneFightsCount(ctx context.Context, tx pgx.Tx, eventId int32) (int, error)
 	SetEventDone(ctx context.Context, tx pgx.Tx, eventId int32) error
+	MergeFighters(ctx context.Context, tx pgx.Tx, req *eventmodel.MergeFightersRequest) (*eventmodel.MergeFightersResult, error)
+	FighterIdsByURLs(ctx context.Context, tx pgx.Tx, urls []string) (map[string]int32, error)
+	SearchFightsByFighters(ctx context.Context, tx pgx.Tx, firstId, secondId int32) ([]*eventmodel.FightMatch, error)
+	TxUpsertResultReview(ctx context.Context, tx pgx.Tx, fr *eventmodel.FightResultRequest, sourceURL string) (int32, error)
+	SearchResultReviews(ctx context.Context, status string, limit int32) ([]*eventmodel.ResultReview, error)
+	TxGetResultReview(ctx context.Context, tx pgx.Tx, reviewId int32) (*eventmodel.ResultReview, error)
+	TxSetResultReviewStatus(ctx context.Context, tx pgx.Tx, reviewId int32, status string) error
 }
 
 // Controller defines a metadata service controller.
@@ -0,0 +1,37 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/mock/gomock"
+	"pickfighter.com/events/gen/mocks"
+)
+
+func newTestController(t *testing.T) (*Controller, *mocks.MockeventRepository) {
+	t.Helper()
+
+	mockRepo := mocks.NewMockeventRepository(gomock.NewController(t))
+
+	return New(mockRepo), mockRepo
+}
+
+// fakeTx is a transaction stub recording how the transaction was finished.
+type fakeTx struct {
+	pgx.Tx
+	committed  bool
+	rolledBack bool
+}
+
+func (tx *fakeTx) Commit(ctx context.Context) error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback(ctx context.Context) error {
+	if !tx.committed {
+		tx.rolledBack = true
+	}
+	return nil
+}
@@ -19,29 +19,62 @@ func (c *Controller) SetFightResult(ctx context.Context, req *model.FightResultR
 		return 0, intErr
 	}
 
-	err = c.repo.SetFightResult(ctx, tx, req)
-	if err != nil {
+	if err := c.applyFightResult(ctx, tx, req); err != nil {
 		if txErr := tx.Rollback(ctx); txErr != nil {
 			logs.Errorf("Unable to rollback transaction: %s", txErr)
 		}
-		intErr := internalErr.New(internalErr.EventsFightResult, err, 904)
+		return 0, err
+	}
+
+	if txErr := tx.Commit(ctx); txErr != nil {
+		logs.Errorf("Unable to commit transaction: %s", txErr)
+		intErr := internalErr.New(internalErr.TxCommit, txErr, 119)
 		return 0, intErr
 	}
 
-	err = c.checkEventIsDone(ctx, tx, req.FightId)
+	return req.FightId, nil
+}
+
+// applyFightResult sets the fight result and marks the event as done after its last fight.
+// It is the result path shared by results typed by admins and approved or auto-applied scraped results.
+func (c *Controller) applyFightResult(ctx context.Context, tx pgx.Tx, req *model.FightResultRequest) error {
+	if err := c.repo.SetFightResult(ctx, tx, req); err != nil {
+		return internalErr.New(internalErr.EventsFightResult, err, 904)
+	}
+
+	if err := c.checkEventIsDone(ctx, tx, req.FightId); err != nil {
+		return internalErr.New(internalErr.EventIsDone, err, 905)
+	}
+
+	return nil
+}
+
+// MergeFighters moves fights, results and bets of a duplicate fighter to the surviving one.
+// It is called when duplicated fighters are merged by the fighters service.
+func (c *Controller) MergeFighters(ctx context.Context, req *model.MergeFightersRequest) (*model.MergeFightersResult, error) {
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.ReadCommitted,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		intErr := internalErr.NewDefault(internalErr.Tx, 118)
+		return nil, intErr
+	}
+
+	result, err := c.repo.MergeFighters(ctx, tx, req)
 	if err != nil {
 		if txErr := tx.Rollback(ctx); txErr != nil {
 			logs.Errorf("Unable to rollback transaction: %s", txErr)
 		}
-		intErr := internalErr.New(internalErr.EventIsDone, err, 905)
-		return 0, intErr
+		intErr := internalErr.New(internalErr.EventsMerge, err, 906)
+		return nil, intErr
 	}
 
 	if txErr := tx.Commit(ctx); txErr != nil {
 		logs.Errorf("Unable to commit transaction: %s", txErr)
 		intErr := internalErr.New(internalErr.TxCommit, txErr, 119)
-		return 0, intErr
+		return nil, intErr
 	}
 
-	return req.FightId, nil
+	return result, nil
 }
@@ -0,0 +1,312 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	internalErr "pickfighter.com/events/pkg/errors"
+	"pickfighter.com/events/pkg/model"
+	logs "pickfighter.com/pkg/logger"
+)
+
+// ErrReviewed is returned when a review was already approved or rejected.
+var ErrReviewed = errors.New("result is already reviewed")
+
+// Limits of listed result reviews.
+const (
+	defaultReviewsLimit = 50
+	maxReviewsLimit     = 500
+)
+
+// SubmitScrapedResults matches results scraped from an event page to fights by fighter URLs and the event.
+// With auto apply a result is set with the same path as results typed by admins if the fight has no result
+// or only its method, round or time are missing. Other results, and all results without auto apply, are queued
+// for an admin approval, results equal to the stored ones are left alone. A result matching fights of several
+// events is never applied, it is queued for every one of them.
+func (c *Controller) SubmitScrapedResults(ctx context.Context, req *model.ScrapedResultsRequest) ([]model.ScrapedResultOutcome, error) {
+	urls := make([]string, 0, len(req.Results)*2)
+	for _, r := range req.Results {
+		urls = append(urls, r.RedURL, r.BlueURL)
+	}
+
+	ids, err := c.repo.FighterIdsByURLs(ctx, nil, urls)
+	if err != nil {
+		return nil, internalErr.New(internalErr.EventsScraped, err, 907)
+	}
+
+	outcomes := make([]model.ScrapedResultOutcome, 0, len(req.Results))
+	for _, r := range req.Results {
+		outcome := c.settleScrapedResult(ctx, req, r, ids)
+		if outcome.Status == model.ResultFailed {
+			logs.Errorf("Unable to settle scraped result of %s vs %s: %s", r.RedURL, r.BlueURL, outcome.Message)
+		}
+
+		outcomes = append(outcomes, outcome)
+	}
+
+	return outcomes, nil
+}
+
+// settleScrapedResult applies or queues a single scraped result.
+func (c *Controller) settleScrapedResult(ctx context.Context, req *model.ScrapedResultsRequest, r model.ScrapedResult, ids map[string]int32) model.ScrapedResultOutcome {
+	outcome := model.ScrapedResultOutcome{Result: r, Status: model.ResultUnmatched}
+
+	redId, blueId := ids[r.RedURL], ids[r.BlueURL]
+	if redId == 0 || blueId == 0 {
+		outcome.Message = "fighter not found"
+		return outcome
+	}
+
+	fights, err := c.repo.SearchFightsByFighters(ctx, nil, redId, blueId)
+	if err != nil {
+		outcome.Status = model.ResultFailed
+		outcome.Message = err.Error()
+		return outcome
+	}
+
+	matches := matchFights(fights, req.EventName)
+	switch len(matches) {
+	case 0:
+		outcome.Message = "fight of the event not found"
+		return outcome
+	case 1:
+		return c.settleFight(ctx, req, r, ids, matches[0], req.AutoApply)
+	}
+
+	// the event name doesn't tell the fights apart, admins pick the right one in the review queue
+	outcome.Status = model.ResultUnchanged
+	for _, fight := range matches {
+		o := c.settleFight(ctx, req, r, ids, fight, false)
+		switch o.Status {
+		case model.ResultFailed, model.ResultUnmatched:
+			return o
+		case model.ResultQueued:
+			if outcome.ReviewId == 0 {
+				outcome.ReviewId = o.ReviewId
+			}
+			outcome.Status = model.ResultQueued
+		}
+	}
+	outcome.Message = fmt.Sprintf("event name matches %d fights, queued for review", len(matches))
+
+	return outcome
+}
+
+// settleFight applies the scraped result to the matched fight or queues it for review.
+// It is applied only with autoApply if the fight has no result or only its details are missing.
+func (c *Controller) settleFight(ctx context.Context, req *model.ScrapedResultsRequest, r model.ScrapedResult, ids map[string]int32, fight *model.FightMatch, autoApply bool) model.ScrapedResultOutcome {
+	outcome := model.ScrapedResultOutcome{Result: r, Status: model.ResultUnmatched, FightId: fight.FightId}
+
+	scraped := model.FightResultRequest{
+		FightId:    fight.FightId,
+		NotContest: r.NotContest,
+		Method:     r.Method,
+		Round:      r.Round,
+		Time:       r.Time,
+	}
+
+	if r.WinnerURL != "" {
+		scraped.WinnerId = ids[r.WinnerURL]
+		if scraped.WinnerId != fight.FighterRedId && scraped.WinnerId != fight.FighterBlueId {
+			outcome.Message = "winner is not a fighter of the fight"
+			return outcome
+		}
+	}
+
+	changes := model.ResultChanges(fight.CurrentResult(), fight.IsDone, scraped)
+	if len(changes) == 0 {
+		outcome.Status = model.ResultUnchanged
+		return outcome
+	}
+
+	if autoApply && (!fight.IsDone || fillsDetails(changes)) {
+		if err := c.applyScrapedResult(ctx, &scraped); err != nil {
+			outcome.Status = model.ResultFailed
+			outcome.Message = err.Error()
+			return outcome
+		}
+
+		outcome.Status = model.ResultApplied
+		return outcome
+	}
+
+	reviewId, err := c.repo.TxUpsertResultReview(ctx, nil, &scraped, req.EventURL)
+	if err != nil {
+		outcome.Status = model.ResultFailed
+		outcome.Message = err.Error()
+		return outcome
+	}
+
+	outcome.Status = model.ResultQueued
+	outcome.ReviewId = reviewId
+
+	return outcome
+}
+
+// applyScrapedResult sets the scraped result in its own transaction.
+func (c *Controller) applyScrapedResult(ctx context.Context, req *model.FightResultRequest) error {
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.applyFightResult(ctx, tx, req); err != nil {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// matchFights returns the fights of the scraped event. Fights of events with the same normalized name
+// are matched, if there are none, events whose name starts with the scraped one or the other way around
+// on a word boundary, so "UFC 300: Pereira vs. Hill" matches "UFC 300" but "UFC 30" doesn't. It returns
+// nothing if no event of the fights matches the name, the fighters may have met on an event that is not
+// created yet, so a fight of another event is never matched by the fighters alone.
+func matchFights(fights []*model.FightMatch, eventName string) []*model.FightMatch {
+	name := normalizeEventName(eventName)
+	if name == "" {
+		return nil
+	}
+
+	var equal, prefixed []*model.FightMatch
+	for _, f := range fights {
+		n := normalizeEventName(f.EventName)
+		switch {
+		case n == "":
+		case n == name:
+			equal = append(equal, f)
+		case hasWordPrefix(n, name) || hasWordPrefix(name, n):
+			prefixed = append(prefixed, f)
+		}
+	}
+
+	if len(equal) > 0 {
+		return equal
+	}
+
+	return prefixed
+}
+
+// hasWordPrefix tells whether the normalized name starts with the words of prefix.
+func hasWordPrefix(name, prefix string) bool {
+	return strings.HasPrefix(name, prefix+" ")
+}
+
+// normalizeEventName lowercases the name, replaces punctuation with spaces and collapses them,
+// so "UFC 300: Pereira vs. Hill" starts with the words of "ufc 300" typed by admins.
+func normalizeEventName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == ':' || r == '.' || r == ',' || r == '-' {
+			return ' '
+		}
+		return r
+	}, strings.ToLower(name))
+
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// fillsDetails tells whether the changes only fill the method, round or time missing in a stored result.
+func fillsDetails(changes []model.ResultChange) bool {
+	for _, ch := range changes {
+		switch ch.Field {
+		case "method", "round", "time":
+			if ch.Existing != "" && ch.Existing != "0" {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// ResultReviews returns reviews with the given status and their differences from the stored results,
+// the newest first.
+func (c *Controller) ResultReviews(ctx context.Context, req *model.ResultReviewsRequest) ([]*model.ResultReview, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultReviewsLimit
+	}
+	limit = min(limit, maxReviewsLimit)
+
+	reviews, err := c.repo.SearchResultReviews(ctx, req.Status, limit)
+	if err != nil {
+		return nil, internalErr.New(internalErr.EventsReviews, err, 908)
+	}
+
+	for _, rv := range reviews {
+		rv.Changes = model.ResultChanges(rv.Existing, rv.ExistingDone, rv.Scraped)
+	}
+
+	return reviews, nil
+}
+
+// ReviewResult approves or rejects a pending review. An approved result is set with the same path
+// as results typed by admins in the transaction updating the review.
+// It returns ErrNotFound for an unknown review and ErrReviewed if it is not pending.
+func (c *Controller) ReviewResult(ctx context.Context, req *model.ReviewResultRequest) (*model.ResultReview, error) {
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		intErr := internalErr.NewDefault(internalErr.Tx, 118)
+		return nil, intErr
+	}
+
+	rollback := func() {
+		if txErr := tx.Rollback(ctx); txErr != nil {
+			logs.Errorf("Unable to rollback transaction: %s", txErr)
+		}
+	}
+
+	rv, err := c.repo.TxGetResultReview(ctx, tx, req.ReviewId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		rollback()
+		return nil, fmt.Errorf("review %d: %w", req.ReviewId, ErrNotFound)
+	} else if err != nil {
+		rollback()
+		return nil, internalErr.New(internalErr.EventsReview, err, 909)
+	}
+
+	if rv.Status != model.ReviewPending {
+		rollback()
+		return nil, fmt.Errorf("review %d is %s: %w", req.ReviewId, rv.Status, ErrReviewed)
+	}
+
+	rv.Status = model.ReviewRejected
+	if req.Approve {
+		rv.Status = model.ReviewApproved
+
+		if err := c.applyFightResult(ctx, tx, &rv.Scraped); err != nil {
+			rollback()
+			return nil, err
+		}
+	}
+
+	if err := c.repo.TxSetResultReviewStatus(ctx, tx, rv.ReviewId, rv.Status); err != nil {
+		rollback()
+		return nil, internalErr.New(internalErr.EventsReview, err, 910)
+	}
+
+	if txErr := tx.Commit(ctx); txErr != nil {
+		logs.Errorf("Unable to commit transaction: %s", txErr)
+		intErr := internalErr.New(internalErr.TxCommit, txErr, 119)
+		return nil, intErr
+	}
+
+	rv.ReviewedAt = time.Now().Unix()
+	rv.Changes = model.ResultChanges(rv.Existing, rv.ExistingDone, rv.Scraped)
+
+	return rv, nil
+}
@@ -0,0 +1,323 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"pickfighter.com/events/gen/mocks"
+	"pickfighter.com/events/pkg/model"
+)
+
+const (
+	redURL   = "https://example.com/fighter/red"
+	blueURL  = "https://example.com/fighter/blue"
+	eventURL = "https://example.com/event/ufc-300"
+)
+
+func newFightMatch(fightId int32, eventName string) *model.FightMatch {
+	return &model.FightMatch{
+		Fight:     model.Fight{FightId: fightId, FighterRedId: 1, FighterBlueId: 2},
+		EventName: eventName,
+	}
+}
+
+// expectApplied expects the result to be set with the path of results typed by admins,
+// the event of the fight has undone fights left.
+func expectApplied(mockRepo *mocks.MockeventRepository, tx pgx.Tx, req *model.FightResultRequest) {
+	gomock.InOrder(
+		mockRepo.EXPECT().SetFightResult(gomock.Any(), tx, req).Return(nil),
+		mockRepo.EXPECT().GetEventId(gomock.Any(), tx, req.FightId).Return(int32(3), nil),
+		mockRepo.EXPECT().GetUndoneFightsCount(gomock.Any(), tx, int32(3)).Return(1, nil),
+	)
+}
+
+func TestMatchFights(t *testing.T) {
+	fights := []*model.FightMatch{
+		newFightMatch(1, "UFC 300: Pereira vs. Hill"),
+		newFightMatch(2, "UFC 30"),
+		newFightMatch(3, "UFC Fight Night: Royval vs. Taira"),
+		newFightMatch(4, "UFC Fight Night: Moreno vs. Albazi"),
+	}
+
+	tests := []struct {
+		name      string
+		eventName string
+		fightIds  []int32
+	}{
+		{name: "Equal names", eventName: "ufc 300 - pereira vs hill", fightIds: []int32{1}},
+		{name: "Prefix on a word boundary", eventName: "UFC 300", fightIds: []int32{1}},
+		{name: "Longer scraped name", eventName: "UFC 30: Phoenix Rising", fightIds: []int32{2}},
+		{name: "Equal name wins over a prefix", eventName: "UFC 30", fightIds: []int32{2}},
+		{name: "Prefix within a word", eventName: "UFC 3", fightIds: nil},
+		{name: "Generic name", eventName: "UFC Fight Night", fightIds: []int32{3, 4}},
+		{name: "Other event", eventName: "UFC 301", fightIds: nil},
+		{name: "Empty name", eventName: " - ", fightIds: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fightIds []int32
+			for _, f := range matchFights(fights, tt.eventName) {
+				fightIds = append(fightIds, f.FightId)
+			}
+			assert.Equal(t, tt.fightIds, fightIds)
+		})
+	}
+}
+
+func TestFillsDetails(t *testing.T) {
+	existing := model.FightResultRequest{FightId: 1, WinnerId: 1, Method: "KO/TKO"}
+
+	tests := []struct {
+		name    string
+		scraped model.FightResultRequest
+		fills   bool
+	}{
+		{name: "Missing round and time", scraped: model.FightResultRequest{FightId: 1, WinnerId: 1, Method: "KO/TKO", Round: 2, Time: "1:03"}, fills: true},
+		{name: "Changed method", scraped: model.FightResultRequest{FightId: 1, WinnerId: 1, Method: "Decision", Round: 3, Time: "5:00"}},
+		{name: "Changed winner", scraped: model.FightResultRequest{FightId: 1, WinnerId: 2, Method: "KO/TKO"}},
+		{name: "No contest", scraped: model.FightResultRequest{FightId: 1, NotContest: true, Method: "KO/TKO"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.fills, fillsDetails(model.ResultChanges(existing, true, tt.scraped)))
+		})
+	}
+}
+
+func TestSubmitScrapedResults(t *testing.T) {
+	ctx := context.Background()
+	ids := map[string]int32{redURL: 1, blueURL: 2}
+	result := model.ScrapedResult{RedURL: redURL, BlueURL: blueURL, WinnerURL: redURL, Method: "KO/TKO", Round: 2, Time: "1:03"}
+
+	scraped := func(fightId int32) *model.FightResultRequest {
+		return &model.FightResultRequest{FightId: fightId, WinnerId: 1, Method: "KO/TKO", Round: 2, Time: "1:03"}
+	}
+
+	// done returns a settled fight with the given result of the red fighter
+	done := func(fightId int32, method string, round int32, time string) *model.FightMatch {
+		f := newFightMatch(fightId, "UFC 300: Pereira vs. Hill")
+		f.IsDone, f.Result, f.Method, f.Round, f.Time = true, 1, method, round, time
+		return f
+	}
+
+	submit := func(t *testing.T, c *Controller, eventName string, autoApply bool) model.ScrapedResultOutcome {
+		t.Helper()
+
+		outcomes, err := c.SubmitScrapedResults(ctx, &model.ScrapedResultsRequest{
+			EventName: eventName,
+			EventURL:  eventURL,
+			Results:   []model.ScrapedResult{result},
+			AutoApply: autoApply,
+		})
+		require.NoError(t, err)
+		require.Len(t, outcomes, 1)
+
+		return outcomes[0]
+	}
+
+	t.Run("Auto apply without a result", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+		tx := &fakeTx{}
+
+		mockRepo.EXPECT().FighterIdsByURLs(ctx, nil, []string{redURL, blueURL}).Return(ids, nil)
+		mockRepo.EXPECT().SearchFightsByFighters(ctx, nil, int32(1), int32(2)).
+			Return([]*model.FightMatch{newFightMatch(7, "UFC 300: Pereira vs. Hill")}, nil)
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		expectApplied(mockRepo, tx, scraped(7))
+
+		outcome := submit(t, c, "UFC 300", true)
+		assert.Equal(t, model.ResultApplied, outcome.Status)
+		assert.Equal(t, int32(7), outcome.FightId)
+		assert.True(t, tx.committed)
+	})
+
+	t.Run("Auto apply of missing details", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+		tx := &fakeTx{}
+
+		mockRepo.EXPECT().FighterIdsByURLs(ctx, nil, gomock.Any()).Return(ids, nil)
+		mockRepo.EXPECT().SearchFightsByFighters(ctx, nil, int32(1), int32(2)).
+			Return([]*model.FightMatch{done(7, "KO/TKO", 0, "")}, nil)
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		expectApplied(mockRepo, tx, scraped(7))
+
+		outcome := submit(t, c, "UFC 300", true)
+		assert.Equal(t, model.ResultApplied, outcome.Status)
+		assert.True(t, tx.committed)
+	})
+
+	t.Run("Changed result is queued", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+
+		// an auto applied result never overwrites a stored one
+		mockRepo.EXPECT().FighterIdsByURLs(ctx, nil, gomock.Any()).Return(ids, nil)
+		mockRepo.EXPECT().SearchFightsByFighters(ctx, nil, int32(1), int32(2)).
+			Return([]*model.FightMatch{done(7, "Decision", 3, "5:00")}, nil)
+		mockRepo.EXPECT().TxUpsertResultReview(ctx, nil, scraped(7), eventURL).Return(int32(11), nil)
+
+		outcome := submit(t, c, "UFC 300", true)
+		assert.Equal(t, model.ResultQueued, outcome.Status)
+		assert.Equal(t, int32(11), outcome.ReviewId)
+	})
+
+	t.Run("Queued without auto apply", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+
+		mockRepo.EXPECT().FighterIdsByURLs(ctx, nil, gomock.Any()).Return(ids, nil)
+		mockRepo.EXPECT().SearchFightsByFighters(ctx, nil, int32(1), int32(2)).
+			Return([]*model.FightMatch{newFightMatch(7, "UFC 300: Pereira vs. Hill")}, nil)
+		mockRepo.EXPECT().TxUpsertResultReview(ctx, nil, scraped(7), eventURL).Return(int32(11), nil)
+
+		outcome := submit(t, c, "UFC 300", false)
+		assert.Equal(t, model.ResultQueued, outcome.Status)
+		assert.Equal(t, int32(11), outcome.ReviewId)
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+
+		mockRepo.EXPECT().FighterIdsByURLs(ctx, nil, gomock.Any()).Return(ids, nil)
+		mockRepo.EXPECT().SearchFightsByFighters(ctx, nil, int32(1), int32(2)).
+			Return([]*model.FightMatch{done(7, "KO/TKO", 2, "1:03")}, nil)
+
+		outcome := submit(t, c, "UFC 300", true)
+		assert.Equal(t, model.ResultUnchanged, outcome.Status)
+	})
+
+	t.Run("Fighter not found", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+
+		mockRepo.EXPECT().FighterIdsByURLs(ctx, nil, gomock.Any()).Return(map[string]int32{redURL: 1}, nil)
+
+		outcome := submit(t, c, "UFC 300", true)
+		assert.Equal(t, model.ResultUnmatched, outcome.Status)
+		assert.Equal(t, "fighter not found", outcome.Message)
+	})
+
+	t.Run("Winner is not a fighter of the fight", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+
+		other := result
+		other.WinnerURL = "https://example.com/fighter/other"
+
+		mockRepo.EXPECT().FighterIdsByURLs(ctx, nil, gomock.Any()).Return(map[string]int32{redURL: 1, blueURL: 2, other.WinnerURL: 5}, nil)
+		mockRepo.EXPECT().SearchFightsByFighters(ctx, nil, int32(1), int32(2)).
+			Return([]*model.FightMatch{newFightMatch(7, "UFC 300: Pereira vs. Hill")}, nil)
+
+		outcomes, err := c.SubmitScrapedResults(ctx, &model.ScrapedResultsRequest{EventName: "UFC 300", Results: []model.ScrapedResult{other}, AutoApply: true})
+		require.NoError(t, err)
+		require.Len(t, outcomes, 1)
+		assert.Equal(t, model.ResultUnmatched, outcomes[0].Status)
+		assert.Equal(t, "winner is not a fighter of the fight", outcomes[0].Message)
+	})
+
+	t.Run("Prefix collision", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+
+		// the fighters met on UFC 300, the result of UFC 30 is not theirs
+		mockRepo.EXPECT().FighterIdsByURLs(ctx, nil, gomock.Any()).Return(ids, nil)
+		mockRepo.EXPECT().SearchFightsByFighters(ctx, nil, int32(1), int32(2)).
+			Return([]*model.FightMatch{newFightMatch(7, "UFC 300: Pereira vs. Hill")}, nil)
+
+		outcome := submit(t, c, "UFC 30", true)
+		assert.Equal(t, model.ResultUnmatched, outcome.Status)
+		assert.Equal(t, "fight of the event not found", outcome.Message)
+	})
+
+	t.Run("Several fights are queued", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+
+		// a generic event name matches both fights of the fighters, none of them is auto applied
+		mockRepo.EXPECT().FighterIdsByURLs(ctx, nil, gomock.Any()).Return(ids, nil)
+		mockRepo.EXPECT().SearchFightsByFighters(ctx, nil, int32(1), int32(2)).Return([]*model.FightMatch{
+			newFightMatch(7, "UFC Fight Night: Royval vs. Taira"),
+			newFightMatch(8, "UFC Fight Night: Moreno vs. Albazi"),
+		}, nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().TxUpsertResultReview(ctx, nil, scraped(7), eventURL).Return(int32(11), nil),
+			mockRepo.EXPECT().TxUpsertResultReview(ctx, nil, scraped(8), eventURL).Return(int32(12), nil),
+		)
+
+		outcome := submit(t, c, "UFC Fight Night", true)
+		assert.Equal(t, model.ResultQueued, outcome.Status)
+		assert.Zero(t, outcome.FightId)
+		assert.Equal(t, int32(11), outcome.ReviewId)
+		assert.Contains(t, outcome.Message, "matches 2 fights")
+	})
+}
+
+func TestReviewResult(t *testing.T) {
+	ctx := context.Background()
+	pending := func() *model.ResultReview {
+		return &model.ResultReview{
+			ReviewId: 11,
+			FightId:  7,
+			Status:   model.ReviewPending,
+			Scraped:  model.FightResultRequest{FightId: 7, WinnerId: 1, Method: "KO/TKO", Round: 2, Time: "1:03"},
+		}
+	}
+
+	t.Run("Approve", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+		tx := &fakeTx{}
+		rv := pending()
+
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxGetResultReview(ctx, tx, int32(11)).Return(rv, nil)
+		expectApplied(mockRepo, tx, &rv.Scraped)
+		mockRepo.EXPECT().TxSetResultReviewStatus(ctx, tx, int32(11), model.ReviewApproved).Return(nil)
+
+		reviewed, err := c.ReviewResult(ctx, &model.ReviewResultRequest{ReviewId: 11, Approve: true})
+		require.NoError(t, err)
+		assert.Equal(t, model.ReviewApproved, reviewed.Status)
+		assert.NotZero(t, reviewed.ReviewedAt)
+		assert.True(t, tx.committed)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+		tx := &fakeTx{}
+
+		// the rejected result is not set
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxGetResultReview(ctx, tx, int32(11)).Return(pending(), nil)
+		mockRepo.EXPECT().TxSetResultReviewStatus(ctx, tx, int32(11), model.ReviewRejected).Return(nil)
+
+		reviewed, err := c.ReviewResult(ctx, &model.ReviewResultRequest{ReviewId: 11})
+		require.NoError(t, err)
+		assert.Equal(t, model.ReviewRejected, reviewed.Status)
+		assert.True(t, tx.committed)
+	})
+
+	t.Run("Already reviewed", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+		tx := &fakeTx{}
+		rv := pending()
+		rv.Status = model.ReviewRejected
+
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxGetResultReview(ctx, tx, int32(11)).Return(rv, nil)
+
+		_, err := c.ReviewResult(ctx, &model.ReviewResultRequest{ReviewId: 11, Approve: true})
+		assert.ErrorIs(t, err, ErrReviewed)
+		assert.True(t, tx.rolledBack)
+	})
+
+	t.Run("Unknown review", func(t *testing.T) {
+		c, mockRepo := newTestController(t)
+		tx := &fakeTx{}
+
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().TxGetResultReview(ctx, tx, int32(11)).Return(nil, pgx.ErrNoRows)
+
+		_, err := c.ReviewResult(ctx, &model.ReviewResultRequest{ReviewId: 11, Approve: true})
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.True(t, tx.rolledBack)
+	})
+}
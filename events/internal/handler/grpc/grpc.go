@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"pickfighter.com/events/internal/controller/event"
 	"pickfighter.com/events/pkg/model"
@@ -71,6 +72,34 @@ func (h *Handler) GetBets(ctx context.Context, req *gen.BetsRequest) (*gen.BetsR
 	return &gen.BetsResponse{Bets: bets, Count: resp.Count}, nil
 }
 
+// ExportBets returns all bets of a user for the export of the user's personal data.
+func (h *Handler) ExportBets(ctx context.Context, req *gen.BetsRequest) (*gen.BetsResponse, error) {
+	if req == nil || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user id should be specified")
+	}
+
+	bets, err := h.ctrl.ExportBets(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.BetsResponse{Bets: model.BetsToProto(bets), Count: int32(len(bets))}, nil
+}
+
+// AnonymizeBets moves the bets of a deleted user to a pseudonym.
+func (h *Handler) AnonymizeBets(ctx context.Context, req *gen.BetsRequest) (*gen.AnonymizeBetsResponse, error) {
+	if req == nil || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user id should be specified")
+	}
+
+	bets, err := h.ctrl.AnonymizeBets(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.AnonymizeBetsResponse{Bets: bets}, nil
+}
+
 func (h *Handler) SetResult(ctx context.Context, req *gen.FightResultRequest) (*gen.FightResultResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "nil request")
@@ -83,4 +112,65 @@ func (h *Handler) SetResult(ctx context.Context, req *gen.FightResultRequest) (*
 	}
 
 	return &gen.FightResultResponse{}, nil
-}
\ No newline at end of file
+}
+
+// SubmitScrapedResults matches results scraped from an event page to fights and applies or queues them.
+func (h *Handler) SubmitScrapedResults(ctx context.Context, req *gen.ScrapedResultsRequest) (*gen.ScrapedResultsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	outcomes, err := h.ctrl.SubmitScrapedResults(ctx, model.ScrapedResultsFromProto(req))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.ScrapedResultsResponse{Outcomes: model.ScrapedResultOutcomesToProto(outcomes)}, nil
+}
+
+// ResultReviews returns queued scraped results with their differences from the stored results.
+func (h *Handler) ResultReviews(ctx context.Context, req *gen.ResultReviewsRequest) (*gen.ResultReviewsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	reviews, err := h.ctrl.ResultReviews(ctx, &model.ResultReviewsRequest{Status: req.Status, Limit: req.Limit})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.ResultReviewsResponse{Reviews: model.ResultReviewsToProto(reviews)}, nil
+}
+
+// ReviewResult approves or rejects a queued scraped result.
+func (h *Handler) ReviewResult(ctx context.Context, req *gen.ReviewResultRequest) (*gen.ReviewResultResponse, error) {
+	if req == nil || req.ReviewId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "review id should be specified")
+	}
+
+	rv, err := h.ctrl.ReviewResult(ctx, &model.ReviewResultRequest{ReviewId: req.ReviewId, Approve: req.Approve})
+	switch {
+	case errors.Is(err, event.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	case errors.Is(err, event.ErrReviewed):
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.ReviewResultResponse{Review: model.ResultReviewToProto(rv)}, nil
+}
+
+// MergeFighters repoints fights and bets of a duplicate fighter to the surviving one.
+func (h *Handler) MergeFighters(ctx context.Context, req *gen.MergeFightersRequest) (*gen.MergeFightersResponse, error) {
+	if req == nil || req.SurvivorId == 0 || req.DuplicateId == 0 || req.SurvivorId == req.DuplicateId {
+		return nil, status.Errorf(codes.InvalidArgument, "survivor and duplicate fighters should be different")
+	}
+
+	res, err := h.ctrl.MergeFighters(ctx, model.MergeFightersFromProto(req))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &gen.MergeFightersResponse{Fights: res.Fights, Bets: res.Bets}, nil
+}
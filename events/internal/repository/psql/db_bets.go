@@ -70,3 +70,17 @@ func (r *Repository) TxCreateBet(ctx context.Context, tx pgx.Tx, bet *eventmodel
 
 	return betId, nil
 }
+
+// TxAnonymizeBets replaces the user of all bets of the user with the pseudonym in the 'pf_bets' table.
+// Pseudonyms are negative user ids without an account, so user_id of 'pf_bets' references no user table,
+// see migrations/0002_anonymized_bets.sql. It returns the number of updated bets.
+func (r *Repository) TxAnonymizeBets(ctx context.Context, tx pgx.Tx, userId, pseudonymId int32) (int32, error) {
+	q := `UPDATE public.pf_bets SET user_id = $2 WHERE user_id = $1`
+
+	tag, err := tx.Exec(ctx, q, userId, pseudonymId)
+	if err != nil {
+		return 0, r.DebugLogSqlErr(q, err)
+	}
+
+	return int32(tag.RowsAffected()), nil
+}
@@ -86,6 +86,7 @@ func (r *Repository) SearchEvents(ctx context.Context) ([]*eventmodel.Event, err
 		e.event_id, e.name, e.is_done AS is_event_done, 
 		f.fight_id, f.is_done AS is_fight_done, f.not_contest, 
 		f.created_at, f.fight_date, f.result,
+		COALESCE(f.result_method, ''), COALESCE(f.result_round, 0), COALESCE(f.result_time, ''),
 		f.fighter_red_id, f.fighter_blue_id
 	FROM
 		filtered_events e
@@ -111,6 +112,7 @@ func (r *Repository) SearchEvents(ctx context.Context) ([]*eventmodel.Event, err
 			&event.EventId, &event.Name, &event.IsDone,
 			&fight.FightId, &fight.IsDone, &fight.NotContest,
 			&fight.CreatedAt, &fight.FightDate, &fight.Result,
+			&fight.Method, &fight.Round, &fight.Time,
 			&fight.FighterRedId, &fight.FighterBlueId,
 		); err != nil {
 			return nil, r.DebugLogSqlErr(q, err)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	eventmodel "pickfighter.com/events/pkg/model"
 )
 
@@ -34,14 +35,16 @@ func (r *Repository) TxCreateEventFight(ctx context.Context, tx pgx.Tx, f eventm
 
 // SetFightResult updates the result of a fight in the 'pf_fights' table.
 // It takes a context, a transaction, and a FightResultRequest.
+// The method, round and time of the finish are stored in the result_method, result_round and result_time columns.
 // It returns an error if the update fails.
 func (r *Repository) SetFightResult(ctx context.Context, tx pgx.Tx, req *eventmodel.FightResultRequest) error {
 	q := `UPDATE pf_fights
-	SET result = $1, not_contest = $2, is_done = true
-	WHERE fight_id = $3;`
+	SET result = $1, not_contest = $2, is_done = true,
+		result_method = $3, result_round = $4, result_time = $5
+	WHERE fight_id = $6;`
 
 	args := []any{
-		req.WinnerId, req.NotContest, req.FightId,
+		req.WinnerId, req.NotContest, req.Method, req.Round, req.Time, req.FightId,
 	}
 
 	if tx != nil {
@@ -56,3 +59,46 @@ func (r *Repository) SetFightResult(ctx context.Context, tx pgx.Tx, req *eventmo
 
 	return nil
 }
+
+// MergeFighters replaces the duplicate fighter with the surviving one in the 'pf_fights' and 'pf_bets' tables.
+// Fighter corners, fight results and bets on the duplicate are moved within the transaction.
+// It returns the number of updated fights and bets.
+func (r *Repository) MergeFighters(ctx context.Context, tx pgx.Tx, req *eventmodel.MergeFightersRequest) (*eventmodel.MergeFightersResult, error) {
+	fightsQuery := `UPDATE pf_fights
+	SET fighter_red_id = CASE WHEN fighter_red_id = $2 THEN $1 ELSE fighter_red_id END,
+		fighter_blue_id = CASE WHEN fighter_blue_id = $2 THEN $1 ELSE fighter_blue_id END,
+		result = CASE WHEN result = $2 THEN $1 ELSE result END
+	WHERE fighter_red_id = $2 OR fighter_blue_id = $2 OR result = $2`
+
+	betsQuery := `UPDATE pf_bets SET bet = $1 WHERE bet = $2`
+
+	exec := func(q string) (int32, error) {
+		var (
+			tag pgconn.CommandTag
+			err error
+		)
+
+		if tx != nil {
+			tag, err = tx.Exec(ctx, q, req.SurvivorId, req.DuplicateId)
+		} else {
+			tag, err = r.GetPool().Exec(ctx, q, req.SurvivorId, req.DuplicateId)
+		}
+		if err != nil {
+			return 0, r.DebugLogSqlErr(q, err)
+		}
+
+		return int32(tag.RowsAffected()), nil
+	}
+
+	fights, err := exec(fightsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	bets, err := exec(betsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventmodel.MergeFightersResult{Fights: fights, Bets: bets}, nil
+}
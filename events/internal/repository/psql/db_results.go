@@ -0,0 +1,206 @@
+package psql
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	eventmodel "pickfighter.com/events/pkg/model"
+)
+
+// querier is implemented by both the pool and a transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// conn returns the transaction if it is set and the pool otherwise.
+func (r *Repository) conn(tx pgx.Tx) querier {
+	if tx != nil {
+		return tx
+	}
+
+	return r.GetPool()
+}
+
+// FighterIdsByURLs finds fighters by their profile URLs in the 'pf_fighters' table. URLs of merged
+// duplicates are found in the 'pf_fighter_aliases' table and resolve to the surviving fighter.
+// It returns fighter ids by URL, unknown URLs are missing in the map.
+func (r *Repository) FighterIdsByURLs(ctx context.Context, tx pgx.Tx, urls []string) (map[string]int32, error) {
+	q := `SELECT fighter_url, fighter_id FROM public.pf_fighters WHERE fighter_url = ANY($1)
+	UNION
+	SELECT fighter_url, fighter_id FROM public.pf_fighter_aliases WHERE fighter_url = ANY($1)`
+
+	rows, err := r.conn(tx).Query(ctx, q, urls)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+	defer rows.Close()
+
+	ids := make(map[string]int32, len(urls))
+	for rows.Next() {
+		var (
+			url string
+			id  int32
+		)
+		if err := rows.Scan(&url, &id); err != nil {
+			return nil, r.DebugLogSqlErr(q, err)
+		}
+
+		ids[url] = id
+	}
+
+	return ids, rows.Err()
+}
+
+// SearchFightsByFighters retrieves fights between two fighters in any corners from the 'pf_fights' table
+// with their events and current results, the latest events first.
+func (r *Repository) SearchFightsByFighters(ctx context.Context, tx pgx.Tx, firstId, secondId int32) ([]*eventmodel.FightMatch, error) {
+	q := `SELECT
+		f.fight_id, f.event_id, e.name, e.is_done,
+		f.fighter_red_id, f.fighter_blue_id, f.is_done, f.not_contest,
+		COALESCE(f.result, 0), COALESCE(f.result_method, ''), COALESCE(f.result_round, 0), COALESCE(f.result_time, '')
+	FROM public.pf_fights f
+	JOIN public.pf_events e ON e.event_id = f.event_id
+	WHERE (f.fighter_red_id = $1 AND f.fighter_blue_id = $2) OR (f.fighter_red_id = $2 AND f.fighter_blue_id = $1)
+	ORDER BY f.event_id DESC`
+
+	rows, err := r.conn(tx).Query(ctx, q, firstId, secondId)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+	defer rows.Close()
+
+	var fights []*eventmodel.FightMatch
+	for rows.Next() {
+		var m eventmodel.FightMatch
+		if err := rows.Scan(
+			&m.FightId, &m.EventId, &m.EventName, &m.IsEventDone,
+			&m.FighterRedId, &m.FighterBlueId, &m.IsDone, &m.NotContest,
+			&m.Result, &m.Method, &m.Round, &m.Time,
+		); err != nil {
+			return nil, r.DebugLogSqlErr(q, err)
+		}
+
+		fights = append(fights, &m)
+	}
+
+	return fights, rows.Err()
+}
+
+// TxUpsertResultReview queues a scraped result for an admin approval in the 'pf_result_reviews' table.
+// A fight has at most one pending review, a newer scraped result replaces the pending one.
+// It returns the review ID.
+func (r *Repository) TxUpsertResultReview(ctx context.Context, tx pgx.Tx, fr *eventmodel.FightResultRequest, sourceURL string) (int32, error) {
+	q := `INSERT INTO public.pf_result_reviews
+		(fight_id, winner_id, not_contest, method, round, time, source_url, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	ON CONFLICT (fight_id) WHERE status = 'pending' DO UPDATE SET
+		winner_id = EXCLUDED.winner_id, not_contest = EXCLUDED.not_contest, method = EXCLUDED.method,
+		round = EXCLUDED.round, time = EXCLUDED.time, source_url = EXCLUDED.source_url, created_at = now()
+	RETURNING review_id`
+
+	args := []any{
+		fr.FightId, fr.WinnerId, fr.NotContest, fr.Method, fr.Round, fr.Time, sourceURL, eventmodel.ReviewPending,
+	}
+
+	var reviewId int32
+	if err := r.conn(tx).QueryRow(ctx, q, args...).Scan(&reviewId); err != nil {
+		return 0, r.DebugLogSqlErr(q, err)
+	}
+
+	return reviewId, nil
+}
+
+// reviewColumns are columns of a review joined with its fight and event, scanned by scanResultReview.
+const reviewColumns = `rv.review_id, rv.fight_id, f.event_id, e.name, f.fighter_red_id, f.fighter_blue_id,
+	rv.status, rv.winner_id, rv.not_contest, rv.method, rv.round, rv.time, rv.source_url, rv.created_at, rv.reviewed_at,
+	f.is_done, f.not_contest, COALESCE(f.result, 0), COALESCE(f.result_method, ''), COALESCE(f.result_round, 0), COALESCE(f.result_time, '')`
+
+// reviewTables joins a review with its fight and event.
+const reviewTables = `public.pf_result_reviews rv
+	JOIN public.pf_fights f ON f.fight_id = rv.fight_id
+	JOIN public.pf_events e ON e.event_id = f.event_id`
+
+func scanResultReview(row pgx.Row) (*eventmodel.ResultReview, error) {
+	var (
+		rv         eventmodel.ResultReview
+		createdAt  time.Time
+		reviewedAt *time.Time
+	)
+
+	if err := row.Scan(
+		&rv.ReviewId, &rv.FightId, &rv.EventId, &rv.EventName, &rv.FighterRedId, &rv.FighterBlueId,
+		&rv.Status, &rv.Scraped.WinnerId, &rv.Scraped.NotContest, &rv.Scraped.Method, &rv.Scraped.Round, &rv.Scraped.Time,
+		&rv.SourceURL, &createdAt, &reviewedAt,
+		&rv.ExistingDone, &rv.Existing.NotContest, &rv.Existing.WinnerId, &rv.Existing.Method, &rv.Existing.Round, &rv.Existing.Time,
+	); err != nil {
+		return nil, err
+	}
+
+	rv.Scraped.FightId = rv.FightId
+	rv.Existing.FightId = rv.FightId
+	rv.CreatedAt = createdAt.Unix()
+	if reviewedAt != nil {
+		rv.ReviewedAt = reviewedAt.Unix()
+	}
+
+	return &rv, nil
+}
+
+// SearchResultReviews retrieves reviews with the given status, all reviews if the status is empty,
+// from the 'pf_result_reviews' table with results currently stored in 'pf_fights', the newest first.
+func (r *Repository) SearchResultReviews(ctx context.Context, status string, limit int32) ([]*eventmodel.ResultReview, error) {
+	q := `SELECT ` + reviewColumns + `
+	FROM ` + reviewTables + `
+	WHERE $1 = '' OR rv.status = $1
+	ORDER BY rv.created_at DESC, rv.review_id DESC
+	LIMIT $2`
+
+	rows, err := r.GetPool().Query(ctx, q, status, limit)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+	defer rows.Close()
+
+	var reviews []*eventmodel.ResultReview
+	for rows.Next() {
+		rv, err := scanResultReview(rows)
+		if err != nil {
+			return nil, r.DebugLogSqlErr(q, err)
+		}
+
+		reviews = append(reviews, rv)
+	}
+
+	return reviews, rows.Err()
+}
+
+// TxGetResultReview retrieves a review by ID and locks it until the end of the transaction.
+// It returns pgx.ErrNoRows if the review does not exist.
+func (r *Repository) TxGetResultReview(ctx context.Context, tx pgx.Tx, reviewId int32) (*eventmodel.ResultReview, error) {
+	q := `SELECT ` + reviewColumns + `
+	FROM ` + reviewTables + `
+	WHERE rv.review_id = $1
+	FOR UPDATE OF rv`
+
+	rv, err := scanResultReview(r.conn(tx).QueryRow(ctx, q, reviewId))
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+
+	return rv, nil
+}
+
+// TxSetResultReviewStatus sets the status and the review time of a review in the 'pf_result_reviews' table.
+func (r *Repository) TxSetResultReviewStatus(ctx context.Context, tx pgx.Tx, reviewId int32, status string) error {
+	q := `UPDATE public.pf_result_reviews SET status = $1, reviewed_at = now() WHERE review_id = $2`
+
+	if _, err := r.conn(tx).Exec(ctx, q, status, reviewId); err != nil {
+		return r.DebugLogSqlErr(q, err)
+	}
+
+	return nil
+}
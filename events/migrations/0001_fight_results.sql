@@ -0,0 +1,38 @@
+-- Scraped event results: finish details of fights and the admin review queue.
+-- Migrations are applied to the database of the service in the order of their numbers.
+
+--- pf_fights result columns
+
+ALTER TABLE public.pf_fights ADD COLUMN IF NOT EXISTS result_method character varying(100) DEFAULT ''::character varying NOT NULL;
+ALTER TABLE public.pf_fights ADD COLUMN IF NOT EXISTS result_round integer DEFAULT 0 NOT NULL;
+ALTER TABLE public.pf_fights ADD COLUMN IF NOT EXISTS result_time character varying(10) DEFAULT ''::character varying NOT NULL;
+
+--- pf_result_reviews table
+
+CREATE TABLE IF NOT EXISTS public.pf_result_reviews (
+    review_id serial NOT NULL,
+    fight_id integer NOT NULL,
+    winner_id integer DEFAULT 0 NOT NULL,
+    not_contest boolean DEFAULT false NOT NULL,
+    method character varying(100) DEFAULT ''::character varying NOT NULL,
+    round integer DEFAULT 0 NOT NULL,
+    "time" character varying(10) DEFAULT ''::character varying NOT NULL,
+    source_url text DEFAULT ''::text NOT NULL,
+    status character varying(20) DEFAULT 'pending'::character varying NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    reviewed_at timestamp with time zone
+);
+
+ALTER TABLE ONLY public.pf_result_reviews
+    ADD CONSTRAINT pf_result_reviews_pkey PRIMARY KEY (review_id);
+
+ALTER TABLE ONLY public.pf_result_reviews
+    ADD CONSTRAINT pf_result_reviews_fight_id_fkey FOREIGN KEY (fight_id) REFERENCES public.pf_fights(fight_id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY public.pf_result_reviews
+    ADD CONSTRAINT pf_result_reviews_status_check CHECK (status IN ('pending', 'approved', 'rejected'));
+
+-- a fight has at most one pending review, TxUpsertResultReview replaces it with ON CONFLICT
+CREATE UNIQUE INDEX pf_result_reviews_fight_id_pending_uindex ON public.pf_result_reviews USING btree (fight_id) WHERE status = 'pending';
+
+CREATE INDEX pf_result_reviews_status_created_at_index ON public.pf_result_reviews USING btree (status, created_at DESC);
@@ -0,0 +1,19 @@
+-- Bets of deleted accounts are moved to a random negative user_id, which no account has.
+-- user_id of pf_bets must not reference pf_users, any such foreign key is dropped.
+
+--- pf_bets user_id foreign keys
+
+DO $$
+DECLARE
+    fk record;
+BEGIN
+    FOR fk IN
+        SELECT con.conname
+        FROM pg_constraint con
+        WHERE con.contype = 'f'
+            AND con.conrelid = 'public.pf_bets'::regclass
+            AND con.confrelid = to_regclass('public.pf_users')
+    LOOP
+        EXECUTE format('ALTER TABLE public.pf_bets DROP CONSTRAINT %I', fk.conname);
+    END LOOP;
+END $$;
@@ -53,10 +53,15 @@ const (
 	EventIsDone       = 902
 	EventsCount       = 903
 	EventsNoRows      = 904
-
-	Bets       = 1200
-	BetsCount  = 1201
-	BetsNoRows = 1202
+	EventsMerge       = 905
+	EventsScraped     = 906
+	EventsReviews     = 907
+	EventsReview      = 908
+
+	Bets          = 1200
+	BetsCount     = 1201
+	BetsNoRows    = 1202
+	BetsAnonymize = 1203
 )
 
 var defaultErrors = DefaultMessagesList{
@@ -92,9 +97,14 @@ var defaultErrors = DefaultMessagesList{
 	EventIsDone:                Error{ErrCode: EventIsDone, Message: "[Events]: Failed to set event done"},
 	EventsCount:                Error{ErrCode: EventIsDone, Message: "[Events]: Failed to get events count"},
 	EventsNoRows:               Error{ErrCode: EventIsDone, Message: "[Events]: No Rows"},
+	EventsMerge:                Error{ErrCode: EventsMerge, Message: "[Events]: Failed to merge fighters"},
+	EventsScraped:              Error{ErrCode: EventsScraped, Message: "[Events]: Failed to match scraped results"},
+	EventsReviews:              Error{ErrCode: EventsReviews, Message: "[Events]: Failed to get result reviews"},
+	EventsReview:               Error{ErrCode: EventsReview, Message: "[Events]: Failed to review result"},
 	Bets:                       Error{ErrCode: EventIsDone, Message: "[Bets]: Error"},
 	BetsCount:                  Error{ErrCode: EventIsDone, Message: "[Bets]: Failed to get bets count"},
 	BetsNoRows:                 Error{ErrCode: EventIsDone, Message: "[Bets]: No Rows"},
+	BetsAnonymize:              Error{ErrCode: BetsAnonymize, Message: "[Bets]: Failed to anonymize bets"},
 }
 
 var unknownError = Error{ErrCode: 9999, Message: "Unknown Error"}
@@ -29,8 +29,24 @@ type FullEventResponse struct {
 }
 
 // FightResultRequest represents a request for fight result with fight id, winner id and not contest flag.
+// Method, round and time of the finish are optional, a zero winner without not contest is a draw.
 type FightResultRequest struct {
-	FightId    int32 `json:"fight_id"`
-	WinnerId   int32 `json:"winner_id"`
-	NotContest bool  `json:"not_contest"`
+	FightId    int32  `json:"fight_id"`
+	WinnerId   int32  `json:"winner_id"`
+	NotContest bool   `json:"not_contest"`
+	Method     string `json:"method,omitempty"`
+	Round      int32  `json:"round,omitempty"`
+	Time       string `json:"time,omitempty"`
+}
+
+// MergeFightersRequest represents a request to replace a duplicate fighter with the surviving one.
+type MergeFightersRequest struct {
+	SurvivorId  int32 `json:"survivor_id"`
+	DuplicateId int32 `json:"duplicate_id"`
+}
+
+// MergeFightersResult represents the number of fights and bets moved to the surviving fighter.
+type MergeFightersResult struct {
+	Fights int32 `json:"fights"`
+	Bets   int32 `json:"bets"`
 }
@@ -29,7 +29,11 @@ func FightsFromProto(p []*gen.Fight) []Fight {
 			FighterBlueId: v.FighterBlueId,
 			IsDone:        v.IsDone,
 			IsCanceled:    v.IsCanceled,
+			NotContest:    v.NotContest,
 			Result:        v.Result,
+			Method:        v.Method,
+			Round:         v.Round,
+			Time:          v.Time,
 			CreatedAt:     v.CreatedAt,
 			FightDate:     int(v.FightDate),
 		}
@@ -49,7 +53,11 @@ func FightsToProto(fights []Fight) []*gen.Fight {
 			FighterBlueId: v.FighterBlueId,
 			IsDone:        v.IsDone,
 			IsCanceled:    v.IsCanceled,
+			NotContest:    v.NotContest,
 			Result:        v.Result,
+			Method:        v.Method,
+			Round:         v.Round,
+			Time:          v.Time,
 			CreatedAt:     v.CreatedAt,
 			FightDate:     int64(v.FightDate),
 		}
@@ -141,6 +149,9 @@ func FightResultFromProto(p *gen.FightResultRequest) *FightResultRequest {
 		FightId:    p.FightId,
 		WinnerId:   p.WinnerId,
 		NotContest: p.NotContest,
+		Method:     p.Method,
+		Round:      p.Round,
+		Time:       p.Time,
 	}
 }
 
@@ -149,6 +160,194 @@ func FightResultToProto(req *FightResultRequest) *gen.FightResultRequest {
 		FightId:    req.FightId,
 		WinnerId:   req.WinnerId,
 		NotContest: req.NotContest,
+		Method:     req.Method,
+		Round:      req.Round,
+		Time:       req.Time,
+	}
+}
+
+// MergeFightersFromProto converts gen.MergeFightersRequest to MergeFightersRequest
+func MergeFightersFromProto(p *gen.MergeFightersRequest) *MergeFightersRequest {
+	return &MergeFightersRequest{
+		SurvivorId:  p.SurvivorId,
+		DuplicateId: p.DuplicateId,
+	}
+}
+
+// MergeFightersToProto converts MergeFightersRequest to gen.MergeFightersRequest
+func MergeFightersToProto(req *MergeFightersRequest) *gen.MergeFightersRequest {
+	return &gen.MergeFightersRequest{
+		SurvivorId:  req.SurvivorId,
+		DuplicateId: req.DuplicateId,
+	}
+}
+
+// ScrapedResultsFromProto converts gen.ScrapedResultsRequest to ScrapedResultsRequest
+func ScrapedResultsFromProto(p *gen.ScrapedResultsRequest) *ScrapedResultsRequest {
+	results := make([]ScrapedResult, len(p.Results))
+	for i, v := range p.Results {
+		results[i] = scrapedResultFromProto(v)
+	}
+
+	return &ScrapedResultsRequest{
+		EventName: p.EventName,
+		EventURL:  p.EventUrl,
+		Results:   results,
+		AutoApply: p.AutoApply,
+	}
+}
+
+// ScrapedResultsToProto converts ScrapedResultsRequest to gen.ScrapedResultsRequest
+func ScrapedResultsToProto(req *ScrapedResultsRequest) *gen.ScrapedResultsRequest {
+	results := make([]*gen.ScrapedResult, len(req.Results))
+	for i, v := range req.Results {
+		results[i] = scrapedResultToProto(v)
+	}
+
+	return &gen.ScrapedResultsRequest{
+		EventName: req.EventName,
+		EventUrl:  req.EventURL,
+		Results:   results,
+		AutoApply: req.AutoApply,
+	}
+}
+
+func scrapedResultFromProto(p *gen.ScrapedResult) ScrapedResult {
+	if p == nil {
+		return ScrapedResult{}
+	}
+
+	return ScrapedResult{
+		RedURL:     p.RedUrl,
+		BlueURL:    p.BlueUrl,
+		WinnerURL:  p.WinnerUrl,
+		NotContest: p.NotContest,
+		Method:     p.Method,
+		Round:      p.Round,
+		Time:       p.Time,
+	}
+}
+
+func scrapedResultToProto(r ScrapedResult) *gen.ScrapedResult {
+	return &gen.ScrapedResult{
+		RedUrl:     r.RedURL,
+		BlueUrl:    r.BlueURL,
+		WinnerUrl:  r.WinnerURL,
+		NotContest: r.NotContest,
+		Method:     r.Method,
+		Round:      r.Round,
+		Time:       r.Time,
+	}
+}
+
+// ScrapedResultOutcomesFromProto converts gen.ScrapedResultOutcome slice to ScrapedResultOutcome slice
+func ScrapedResultOutcomesFromProto(p []*gen.ScrapedResultOutcome) []ScrapedResultOutcome {
+	outcomes := make([]ScrapedResultOutcome, len(p))
+
+	for i, v := range p {
+		outcomes[i] = ScrapedResultOutcome{
+			Result:   scrapedResultFromProto(v.Result),
+			FightId:  v.FightId,
+			Status:   v.Status,
+			Message:  v.Message,
+			ReviewId: v.ReviewId,
+		}
+	}
+
+	return outcomes
+}
+
+// ScrapedResultOutcomesToProto converts ScrapedResultOutcome slice to gen.ScrapedResultOutcome slice
+func ScrapedResultOutcomesToProto(outcomes []ScrapedResultOutcome) []*gen.ScrapedResultOutcome {
+	protoOutcomes := make([]*gen.ScrapedResultOutcome, len(outcomes))
+
+	for i, v := range outcomes {
+		protoOutcomes[i] = &gen.ScrapedResultOutcome{
+			Result:   scrapedResultToProto(v.Result),
+			FightId:  v.FightId,
+			Status:   v.Status,
+			Message:  v.Message,
+			ReviewId: v.ReviewId,
+		}
+	}
+
+	return protoOutcomes
+}
+
+// ResultReviewsFromProto converts gen.ResultReview slice to ResultReview slice
+func ResultReviewsFromProto(p []*gen.ResultReview) []*ResultReview {
+	reviews := make([]*ResultReview, len(p))
+	for i, v := range p {
+		reviews[i] = ResultReviewFromProto(v)
+	}
+
+	return reviews
+}
+
+// ResultReviewsToProto converts ResultReview slice to gen.ResultReview slice
+func ResultReviewsToProto(reviews []*ResultReview) []*gen.ResultReview {
+	protoReviews := make([]*gen.ResultReview, len(reviews))
+	for i, v := range reviews {
+		protoReviews[i] = ResultReviewToProto(v)
+	}
+
+	return protoReviews
+}
+
+// ResultReviewFromProto converts gen.ResultReview to ResultReview
+func ResultReviewFromProto(p *gen.ResultReview) *ResultReview {
+	changes := make([]ResultChange, len(p.Changes))
+	for i, v := range p.Changes {
+		changes[i] = ResultChange{Field: v.Field, Existing: v.Existing, Scraped: v.Scraped}
+	}
+
+	review := &ResultReview{
+		ReviewId:      p.ReviewId,
+		FightId:       p.FightId,
+		EventId:       p.EventId,
+		EventName:     p.EventName,
+		FighterRedId:  p.FighterRedId,
+		FighterBlueId: p.FighterBlueId,
+		Status:        p.Status,
+		ExistingDone:  p.ExistingDone,
+		Changes:       changes,
+		SourceURL:     p.SourceUrl,
+		CreatedAt:     p.CreatedAt,
+		ReviewedAt:    p.ReviewedAt,
+	}
+
+	if p.Existing != nil {
+		review.Existing = *FightResultFromProto(p.Existing)
+	}
+	if p.Scraped != nil {
+		review.Scraped = *FightResultFromProto(p.Scraped)
+	}
+
+	return review
+}
+
+// ResultReviewToProto converts ResultReview to gen.ResultReview
+func ResultReviewToProto(r *ResultReview) *gen.ResultReview {
+	changes := make([]*gen.ResultChange, len(r.Changes))
+	for i, v := range r.Changes {
+		changes[i] = &gen.ResultChange{Field: v.Field, Existing: v.Existing, Scraped: v.Scraped}
+	}
+
+	return &gen.ResultReview{
+		ReviewId:      r.ReviewId,
+		FightId:       r.FightId,
+		EventId:       r.EventId,
+		EventName:     r.EventName,
+		FighterRedId:  r.FighterRedId,
+		FighterBlueId: r.FighterBlueId,
+		Status:        r.Status,
+		ExistingDone:  r.ExistingDone,
+		Existing:      FightResultToProto(&r.Existing),
+		Scraped:       FightResultToProto(&r.Scraped),
+		Changes:       changes,
+		SourceUrl:     r.SourceURL,
+		CreatedAt:     r.CreatedAt,
+		ReviewedAt:    r.ReviewedAt,
 	}
 }
 
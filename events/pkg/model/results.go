@@ -0,0 +1,137 @@
+package model
+
+import (
+	"strconv"
+)
+
+// Statuses of a submitted scraped result.
+const (
+	// ResultApplied means the result was set with the same path as the results typed by admins.
+	ResultApplied = "applied"
+	// ResultQueued means the result waits for an admin approval in the review queue.
+	ResultQueued = "queued"
+	// ResultUnchanged means the fight already has the same result.
+	ResultUnchanged = "unchanged"
+	// ResultUnmatched means no fight of the fighters was found on the scraped event or the winner is not one of them.
+	ResultUnmatched = "unmatched"
+	// ResultFailed means the result matched a fight but could not be applied or queued.
+	ResultFailed = "failed"
+)
+
+// Statuses of a result review.
+const (
+	ReviewPending  = "pending"
+	ReviewApproved = "approved"
+	ReviewRejected = "rejected"
+)
+
+// ScrapedResult is a bout result read from an event page, fighters are identified by their profile URLs.
+// The winner URL is empty for a draw or a no contest.
+type ScrapedResult struct {
+	RedURL     string `json:"red_url"`
+	BlueURL    string `json:"blue_url"`
+	WinnerURL  string `json:"winner_url,omitempty"`
+	NotContest bool   `json:"not_contest"`
+	Method     string `json:"method,omitempty"`
+	Round      int32  `json:"round,omitempty"`
+	Time       string `json:"time,omitempty"`
+}
+
+// ScrapedResultsRequest represents results of an event submitted by the scraper. With AutoApply results
+// of unsettled fights are applied at once, otherwise and for fights with a different result they are queued
+// for an admin approval.
+type ScrapedResultsRequest struct {
+	EventName string          `json:"event_name"`
+	EventURL  string          `json:"event_url"`
+	Results   []ScrapedResult `json:"results"`
+	AutoApply bool            `json:"auto_apply"`
+}
+
+// ScrapedResultOutcome tells what happened with a submitted result.
+type ScrapedResultOutcome struct {
+	Result   ScrapedResult `json:"result"`
+	FightId  int32         `json:"fight_id,omitempty"`
+	Status   string        `json:"status"`
+	Message  string        `json:"message,omitempty"`
+	ReviewId int32         `json:"review_id,omitempty"`
+}
+
+// FightMatch is a fight found by its fighters with the event and the current result.
+type FightMatch struct {
+	Fight
+	EventName   string `json:"event_name"`
+	IsEventDone bool   `json:"is_event_done"`
+}
+
+// CurrentResult returns the stored result of the fight.
+func (m *FightMatch) CurrentResult() FightResultRequest {
+	return FightResultRequest{
+		FightId:    m.FightId,
+		WinnerId:   m.Result,
+		NotContest: m.NotContest,
+		Method:     m.Method,
+		Round:      m.Round,
+		Time:       m.Time,
+	}
+}
+
+// ResultChange is a field of a fight result that differs between the stored and the scraped results.
+type ResultChange struct {
+	Field    string `json:"field"`
+	Existing string `json:"existing"`
+	Scraped  string `json:"scraped"`
+}
+
+// ResultReview is a scraped result waiting for an admin approval with the result stored at the moment.
+type ResultReview struct {
+	ReviewId      int32              `json:"review_id"`
+	FightId       int32              `json:"fight_id"`
+	EventId       int32              `json:"event_id"`
+	EventName     string             `json:"event_name"`
+	FighterRedId  int32              `json:"fighter_red_id"`
+	FighterBlueId int32              `json:"fighter_blue_id"`
+	Status        string             `json:"status"`
+	ExistingDone  bool               `json:"existing_done"`
+	Existing      FightResultRequest `json:"existing"`
+	Scraped       FightResultRequest `json:"scraped"`
+	Changes       []ResultChange     `json:"changes"`
+	SourceURL     string             `json:"source_url,omitempty"`
+	CreatedAt     int64              `json:"created_at"`
+	ReviewedAt    int64              `json:"reviewed_at,omitempty"`
+}
+
+// ResultReviewsRequest filters reviews by status, an empty status returns reviews of all statuses.
+type ResultReviewsRequest struct {
+	Status string `json:"status"`
+	Limit  int32  `json:"limit"`
+}
+
+// ReviewResultRequest approves or rejects a pending review.
+type ReviewResultRequest struct {
+	ReviewId int32 `json:"review_id"`
+	Approve  bool  `json:"approve"`
+}
+
+// ResultChanges compares the stored result of a fight with the scraped one. Every field of a fight
+// without a result is a change.
+func ResultChanges(existing FightResultRequest, existingDone bool, scraped FightResultRequest) []ResultChange {
+	changes := make([]ResultChange, 0, 5)
+
+	add := func(field, a, b string) {
+		if !existingDone || a != b {
+			changes = append(changes, ResultChange{Field: field, Existing: a, Scraped: b})
+		}
+	}
+
+	if !existingDone {
+		existing = FightResultRequest{}
+	}
+
+	add("winner_id", strconv.Itoa(int(existing.WinnerId)), strconv.Itoa(int(scraped.WinnerId)))
+	add("not_contest", strconv.FormatBool(existing.NotContest), strconv.FormatBool(scraped.NotContest))
+	add("method", existing.Method, scraped.Method)
+	add("round", strconv.Itoa(int(existing.Round)), strconv.Itoa(int(scraped.Round)))
+	add("time", existing.Time, scraped.Time)
+
+	return changes
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"pickfighter.com/fighters/internal/repository/psql"
 	"pickfighter.com/fighters/pkg/cfg"
+	"pickfighter.com/fighters/pkg/export"
 	fightersmodel "pickfighter.com/fighters/pkg/model"
+	"pickfighter.com/pkg/domain"
 	"pickfighter.com/pkg/model"
 )
 
@@ -149,6 +151,98 @@ func TestWriteFighterData(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestExportRequest(t *testing.T) {
+	lightweight := fightersmodel.Lightweight
+
+	tests := []struct {
+		name     string
+		status   string
+		division int
+		expected *fightersmodel.FightersRequest
+		err      error
+	}{
+		{"AllDivisions", "Active", -1, &fightersmodel.FightersRequest{Status: "Active"}, nil},
+		{"LowercaseStatus", "not fighting", -1, &fightersmodel.FightersRequest{Status: "Not Fighting"}, nil},
+		{"WithDivision", "", 3, &fightersmodel.FightersRequest{Division: &lightweight}, nil},
+		{"UnknownStatus", "inactive", -1, nil, domain.ErrUnknownStatus},
+		{"UnknownDivision", "", 42, nil, domain.ErrUnknownDivision},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := exportRequest(tc.status, tc.division)
+
+			assert.Equal(t, tc.expected, req)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestExportFighterData(t *testing.T) {
+	initTestConfig()
+	defer viper.Reset()
+
+	ctx := context.Background()
+	config := cfg.ViperTestPostgres()
+
+	var buf bytes.Buffer
+	err := ExportFighterData(ctx, config, &fightersmodel.FightersRequest{Status: "Active"}, export.FormatNDJSON, &buf)
+	assert.NoError(t, err)
+	assert.True(t, buf.Len() > 0)
+}
+
+func TestParseMergePairs(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected [][2]int32
+		err      error
+	}{
+		{"Empty", nil, [][2]int32{}, nil},
+		{"Pairs", []string{"20:10", " 7:3 "}, [][2]int32{{20, 10}, {7, 3}}, nil},
+		{"NoSeparator", []string{"20"}, nil, ErrMergePair},
+		{"NotNumber", []string{"20:abc"}, nil, ErrMergePair},
+		{"SameFighter", []string{"20:20"}, nil, ErrMergePair},
+		{"Negative", []string{"-1:20"}, nil, ErrMergePair},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pairs, err := parseMergePairs(tc.values)
+
+			assert.Equal(t, tc.expected, pairs)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestResolveMergePairs(t *testing.T) {
+	tests := []struct {
+		name     string
+		pairs    [][2]int32
+		expected [][2]int32
+		err      error
+	}{
+		{"Independent", [][2]int32{{20, 10}, {7, 3}}, [][2]int32{{20, 10}, {7, 3}}, nil},
+		{"Chain", [][2]int32{{10, 20}, {20, 30}}, [][2]int32{{10, 20}, {10, 30}}, nil},
+		{"ChainReversed", [][2]int32{{20, 30}, {10, 20}}, [][2]int32{{10, 30}, {10, 20}}, nil},
+		{"LongChain", [][2]int32{{1, 2}, {2, 3}, {3, 4}}, [][2]int32{{1, 2}, {1, 3}, {1, 4}}, nil},
+		{"Repeated", [][2]int32{{20, 10}, {20, 10}}, [][2]int32{{20, 10}}, nil},
+		{"TwoSurvivors", [][2]int32{{20, 10}, {30, 10}}, nil, ErrMergeConflict},
+		{"Cycle", [][2]int32{{1, 2}, {2, 1}}, nil, ErrMergeConflict},
+		{"LongCycle", [][2]int32{{1, 2}, {2, 3}, {3, 1}}, nil, ErrMergeConflict},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pairs, err := resolveMergePairs(tc.pairs)
+
+			assert.Equal(t, tc.expected, pairs)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
 func TestDeleteFighterData(t *testing.T) {
 	// initTestConfig()
 	// defer viper.Reset()
@@ -11,6 +11,7 @@ import (
 	grpchandler "pickfighter.com/fighters/internal/handler/grpc"
 	"pickfighter.com/fighters/internal/repository/psql"
 	service "pickfighter.com/fighters/internal/service/fighters"
+	"pickfighter.com/fighters/pkg/blob"
 	"pickfighter.com/fighters/pkg/cfg"
 	"pickfighter.com/pkg/discovery"
 	"pickfighter.com/pkg/discovery/consul"
@@ -104,7 +105,13 @@ func runServe(cmd *cobra.Command, args []string) {
 	}
 	defer repo.GracefulShutdown()
 
-	ctl := fighters.New(repo)
+	store, err := blob.NewLocalStore(viper.GetString("images.store.path"))
+	if err != nil {
+		logs.Errorf("Unable to open images store: %s", err)
+		return
+	}
+
+	ctl := fighters.New(repo, store)
 	h := grpchandler.New(ctl)
 
 	app.Init(h)
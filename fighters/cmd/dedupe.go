@@ -0,0 +1,293 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/cobra"
+	"pickfighter.com/fighters/internal/repository/psql"
+	"pickfighter.com/fighters/pkg/cfg"
+	"pickfighter.com/fighters/pkg/dedupe"
+	"pickfighter.com/fighters/pkg/model"
+	"pickfighter.com/gen"
+	"pickfighter.com/internal/grpcutil"
+	"pickfighter.com/pkg/discovery/consul"
+	logs "pickfighter.com/pkg/logger"
+)
+
+var ErrMergePair = errors.New("merge pair must be in the survivor:duplicate form")
+var ErrMergeConflict = errors.New("conflicting merge pairs")
+var ErrMergeFighter = errors.New("merged fighter not found")
+
+func init() {
+	repoCmd.AddCommand(dedupeCmd)
+
+	dedupeCmd.Flags().Int("min-score", dedupe.DefaultMinScore, "Minimal score of a reported duplicate")
+	dedupeCmd.Flags().String("format", string(dedupe.FormatText), "Report format: text or json")
+	dedupeCmd.Flags().StringP("output", "o", "", "Report file path (default is stdout)")
+	dedupeCmd.Flags().Bool("apply", false, "Merge every reported duplicate into its survivor")
+	dedupeCmd.Flags().StringSlice("merge", nil, "Merge the given survivor:duplicate pairs, e.g. --merge 20:10")
+	dedupeCmd.Flags().Bool("skip-events", false, "Do not repoint event fights and bets to the survivor")
+}
+
+// dedupeCmd represents the dedupe command. It is used to find fighters stored twice,
+// e.g. after the promotion changed a profile URL, and to merge them after a review.
+var dedupeCmd = &cobra.Command{
+	Use:   "dedupe",
+	Short: "Finds and merges duplicated fighters",
+	Long: `Finds fighters with the same normalized name, debut and physical stats and prints a review report.
+Reported pairs are merged with --apply, selected pairs with --merge survivor:duplicate.`,
+	RunE: runDedupe,
+}
+
+// mergeEventsFunc repoints event fights and bets of the duplicate fighter to the survivor.
+type mergeEventsFunc func(ctx context.Context, survivorId, duplicateId int32) error
+
+// runDedupe is the function executed when the dedupe command is run.
+// It writes the report of duplicates and merges the pairs requested by flags.
+func runDedupe(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	minScore, _ := cmd.Flags().GetInt("min-score")
+	format, _ := cmd.Flags().GetString("format")
+	output, _ := cmd.Flags().GetString("output")
+	apply, _ := cmd.Flags().GetBool("apply")
+	merge, _ := cmd.Flags().GetStringSlice("merge")
+	skipEvents, _ := cmd.Flags().GetBool("skip-events")
+
+	f, err := dedupe.ParseFormat(format)
+	if err != nil {
+		return err
+	}
+
+	pairs, err := parseMergePairs(merge)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		out = file
+	}
+
+	rep, err := psql.New(ctx, cfg.ViperPostgres())
+	if err != nil {
+		return fmt.Errorf("unable to start postgresql connection: %w", err)
+	}
+	defer rep.PoolClose()
+
+	fighters, err := rep.SearchFighters(ctx, &model.FightersRequest{})
+	if err != nil {
+		return err
+	}
+
+	candidates := dedupe.Find(fighters, minScore)
+	if err := dedupe.WriteReport(out, candidates, f); err != nil {
+		return err
+	}
+
+	if apply {
+		for _, c := range candidates {
+			pairs = append(pairs, [2]int32{c.Survivor.FighterId, c.Duplicate.FighterId})
+		}
+	}
+
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	var mergeEvents mergeEventsFunc
+	if !skipEvents {
+		mergeEvents = mergeEventFighters
+	}
+
+	return MergeDuplicates(ctx, rep, pairs, mergeEvents)
+}
+
+// parseMergePairs converts "survivor:duplicate" strings into pairs of fighter ids.
+func parseMergePairs(values []string) ([][2]int32, error) {
+	pairs := make([][2]int32, 0, len(values))
+
+	for _, v := range values {
+		s, d, ok := strings.Cut(strings.TrimSpace(v), ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrMergePair, v)
+		}
+
+		survivor, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrMergePair, v)
+		}
+
+		duplicate, err := strconv.ParseInt(d, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrMergePair, v)
+		}
+
+		if survivor <= 0 || duplicate <= 0 || survivor == duplicate {
+			return nil, fmt.Errorf("%w: %q", ErrMergePair, v)
+		}
+
+		pairs = append(pairs, [2]int32{int32(survivor), int32(duplicate)})
+	}
+
+	return pairs, nil
+}
+
+// resolveMergePairs merges chained pairs, so every duplicate is merged straight into the fighter that survives
+// all pairs, e.g. 10:20 and 20:30 are resolved into 10:20 and 10:30. Repeated pairs are merged once.
+// It returns ErrMergeConflict if a duplicate is merged into two fighters or pairs form a cycle.
+func resolveMergePairs(pairs [][2]int32) ([][2]int32, error) {
+	survivors := make(map[int32]int32, len(pairs))
+	order := make([]int32, 0, len(pairs))
+
+	for _, p := range pairs {
+		survivorId, duplicateId := p[0], p[1]
+
+		if prev, ok := survivors[duplicateId]; ok {
+			if prev != survivorId {
+				return nil, fmt.Errorf("%w: fighter %d is merged into %d and %d", ErrMergeConflict, duplicateId, prev, survivorId)
+			}
+			continue
+		}
+
+		survivors[duplicateId] = survivorId
+		order = append(order, duplicateId)
+	}
+
+	resolved := make([][2]int32, 0, len(order))
+	for _, duplicateId := range order {
+		survivorId := survivors[duplicateId]
+
+		for steps := 0; ; steps++ {
+			next, ok := survivors[survivorId]
+			if !ok {
+				break
+			}
+			if next == duplicateId || steps > len(survivors) {
+				return nil, fmt.Errorf("%w: fighter %d is merged into itself", ErrMergeConflict, duplicateId)
+			}
+			survivorId = next
+		}
+
+		resolved = append(resolved, [2]int32{survivorId, duplicateId})
+	}
+
+	return resolved, nil
+}
+
+// checkMergeFighters makes sure that every fighter of the pairs exists, so a merge is not started with a
+// fighter that was removed or merged by an earlier run. It returns an error wrapping ErrMergeFighter.
+func checkMergeFighters(ctx context.Context, rep *psql.Repository, pairs [][2]int32) error {
+	ids := make([]int32, 0, len(pairs)*2)
+	for _, p := range pairs {
+		ids = append(ids, p[0], p[1])
+	}
+
+	found, err := rep.SearchFighters(ctx, &model.FightersRequest{FightersIds: ids})
+	if err != nil {
+		return err
+	}
+
+	exists := make(map[int32]bool, len(found))
+	for _, f := range found {
+		exists[f.FighterId] = true
+	}
+
+	for _, id := range ids {
+		if !exists[id] {
+			return fmt.Errorf("%w: %d", ErrMergeFighter, id)
+		}
+	}
+
+	return nil
+}
+
+// MergeDuplicates merges every duplicate into its survivor, each pair in its own transaction.
+// Chained pairs are resolved and all fighters are checked before anything is changed, so a run does not
+// stop halfway because a survivor was merged by an earlier pair. Events are updated first: repointing
+// fights is idempotent, so a pair failed in the fighters database can be merged again.
+// If mergeEvents is nil, only the fighters database is updated.
+func MergeDuplicates(ctx context.Context, rep *psql.Repository, pairs [][2]int32, mergeEvents mergeEventsFunc) error {
+	pairs, err := resolveMergePairs(pairs)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMergeFighters(ctx, rep, pairs); err != nil {
+		return err
+	}
+
+	for _, p := range pairs {
+		survivorId, duplicateId := p[0], p[1]
+
+		if mergeEvents != nil {
+			if err := mergeEvents(ctx, survivorId, duplicateId); err != nil {
+				return fmt.Errorf("failed to merge events of fighter %d into %d: %w", duplicateId, survivorId, err)
+			}
+		}
+
+		if err := mergeFighters(ctx, rep, survivorId, duplicateId); err != nil {
+			return fmt.Errorf("failed to merge fighter %d into %d: %w", duplicateId, survivorId, err)
+		}
+
+		logs.Infof("Fighter %d merged into %d", duplicateId, survivorId)
+	}
+
+	return nil
+}
+
+func mergeFighters(ctx context.Context, rep *psql.Repository, survivorId, duplicateId int32) error {
+	tx, err := rep.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.ReadCommitted,
+	})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := rep.MergeFighters(ctx, tx, survivorId, duplicateId); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// mergeEventFighters asks the event service to repoint fights and bets to the survivor.
+func mergeEventFighters(ctx context.Context, survivorId, duplicateId int32) error {
+	registry, err := consul.NewRegistry("localhost:8500")
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpcutil.ServiceConnection(ctx, "event-service", registry)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := gen.NewEventServiceClient(conn).MergeFighters(ctx, &gen.MergeFightersRequest{
+		SurvivorId:  survivorId,
+		DuplicateId: duplicateId,
+	})
+	if err != nil {
+		return err
+	}
+
+	logs.Infof("Fighter %d: %d fight(s) and %d bet(s) repointed to %d", duplicateId, resp.Fights, resp.Bets, survivorId)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"pickfighter.com/fighters/internal/repository/psql"
+	"pickfighter.com/fighters/pkg/cfg"
+	"pickfighter.com/fighters/pkg/export"
+	"pickfighter.com/fighters/pkg/model"
+	"pickfighter.com/pkg/domain"
+	"pickfighter.com/pkg/pgxs"
+)
+
+func init() {
+	repoCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().String("format", string(export.FormatJSON), "Output format: json, ndjson or csv")
+	exportCmd.Flags().String("status", "", "Export only fighters with the given status: active, not fighting or retired")
+	exportCmd.Flags().Int("division", -1, "Export only fighters of the given division id")
+	exportCmd.Flags().StringP("output", "o", "", "Output file path (default is stdout)")
+}
+
+// exportCmd represents the export command. It is used to dump the fighters table
+// in one of the supported formats with the same filters as the fighters search.
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Exports fighters with stats as json, ndjson or csv",
+	Long:  ``,
+	RunE:  runExport,
+}
+
+// runExport is the function executed when the export command is run.
+// It reads the filters from flags and writes the matching fighters to the output.
+func runExport(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	format, _ := cmd.Flags().GetString("format")
+	status, _ := cmd.Flags().GetString("status")
+	division, _ := cmd.Flags().GetInt("division")
+	output, _ := cmd.Flags().GetString("output")
+
+	f, err := export.ParseFormat(format)
+	if err != nil {
+		return err
+	}
+
+	req, err := exportRequest(status, division)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		out = file
+	}
+
+	return ExportFighterData(ctx, cfg.ViperPostgres(), req, f, out)
+}
+
+// exportRequest builds a FightersRequest from the export command flags.
+// An empty status or a negative division means that the filter is not applied.
+func exportRequest(status string, division int) (*model.FightersRequest, error) {
+	req := &model.FightersRequest{}
+
+	if status != "" {
+		s, err := domain.ParseStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		req.Status = s
+	}
+
+	if division >= 0 {
+		d, err := domain.DivisionFromId(division)
+		if err != nil {
+			return nil, err
+		}
+		req.Division = &d
+	}
+
+	return req, nil
+}
+
+// ExportFighterData reads fighters matching the request from the database
+// and writes them to out in the given format.
+func ExportFighterData(ctx context.Context, cfg *pgxs.Config, req *model.FightersRequest, format export.Format, out io.Writer) error {
+	rep, err := psql.New(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("unable to start postgresql connection: %w", err)
+	}
+	defer rep.PoolClose()
+
+	fighters, err := rep.SearchFighters(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	w, err := export.NewWriter(out, format)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fighters {
+		if err := w.Write(f); err != nil {
+			return err
+		}
+	}
+
+	return w.Close()
+}
@@ -106,6 +106,13 @@ func setConfigDefaults() {
 	viper.SetDefault("postgres.main.port", "5432")
 	viper.SetDefault("postgres.main.name", "postgres")
 	viper.SetDefault("postgres.main.user", "postgres")
+
+	// fighter images
+	viper.SetDefault("images.store.path", "./data/images")
+	viper.SetDefault("images.download_timeout", 30*time.Second)
+
+	// fighter analytics
+	viper.SetDefault("analytics.cache_ttl", 10*time.Minute)
 }
 
 // bindViperPersistentFlag binds a Viper configuration flag to a persistent Cobra command flag.
@@ -2,14 +2,20 @@ package cmd
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"pickfighter.com/fighters/pkg/blob"
 	"pickfighter.com/fighters/pkg/cfg"
+	"pickfighter.com/fighters/pkg/images"
 	logs "pickfighter.com/pkg/logger"
-	"github.com/spf13/cobra"
 )
 
 func init() {
 	repoCmd.AddCommand(updateRosterCmd)
+
+	updateRosterCmd.Flags().Bool("skip-images", false, "Do not download fighter images and generate thumbnails")
 }
 
 // updateRosterCmd represents the update command. It is used to update the fighters table using a JSON list.
@@ -22,7 +28,8 @@ var updateRosterCmd = &cobra.Command{
 }
 
 // runUpdate is the function executed when the update command is run.
-// The table will be updated from the existing JSON file.
+// The table will be updated from the existing JSON file, after that fighter
+// images are downloaded and thumbnails are stored unless --skip-images is set.
 func runUpdate(cmd *cobra.Command, args []string) {
 	ctx := context.Background()
 	fighters, err := ReadFighterData()
@@ -31,5 +38,22 @@ func runUpdate(cmd *cobra.Command, args []string) {
 	}
 
 	cfg := cfg.ViperPostgres()
-	WriteFighterData(ctx, fighters, cfg)
+	if err := WriteFighterData(ctx, fighters, cfg); err != nil {
+		return
+	}
+
+	if skip, _ := cmd.Flags().GetBool("skip-images"); skip {
+		return
+	}
+
+	store, err := blob.NewLocalStore(viper.GetString("images.store.path"))
+	if err != nil {
+		logs.Errorf("Unable to open images store: %s", err)
+		return
+	}
+
+	client := &http.Client{Timeout: viper.GetDuration("images.download_timeout")}
+	if err := CacheFighterImages(ctx, cfg, images.NewPipeline(store, client)); err != nil {
+		logs.Errorf("Error while caching fighter images: %s", err)
+	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 	"pickfighter.com/fighters/internal/repository/psql"
 	internalErr "pickfighter.com/fighters/pkg/errors"
+	"pickfighter.com/fighters/pkg/images"
 	"pickfighter.com/fighters/pkg/model"
 	"pickfighter.com/pkg/httplib"
 	logs "pickfighter.com/pkg/logger"
@@ -109,6 +110,37 @@ func DeleteFighterData(ctx context.Context, cfg *pgxs.Config) error {
 	return nil
 }
 
+// CacheFighterImages runs the image pipeline for every fighter stored in the database.
+// A failed download is logged and skipped, so one broken image does not stop the update.
+func CacheFighterImages(ctx context.Context, cfg *pgxs.Config, pipeline *images.Pipeline) error {
+	rep, err := psql.New(ctx, cfg)
+	if err != nil {
+		logs.Errorf("Unable to start postgresql connection: %s", err)
+		return err
+	}
+	defer rep.PoolClose()
+
+	fighters, err := rep.SearchFighters(ctx, &model.FightersRequest{})
+	if err != nil {
+		logs.Errorf("Failed to find fighters: %s", err)
+		return err
+	}
+
+	for i, fighter := range fighters {
+		processed, err := pipeline.Process(ctx, fighter.FighterId, fighter.ImageUrl)
+		if err != nil {
+			logs.Errorf("Failed to cache image of %s: %s", fighter.Name, err)
+			continue
+		}
+
+		if processed {
+			fmt.Printf("[Image №%d] Cached: %s\n", i+1, fighter.Name)
+		}
+	}
+
+	return nil
+}
+
 // createNewFighterTx performs a transaction to create a new fighter in the database.
 // It takes a context, a fighter repository, and a model.Fighter as parameters.
 // If the transaction fails, it logs the error and returns an appropriate ApiError.
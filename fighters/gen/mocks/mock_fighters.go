@@ -214,6 +214,21 @@ func (mr *MockFightersRepositoryMockRecorder) SearchFighters(ctx, req any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFighters", reflect.TypeOf((*MockFightersRepository)(nil).SearchFighters), ctx, req)
 }
 
+// SearchFightersByText mocks base method.
+func (m *MockFightersRepository) SearchFightersByText(ctx context.Context, req *model.FightersTextSearchRequest) ([]*model.Fighter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFightersByText", ctx, req)
+	ret0, _ := ret[0].([]*model.Fighter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFightersByText indicates an expected call of SearchFightersByText.
+func (mr *MockFightersRepositoryMockRecorder) SearchFightersByText(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFightersByText", reflect.TypeOf((*MockFightersRepository)(nil).SearchFightersByText), ctx, req)
+}
+
 // SearchFightersCount mocks base method.
 func (m *MockFightersRepository) SearchFightersCount(ctx context.Context, req *model.FightersRequest) (int32, error) {
 	m.ctrl.T.Helper()
@@ -257,3 +272,41 @@ func (mr *MockFightersRepositoryMockRecorder) UpdateFighterStats(ctx, tx, stats
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFighterStats", reflect.TypeOf((*MockFightersRepository)(nil).UpdateFighterStats), ctx, tx, stats)
 }
+
+// MockImageStore is a mock of ImageStore interface.
+type MockImageStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageStoreMockRecorder
+}
+
+// MockImageStoreMockRecorder is the mock recorder for MockImageStore.
+type MockImageStoreMockRecorder struct {
+	mock *MockImageStore
+}
+
+// NewMockImageStore creates a new mock instance.
+func NewMockImageStore(ctrl *gomock.Controller) *MockImageStore {
+	mock := &MockImageStore{ctrl: ctrl}
+	mock.recorder = &MockImageStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageStore) EXPECT() *MockImageStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockImageStore) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockImageStoreMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockImageStore)(nil).Get), ctx, key)
+}
@@ -40,6 +40,21 @@ func (m *MockFightersController) EXPECT() *MockFightersControllerMockRecorder {
 	return m.recorder
 }
 
+// FighterImage mocks base method.
+func (m *MockFightersController) FighterImage(ctx context.Context, req *model.FighterImageRequest) (*model.FighterImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FighterImage", ctx, req)
+	ret0, _ := ret[0].(*model.FighterImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FighterImage indicates an expected call of FighterImage.
+func (mr *MockFightersControllerMockRecorder) FighterImage(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FighterImage", reflect.TypeOf((*MockFightersController)(nil).FighterImage), ctx, req)
+}
+
 // HealthCheck mocks base method.
 func (m *MockFightersController) HealthCheck() *model.HealthStatus {
 	m.ctrl.T.Helper()
@@ -69,6 +84,21 @@ func (mr *MockFightersControllerMockRecorder) SearchFighters(ctx, req any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFighters", reflect.TypeOf((*MockFightersController)(nil).SearchFighters), ctx, req)
 }
 
+// SearchFightersByText mocks base method.
+func (m *MockFightersController) SearchFightersByText(ctx context.Context, req *model.FightersTextSearchRequest) ([]*model.Fighter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFightersByText", ctx, req)
+	ret0, _ := ret[0].([]*model.Fighter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFightersByText indicates an expected call of SearchFightersByText.
+func (mr *MockFightersControllerMockRecorder) SearchFightersByText(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFightersByText", reflect.TypeOf((*MockFightersController)(nil).SearchFightersByText), ctx, req)
+}
+
 // SearchFightersCount mocks base method.
 func (m *MockFightersController) SearchFightersCount(ctx context.Context, req *model.FightersRequest) (int32, error) {
 	m.ctrl.T.Helper()
@@ -83,3 +113,18 @@ func (mr *MockFightersControllerMockRecorder) SearchFightersCount(ctx, req any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFightersCount", reflect.TypeOf((*MockFightersController)(nil).SearchFightersCount), ctx, req)
 }
+
+// UpsertFighter mocks base method.
+func (m *MockFightersController) UpsertFighter(ctx context.Context, fighter *model.Fighter) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFighter", ctx, fighter)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertFighter indicates an expected call of UpsertFighter.
+func (mr *MockFightersControllerMockRecorder) UpsertFighter(ctx, fighter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFighter", reflect.TypeOf((*MockFightersController)(nil).UpsertFighter), ctx, fighter)
+}
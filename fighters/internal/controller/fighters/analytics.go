@@ -0,0 +1,81 @@
+package fighters
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"pickfighter.com/fighters/pkg/analytics"
+	"pickfighter.com/fighters/pkg/model"
+	logs "pickfighter.com/pkg/logger"
+)
+
+// defaultAnalyticsTTL is used when analytics.cache_ttl is not configured.
+const defaultAnalyticsTTL = 10 * time.Minute
+
+// analyticsCache keeps analytics of the whole roster. Percentile ranks depend on every
+// fighter of a division, so analytics are always recomputed for all fighters at once.
+type analyticsCache struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	byId     map[int32]*model.FighterAnalytics
+	loadedAt time.Time
+}
+
+func newAnalyticsCache(ttl time.Duration) *analyticsCache {
+	if ttl <= 0 {
+		ttl = defaultAnalyticsTTL
+	}
+
+	return &analyticsCache{ttl: ttl}
+}
+
+// get returns cached analytics, reloading them with load when the cache is expired.
+// Concurrent callers wait for a single reload.
+func (c *analyticsCache) get(ctx context.Context, load func(ctx context.Context) ([]*model.Fighter, error)) (map[int32]*model.FighterAnalytics, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.byId != nil && time.Since(c.loadedAt) < c.ttl {
+		return c.byId, nil
+	}
+
+	fighters, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.byId = analytics.Compute(fighters)
+	c.loadedAt = time.Now()
+
+	return c.byId, nil
+}
+
+// invalidate drops cached analytics, so they are reloaded by the next get.
+func (c *analyticsCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.byId = nil
+}
+
+// attachAnalytics sets cached analytics to the fighters. Analytics are an addition to the
+// fighter data, so a failure to compute them is logged and fighters are returned without them.
+func (c *Controller) attachAnalytics(ctx context.Context, fighters []*model.Fighter) {
+	if c.analytics == nil || len(fighters) == 0 {
+		return
+	}
+
+	byId, err := c.analytics.get(ctx, func(ctx context.Context) ([]*model.Fighter, error) {
+		return c.repo.SearchFighters(ctx, &model.FightersRequest{})
+	})
+	if err != nil {
+		logs.Errorf("Failed to compute fighters analytics: %s", err)
+		return
+	}
+
+	for _, f := range fighters {
+		f.Analytics = byId[f.FighterId]
+	}
+}
@@ -2,12 +2,16 @@ package fighters
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/spf13/viper"
+	"pickfighter.com/fighters/pkg/blob"
+	"pickfighter.com/fighters/pkg/images"
 	"pickfighter.com/fighters/pkg/model"
 	"pickfighter.com/fighters/pkg/version"
 	logs "pickfighter.com/pkg/logger"
@@ -17,10 +21,14 @@ import (
 // ErrNotFound is returned when a requested record is not found.
 var ErrNotFound = errors.New("not found")
 
+// ErrInvalidFighter is returned when a fighter to store has no name or profile URL.
+var ErrInvalidFighter = errors.New("fighter name and url are required")
+
 type FightersRepository interface {
 	pgxs.PickfighterRepo
 	SearchFightersCount(ctx context.Context, req *model.FightersRequest) (int32, error)
 	SearchFighters(ctx context.Context, req *model.FightersRequest) ([]*model.Fighter, error)
+	SearchFightersByText(ctx context.Context, req *model.FightersTextSearchRequest) ([]*model.Fighter, error)
 	FindFighter(ctx context.Context, req model.Fighter) (int32, error)
 	CreateNewFighter(ctx context.Context, tx pgx.Tx, fighter model.Fighter) (int32, error)
 	CreateNewFighterStats(ctx context.Context, tx pgx.Tx, stats model.FighterStats) error
@@ -28,15 +36,26 @@ type FightersRepository interface {
 	UpdateFighterStats(ctx context.Context, tx pgx.Tx, stats model.FighterStats) error
 }
 
+// ImageStore provides read access to stored fighter thumbnails.
+type ImageStore interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
 // Controller defines a metadata service controller.
 type Controller struct {
-	repo FightersRepository
+	repo      FightersRepository
+	images    ImageStore
+	analytics *analyticsCache
 }
 
 // New creates a Fighters service controller.
-func New(repo FightersRepository) *Controller {
+// The image store is optional, without it every fighter image request is reported as not found.
+// Fighter analytics are cached for the analytics.cache_ttl duration.
+func New(repo FightersRepository, images ImageStore) *Controller {
 	return &Controller{
-		repo: repo,
+		repo:      repo,
+		images:    images,
+		analytics: newAnalyticsCache(viper.GetDuration("analytics.cache_ttl")),
 	}
 }
 
@@ -60,7 +79,7 @@ func (c *Controller) SearchFightersCount(ctx context.Context, req *model.Fighter
 // SearchFighters retrieves fighters based on the provided request.
 // It first retrieves the count of fighters to determine if any exist.
 // If no fighters are found, it returns an empty list.
-// Otherwise, it calls the repository's method to fetch the fighters and returns them.
+// Otherwise, it calls the repository's method to fetch the fighters and returns them with analytics.
 // If an error occurs during the process, it logs the error and returns it.
 func (c *Controller) SearchFighters(ctx context.Context, req *model.FightersRequest) ([]*model.Fighter, error) {
 	count, err := c.repo.SearchFightersCount(ctx, req)
@@ -87,9 +106,127 @@ func (c *Controller) SearchFighters(ctx context.Context, req *model.FightersRequ
 		return []*model.Fighter{}, err
 	}
 
+	c.attachAnalytics(ctx, fighters)
+
+	return fighters, nil
+}
+
+// SearchFightersByText searches fighters by name, nickname, hometown or gym, the best matches first.
+// The request is normalized before the search, an invalid query or limit is returned as is,
+// so the caller can tell it from a repository error. Found fighters are returned with analytics.
+func (c *Controller) SearchFightersByText(ctx context.Context, req *model.FightersTextSearchRequest) ([]*model.Fighter, error) {
+	if err := req.Normalize(); err != nil {
+		return nil, err
+	}
+
+	fighters, err := c.repo.SearchFightersByText(ctx, req)
+	if err != nil {
+		logs.Errorf("Failed to search fighters: %s", err)
+		return nil, err
+	}
+
+	if fighters == nil {
+		fighters = []*model.Fighter{}
+	}
+
+	c.attachAnalytics(ctx, fighters)
+
 	return fighters, nil
 }
 
+// UpsertFighter stores a scraped fighter with stats. The fighter is matched with a stored one the same way
+// as by the update command: by name and profile URL or debut, then by aliases of merged fighters.
+// A matched fighter is updated, otherwise a new one is created. It reports whether the fighter was created.
+// Cached analytics are dropped after every stored fighter, so they are recomputed on the next search.
+func (c *Controller) UpsertFighter(ctx context.Context, fighter *model.Fighter) (bool, error) {
+	if fighter.Name == "" || fighter.FighterUrl == "" {
+		return false, ErrInvalidFighter
+	}
+
+	fighterId, err := c.repo.FindFighter(ctx, *fighter)
+	created := errors.Is(err, pgx.ErrNoRows)
+	if err != nil && !created {
+		logs.Errorf("Failed to find fighter: %s", err)
+		return false, err
+	}
+
+	tx, err := c.repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.ReadCommitted,
+	})
+	if err != nil {
+		logs.Errorf("Unable to begin transaction: %s", err)
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	if created {
+		fighterId, err = c.repo.CreateNewFighter(ctx, tx, *fighter)
+		if err == nil {
+			fighter.Stats.FighterId = fighterId
+			err = c.repo.CreateNewFighterStats(ctx, tx, fighter.Stats)
+		}
+	} else {
+		fighter.FighterId = fighterId
+		fighterId, err = c.repo.UpdateFighter(ctx, tx, *fighter)
+		if err == nil {
+			fighter.Stats.FighterId = fighterId
+			err = c.repo.UpdateFighterStats(ctx, tx, fighter.Stats)
+		}
+	}
+	if err != nil {
+		logs.Errorf("Failed to store fighter %s: %s", fighter.Name, err)
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logs.Errorf("Unable to commit transaction: %s", err)
+		return false, err
+	}
+
+	if c.analytics != nil {
+		c.analytics.invalidate()
+	}
+
+	return created, nil
+}
+
+// FighterImage retrieves a stored fighter thumbnail of the requested size and format.
+// It returns ErrNotFound if the thumbnail was not generated yet, so the caller can fall back to a placeholder.
+func (c *Controller) FighterImage(ctx context.Context, req *model.FighterImageRequest) (*model.FighterImage, error) {
+	size, err := images.ParseSize(req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := images.ParseFormat(req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.images == nil {
+		return nil, ErrNotFound
+	}
+
+	data, err := c.images.Get(ctx, images.ThumbnailKey(req.FighterId, size, format))
+	if errors.Is(err, blob.ErrNotFound) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		logs.Errorf("Failed to read fighter image: %s", err)
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return &model.FighterImage{
+		FighterId:   req.FighterId,
+		Size:        string(size),
+		Format:      string(format),
+		ContentType: format.ContentType(),
+		ETag:        hex.EncodeToString(sum[:16]),
+		Data:        data,
+	}, nil
+}
+
 // HealthCheck returns the current health status of the application.
 // It includes information such as the app version, start time, uptime,
 // and a message indicating the application's health.
@@ -4,9 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"pickfighter.com/fighters/gen/mocks"
 	"pickfighter.com/fighters/internal/repository/psql"
+	"pickfighter.com/fighters/pkg/analytics"
+	"pickfighter.com/fighters/pkg/blob"
+	"pickfighter.com/fighters/pkg/images"
 	"pickfighter.com/fighters/pkg/model"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -14,7 +19,7 @@ import (
 
 func TestNew(t *testing.T) {
 	mockRepo := &psql.Repository{}
-	ctrl := New(mockRepo)
+	ctrl := New(mockRepo, nil)
 
 	if ctrl.repo != mockRepo {
 		t.Errorf("expected controller to be %v, got %v", mockRepo, ctrl.repo)
@@ -155,3 +160,295 @@ func TestSearchFighters(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchFightersAnalytics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mocks.NewMockFightersRepository(ctrl)
+
+	controller := &Controller{
+		repo:      mockRepo,
+		analytics: newAnalyticsCache(time.Minute),
+	}
+	ctx := context.Background()
+	req := &model.FightersRequest{Status: "Active"}
+
+	roster := []*model.Fighter{
+		{FighterId: 1, Stats: model.FighterStats{AvgFightTime: "10:00", WinByKO: 1, WinByDec: 1}},
+		{FighterId: 2, Stats: model.FighterStats{AvgFightTime: "05:00", WinByKO: 2}},
+	}
+
+	mockRepo.EXPECT().SearchFightersCount(ctx, req).Return(int32(1), nil).Times(2)
+	mockRepo.EXPECT().SearchFighters(ctx, req).DoAndReturn(func(context.Context, *model.FightersRequest) ([]*model.Fighter, error) {
+		return []*model.Fighter{{FighterId: 1}}, nil
+	}).Times(2)
+	// the roster is loaded once and cached for the following searches
+	mockRepo.EXPECT().SearchFighters(ctx, &model.FightersRequest{}).Return(roster, nil).Times(1)
+
+	for i := 0; i < 2; i++ {
+		fighters, err := controller.SearchFighters(ctx, req)
+		assert.NoError(t, err)
+		assert.Len(t, fighters, 1)
+		assert.NotNil(t, fighters[0].Analytics)
+		assert.Equal(t, int32(600), fighters[0].Analytics.AvgFightTimeSeconds)
+		assert.Equal(t, float32(50), fighters[0].Analytics.FinishRate)
+		assert.Equal(t, float32(25), fighters[0].Analytics.Percentiles[analytics.MetricFinishRate])
+	}
+}
+
+func TestSearchFightersAnalyticsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mocks.NewMockFightersRepository(ctrl)
+
+	controller := &Controller{
+		repo:      mockRepo,
+		analytics: newAnalyticsCache(time.Minute),
+	}
+	ctx := context.Background()
+	req := &model.FightersRequest{Status: "Active"}
+
+	mockRepo.EXPECT().SearchFightersCount(ctx, req).Return(int32(1), nil)
+	mockRepo.EXPECT().SearchFighters(ctx, req).Return([]*model.Fighter{{FighterId: 1}}, nil)
+	mockRepo.EXPECT().SearchFighters(ctx, &model.FightersRequest{}).Return(nil, errors.New("database error"))
+
+	// fighters are still returned, only without analytics
+	fighters, err := controller.SearchFighters(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.Fighter{{FighterId: 1}}, fighters)
+}
+
+func TestSearchFightersByText(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mocks.NewMockFightersRepository(ctrl)
+
+	controller := &Controller{repo: mockRepo}
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		req           *model.FightersTextSearchRequest
+		repoReq       *model.FightersTextSearchRequest
+		mockResp      []*model.Fighter
+		mockErr       error
+		expected      []*model.Fighter
+		expectedError error
+	}{
+		{
+			name:     "Success",
+			req:      &model.FightersTextSearchRequest{Query: " khabib  nurmagomedov "},
+			repoReq:  &model.FightersTextSearchRequest{Query: "khabib nurmagomedov", Limit: model.TextSearchDefaultLimit},
+			mockResp: []*model.Fighter{{FighterId: 1}},
+			expected: []*model.Fighter{{FighterId: 1}},
+		},
+		{
+			name:     "Nothing found",
+			req:      &model.FightersTextSearchRequest{Query: "zzz", Limit: 100},
+			repoReq:  &model.FightersTextSearchRequest{Query: "zzz", Limit: model.TextSearchMaxLimit},
+			expected: []*model.Fighter{},
+		},
+		{
+			name:          "Invalid query",
+			req:           &model.FightersTextSearchRequest{Query: "k"},
+			expectedError: model.ErrTextSearchQuery,
+		},
+		{
+			name:          "Repository error",
+			req:           &model.FightersTextSearchRequest{Query: "khabib"},
+			repoReq:       &model.FightersTextSearchRequest{Query: "khabib", Limit: model.TextSearchDefaultLimit},
+			mockErr:       errors.New("database error"),
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.repoReq != nil {
+				mockRepo.EXPECT().SearchFightersByText(ctx, tc.repoReq).Return(tc.mockResp, tc.mockErr)
+			}
+
+			fighters, err := controller.SearchFightersByText(ctx, tc.req)
+			assert.Equal(t, tc.expected, fighters)
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestUpsertFighter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mocks.NewMockFightersRepository(ctrl)
+
+	controller := &Controller{
+		repo:      mockRepo,
+		analytics: newAnalyticsCache(time.Minute),
+	}
+	ctx := context.Background()
+
+	t.Run("Invalid fighter", func(t *testing.T) {
+		created, err := controller.UpsertFighter(ctx, &model.Fighter{Name: "Fabio Agu"})
+		assert.False(t, created)
+		assert.Equal(t, ErrInvalidFighter, err)
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		fighter := &model.Fighter{Name: "Fabio Agu", FighterUrl: "https://www.ufc.com/athlete/fabio-agu"}
+		tx := &fakeTx{}
+
+		mockRepo.EXPECT().FindFighter(ctx, *fighter).Return(int32(0), pgx.ErrNoRows)
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().CreateNewFighter(ctx, tx, *fighter).Return(int32(7), nil)
+		mockRepo.EXPECT().CreateNewFighterStats(ctx, tx, model.FighterStats{FighterId: 7}).Return(nil)
+
+		created, err := controller.UpsertFighter(ctx, fighter)
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.True(t, tx.committed)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		fighter := &model.Fighter{Name: "Fabio Agu", FighterUrl: "https://www.ufc.com/athlete/fabio-agu", Wins: 1}
+		tx := &fakeTx{}
+
+		// cached analytics are dropped after the update
+		controller.analytics.byId = map[int32]*model.FighterAnalytics{}
+
+		mockRepo.EXPECT().FindFighter(ctx, *fighter).Return(int32(7), nil)
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().UpdateFighter(ctx, tx, gomock.Any()).Return(int32(7), nil)
+		mockRepo.EXPECT().UpdateFighterStats(ctx, tx, model.FighterStats{FighterId: 7}).Return(nil)
+
+		created, err := controller.UpsertFighter(ctx, fighter)
+		assert.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, int32(7), fighter.FighterId)
+		assert.True(t, tx.committed)
+		assert.Nil(t, controller.analytics.byId)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		fighter := &model.Fighter{Name: "Fabio Agu", FighterUrl: "https://www.ufc.com/athlete/fabio-agu"}
+		tx := &fakeTx{}
+
+		mockRepo.EXPECT().FindFighter(ctx, *fighter).Return(int32(0), pgx.ErrNoRows)
+		mockRepo.EXPECT().BeginTx(ctx, gomock.Any()).Return(tx, nil)
+		mockRepo.EXPECT().CreateNewFighter(ctx, tx, *fighter).Return(int32(0), errors.New("database error"))
+
+		created, err := controller.UpsertFighter(ctx, fighter)
+		assert.EqualError(t, err, "database error")
+		assert.False(t, created)
+		assert.False(t, tx.committed)
+		assert.True(t, tx.rolledBack)
+	})
+
+	t.Run("Find error", func(t *testing.T) {
+		fighter := &model.Fighter{Name: "Fabio Agu", FighterUrl: "https://www.ufc.com/athlete/fabio-agu"}
+
+		mockRepo.EXPECT().FindFighter(ctx, *fighter).Return(int32(0), errors.New("connection refused"))
+
+		_, err := controller.UpsertFighter(ctx, fighter)
+		assert.EqualError(t, err, "connection refused")
+	})
+}
+
+// fakeTx is a transaction stub recording how the transaction was finished.
+type fakeTx struct {
+	pgx.Tx
+	committed  bool
+	rolledBack bool
+}
+
+func (tx *fakeTx) Commit(ctx context.Context) error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback(ctx context.Context) error {
+	if !tx.committed {
+		tx.rolledBack = true
+	}
+	return nil
+}
+
+func TestFighterImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mocks.NewMockImageStore(ctrl)
+
+	controller := &Controller{
+		images: mockStore,
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		req          *model.FighterImageRequest
+		mockBehavior func()
+		expected     *model.FighterImage
+		expectedErr  error
+	}{
+		{
+			name:         "Unknown size",
+			req:          &model.FighterImageRequest{FighterId: 1, Size: "huge", Format: "jpg"},
+			mockBehavior: func() {},
+			expectedErr:  images.ErrUnknownSize,
+		},
+		{
+			name:         "Unknown format",
+			req:          &model.FighterImageRequest{FighterId: 1, Size: "small", Format: "gif"},
+			mockBehavior: func() {},
+			expectedErr:  images.ErrUnknownFormat,
+		},
+		{
+			name: "Not found",
+			req:  &model.FighterImageRequest{FighterId: 1, Size: "small", Format: "jpg"},
+			mockBehavior: func() {
+				mockStore.EXPECT().Get(ctx, "fighters/1/small.jpg").Return(nil, blob.ErrNotFound)
+			},
+			expectedErr: ErrNotFound,
+		},
+		{
+			name: "Store error",
+			req:  &model.FighterImageRequest{FighterId: 1, Size: "small", Format: "jpg"},
+			mockBehavior: func() {
+				mockStore.EXPECT().Get(ctx, "fighters/1/small.jpg").Return(nil, errors.New("disk error"))
+			},
+			expectedErr: errors.New("disk error"),
+		},
+		{
+			name: "Success",
+			req:  &model.FighterImageRequest{FighterId: 2, Size: "medium", Format: "webp"},
+			mockBehavior: func() {
+				mockStore.EXPECT().Get(ctx, "fighters/2/medium.webp").Return([]byte("image"), nil)
+			},
+			expected: &model.FighterImage{
+				FighterId:   2,
+				Size:        "medium",
+				Format:      "webp",
+				ContentType: "image/webp",
+				ETag:        "6105d6cc76af400325e94d588ce511be",
+				Data:        []byte("image"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			img, err := controller.FighterImage(ctx, tc.req)
+
+			assert.Equal(t, tc.expected, img)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestFighterImageWithoutStore(t *testing.T) {
+	controller := New(nil, nil)
+
+	img, err := controller.FighterImage(context.Background(), &model.FighterImageRequest{FighterId: 1, Size: "small", Format: "jpg"})
+	assert.Nil(t, img)
+	assert.Equal(t, ErrNotFound, err)
+}
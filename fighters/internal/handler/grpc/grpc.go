@@ -3,17 +3,23 @@ package grpc
 import (
 	"context"
 	"errors"
+	"io"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"pickfighter.com/fighters/internal/controller/fighters"
+	"pickfighter.com/fighters/pkg/images"
 	"pickfighter.com/fighters/pkg/model"
 	"pickfighter.com/gen"
+	"pickfighter.com/pkg/domain"
 )
 
 type FightersController interface {
 	SearchFightersCount(ctx context.Context, req *model.FightersRequest) (int32, error)
 	SearchFighters(ctx context.Context, req *model.FightersRequest) ([]*model.Fighter, error)
+	SearchFightersByText(ctx context.Context, req *model.FightersTextSearchRequest) ([]*model.Fighter, error)
+	UpsertFighter(ctx context.Context, fighter *model.Fighter) (bool, error)
+	FighterImage(ctx context.Context, req *model.FighterImageRequest) (*model.FighterImage, error)
 	HealthCheck() *model.HealthStatus
 }
 
@@ -30,13 +36,15 @@ func New(ctrl FightersController) *Handler {
 
 // SearchFightersCount retrieves the count of fighters based on the provided request.
 // It converts the request to the internal model, calls the controller's method, and returns the count.
+// Unknown status or division filters are rejected with InvalidArgument.
 func (h *Handler) SearchFightersCount(ctx context.Context, req *gen.FightersRequest) (*gen.FightersCountResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "nil request")
 	}
 
-	fReq := &model.FightersRequest{
-		Status: req.Status,
+	fReq, err := model.FightersReqFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
 	v, err := h.ctrl.SearchFightersCount(ctx, fReq)
@@ -50,14 +58,15 @@ func (h *Handler) SearchFightersCount(ctx context.Context, req *gen.FightersRequ
 // SearchFighters retrieves fighters based on the provided request.
 // It converts the request to the internal model, calls the controller's method, and returns the response.
 // If no fighters are found, it returns a NotFound error; otherwise, it returns the list of fighters.
+// Unknown status or division filters are rejected with InvalidArgument.
 func (h *Handler) SearchFighters(ctx context.Context, req *gen.FightersRequest) (*gen.FightersResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "nil request")
 	}
 
-	fReq := &model.FightersRequest{
-		Status:      req.Status,
-		FightersIds: req.FightersIds,
+	fReq, err := model.FightersReqFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
 	f, err := h.ctrl.SearchFighters(ctx, fReq)
@@ -71,3 +80,115 @@ func (h *Handler) SearchFighters(ctx context.Context, req *gen.FightersRequest)
 		Fighters: model.FightersToProto(f),
 	}, nil
 }
+
+// SearchFightersByText searches fighters by name, nickname, hometown or gym.
+// An invalid query, limit, status or division is rejected with InvalidArgument, no matches is not an error.
+func (h *Handler) SearchFightersByText(ctx context.Context, req *gen.FightersTextSearchRequest) (*gen.FightersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	sReq, err := model.FightersTextSearchReqFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	f, err := h.ctrl.SearchFightersByText(ctx, sReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrTextSearchQuery), errors.Is(err, model.ErrTextSearchLimit), errors.Is(err, domain.ErrUnknownStatus):
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
+	return &gen.FightersResponse{
+		Fighters: model.FightersToProto(f),
+	}, nil
+}
+
+// ExportFighters streams fighters matching the provided request one by one.
+// It uses the same filters as SearchFighters, so an export always matches the search results.
+func (h *Handler) ExportFighters(req *gen.FightersRequest, stream gen.FightersService_ExportFightersServer) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	fReq, err := model.FightersReqFromProto(req)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	f, err := h.ctrl.SearchFighters(stream.Context(), fReq)
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+
+	for _, fighter := range f {
+		if err := stream.Send(model.FighterToProto(fighter)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpsertFighters receives a stream of scraped fighters and creates or updates them one by one.
+// A fighter that fails to be stored is counted and skipped, so a single broken profile does not
+// stop the whole import. Counts of created, updated and failed fighters are sent when the client closes the stream.
+// A fighter with an unknown division or status stops the stream with InvalidArgument.
+func (h *Handler) UpsertFighters(stream gen.FightersService_UpsertFightersServer) error {
+	resp := &gen.UpsertFightersResponse{}
+
+	for {
+		f, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+
+		fighter, err := model.ParseFighterProto(f)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "fighter %q: %s", f.Name, err)
+		}
+
+		created, err := h.ctrl.UpsertFighter(stream.Context(), fighter)
+		switch {
+		case err != nil:
+			resp.Failed++
+		case created:
+			resp.Created++
+		default:
+			resp.Updated++
+		}
+	}
+}
+
+// FighterImage returns a stored fighter thumbnail.
+// Unknown sizes or formats are rejected with InvalidArgument, missing thumbnails with NotFound.
+func (h *Handler) FighterImage(ctx context.Context, req *gen.FighterImageRequest) (*gen.FighterImageResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "nil request")
+	}
+
+	img, err := h.ctrl.FighterImage(ctx, &model.FighterImageRequest{
+		FighterId: req.FighterId,
+		Size:      req.Size,
+		Format:    req.Format,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, fighters.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		case errors.Is(err, images.ErrUnknownSize), errors.Is(err, images.ErrUnknownFormat):
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+
+	return model.FighterImageToProto(img), nil
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 
 	"pickfighter.com/fighters/gen/mocks"
 	"pickfighter.com/fighters/internal/controller/fighters"
+	"pickfighter.com/fighters/pkg/images"
 	"pickfighter.com/fighters/pkg/model"
 	"pickfighter.com/gen"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gopkg.in/go-playground/assert.v1"
@@ -103,6 +106,7 @@ func TestSearchFighters(t *testing.T) {
 	mockCtrl := mocks.NewMockFightersController(ctrl)
 	handler := &Handler{ctrl: mockCtrl}
 	ctx := context.Background()
+	unknownDivision := int32(99)
 
 	tests := []struct {
 		name          string
@@ -122,7 +126,7 @@ func TestSearchFighters(t *testing.T) {
 		},
 		{
 			name:          "Controller error not found",
-			req:           &gen.FightersRequest{Status: "inactive", FightersIds: []int32{-5}},
+			req:           &gen.FightersRequest{Status: "Not Fighting", FightersIds: []int32{-5}},
 			mockResp:      nil,
 			mockErr:       fighters.ErrNotFound,
 			expectedResp:  nil,
@@ -130,15 +134,27 @@ func TestSearchFighters(t *testing.T) {
 		},
 		{
 			name:          "Controller error",
-			req:           &gen.FightersRequest{Status: "inactive"},
+			req:           &gen.FightersRequest{Status: "Not Fighting"},
 			mockResp:      nil,
 			mockErr:       errors.New("internal error"),
 			expectedResp:  nil,
 			expectedError: status.Errorf(codes.Internal, "internal error"),
 		},
+		{
+			name:          "Unknown status",
+			req:           &gen.FightersRequest{Status: "Active' OR '1'='1"},
+			expectedResp:  nil,
+			expectedError: status.Errorf(codes.InvalidArgument, `unknown fighter status: "Active' OR '1'='1"`),
+		},
+		{
+			name:          "Unknown division",
+			req:           &gen.FightersRequest{Division: &unknownDivision},
+			expectedResp:  nil,
+			expectedError: status.Errorf(codes.InvalidArgument, "unknown division: 99"),
+		},
 		{
 			name:          "Success",
-			req:           &gen.FightersRequest{Status: "active", FightersIds: []int32{1, 2}},
+			req:           &gen.FightersRequest{Status: "Active", FightersIds: []int32{1, 2}},
 			mockResp:      []*model.Fighter{{FighterId: 1}, {FighterId: 2}},
 			mockErr:       nil,
 			expectedResp:  &gen.FightersResponse{Fighters: model.FightersToProto([]*model.Fighter{{FighterId: 1}, {FighterId: 2}})},
@@ -148,8 +164,8 @@ func TestSearchFighters(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			if tc.req != nil {
-				fReq := &model.FightersRequest{Status: tc.req.Status, FightersIds: tc.req.FightersIds}
+			if status.Code(tc.expectedError) != codes.InvalidArgument {
+				fReq := &model.FightersRequest{Status: model.FighterStatus(tc.req.Status), FightersIds: tc.req.FightersIds}
 				mockCtrl.EXPECT().SearchFighters(gomock.Any(), fReq).Return(tc.mockResp, tc.mockErr)
 			}
 
@@ -160,3 +176,286 @@ func TestSearchFighters(t *testing.T) {
 		})
 	}
 }
+
+// exportStream is a server stream stub collecting sent fighters.
+type exportStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    []*gen.Fighter
+	sendErr error
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(f *gen.Fighter) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+
+	s.sent = append(s.sent, f)
+	return nil
+}
+
+func TestExportFighters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCtrl := mocks.NewMockFightersController(ctrl)
+	handler := &Handler{ctrl: mockCtrl}
+	ctx := context.Background()
+	division := int32(3)
+
+	tests := []struct {
+		name          string
+		req           *gen.FightersRequest
+		mockResp      []*model.Fighter
+		mockErr       error
+		sendErr       error
+		expectedSent  []*gen.Fighter
+		expectedError error
+	}{
+		{
+			name:          "Nil request",
+			req:           nil,
+			expectedError: status.Errorf(codes.InvalidArgument, "nil request"),
+		},
+		{
+			name:          "Controller error",
+			req:           &gen.FightersRequest{Status: "Active"},
+			mockErr:       errors.New("internal error"),
+			expectedError: status.Errorf(codes.Internal, "internal error"),
+		},
+		{
+			name:          "Send error",
+			req:           &gen.FightersRequest{Status: "Active"},
+			mockResp:      []*model.Fighter{{FighterId: 1}},
+			sendErr:       errors.New("stream closed"),
+			expectedError: errors.New("stream closed"),
+		},
+		{
+			name:         "Success",
+			req:          &gen.FightersRequest{Status: "Active", Division: &division},
+			mockResp:     []*model.Fighter{{FighterId: 1}, {FighterId: 2}},
+			expectedSent: model.FightersToProto([]*model.Fighter{{FighterId: 1}, {FighterId: 2}}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.req != nil {
+				fReq, err := model.FightersReqFromProto(tc.req)
+				assert.Equal(t, nil, err)
+				mockCtrl.EXPECT().SearchFighters(gomock.Any(), fReq).Return(tc.mockResp, tc.mockErr)
+			}
+
+			stream := &exportStream{ctx: ctx, sendErr: tc.sendErr}
+			err := handler.ExportFighters(tc.req, stream)
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedSent, stream.sent)
+		})
+	}
+}
+
+func TestFighterImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCtrl := mocks.NewMockFightersController(ctrl)
+	handler := &Handler{ctrl: mockCtrl}
+	ctx := context.Background()
+
+	img := &model.FighterImage{FighterId: 1, Size: "small", Format: "jpeg", ContentType: "image/jpeg", ETag: "abc", Data: []byte("image")}
+
+	tests := []struct {
+		name          string
+		req           *gen.FighterImageRequest
+		mockResp      *model.FighterImage
+		mockErr       error
+		expectedResp  *gen.FighterImageResponse
+		expectedError error
+	}{
+		{
+			name:          "Nil request",
+			req:           nil,
+			expectedError: status.Errorf(codes.InvalidArgument, "nil request"),
+		},
+		{
+			name:          "Not found",
+			req:           &gen.FighterImageRequest{FighterId: 1, Size: "small", Format: "jpg"},
+			mockErr:       fighters.ErrNotFound,
+			expectedError: status.Errorf(codes.NotFound, "not found"),
+		},
+		{
+			name:          "Invalid size",
+			req:           &gen.FighterImageRequest{FighterId: 1, Size: "huge", Format: "jpg"},
+			mockErr:       images.ErrUnknownSize,
+			expectedError: status.Errorf(codes.InvalidArgument, images.ErrUnknownSize.Error()),
+		},
+		{
+			name:          "Controller error",
+			req:           &gen.FighterImageRequest{FighterId: 1, Size: "small", Format: "jpg"},
+			mockErr:       errors.New("internal error"),
+			expectedError: status.Errorf(codes.Internal, "internal error"),
+		},
+		{
+			name:         "Success",
+			req:          &gen.FighterImageRequest{FighterId: 1, Size: "small", Format: "jpg"},
+			mockResp:     img,
+			expectedResp: model.FighterImageToProto(img),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.req != nil {
+				fReq := &model.FighterImageRequest{FighterId: tc.req.FighterId, Size: tc.req.Size, Format: tc.req.Format}
+				mockCtrl.EXPECT().FighterImage(gomock.Any(), fReq).Return(tc.mockResp, tc.mockErr)
+			}
+
+			resp, err := handler.FighterImage(ctx, tc.req)
+
+			assert.Equal(t, tc.expectedResp, resp)
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestSearchFightersByText(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCtrl := mocks.NewMockFightersController(ctrl)
+	handler := &Handler{ctrl: mockCtrl}
+	ctx := context.Background()
+
+	found := []*model.Fighter{{FighterId: 1, Name: "Khabib Nurmagomedov"}}
+
+	tests := []struct {
+		name          string
+		req           *gen.FightersTextSearchRequest
+		mockResp      []*model.Fighter
+		mockErr       error
+		expectedResp  *gen.FightersResponse
+		expectedError error
+	}{
+		{
+			name:          "Nil request",
+			req:           nil,
+			expectedError: status.Errorf(codes.InvalidArgument, "nil request"),
+		},
+		{
+			name:          "Short query",
+			req:           &gen.FightersTextSearchRequest{Query: "k"},
+			mockErr:       model.ErrTextSearchQuery,
+			expectedError: status.Errorf(codes.InvalidArgument, model.ErrTextSearchQuery.Error()),
+		},
+		{
+			name:          "Unknown status",
+			req:           &gen.FightersTextSearchRequest{Query: "khabib", Status: "Inactive"},
+			expectedError: status.Errorf(codes.InvalidArgument, `unknown fighter status: "Inactive"`),
+		},
+		{
+			name:          "Controller error",
+			req:           &gen.FightersTextSearchRequest{Query: "khabib"},
+			mockErr:       errors.New("internal error"),
+			expectedError: status.Errorf(codes.Internal, "internal error"),
+		},
+		{
+			name:         "Success",
+			req:          &gen.FightersTextSearchRequest{Query: "khabeeb", Limit: 5},
+			mockResp:     found,
+			expectedResp: &gen.FightersResponse{Fighters: model.FightersToProto(found)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.req != nil && tc.req.Status == "" {
+				sReq, err := model.FightersTextSearchReqFromProto(tc.req)
+				assert.Equal(t, nil, err)
+				mockCtrl.EXPECT().SearchFightersByText(gomock.Any(), sReq).Return(tc.mockResp, tc.mockErr)
+			}
+
+			resp, err := handler.SearchFightersByText(ctx, tc.req)
+
+			assert.Equal(t, tc.expectedResp, resp)
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func TestUpsertFighters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCtrl := mocks.NewMockFightersController(ctrl)
+	handler := &Handler{ctrl: mockCtrl}
+	ctx := context.Background()
+
+	received := []*gen.Fighter{
+		{Name: "Fabio Agu", FighterUrl: "https://www.ufc.com/athlete/fabio-agu"},
+		{Name: "Daichi Abe", FighterUrl: "https://www.ufc.com/athlete/daichi-abe"},
+		{Name: "Papy Abedi", FighterUrl: "https://www.ufc.com/athlete/papy-abedi"},
+	}
+
+	gomock.InOrder(
+		mockCtrl.EXPECT().UpsertFighter(ctx, model.FighterFromProto(received[0])).Return(true, nil),
+		mockCtrl.EXPECT().UpsertFighter(ctx, model.FighterFromProto(received[1])).Return(false, nil),
+		mockCtrl.EXPECT().UpsertFighter(ctx, model.FighterFromProto(received[2])).Return(false, errors.New("database error")),
+	)
+
+	stream := &upsertStream{ctx: ctx, received: received}
+	err := handler.UpsertFighters(stream)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, &gen.UpsertFightersResponse{Created: 1, Updated: 1, Failed: 1}, stream.resp)
+
+	// a broken stream is returned as is
+	stream = &upsertStream{ctx: ctx, recvErr: errors.New("stream closed")}
+	err = handler.UpsertFighters(stream)
+
+	assert.Equal(t, errors.New("stream closed"), err)
+	assert.Equal(t, nil, stream.resp)
+
+	// a fighter with an unknown status is rejected before it is stored
+	stream = &upsertStream{ctx: ctx, received: []*gen.Fighter{{Name: "Fabio Agu", Status: "Inactive"}}}
+	err = handler.UpsertFighters(stream)
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, nil, stream.resp)
+}
+
+// upsertStream is a client stream stub returning the received fighters and then io.EOF.
+type upsertStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received []*gen.Fighter
+	recvErr  error
+	resp     *gen.UpsertFightersResponse
+}
+
+func (s *upsertStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *upsertStream) Recv() (*gen.Fighter, error) {
+	if s.recvErr != nil {
+		return nil, s.recvErr
+	}
+	if len(s.received) == 0 {
+		return nil, io.EOF
+	}
+
+	f := s.received[0]
+	s.received = s.received[1:]
+
+	return f, nil
+}
+
+func (s *upsertStream) SendAndClose(resp *gen.UpsertFightersResponse) error {
+	s.resp = resp
+	return nil
+}
@@ -34,24 +34,30 @@ func TestNew(t *testing.T) {
 }
 
 func TestPerformFightersQuery(t *testing.T) {
+	lightweight := model.Lightweight
+
 	tests := []struct {
-		name     string
-		req      *model.FightersRequest
-		expected []string
+		name         string
+		req          *model.FightersRequest
+		offset       int
+		expected     []string
+		expectedArgs []any
 	}{
 		{
-			name:     "nil request",
-			req:      nil,
-			expected: []string{},
+			name:         "nil request",
+			req:          nil,
+			expected:     []string{},
+			expectedArgs: []any{},
 		},
 		{
 			name: "status only",
 			req: &model.FightersRequest{
-				Status: "active",
+				Status: "Active",
 			},
 			expected: []string{
-				`f.status = 'active'`,
+				`f.status = $1`,
 			},
+			expectedArgs: []any{"Active"},
 		},
 		{
 			name: "fighters IDs only",
@@ -59,19 +65,42 @@ func TestPerformFightersQuery(t *testing.T) {
 				FightersIds: []int32{1, 2, 3},
 			},
 			expected: []string{
-				`f.fighter_id IN (1, 2, 3)`,
+				`f.fighter_id = ANY($1)`,
 			},
+			expectedArgs: []any{[]int32{1, 2, 3}},
 		},
 		{
 			name: "status and fighters IDs",
 			req: &model.FightersRequest{
-				Status:      "inactive",
+				Status:      "Not Fighting",
 				FightersIds: []int32{4, 5},
 			},
 			expected: []string{
-				`f.status = 'inactive'`,
-				`f.fighter_id IN (4, 5)`,
+				`f.status = $1`,
+				`f.fighter_id = ANY($2)`,
+			},
+			expectedArgs: []any{"Not Fighting", []int32{4, 5}},
+		},
+		{
+			name: "status is bound as is",
+			req: &model.FightersRequest{
+				Status: "Active' OR '1'='1",
+			},
+			expected: []string{
+				`f.status = $1`,
+			},
+			expectedArgs: []any{"Active' OR '1'='1"},
+		},
+		{
+			name: "division after other arguments",
+			req: &model.FightersRequest{
+				Division: &lightweight,
+			},
+			offset: 4,
+			expected: []string{
+				`f.division = $5`,
 			},
+			expectedArgs: []any{3},
 		},
 		{
 			name: "empty status and empty fighters IDs",
@@ -79,7 +108,8 @@ func TestPerformFightersQuery(t *testing.T) {
 				Status:      "",
 				FightersIds: nil,
 			},
-			expected: []string{},
+			expected:     []string{},
+			expectedArgs: []any{},
 		},
 	}
 
@@ -87,8 +117,9 @@ func TestPerformFightersQuery(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result := repo.performFightersQuery(tc.req)
-			assert.ElementsMatch(t, tc.expected, result)
+			conds, args := repo.performFightersQuery(tc.req, tc.offset)
+			assert.ElementsMatch(t, tc.expected, conds)
+			assert.ElementsMatch(t, tc.expectedArgs, args)
 		})
 	}
 }
@@ -126,6 +157,95 @@ func TestSearchFighters(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSearchFightersByText(t *testing.T) {
+	initTestConfig()
+	defer viper.Reset()
+
+	ctx := context.Background()
+	config := cfg.ViperTestPostgres()
+
+	repo, err := New(ctx, config)
+	assert.NoError(t, err)
+	defer repo.GracefulShutdown()
+
+	notFighting := model.FighterStatus("Not Fighting")
+	welterweight := model.Welterweight
+
+	tests := []struct {
+		name     string
+		req      model.FightersTextSearchRequest
+		expected []int32
+	}{
+		{
+			name:     "Name prefix",
+			req:      model.FightersTextSearchRequest{Query: "akm", Limit: 10},
+			expected: []int32{57918},
+		},
+		{
+			name:     "Exact name ranked first",
+			req:      model.FightersTextSearchRequest{Query: "Abe", Limit: 2},
+			expected: []int32{57904, 57905},
+		},
+		{
+			name:     "Misspelled name",
+			req:      model.FightersTextSearchRequest{Query: "rostam akmen", Limit: 10},
+			expected: []int32{57918},
+		},
+		{
+			name:     "Nickname",
+			req:      model.FightersTextSearchRequest{Query: "razor", Limit: 10},
+			expected: []int32{57919},
+		},
+		{
+			name:     "Accents",
+			req:      model.FightersTextSearchRequest{Query: "Fábio", Limit: 10},
+			expected: []int32{57913},
+		},
+		{
+			name:     "Status and division",
+			req:      model.FightersTextSearchRequest{Query: "abe", Limit: 10, Status: notFighting, Division: &welterweight},
+			expected: []int32{57904},
+		},
+		{
+			name:     "Not found",
+			req:      model.FightersTextSearchRequest{Query: "zzzzzz", Limit: 10},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fighters, err := repo.SearchFightersByText(ctx, &tc.req)
+			assert.NoError(t, err)
+
+			var ids []int32
+			for _, f := range fighters {
+				ids = append(ids, f.FighterId)
+			}
+			assert.Equal(t, tc.expected, ids)
+		})
+	}
+}
+
+func TestPrefixTsQuery(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Khabib", "khabib:*"},
+		{"  Jon  Jo ", "jon:* & jo:*"},
+		{"O'Malley", "o:* & malley:*"},
+		{"José & Aldo | !", "josé:* & aldo:*"},
+		{"!!", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, prefixTsQuery(tc.input))
+		})
+	}
+}
+
 func TestFindFighter(t *testing.T) {
 	initTestConfig()
 	defer viper.Reset()
@@ -323,6 +443,47 @@ func TestUpdateFighterStats(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMergeFighters(t *testing.T) {
+	initTestConfig()
+	defer viper.Reset()
+
+	ctx := context.Background()
+	config := cfg.ViperTestPostgres()
+
+	repo, err := New(ctx, config)
+	assert.NoError(t, err)
+	defer repo.GracefulShutdown()
+
+	suffix := time.Now().Format(time.RFC3339Nano)
+	survivor := model.Fighter{Name: "Rostam Akman", Status: "Active", DebutTimestamp: 1508025600, FighterUrl: "http://rostam-akman/" + suffix}
+	duplicate := model.Fighter{Name: "Rostem Akman", Status: "Active", DebutTimestamp: 1508025600, FighterUrl: "http://rostem-akman/" + suffix}
+
+	survivorId, err := repo.CreateNewFighter(ctx, nil, survivor)
+	assert.NoError(t, err)
+	duplicateId, err := repo.CreateNewFighter(ctx, nil, duplicate)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.CreateNewFighterStats(ctx, nil, model.FighterStats{FighterId: duplicateId}))
+
+	tx, err := repo.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.ReadCommitted,
+	})
+	assert.NoError(t, err)
+
+	err = repo.MergeFighters(ctx, tx, survivorId, duplicateId)
+	assert.NoError(t, err)
+
+	err = tx.Commit(ctx)
+	assert.NoError(t, err)
+
+	// the next scrape of the old profile maps onto the survivor
+	id, err := repo.FindFighter(ctx, duplicate)
+	assert.NoError(t, err)
+	assert.Equal(t, survivorId, id)
+
+	err = repo.MergeFighters(ctx, nil, survivorId, duplicateId)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
 func initTestConfig() {
 	if os.Getenv("APP_ENV") != "ci" {
 		err := godotenv.Load("../../../../.env")
@@ -6,12 +6,15 @@ import (
 	"pickfighter.com/fighters/pkg/model"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 // FindFighter searches for a fighter in the database based on the provided model.Fighter struct.
 // It takes a context, a model.Fighter struct containing the search criteria (name and debut timestamp).
 // The method constructs and executes a SQL query to select the fighter_id from the 'pf_fighters' table
 // where the name and debut_timestamp match the provided criteria. The result is scanned into the fighterId variable.
+// If no fighter matches, aliases of merged fighters are checked, so a fighter whose profile URL or name
+// was changed by the promotion is mapped onto the surviving record.
 // If a matching fighter is found, the method returns the fighter_id; otherwise, it returns an error indicating
 // that no matching fighter was found.
 func (r *Repository) FindFighter(ctx context.Context, req model.Fighter) (int32, error) {
@@ -19,6 +22,26 @@ func (r *Repository) FindFighter(ctx context.Context, req model.Fighter) (int32,
 	var fighterId int32
 
 	err := r.GetPool().QueryRow(ctx, q, req.Name, req.FighterUrl, req.DebutTimestamp).Scan(&fighterId)
+	if err == pgx.ErrNoRows {
+		return r.FindFighterAlias(ctx, req)
+	} else if err != nil {
+		return fighterId, err
+	}
+
+	return fighterId, nil
+}
+
+// FindFighterAlias searches the 'pf_fighter_aliases' table for a fighter merged into another one.
+// An alias matches by the profile URL or by the name and debut timestamp of the merged fighter.
+// It returns the id of the surviving fighter or pgx.ErrNoRows if there is no such alias.
+func (r *Repository) FindFighterAlias(ctx context.Context, req model.Fighter) (int32, error) {
+	q := `SELECT fighter_id FROM pf_fighter_aliases
+		WHERE fighter_url = $1 OR (name = $2 AND debut_timestamp = $3)
+		ORDER BY fighter_url = $1 DESC, created_at DESC
+		LIMIT 1`
+	var fighterId int32
+
+	err := r.GetPool().QueryRow(ctx, q, req.FighterUrl, req.Name, req.DebutTimestamp).Scan(&fighterId)
 	if err != nil {
 		return fighterId, err
 	}
@@ -178,3 +201,43 @@ func (r *Repository) UpdateFighterStats(ctx context.Context, tx pgx.Tx, stats mo
 
 	return nil
 }
+
+// MergeFighters merges the duplicate fighter into the surviving one within the transaction.
+// The name, debut timestamp and URL of the duplicate are recorded in 'pf_fighter_aliases', so the next
+// update maps the scraped profile onto the survivor. Stats of the duplicate are moved to the survivor
+// unless it has its own, then the duplicate is deleted. It returns pgx.ErrNoRows if the duplicate does not exist.
+func (r *Repository) MergeFighters(ctx context.Context, tx pgx.Tx, survivorId, duplicateId int32) error {
+	queries := []string{
+		`INSERT INTO public.pf_fighter_aliases (fighter_id, merged_id, name, debut_timestamp, fighter_url)
+		SELECT $1, fighter_id, name, debut_timestamp, fighter_url FROM public.pf_fighters WHERE fighter_id = $2
+		ON CONFLICT (fighter_url) DO UPDATE SET fighter_id = EXCLUDED.fighter_id`,
+		`UPDATE public.pf_fighter_aliases SET fighter_id = $1 WHERE fighter_id = $2`,
+		`UPDATE public.pf_fighter_stats SET fighter_id = $1
+		WHERE fighter_id = $2 AND NOT EXISTS (SELECT 1 FROM public.pf_fighter_stats WHERE fighter_id = $1)`,
+		`DELETE FROM public.pf_fighter_stats WHERE fighter_id = $2`,
+		`DELETE FROM public.pf_fighters WHERE fighter_id = $2`,
+	}
+
+	for i, q := range queries {
+		var (
+			tag pgconn.CommandTag
+			err error
+		)
+
+		if tx != nil {
+			tag, err = tx.Exec(ctx, q, survivorId, duplicateId)
+		} else {
+			tag, err = r.GetPool().Exec(ctx, q, survivorId, duplicateId)
+		}
+		if err != nil {
+			return r.DebugLogSqlErr(q, err)
+		}
+
+		// the alias is created from the duplicate row, nothing is inserted if it is missing
+		if i == 0 && tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+	}
+
+	return nil
+}
@@ -4,10 +4,26 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"unicode"
 
+	"github.com/jackc/pgx/v5"
 	"pickfighter.com/fighters/pkg/model"
 )
 
+// fighterColumns are the columns scanned by scanFighters.
+const fighterColumns = `f.fighter_id, f.name, f.nickname, f.division, f.status,
+		f.hometown, f.trains_at, f.fighting_style, f.age, f.height,
+		f.weight, f.octagon_debut, f.debut_timestamp, f.reach, f.leg_reach,
+		f.fighter_url, f.image_url, f.wins, f.loses, f.draw,
+		fs.total_sig_str_landed, fs.total_sig_str_attempted, fs.str_accuracy, fs.total_tkd_landed, fs.total_tkd_attempted,
+		fs.tkd_accuracy, fs.sig_str_landed, fs.sig_str_absorbed, fs.sig_str_defense, fs.takedown_defense,
+		fs.takedown_avg, fs.submission_avg, fs.knockdown_avg, fs.avg_fight_time, fs.win_by_ko,
+		fs.win_by_sub, fs.win_by_dec`
+
+// textSearchSimilarity is the minimal trigram word similarity of a misspelled query and a fighter name,
+// e.g. "khabeeb" is similar enough to "khabib nurmagomedov".
+const textSearchSimilarity = 0.4
+
 // SearchFightersCount retrieves the count of fighters based on the provided FightersRequest.
 // It constructs a SQL query to count the number of records in thepf_fighters table, applying
 // optional conditions specified in the FightersRequest for filtering. If the request is successful,
@@ -15,14 +31,14 @@ import (
 func (r *Repository) SearchFightersCount(ctx context.Context, req *model.FightersRequest) (int32, error) {
 	q := `SELECT count(*) FROM public.pf_fighters AS f`
 
-	args := r.performFightersQuery(req)
-	if len(args) > 0 {
+	conds, args := r.performFightersQuery(req, 0)
+	if len(conds) > 0 {
 		q += ` WHERE `
-		q += strings.Join(args, ` AND `)
+		q += strings.Join(conds, ` AND `)
 	}
 
 	var count int32
-	if err := r.GetPool().QueryRow(ctx, q).Scan(&count); err != nil {
+	if err := r.GetPool().QueryRow(ctx, q, args...).Scan(&count); err != nil {
 		return 0, r.DebugLogSqlErr(q, err)
 	}
 
@@ -35,29 +51,83 @@ func (r *Repository) SearchFightersCount(ctx context.Context, req *model.Fighter
 // information about the fighters and their statistics. If the request is successful, it returns
 // a slice of Fighter models. In case of an error, it returns nil and the error details.
 func (r *Repository) SearchFighters(ctx context.Context, req *model.FightersRequest) ([]*model.Fighter, error) {
-	q := `SELECT f.fighter_id, f.name, f.nickname, f.division, f.status,
-		f.hometown, f.trains_at, f.fighting_style, f.age, f.height,
-		f.weight, f.octagon_debut, f.debut_timestamp, f.reach, f.leg_reach,
-		f.fighter_url, f.image_url, f.wins, f.loses, f.draw,
-		fs.total_sig_str_landed, fs.total_sig_str_attempted, fs.str_accuracy, fs.total_tkd_landed, fs.total_tkd_attempted,
-		fs.tkd_accuracy, fs.sig_str_landed, fs.sig_str_absorbed, fs.sig_str_defense, fs.takedown_defense,
-		fs.takedown_avg, fs.submission_avg, fs.knockdown_avg, fs.avg_fight_time, fs.win_by_ko,
-		fs.win_by_sub, fs.win_by_dec
+	q := `SELECT ` + fighterColumns + `
 		FROM public.pf_fighters AS f
 		LEFT JOIN public.pf_fighter_stats AS fs ON f.fighter_id = fs.fighter_id`
 
-	args := r.performFightersQuery(req)
-	if len(args) > 0 {
+	conds, args := r.performFightersQuery(req, 0)
+	if len(conds) > 0 {
 		q += ` WHERE `
-		q += strings.Join(args, ` AND `)
+		q += strings.Join(conds, ` AND `)
 	}
 
-	rows, err := r.GetPool().Query(ctx, q)
+	rows, err := r.GetPool().Query(ctx, q, args...)
 	if err != nil {
 		return nil, r.DebugLogSqlErr(q, err)
 	}
 	defer rows.Close()
 
+	return scanFighters(rows)
+}
+
+// SearchFightersByText searches fighters by name, nickname, hometown and gym.
+// Every word of the query is matched as a prefix against the 'search_document' column, so a typeahead
+// finds "Israel Adesanya" by "adesa" or "isr ade", and misspelled names are found by trigram similarity
+// of the whole query and the name. Accents are folded on both sides, so "jose" matches "José".
+// Name matches are ranked above nickname, hometown and gym matches, the most experienced fighters go first on a tie.
+// The status and division of the request are applied the same way as in SearchFighters.
+// The search columns and the 'pf_unaccent' function are created by migrations/0002_fighter_search.sql.
+func (r *Repository) SearchFightersByText(ctx context.Context, req *model.FightersTextSearchRequest) ([]*model.Fighter, error) {
+	q := `WITH s AS (
+			SELECT public.pf_unaccent($1) AS term, to_tsquery('simple', public.pf_unaccent($2)) AS query
+		)
+		SELECT ` + fighterColumns + `
+		FROM public.pf_fighters AS f
+		LEFT JOIN public.pf_fighter_stats AS fs ON f.fighter_id = fs.fighter_id
+		CROSS JOIN s
+		WHERE (f.search_document @@ s.query OR word_similarity(s.term, f.search_name) >= $3)`
+
+	args := []any{req.Query, prefixTsQuery(req.Query), textSearchSimilarity, req.Limit}
+	conds, filterArgs := r.performFightersQuery(&model.FightersRequest{
+		Status:   req.Status,
+		Division: req.Division,
+	}, len(args))
+	if len(conds) > 0 {
+		q += ` AND `
+		q += strings.Join(conds, ` AND `)
+	}
+	args = append(args, filterArgs...)
+
+	q += ` ORDER BY ts_rank('{0.1, 0.2, 0.4, 1.0}', f.search_document, s.query) + word_similarity(s.term, f.search_name) DESC,
+		f.wins + f.loses + f.draw DESC, f.fighter_id
+		LIMIT $4`
+
+	rows, err := r.GetPool().Query(ctx, q, args...)
+	if err != nil {
+		return nil, r.DebugLogSqlErr(q, err)
+	}
+	defer rows.Close()
+
+	return scanFighters(rows)
+}
+
+// prefixTsQuery converts a search query into a tsquery matching every word as a prefix,
+// e.g. "Jon  Jo" is converted into "jon:* & jo:*". Punctuation is dropped, so the result is
+// always a valid tsquery. An empty string is returned if the query has no words.
+func prefixTsQuery(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, w := range words {
+		words[i] = w + ":*"
+	}
+
+	return strings.Join(words, " & ")
+}
+
+// scanFighters reads fighters with stats selected by fighterColumns.
+func scanFighters(rows pgx.Rows) ([]*model.Fighter, error) {
 	var results []*model.Fighter
 
 	for rows.Next() {
@@ -81,29 +151,34 @@ func (r *Repository) SearchFighters(ctx context.Context, req *model.FightersRequ
 		results = append(results, &f)
 	}
 
-	return results, nil
+	return results, rows.Err()
 }
 
 // performFightersQuery constructs the conditions for filtering fighter search based on the provided FightersRequest.
-// It returns a slice of string conditions that can be used in the WHERE clause of the SQL query.
+// It returns a slice of string conditions that can be used in the WHERE clause of the SQL query and the values
+// bound to their placeholders. Placeholders are numbered after the first argsOffset arguments of the query.
 // If the provided FightersRequest is nil, an empty slice is returned.
-func (r *Repository) performFightersQuery(req *model.FightersRequest) []string {
-	var args []string
+func (r *Repository) performFightersQuery(req *model.FightersRequest, argsOffset int) ([]string, []any) {
+	var conds []string
+	var args []any
 	if req == nil {
-		return args
+		return conds, args
 	}
 
 	if req.Status != "" {
-		args = append(args, fmt.Sprintf(`f.status = '%s'`, req.Status))
+		args = append(args, req.Status.String())
+		conds = append(conds, fmt.Sprintf(`f.status = $%d`, argsOffset+len(args)))
 	}
 
-	if req.FightersIds != nil && len(req.FightersIds) > 0 {
-		stringedIds := make([]string, len(req.FightersIds))
-		for i, id := range req.FightersIds {
-			stringedIds[i] = fmt.Sprintf("%d", id)
-		}
-		args = append(args, fmt.Sprintf(`f.fighter_id IN (%s)`, strings.Join(stringedIds, ", ")))
+	if req.Division != nil {
+		args = append(args, int(*req.Division))
+		conds = append(conds, fmt.Sprintf(`f.division = $%d`, argsOffset+len(args)))
+	}
+
+	if len(req.FightersIds) > 0 {
+		args = append(args, req.FightersIds)
+		conds = append(conds, fmt.Sprintf(`f.fighter_id = ANY($%d)`, argsOffset+len(args)))
 	}
 
-	return args
+	return conds, args
 }
@@ -0,0 +1,28 @@
+-- Aliases of fighters merged by the dedupe command: the name, debut and profile URL of a merged
+-- duplicate map later scraped profiles onto the surviving fighter.
+-- Migrations are applied to the database of the service in the order of their numbers.
+
+--- pf_fighter_aliases table
+
+CREATE TABLE IF NOT EXISTS public.pf_fighter_aliases (
+    alias_id serial NOT NULL,
+    fighter_id integer NOT NULL,
+    merged_id integer NOT NULL,
+    name character varying(255) NOT NULL,
+    debut_timestamp bigint NOT NULL,
+    fighter_url character varying(255) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+ALTER TABLE ONLY public.pf_fighter_aliases
+    ADD CONSTRAINT pf_fighter_aliases_pkey PRIMARY KEY (alias_id);
+
+-- merged_id is not a foreign key, the duplicate is deleted by the merge
+ALTER TABLE ONLY public.pf_fighter_aliases
+    ADD CONSTRAINT pf_fighter_aliases_fighter_id_fkey FOREIGN KEY (fighter_id) REFERENCES public.pf_fighters(fighter_id);
+
+-- a profile URL belongs to one surviving fighter, merging again moves it
+CREATE UNIQUE INDEX pf_fighter_aliases_fighter_url_uindex ON public.pf_fighter_aliases USING btree (fighter_url);
+
+-- aliases are also looked up by the name and debut of the merged fighter
+CREATE INDEX pf_fighter_aliases_name_debut_timestamp_index ON public.pf_fighter_aliases USING btree (name, debut_timestamp);
@@ -0,0 +1,32 @@
+-- Full-text and fuzzy search of fighters by name, nickname, hometown and gym, ignoring case and accents.
+-- Requires the unaccent and pg_trgm extensions of the PostgreSQL contrib package. Both are trusted,
+-- an owner of the database may create them, otherwise a superuser has to run the CREATE EXTENSION
+-- statements below before the migration is applied.
+
+CREATE EXTENSION IF NOT EXISTS unaccent;
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+--- pf_unaccent function
+
+-- unaccent is only stable because the dictionary can be changed,
+-- the wrapper with a fixed dictionary can be used in generated columns and indexes
+CREATE OR REPLACE FUNCTION public.pf_unaccent(text) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+    AS $$ SELECT lower(public.unaccent('public.unaccent'::regdictionary, $1)) $$;
+
+--- pf_fighters search columns
+
+ALTER TABLE public.pf_fighters ADD COLUMN IF NOT EXISTS search_name text
+    GENERATED ALWAYS AS (public.pf_unaccent(name)) STORED;
+
+ALTER TABLE public.pf_fighters ADD COLUMN IF NOT EXISTS search_document tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple'::regconfig, public.pf_unaccent(name)), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, public.pf_unaccent(coalesce(nickname, ''))), 'B') ||
+    setweight(to_tsvector('simple'::regconfig, public.pf_unaccent(coalesce(hometown, '') || ' ' || coalesce(trains_at, ''))), 'C')
+) STORED;
+
+-- full-text matches of words
+CREATE INDEX IF NOT EXISTS pf_fighters_search_document_index ON public.pf_fighters USING gin (search_document);
+
+-- fuzzy matches of misspelled names
+CREATE INDEX IF NOT EXISTS pf_fighters_search_name_trgm_index ON public.pf_fighters USING gin (search_name gin_trgm_ops);
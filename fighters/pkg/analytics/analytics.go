@@ -0,0 +1,145 @@
+// Package analytics derives comparable metrics from raw fighter stats.
+package analytics
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"pickfighter.com/fighters/pkg/model"
+)
+
+// Names of metrics ranked within a division.
+const (
+	MetricStrikingDifferential = "strikingDifferential"
+	MetricFinishRate           = "finishRate"
+	MetricTakedownSuccess      = "takedownSuccess"
+	MetricSigStrLanded         = "sigStrLanded"
+	MetricSigStrDefense        = "sigStrDefense"
+	MetricTakedownDefense      = "takedownDefense"
+)
+
+var ErrFightTime = errors.New("invalid fight time")
+
+// ParseFightTime converts a fight time in the "mm:ss" form, e.g. "08:58", into seconds.
+func ParseFightTime(s string) (int32, error) {
+	mm, ss, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return 0, ErrFightTime
+	}
+
+	minutes, err := strconv.Atoi(mm)
+	if err != nil || minutes < 0 {
+		return 0, ErrFightTime
+	}
+
+	seconds, err := strconv.Atoi(ss)
+	if err != nil || seconds < 0 || seconds > 59 {
+		return 0, ErrFightTime
+	}
+
+	return int32(minutes*60 + seconds), nil
+}
+
+// Compute returns analytics of every fighter keyed by fighter id.
+// Percentile ranks are computed among the fighters of the same division,
+// so the whole roster has to be passed to get meaningful ranks.
+func Compute(fighters []*model.Fighter) map[int32]*model.FighterAnalytics {
+	result := make(map[int32]*model.FighterAnalytics, len(fighters))
+	samples := make(map[model.Division]map[string][]float64)
+
+	metrics := make([]map[string]float64, len(fighters))
+	for i, f := range fighters {
+		a, m := compute(f)
+		result[f.FighterId] = a
+		metrics[i] = m
+
+		if samples[f.Division] == nil {
+			samples[f.Division] = make(map[string][]float64)
+		}
+		for name, v := range m {
+			samples[f.Division][name] = append(samples[f.Division][name], v)
+		}
+	}
+
+	for _, division := range samples {
+		for _, values := range division {
+			sort.Float64s(values)
+		}
+	}
+
+	for i, f := range fighters {
+		if len(metrics[i]) == 0 {
+			continue
+		}
+
+		a := result[f.FighterId]
+		a.Percentiles = make(map[string]float32, len(metrics[i]))
+		for name, v := range metrics[i] {
+			a.Percentiles[name] = round(percentileRank(samples[f.Division][name], v), 1)
+		}
+	}
+
+	return result
+}
+
+// compute returns the analytics of a single fighter without percentiles
+// and the values of ranked metrics the fighter has data for.
+func compute(f *model.Fighter) (*model.FighterAnalytics, map[string]float64) {
+	s := f.Stats
+	a := &model.FighterAnalytics{}
+	m := make(map[string]float64)
+
+	// the fight time is missing for fighters without recorded UFC bouts,
+	// their per-minute averages are zeros rather than real values
+	if seconds, err := ParseFightTime(s.AvgFightTime); err == nil && seconds > 0 {
+		a.AvgFightTimeSeconds = seconds
+		a.StrikingDifferential = round(float64(s.SigStrLanded-s.SigStrAbs), 2)
+
+		m[MetricStrikingDifferential] = float64(s.SigStrLanded - s.SigStrAbs)
+		m[MetricSigStrLanded] = float64(s.SigStrLanded)
+		m[MetricSigStrDefense] = float64(s.SigStrDefense)
+		m[MetricTakedownDefense] = float64(s.TakedownDefense)
+	}
+
+	// win methods are not published for every win, so they are compared to their own total
+	if wins := s.WinByKO + s.WinBySub + s.WinByDec; wins > 0 {
+		finishRate := percent(s.WinByKO+s.WinBySub, wins)
+
+		a.FinishRate = round(finishRate, 2)
+		a.KOPercentage = round(percent(s.WinByKO, wins), 2)
+		a.SubPercentage = round(percent(s.WinBySub, wins), 2)
+		a.DecPercentage = round(percent(s.WinByDec, wins), 2)
+
+		m[MetricFinishRate] = finishRate
+	}
+
+	if s.TotalTkdAttempted > 0 {
+		success := percent(s.TotalTkdLanded, s.TotalTkdAttempted)
+
+		a.TakedownSuccess = round(success, 2)
+		m[MetricTakedownSuccess] = success
+	}
+
+	return a, m
+}
+
+// percentileRank returns the share of sorted values below v, counting equal values as half.
+func percentileRank(sorted []float64, v float64) float64 {
+	below := sort.SearchFloat64s(sorted, v)
+	equal := sort.SearchFloat64s(sorted, math.Nextafter(v, math.Inf(1))) - below
+
+	return (float64(below) + float64(equal)/2) / float64(len(sorted)) * 100
+}
+
+func percent(part, total int) float64 {
+	return float64(part) / float64(total) * 100
+}
+
+// round rounds v to the given number of decimal places.
+func round(v float64, places int) float32 {
+	p := math.Pow(10, float64(places))
+	return float32(math.Round(v*p) / p)
+}
@@ -0,0 +1,124 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pickfighter.com/fighters/pkg/model"
+)
+
+func TestParseFightTime(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int32
+		err      error
+	}{
+		{"08:58", 538, nil},
+		{"15:00", 900, nil},
+		{" 0:07 ", 7, nil},
+		{"", 0, ErrFightTime},
+		{"15", 0, ErrFightTime},
+		{"10:75", 0, ErrFightTime},
+		{"ab:00", 0, ErrFightTime},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			v, err := ParseFightTime(tc.input)
+			assert.Equal(t, tc.expected, v)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	fighters := []*model.Fighter{
+		{
+			FighterId: 1,
+			Division:  model.Lightweight,
+			Stats: model.FighterStats{
+				SigStrLanded:      5.5,
+				SigStrAbs:         3.25,
+				SigStrDefense:     60,
+				TakedownDefense:   70,
+				TotalTkdLanded:    4,
+				TotalTkdAttempted: 12,
+				AvgFightTime:      "10:30",
+				WinByKO:           3,
+				WinBySub:          1,
+				WinByDec:          4,
+			},
+		},
+		{
+			FighterId: 2,
+			Division:  model.Lightweight,
+			Stats: model.FighterStats{
+				SigStrLanded:    3,
+				SigStrAbs:       4,
+				SigStrDefense:   50,
+				TakedownDefense: 80,
+				AvgFightTime:    "15:00",
+				WinByDec:        2,
+			},
+		},
+		{
+			FighterId: 3,
+			Division:  model.Lightweight,
+			Stats: model.FighterStats{
+				SigStrLanded:    4,
+				SigStrAbs:       4,
+				SigStrDefense:   50,
+				TakedownDefense: 60,
+				AvgFightTime:    "05:00",
+			},
+		},
+		// no recorded bouts, not ranked
+		{FighterId: 4, Division: model.Lightweight},
+		// a different division, ranked alone
+		{
+			FighterId: 5,
+			Division:  model.Heavyweight,
+			Stats:     model.FighterStats{SigStrLanded: 1, AvgFightTime: "01:00", WinByKO: 1},
+		},
+	}
+
+	result := Compute(fighters)
+	assert.Len(t, result, 5)
+
+	assert.Equal(t, &model.FighterAnalytics{
+		StrikingDifferential: 2.25,
+		FinishRate:           50,
+		KOPercentage:         37.5,
+		SubPercentage:        12.5,
+		DecPercentage:        50,
+		TakedownSuccess:      33.33,
+		AvgFightTimeSeconds:  630,
+		Percentiles: map[string]float32{
+			MetricStrikingDifferential: 83.3,
+			MetricFinishRate:           75,
+			MetricTakedownSuccess:      50,
+			MetricSigStrLanded:         83.3,
+			MetricSigStrDefense:        83.3,
+			MetricTakedownDefense:      50,
+		},
+	}, result[1])
+
+	assert.Equal(t, &model.FighterAnalytics{
+		StrikingDifferential: -1,
+		DecPercentage:        100,
+		AvgFightTimeSeconds:  900,
+		Percentiles: map[string]float32{
+			MetricStrikingDifferential: 16.7,
+			MetricFinishRate:           25,
+			MetricSigStrLanded:         16.7,
+			MetricSigStrDefense:        33.3,
+			MetricTakedownDefense:      83.3,
+		},
+	}, result[2])
+
+	assert.Equal(t, &model.FighterAnalytics{}, result[4])
+
+	assert.Equal(t, float32(100), result[5].FinishRate)
+	assert.Equal(t, float32(50), result[5].Percentiles[MetricFinishRate])
+	assert.Equal(t, float32(50), result[5].Percentiles[MetricSigStrLanded])
+}
@@ -0,0 +1,21 @@
+package blob
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned when there is no object stored under the requested key.
+var ErrNotFound = errors.New("blob not found")
+
+// ErrInvalidKey is returned when a key is empty or points outside of the store.
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// Store defines a storage for binary objects addressed by slash separated keys,
+// e.g. "fighters/42/medium.webp". Implementations must be safe for concurrent use.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+}
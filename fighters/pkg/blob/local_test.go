@@ -0,0 +1,63 @@
+package blob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(filepath.Join(t.TempDir(), "images"))
+	assert.NoError(t, err)
+
+	exists, err := store.Exists(ctx, "fighters/1/small.jpg")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = store.Get(ctx, "fighters/1/small.jpg")
+	assert.Equal(t, ErrNotFound, err)
+
+	assert.NoError(t, store.Put(ctx, "fighters/1/small.jpg", []byte("image")))
+
+	exists, err = store.Exists(ctx, "fighters/1/small.jpg")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	data, err := store.Get(ctx, "fighters/1/small.jpg")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("image"), data)
+
+	assert.NoError(t, store.Put(ctx, "fighters/1/small.jpg", []byte("updated")))
+	data, err = store.Get(ctx, "fighters/1/small.jpg")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("updated"), data)
+
+	entries, err := os.ReadDir(filepath.Join(store.root, "fighters", "1"))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files should not be left behind")
+
+	assert.NoError(t, store.Delete(ctx, "fighters/1/small.jpg"))
+	assert.NoError(t, store.Delete(ctx, "fighters/1/small.jpg"))
+
+	_, err = store.Get(ctx, "fighters/1/small.jpg")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestLocalStoreInvalidKey(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+
+	for _, key := range []string{"", "/", "../secret", "fighters/../../secret"} {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, ErrInvalidKey, store.Put(ctx, key, []byte("x")))
+
+			_, err := store.Get(ctx, key)
+			assert.Equal(t, ErrInvalidKey, err)
+		})
+	}
+}
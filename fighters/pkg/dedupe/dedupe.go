@@ -0,0 +1,235 @@
+// Package dedupe finds fighters that were stored twice, e.g. after the promotion changed a profile URL.
+package dedupe
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"pickfighter.com/fighters/pkg/model"
+	"pickfighter.com/pkg/domain"
+)
+
+// DefaultMinScore is the minimal score of a reported candidate. Fighters with the same
+// name and debut reach it only if the division or a physical stat matches as well.
+const DefaultMinScore = 6
+
+// Scores of matching fighter attributes.
+const (
+	scoreName        = 3
+	scoreSimilarName = 2
+	scoreDebut       = 2
+	scorePhysical    = 1
+)
+
+// Reasons why a pair of fighters is reported.
+const (
+	ReasonName        = "name"
+	ReasonSimilarName = "similar name"
+	ReasonDebut       = "debut"
+	ReasonDivision    = "division"
+	ReasonHeight      = "height"
+	ReasonWeight      = "weight"
+	ReasonReach       = "reach"
+)
+
+// Fighter is a short fighter description used in a review report.
+type Fighter struct {
+	FighterId      int32               `json:"fighter_id"`
+	Name           string              `json:"name"`
+	Division       model.Division      `json:"division"`
+	Status         model.FighterStatus `json:"status"`
+	OctagonDebut   string              `json:"octagonDebut"`
+	DebutTimestamp int                 `json:"debutTimestamp"`
+	Height         float32             `json:"height"`
+	Weight         float32             `json:"weight"`
+	Reach          float32             `json:"reach"`
+	Record         [3]int              `json:"record"`
+	FighterUrl     string              `json:"fighterUrl"`
+}
+
+// Candidate is a pair of fighters that are likely the same person.
+// The duplicate is merged into the survivor.
+type Candidate struct {
+	Survivor  Fighter  `json:"survivor"`
+	Duplicate Fighter  `json:"duplicate"`
+	Score     int      `json:"score"`
+	Reasons   []string `json:"reasons"`
+}
+
+// Find returns pairs of likely duplicated fighters with at least minScore, the best matches first.
+// Only fighters sharing a normalized name or a debut date are compared, and names of a pair
+// have to be equal or differ by a couple of letters.
+func Find(fighters []*model.Fighter, minScore int) []Candidate {
+	names := make(map[*model.Fighter]string, len(fighters))
+	groups := make(map[string][]*model.Fighter)
+	for _, f := range fighters {
+		names[f] = NormalizeName(f.Name)
+
+		nameKey := "name:" + names[f]
+		groups[nameKey] = append(groups[nameKey], f)
+
+		if f.DebutTimestamp != 0 {
+			debutKey := "debut:" + strconv.Itoa(f.DebutTimestamp)
+			groups[debutKey] = append(groups[debutKey], f)
+		}
+	}
+
+	seen := make(map[[2]int32]bool)
+	var candidates []Candidate
+
+	for _, group := range groups {
+		for i := 0; i < len(group); i++ {
+			for j := i + 1; j < len(group); j++ {
+				a, b := group[i], group[j]
+
+				key := [2]int32{a.FighterId, b.FighterId}
+				if key[0] > key[1] {
+					key[0], key[1] = key[1], key[0]
+				}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				score, reasons := score(a, b, names[a], names[b])
+				if score < minScore {
+					continue
+				}
+
+				survivor, duplicate := chooseSurvivor(a, b)
+				candidates = append(candidates, Candidate{
+					Survivor:  summary(survivor),
+					Duplicate: summary(duplicate),
+					Score:     score,
+					Reasons:   reasons,
+				})
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Survivor.FighterId < candidates[j].Survivor.FighterId
+	})
+
+	return candidates
+}
+
+// Score returns how likely two fighters are the same person and which attributes match.
+// Fighters with different names get a zero score, missing physical stats never match.
+func Score(a, b *model.Fighter) (int, []string) {
+	return score(a, b, NormalizeName(a.Name), NormalizeName(b.Name))
+}
+
+// score is Score with already normalized names.
+func score(a, b *model.Fighter, nameA, nameB string) (int, []string) {
+	var (
+		score   int
+		reasons []string
+	)
+
+	switch {
+	case nameA == nameB:
+		score += scoreName
+		reasons = append(reasons, ReasonName)
+	case len(nameA) >= 5 && levenshtein(nameA, nameB) <= 2:
+		score += scoreSimilarName
+		reasons = append(reasons, ReasonSimilarName)
+	default:
+		return 0, nil
+	}
+
+	if a.DebutTimestamp != 0 && a.DebutTimestamp == b.DebutTimestamp {
+		score += scoreDebut
+		reasons = append(reasons, ReasonDebut)
+	}
+
+	physical := []struct {
+		reason    string
+		a, b      float32
+		tolerance float64
+	}{
+		{ReasonHeight, a.Height, b.Height, 0.5},
+		{ReasonWeight, a.Weight, b.Weight, 3},
+		{ReasonReach, a.Reach, b.Reach, 0.5},
+	}
+	for _, p := range physical {
+		if p.a > 0 && p.b > 0 && math.Abs(float64(p.a-p.b)) <= p.tolerance {
+			score += scorePhysical
+			reasons = append(reasons, p.reason)
+		}
+	}
+
+	if a.Division == b.Division {
+		score += scorePhysical
+		reasons = append(reasons, ReasonDivision)
+	}
+
+	return score, reasons
+}
+
+// NormalizeName folds accents, case and punctuation of a fighter name,
+// e.g. "José Aldo" and "Jose  Aldo" are normalized to "jose aldo".
+func NormalizeName(name string) string {
+	return domain.NormalizeName(name)
+}
+
+// chooseSurvivor keeps the fighter with the longer record, which is the one updated by
+// the latest scrape. On a tie the older row is kept, as events are more likely to refer to it.
+func chooseSurvivor(a, b *model.Fighter) (*model.Fighter, *model.Fighter) {
+	fightsA, fightsB := a.Wins+a.Loses+a.Draw, b.Wins+b.Loses+b.Draw
+	if fightsA != fightsB {
+		if fightsA > fightsB {
+			return a, b
+		}
+		return b, a
+	}
+
+	if a.FighterId < b.FighterId {
+		return a, b
+	}
+	return b, a
+}
+
+func summary(f *model.Fighter) Fighter {
+	return Fighter{
+		FighterId:      f.FighterId,
+		Name:           f.Name,
+		Division:       f.Division,
+		Status:         f.Status,
+		OctagonDebut:   f.OctagonDebut,
+		DebutTimestamp: f.DebutTimestamp,
+		Height:         f.Height,
+		Weight:         f.Weight,
+		Reach:          f.Reach,
+		Record:         [3]int{f.Wins, f.Loses, f.Draw},
+		FighterUrl:     f.FighterUrl,
+	}
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
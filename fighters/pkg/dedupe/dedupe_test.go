@@ -0,0 +1,185 @@
+package dedupe
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pickfighter.com/fighters/pkg/model"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"José Aldo", "jose aldo"},
+		{"Jose  Aldo", "jose aldo"},
+		{"Jan Błachowicz", "jan błachowicz"},
+		{"Khalil Rountree Jr.", "khalil rountree jr"},
+		{"Dan O'Connor", "dan oconnor"},
+		{"Dan O’Connor", "dan oconnor"},
+		{"Jean-Claude  Van Damme", "jean claude van damme"},
+		{"", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NormalizeName(tc.input))
+		})
+	}
+}
+
+func TestScore(t *testing.T) {
+	a := &model.Fighter{
+		FighterId:      1,
+		Name:           "Rostem Akman",
+		Division:       model.Welterweight,
+		DebutTimestamp: 1508025600,
+		Height:         72,
+		Weight:         170,
+		Reach:          75,
+	}
+
+	tests := []struct {
+		name            string
+		b               *model.Fighter
+		expectedScore   int
+		expectedReasons []string
+	}{
+		{
+			name: "Same fighter",
+			b: &model.Fighter{
+				Name:           "Rostém Akman",
+				Division:       model.Welterweight,
+				DebutTimestamp: 1508025600,
+				Height:         72,
+				Weight:         171,
+				Reach:          75,
+			},
+			expectedScore:   9,
+			expectedReasons: []string{ReasonName, ReasonDebut, ReasonHeight, ReasonWeight, ReasonReach, ReasonDivision},
+		},
+		{
+			name: "Similar name",
+			b: &model.Fighter{
+				Name:           "Rostam Akman",
+				Division:       model.Middleweight,
+				DebutTimestamp: 1508025600,
+				Weight:         185,
+			},
+			expectedScore:   4,
+			expectedReasons: []string{ReasonSimilarName, ReasonDebut},
+		},
+		{
+			name: "Different name",
+			b: &model.Fighter{
+				Name:           "Rob Akman",
+				Division:       model.Welterweight,
+				DebutTimestamp: 1508025600,
+				Height:         72,
+			},
+			expectedScore:   0,
+			expectedReasons: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			score, reasons := Score(a, tc.b)
+			assert.Equal(t, tc.expectedScore, score)
+			assert.Equal(t, tc.expectedReasons, reasons)
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	fighters := []*model.Fighter{
+		{FighterId: 10, Name: "Rostem Akman", Division: model.Welterweight, DebutTimestamp: 1508025600, Weight: 170, Wins: 6, Loses: 2},
+		{FighterId: 20, Name: "Rostam Akman", Division: model.Welterweight, DebutTimestamp: 1508025600, Weight: 170, Wins: 6, Loses: 3},
+		{FighterId: 30, Name: "Bruno Silva", Division: model.Middleweight, DebutTimestamp: 1616803200, Height: 71},
+		{FighterId: 40, Name: "Bruno Silva", Division: model.Flyweight, DebutTimestamp: 1637971200, Height: 65},
+		{FighterId: 50, Name: "José Aldo", Division: model.Bantamweight, DebutTimestamp: 1256947200, Height: 67, Reach: 70},
+		{FighterId: 60, Name: "Jose Aldo", Division: model.Bantamweight, DebutTimestamp: 1256947200, Height: 67, Reach: 70},
+		{FighterId: 70, Name: "Ben Askren", Division: model.Welterweight, DebutTimestamp: 1508025600, Weight: 170},
+	}
+
+	candidates := Find(fighters, DefaultMinScore)
+	assert.Len(t, candidates, 2)
+
+	// the longer record survives, on a tie the older row does
+	assert.Equal(t, int32(50), candidates[0].Survivor.FighterId)
+	assert.Equal(t, int32(60), candidates[0].Duplicate.FighterId)
+	assert.Equal(t, 8, candidates[0].Score)
+
+	assert.Equal(t, int32(20), candidates[1].Survivor.FighterId)
+	assert.Equal(t, int32(10), candidates[1].Duplicate.FighterId)
+	assert.Equal(t, [3]int{6, 3, 0}, candidates[1].Survivor.Record)
+	assert.Equal(t, 6, candidates[1].Score)
+
+	// namesakes from different divisions are reported only with a lower threshold
+	assert.Len(t, Find(fighters, 3), 3)
+	assert.Empty(t, Find(nil, DefaultMinScore))
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Format
+		err      error
+	}{
+		{"", FormatText, nil},
+		{"TEXT", FormatText, nil},
+		{"json", FormatJSON, nil},
+		{"csv", "", ErrUnknownFormat},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			f, err := ParseFormat(tc.input)
+			assert.Equal(t, tc.expected, f)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	candidates := []Candidate{
+		{
+			Survivor:  Fighter{FighterId: 20, Name: "Rostam Akman", Record: [3]int{6, 3, 0}, FighterUrl: "https://www.ufc.com/athlete/rostam-akman"},
+			Duplicate: Fighter{FighterId: 10, Name: "Rostem Akman", Record: [3]int{6, 2, 0}, FighterUrl: "https://www.ufc.com/athlete/rostem-akman"},
+			Score:     6,
+			Reasons:   []string{ReasonSimilarName, ReasonDebut, ReasonWeight, ReasonDivision},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteReport(&buf, candidates, FormatText))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 4)
+	assert.True(t, strings.HasPrefix(lines[0], "SCORE"))
+	assert.Contains(t, lines[1], "Rostam Akman #20 (6-3-0, rostam-akman)")
+	assert.Contains(t, lines[1], "similar name, debut, weight, division")
+	assert.True(t, strings.HasSuffix(lines[1], "20:10"))
+	assert.Equal(t, "1 candidate(s) found", lines[3])
+
+	buf.Reset()
+	assert.NoError(t, WriteReport(&buf, candidates, FormatJSON))
+
+	var decoded []Candidate
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, candidates, decoded)
+
+	buf.Reset()
+	assert.NoError(t, WriteReport(&buf, nil, FormatJSON))
+	assert.Equal(t, "[]\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, WriteReport(&buf, nil, FormatText))
+	assert.Equal(t, "No duplicated fighters found\n", buf.String())
+
+	assert.Equal(t, ErrUnknownFormat, WriteReport(&buf, nil, "csv"))
+}
@@ -0,0 +1,85 @@
+package dedupe
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Format defines a review report format.
+type Format string
+
+// Supported report formats.
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+)
+
+var ErrUnknownFormat = errors.New("unknown report format")
+
+// ParseFormat converts a string into a supported report Format. An empty string means text.
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case "", FormatText:
+		return FormatText, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+// WriteReport writes candidates to w in the given format. The text report is a table
+// with one candidate per line, intended to be reviewed before the merge.
+func WriteReport(w io.Writer, candidates []Candidate, format Format) error {
+	switch format {
+	case FormatJSON:
+		if candidates == nil {
+			candidates = []Candidate{}
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(candidates)
+	case FormatText:
+		return writeTable(w, candidates)
+	default:
+		return ErrUnknownFormat
+	}
+}
+
+func writeTable(w io.Writer, candidates []Candidate) error {
+	if len(candidates) == 0 {
+		_, err := fmt.Fprintln(w, "No duplicated fighters found")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SCORE\tSURVIVOR\tDUPLICATE\tREASONS\tMERGE")
+
+	for _, c := range candidates {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%d:%d\n",
+			c.Score, describe(c.Survivor), describe(c.Duplicate), strings.Join(c.Reasons, ", "),
+			c.Survivor.FighterId, c.Duplicate.FighterId)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d candidate(s) found\n", len(candidates))
+	return err
+}
+
+// describe returns a one-line description of a fighter, e.g. "Rostem Akman #57918 (0-2-0, rostam-akman)".
+func describe(f Fighter) string {
+	slug := f.FighterUrl
+	if i := strings.LastIndex(slug, "/"); i >= 0 {
+		slug = slug[i+1:]
+	}
+
+	return fmt.Sprintf("%s #%d (%d-%d-%d, %s)", f.Name, f.FighterId, f.Record[0], f.Record[1], f.Record[2], slug)
+}
@@ -0,0 +1,194 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"pickfighter.com/fighters/pkg/model"
+)
+
+// Format defines an output format of the fighters export.
+type Format string
+
+// Supported export formats.
+const (
+	FormatJSON   Format = "json"
+	FormatNDJSON Format = "ndjson"
+	FormatCSV    Format = "csv"
+)
+
+// ErrUnknownFormat is returned when the requested export format is not supported.
+var ErrUnknownFormat = fmt.Errorf("unknown export format, allowed formats are: %s, %s, %s", FormatJSON, FormatNDJSON, FormatCSV)
+
+// ParseFormat converts a string into a supported export Format.
+// The comparison is case-insensitive, an empty string is treated as JSON.
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(s))) {
+	case "", FormatJSON:
+		return FormatJSON, nil
+	case FormatNDJSON:
+		return FormatNDJSON, nil
+	case FormatCSV:
+		return FormatCSV, nil
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+// ContentType returns the MIME type of the export format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatCSV:
+		return "text/csv"
+	default:
+		return "application/json"
+	}
+}
+
+// Writer writes fighters one by one, so the export can be streamed
+// without holding the whole roster in memory.
+type Writer interface {
+	Write(f *model.Fighter) error
+	Close() error
+}
+
+// NewWriter creates a Writer for the given format on top of w.
+// Close must be called after the last fighter to finalize the output.
+func NewWriter(w io.Writer, format Format) (Writer, error) {
+	switch format {
+	case FormatJSON:
+		return &jsonWriter{w: w}, nil
+	case FormatNDJSON:
+		return &ndjsonWriter{enc: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// jsonWriter writes fighters as a single JSON array.
+type jsonWriter struct {
+	w       io.Writer
+	written int
+}
+
+func (jw *jsonWriter) Write(f *model.Fighter) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+
+	prefix := ","
+	if jw.written == 0 {
+		prefix = "["
+	}
+
+	if _, err := io.WriteString(jw.w, prefix); err != nil {
+		return err
+	}
+	if _, err := jw.w.Write(data); err != nil {
+		return err
+	}
+
+	jw.written++
+
+	return nil
+}
+
+func (jw *jsonWriter) Close() error {
+	closing := "]\n"
+	if jw.written == 0 {
+		closing = "[]\n"
+	}
+
+	_, err := io.WriteString(jw.w, closing)
+	return err
+}
+
+// ndjsonWriter writes one JSON encoded fighter per line.
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func (nw *ndjsonWriter) Write(f *model.Fighter) error {
+	return nw.enc.Encode(f)
+}
+
+func (nw *ndjsonWriter) Close() error {
+	return nil
+}
+
+// csvHeader is the list of columns of the CSV export, fighter stats are flattened into the same row.
+var csvHeader = []string{
+	"fighter_id", "name", "nickname", "division", "status",
+	"hometown", "trains_at", "fighting_style", "age", "height",
+	"weight", "octagon_debut", "debut_timestamp", "reach", "leg_reach",
+	"wins", "loses", "draw", "fighter_url", "image_url",
+	"total_sig_str_landed", "total_sig_str_attempted", "str_accuracy", "total_tkd_landed", "total_tkd_attempted",
+	"tkd_accuracy", "sig_str_landed", "sig_str_absorbed", "sig_str_defense", "takedown_defense",
+	"takedown_avg", "submission_avg", "knockdown_avg", "avg_fight_time", "win_by_ko",
+	"win_by_sub", "win_by_dec",
+}
+
+// csvWriter writes fighters as CSV rows with a header row.
+type csvWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (cw *csvWriter) Write(f *model.Fighter) error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+
+	return cw.w.Write(csvRecord(f))
+}
+
+func (cw *csvWriter) Close() error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (cw *csvWriter) writeHeader() error {
+	if cw.headerWritten {
+		return nil
+	}
+	cw.headerWritten = true
+
+	return cw.w.Write(csvHeader)
+}
+
+// csvRecord converts a fighter into a CSV row matching csvHeader.
+func csvRecord(f *model.Fighter) []string {
+	s := f.Stats
+
+	return []string{
+		itoa(int(f.FighterId)), f.Name, f.NickName, f.Division.String(), string(f.Status),
+		f.Hometown, f.TrainsAt, f.FightingStyle, itoa(int(f.Age)), ftoa(f.Height),
+		ftoa(f.Weight), f.OctagonDebut, itoa(f.DebutTimestamp), ftoa(f.Reach), ftoa(f.LegReach),
+		itoa(f.Wins), itoa(f.Loses), itoa(f.Draw), f.FighterUrl, f.ImageUrl,
+		itoa(s.TotalSigStrLanded), itoa(s.TotalSigStrAttempted), itoa(s.StrAccuracy), itoa(s.TotalTkdLanded), itoa(s.TotalTkdAttempted),
+		itoa(s.TkdAccuracy), ftoa(s.SigStrLanded), ftoa(s.SigStrAbs), itoa(int(s.SigStrDefense)), itoa(int(s.TakedownDefense)),
+		ftoa(s.TakedownAvg), ftoa(s.SubmissionAvg), ftoa(s.KnockdownAvg), s.AvgFightTime, itoa(s.WinByKO),
+		itoa(s.WinBySub), itoa(s.WinByDec),
+	}
+}
+
+func itoa(i int) string {
+	return strconv.Itoa(i)
+}
+
+func ftoa(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
@@ -0,0 +1,139 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pickfighter.com/fighters/pkg/model"
+)
+
+var testFighters = []*model.Fighter{
+	{
+		FighterId: 1,
+		Name:      "John Doe",
+		NickName:  "The Phantom",
+		Division:  model.Lightweight,
+		Status:    "Active",
+		Wins:      10,
+		Height:    72.5,
+		Stats: model.FighterStats{
+			FighterId:    1,
+			AvgFightTime: "10:30",
+			WinByKO:      5,
+		},
+	},
+	{
+		FighterId: 2,
+		Name:      "Jane, Doe",
+		Division:  model.WomensFlyweight,
+		Status:    "Retired",
+	},
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Format
+		err      error
+	}{
+		{"", FormatJSON, nil},
+		{"json", FormatJSON, nil},
+		{"NDJSON", FormatNDJSON, nil},
+		{" csv ", FormatCSV, nil},
+		{"xml", "", ErrUnknownFormat},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			f, err := ParseFormat(tc.input)
+			assert.Equal(t, tc.expected, f)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestContentType(t *testing.T) {
+	assert.Equal(t, "application/json", FormatJSON.ContentType())
+	assert.Equal(t, "application/x-ndjson", FormatNDJSON.ContentType())
+	assert.Equal(t, "text/csv", FormatCSV.ContentType())
+}
+
+func TestNewWriterUnknownFormat(t *testing.T) {
+	w, err := NewWriter(&bytes.Buffer{}, Format("xml"))
+	assert.Nil(t, w)
+	assert.Equal(t, ErrUnknownFormat, err)
+}
+
+func TestJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writeAll(t, &buf, FormatJSON, testFighters)
+
+	var result []model.Fighter
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	assert.Len(t, result, 2)
+	assert.Equal(t, testFighters[0].Name, result[0].Name)
+	assert.Equal(t, testFighters[0].Stats.WinByKO, result[0].Stats.WinByKO)
+	assert.Equal(t, testFighters[1].Division, result[1].Division)
+}
+
+func TestJSONWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	writeAll(t, &buf, FormatJSON, nil)
+
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writeAll(t, &buf, FormatNDJSON, testFighters)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+
+	for i, line := range lines {
+		var f model.Fighter
+		assert.NoError(t, json.Unmarshal([]byte(line), &f))
+		assert.Equal(t, testFighters[i].FighterId, f.FighterId)
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writeAll(t, &buf, FormatCSV, testFighters)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, csvHeader, records[0])
+
+	assert.Equal(t, "John Doe", records[1][1])
+	assert.Equal(t, "Lightweight", records[1][3])
+	assert.Equal(t, "72.5", records[1][9])
+	assert.Equal(t, "10:30", records[1][33])
+	assert.Equal(t, "Jane, Doe", records[2][1])
+	assert.Equal(t, "Women's Flyweight", records[2][3])
+}
+
+func TestCSVWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	writeAll(t, &buf, FormatCSV, nil)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{csvHeader}, records)
+}
+
+func writeAll(t *testing.T, buf *bytes.Buffer, format Format, fighters []*model.Fighter) {
+	w, err := NewWriter(buf, format)
+	assert.NoError(t, err)
+
+	for _, f := range fighters {
+		assert.NoError(t, w.Write(f))
+	}
+
+	assert.NoError(t, w.Close())
+}
@@ -0,0 +1,164 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"strings"
+
+	"golang.org/x/image/draw"
+)
+
+// Size defines a fixed thumbnail size preset.
+type Size string
+
+// Supported thumbnail sizes.
+const (
+	SizeSmall  Size = "small"
+	SizeMedium Size = "medium"
+	SizeLarge  Size = "large"
+)
+
+// Sizes is the list of thumbnails generated for every fighter image.
+var Sizes = []Size{SizeSmall, SizeMedium, SizeLarge}
+
+// Format defines an encoding of the thumbnail.
+type Format string
+
+// Supported thumbnail formats.
+const (
+	FormatJPEG Format = "jpeg"
+	FormatWebP Format = "webp"
+)
+
+// Formats is the list of encodings generated for every thumbnail size.
+var Formats = []Format{FormatJPEG, FormatWebP}
+
+// jpegQuality is the quality used for JPEG thumbnails.
+const jpegQuality = 85
+
+// placeholderColor is a neutral gray used when a fighter has no image.
+var placeholderColor = color.NRGBA{R: 0xd9, G: 0xd9, B: 0xd9, A: 0xff}
+
+var (
+	ErrUnknownSize   = errors.New("unknown image size")
+	ErrUnknownFormat = errors.New("unknown image format")
+)
+
+// ParseSize converts a string into a supported Size.
+func ParseSize(s string) (Size, error) {
+	switch Size(strings.ToLower(s)) {
+	case SizeSmall:
+		return SizeSmall, nil
+	case SizeMedium:
+		return SizeMedium, nil
+	case SizeLarge:
+		return SizeLarge, nil
+	default:
+		return "", ErrUnknownSize
+	}
+}
+
+// Pixels returns the width and height of the square thumbnail.
+func (s Size) Pixels() int {
+	switch s {
+	case SizeSmall:
+		return 96
+	case SizeLarge:
+		return 512
+	default:
+		return 256
+	}
+}
+
+// ParseFormat converts a string or file extension into a supported Format.
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "jpeg", "jpg":
+		return FormatJPEG, nil
+	case "webp":
+		return FormatWebP, nil
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	if f == FormatWebP {
+		return "image/webp"
+	}
+	return "image/jpeg"
+}
+
+// Ext returns the file extension of the format.
+func (f Format) Ext() string {
+	if f == FormatWebP {
+		return "webp"
+	}
+	return "jpg"
+}
+
+// ThumbnailKey returns the blob store key of the fighter thumbnail.
+func ThumbnailKey(fighterId int32, size Size, format Format) string {
+	return fmt.Sprintf("fighters/%d/%s.%s", fighterId, size, format.Ext())
+}
+
+// SourceKey returns the blob store key where the source URL of the fighter image is kept.
+func SourceKey(fighterId int32) string {
+	return fmt.Sprintf("fighters/%d/source", fighterId)
+}
+
+// Thumbnail scales src to fill a square of the given size and crops the overflow.
+// Fighter photos are portraits, so tall images are cropped from the top to keep the face.
+func Thumbnail(src image.Image, px int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	crop := b
+	if w > h {
+		offset := (w - h) / 2
+		crop = image.Rect(b.Min.X+offset, b.Min.Y, b.Min.X+offset+h, b.Max.Y)
+	} else if h > w {
+		crop = image.Rect(b.Min.X, b.Min.Y, b.Max.X, b.Min.Y+w)
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, px, px))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, crop, draw.Src, nil)
+
+	return dst
+}
+
+// Placeholder returns a plain image of the given size used when a fighter image is missing.
+func Placeholder(px int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, px, px))
+	draw.Draw(img, img.Bounds(), image.NewUniform(placeholderColor), image.Point{}, draw.Src)
+
+	return img
+}
+
+// Encode writes img to w in the given format.
+func Encode(w io.Writer, img image.Image, format Format) error {
+	switch format {
+	case FormatJPEG:
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+	case FormatWebP:
+		return encodeWebP(w, img)
+	default:
+		return ErrUnknownFormat
+	}
+}
+
+// EncodeBytes is a shortcut for Encode returning the encoded image.
+func EncodeBytes(img image.Image, format Format) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, img, format); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
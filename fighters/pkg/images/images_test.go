@@ -0,0 +1,267 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/webp"
+	"pickfighter.com/fighters/pkg/blob"
+)
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Size
+		err      error
+	}{
+		{"small", SizeSmall, nil},
+		{"Medium", SizeMedium, nil},
+		{"large", SizeLarge, nil},
+		{"huge", "", ErrUnknownSize},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			s, err := ParseSize(tc.input)
+			assert.Equal(t, tc.expected, s)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Format
+		err      error
+	}{
+		{"jpg", FormatJPEG, nil},
+		{"JPEG", FormatJPEG, nil},
+		{"webp", FormatWebP, nil},
+		{"gif", "", ErrUnknownFormat},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			f, err := ParseFormat(tc.input)
+			assert.Equal(t, tc.expected, f)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestKeys(t *testing.T) {
+	assert.Equal(t, "fighters/42/small.jpg", ThumbnailKey(42, SizeSmall, FormatJPEG))
+	assert.Equal(t, "fighters/42/large.webp", ThumbnailKey(42, SizeLarge, FormatWebP))
+	assert.Equal(t, "fighters/42/source", SourceKey(42))
+}
+
+func TestThumbnail(t *testing.T) {
+	// a portrait image with a red top half, the crop must keep the top
+	src := image.NewNRGBA(image.Rect(0, 0, 100, 300))
+	for y := 0; y < 300; y++ {
+		for x := 0; x < 100; x++ {
+			c := color.NRGBA{B: 0xff, A: 0xff}
+			if y < 150 {
+				c = color.NRGBA{R: 0xff, A: 0xff}
+			}
+			src.SetNRGBA(x, y, c)
+		}
+	}
+
+	thumb := Thumbnail(src, 64)
+	assert.Equal(t, image.Rect(0, 0, 64, 64), thumb.Bounds())
+
+	r, _, b, _ := thumb.At(32, 32).RGBA()
+	assert.Equal(t, uint32(0xffff), r)
+	assert.Equal(t, uint32(0), b)
+}
+
+func TestEncodeWebP(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	tests := []struct {
+		name string
+		img  *image.NRGBA
+	}{
+		{"Uniform", Placeholder(16).(*image.NRGBA)},
+		{"Noise", noiseImage(rnd, 37, 23, false)},
+		{"NoiseWithAlpha", noiseImage(rnd, 20, 41, true)},
+		{"SinglePixel", noiseImage(rnd, 1, 1, false)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := EncodeBytes(tc.img, FormatWebP)
+			assert.NoError(t, err)
+
+			decoded, err := webp.Decode(bytes.NewReader(data))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.img.Bounds(), decoded.Bounds())
+
+			for y := 0; y < tc.img.Bounds().Dy(); y++ {
+				for x := 0; x < tc.img.Bounds().Dx(); x++ {
+					expected := tc.img.NRGBAAt(x, y)
+					actual := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+					if expected != actual {
+						t.Fatalf("pixel (%d, %d): expected %v, got %v", x, y, expected, actual)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeWebPTooLarge(t *testing.T) {
+	err := Encode(&bytes.Buffer{}, image.NewNRGBA(image.Rect(0, 0, 0, 10)), FormatWebP)
+	assert.Equal(t, errWebPSize, err)
+}
+
+func TestEncodeJPEG(t *testing.T) {
+	data, err := EncodeBytes(Placeholder(32), FormatJPEG)
+	assert.NoError(t, err)
+
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 32, 32), img.Bounds())
+}
+
+func TestHuffmanLengthsLimit(t *testing.T) {
+	// fibonacci counts produce the deepest possible tree
+	hist := make([]uint32, 30)
+	a, b := uint32(1), uint32(1)
+	for i := range hist {
+		hist[i] = a
+		a, b = b, a+b
+	}
+
+	lengths := huffmanLengths(hist, maxCodeLength)
+
+	kraft := 0.0
+	for _, l := range lengths {
+		assert.LessOrEqual(t, l, uint8(maxCodeLength))
+		assert.Greater(t, l, uint8(0))
+		kraft += 1 / float64(uint(1)<<l)
+	}
+	assert.Equal(t, 1.0, kraft)
+}
+
+func TestPipelineProcess(t *testing.T) {
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, noiseImage(rand.New(rand.NewSource(2)), 60, 90, false)))
+
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.URL.Path == "/missing.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(buf.Bytes())
+	}))
+	defer srv.Close()
+
+	store, err := blob.NewLocalStore(filepath.Join(t.TempDir(), "images"))
+	assert.NoError(t, err)
+
+	p := NewPipeline(store, srv.Client())
+
+	processed, err := p.Process(ctx, 7, srv.URL+"/fighter.png?Expires=1&Signature=a")
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	for _, size := range Sizes {
+		data, err := store.Get(ctx, ThumbnailKey(7, size, FormatWebP))
+		assert.NoError(t, err)
+
+		img, err := webp.Decode(bytes.NewReader(data))
+		assert.NoError(t, err)
+		assert.Equal(t, size.Pixels(), img.Bounds().Dx())
+
+		exists, err := store.Exists(ctx, ThumbnailKey(7, size, FormatJPEG))
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	}
+
+	// the same image with a new signature is not downloaded again
+	processed, err = p.Process(ctx, 7, srv.URL+"/fighter.png?Expires=2&Signature=b")
+	assert.NoError(t, err)
+	assert.False(t, processed)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+
+	// a missing thumbnail forces regeneration
+	assert.NoError(t, store.Delete(ctx, ThumbnailKey(7, SizeSmall, FormatJPEG)))
+	processed, err = p.Process(ctx, 7, srv.URL+"/fighter.png")
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	processed, err = p.Process(ctx, 8, "")
+	assert.NoError(t, err)
+	assert.False(t, processed)
+
+	_, err = p.Process(ctx, 9, srv.URL+"/missing.png")
+	assert.Error(t, err)
+}
+
+// wrappingStore wraps the errors of the local store like remote backends do.
+type wrappingStore struct {
+	blob.Store
+}
+
+func (s wrappingStore) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := s.Store.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("get %s: %w", key, err)
+	}
+	return data, nil
+}
+
+func TestPipelineWrappedNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, noiseImage(rand.New(rand.NewSource(3)), 40, 40, false)))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(buf.Bytes())
+	}))
+	defer srv.Close()
+
+	store, err := blob.NewLocalStore(filepath.Join(t.TempDir(), "images"))
+	assert.NoError(t, err)
+
+	p := NewPipeline(wrappingStore{store}, srv.Client())
+
+	processed, err := p.Process(ctx, 7, srv.URL+"/fighter.png")
+	assert.NoError(t, err)
+	assert.True(t, processed)
+}
+
+func noiseImage(rnd *rand.Rand, w, h int, alpha bool) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i] = uint8(rnd.Intn(256))
+		img.Pix[i+1] = uint8(rnd.Intn(256))
+		img.Pix[i+2] = uint8(rnd.Intn(64))
+		img.Pix[i+3] = 0xff
+		if alpha {
+			img.Pix[i+3] = uint8(rnd.Intn(256))
+		}
+	}
+
+	return img
+}
@@ -0,0 +1,150 @@
+package images
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"net/url"
+
+	// decoders for the source images
+	_ "image/jpeg"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+
+	"pickfighter.com/fighters/pkg/blob"
+)
+
+// maxSourceSize limits the size of a downloaded source image.
+const maxSourceSize = 20 << 20
+
+// Pipeline downloads fighter images and stores their thumbnails in a blob store.
+type Pipeline struct {
+	store  blob.Store
+	client *http.Client
+}
+
+// NewPipeline creates a new image Pipeline. If client is nil, http.DefaultClient is used.
+func NewPipeline(store blob.Store, client *http.Client) *Pipeline {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Pipeline{
+		store:  store,
+		client: client,
+	}
+}
+
+// Process downloads the fighter image from imageUrl and stores thumbnails of every size and format.
+// The promotion CDN signs image URLs with expiring query strings, so an image is downloaded
+// again only when the URL without the query string changes or some thumbnail is missing.
+// It reports whether the thumbnails were (re)generated.
+func (p *Pipeline) Process(ctx context.Context, fighterId int32, imageUrl string) (bool, error) {
+	if imageUrl == "" {
+		return false, nil
+	}
+
+	source, err := stableURL(imageUrl)
+	if err != nil {
+		return false, err
+	}
+
+	cached, err := p.isCached(ctx, fighterId, source)
+	if err != nil || cached {
+		return false, err
+	}
+
+	img, err := p.download(ctx, imageUrl)
+	if err != nil {
+		return false, err
+	}
+
+	for _, size := range Sizes {
+		thumb := Thumbnail(img, size.Pixels())
+
+		for _, format := range Formats {
+			data, err := EncodeBytes(thumb, format)
+			if err != nil {
+				return false, fmt.Errorf("failed to encode %s %s thumbnail: %w", size, format, err)
+			}
+
+			if err := p.store.Put(ctx, ThumbnailKey(fighterId, size, format), data); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	// the source is written last, so an interrupted run is retried next time
+	if err := p.store.Put(ctx, SourceKey(fighterId), []byte(source)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// isCached reports whether thumbnails for the source are already stored.
+func (p *Pipeline) isCached(ctx context.Context, fighterId int32, source string) (bool, error) {
+	stored, err := p.store.Get(ctx, SourceKey(fighterId))
+	if errors.Is(err, blob.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if string(stored) != source {
+		return false, nil
+	}
+
+	for _, size := range Sizes {
+		for _, format := range Formats {
+			ok, err := p.store.Exists(ctx, ThumbnailKey(fighterId, size, format))
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// download fetches and decodes the image.
+func (p *Pipeline) download(ctx context.Context, imageUrl string) (image.Image, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download image %s: %s", imageUrl, resp.Status)
+	}
+
+	img, _, err := image.Decode(io.LimitReader(resp.Body, maxSourceSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image %s: %w", imageUrl, err)
+	}
+
+	return img, nil
+}
+
+// stableURL strips the query string and fragment from the image URL.
+func stableURL(imageUrl string) (string, error) {
+	u, err := url.Parse(imageUrl)
+	if err != nil {
+		return "", err
+	}
+
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return u.String(), nil
+}
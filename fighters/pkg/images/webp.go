@@ -0,0 +1,364 @@
+package images
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"io"
+	"sort"
+)
+
+// The WebP encoder below writes lossless (VP8L) images using the subtract-green
+// transform and per-channel Huffman coding. It does not use backward references,
+// which keeps it small while still producing files noticeably smaller than raw pixels.
+// See https://developers.google.com/speed/webp/docs/webp_lossless_bitstream_specification.
+
+const (
+	vp8lSignature   = 0x2f
+	vp8lMaxSize     = 1 << 14
+	maxCodeLength   = 15
+	maxCLCodeLength = 7
+
+	transformSubtractGreen = 2
+
+	// alphabet sizes of the five prefix codes: green (+ 24 length codes), red, blue, alpha, distance
+	greenAlphabet    = 256 + 24
+	colorAlphabet    = 256
+	distanceAlphabet = 40
+)
+
+// codeLengthCodeOrder is the order in which code length code lengths are stored.
+var codeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+var errWebPSize = errors.New("webp: image is too large")
+
+// encodeWebP writes img to w as a lossless WebP image.
+func encodeWebP(w io.Writer, img image.Image) error {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width < 1 || height < 1 || width > vp8lMaxSize || height > vp8lMaxSize {
+		return errWebPSize
+	}
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(nrgba, nrgba.Bounds(), img, b.Min, draw.Src)
+
+	// subtract-green transform, the decoder adds green back to red and blue
+	pix := nrgba.Pix
+	hasAlpha := false
+	var hist [4][]uint32
+	hist[0] = make([]uint32, greenAlphabet)
+	for i := 1; i < 4; i++ {
+		hist[i] = make([]uint32, colorAlphabet)
+	}
+	for i := 0; i < len(pix); i += 4 {
+		pix[i] -= pix[i+1]
+		pix[i+2] -= pix[i+1]
+		if pix[i+3] != 0xff {
+			hasAlpha = true
+		}
+
+		hist[0][pix[i+1]]++
+		hist[1][pix[i]]++
+		hist[2][pix[i+2]]++
+		hist[3][pix[i+3]]++
+	}
+
+	bw := &bitWriter{}
+	bw.writeBits(vp8lSignature, 8)
+	bw.writeBits(uint64(width-1), 14)
+	bw.writeBits(uint64(height-1), 14)
+	if hasAlpha {
+		bw.writeBits(1, 1)
+	} else {
+		bw.writeBits(0, 1)
+	}
+	bw.writeBits(0, 3) // version
+
+	bw.writeBits(1, 1) // transform present
+	bw.writeBits(transformSubtractGreen, 2)
+	bw.writeBits(0, 1) // no more transforms
+
+	bw.writeBits(0, 1) // no color cache
+	bw.writeBits(0, 1) // single prefix code group
+
+	var codes [4]prefixCode
+	for i := range hist {
+		codes[i] = bw.writePrefixCode(hist[i])
+	}
+	bw.writePrefixCode(make([]uint32, distanceAlphabet))
+
+	for i := 0; i < len(pix); i += 4 {
+		codes[0].write(bw, pix[i+1])
+		codes[1].write(bw, pix[i])
+		codes[2].write(bw, pix[i+2])
+		codes[3].write(bw, pix[i+3])
+	}
+
+	data := bw.bytes()
+
+	size := len(data)
+	padded := size + size&1
+
+	header := make([]byte, 20)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(4+8+padded))
+	copy(header[8:], "WEBP")
+	copy(header[12:], "VP8L")
+	binary.LittleEndian.PutUint32(header[16:], uint32(size))
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if size&1 == 1 {
+		if _, err := w.Write([]byte{0}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bitWriter packs values into bytes starting from the least significant bit.
+type bitWriter struct {
+	buf  []byte
+	acc  uint64
+	nacc uint
+}
+
+func (bw *bitWriter) writeBits(v uint64, n uint) {
+	bw.acc |= v << bw.nacc
+	bw.nacc += n
+	for bw.nacc >= 8 {
+		bw.buf = append(bw.buf, byte(bw.acc))
+		bw.acc >>= 8
+		bw.nacc -= 8
+	}
+}
+
+// writeCode writes a Huffman code, which is stored starting from its most significant bit.
+func (bw *bitWriter) writeCode(code uint32, length uint8) {
+	var rev uint64
+	for i := uint8(0); i < length; i++ {
+		rev = rev<<1 | uint64(code>>i&1)
+	}
+	bw.writeBits(rev, uint(length))
+}
+
+func (bw *bitWriter) bytes() []byte {
+	if bw.nacc > 0 {
+		bw.buf = append(bw.buf, byte(bw.acc))
+		bw.acc, bw.nacc = 0, 0
+	}
+	return bw.buf
+}
+
+// prefixCode holds canonical Huffman codes of an alphabet.
+type prefixCode struct {
+	lengths []uint8
+	codes   []uint32
+}
+
+func (pc prefixCode) write(bw *bitWriter, symbol uint8) {
+	bw.writeCode(pc.codes[symbol], pc.lengths[symbol])
+}
+
+// writePrefixCode writes the prefix code built for the histogram and returns it.
+// Alphabets with one or two used symbols are written as simple codes.
+func (bw *bitWriter) writePrefixCode(hist []uint32) prefixCode {
+	var used []int
+	for s, c := range hist {
+		if c > 0 {
+			used = append(used, s)
+		}
+	}
+
+	pc := prefixCode{
+		lengths: make([]uint8, len(hist)),
+		codes:   make([]uint32, len(hist)),
+	}
+
+	if len(used) <= 2 {
+		if len(used) == 0 {
+			used = []int{0}
+		}
+
+		bw.writeBits(1, 1) // simple code
+		bw.writeBits(uint64(len(used)-1), 1)
+		if used[0] < 2 {
+			bw.writeBits(0, 1)
+			bw.writeBits(uint64(used[0]), 1)
+		} else {
+			bw.writeBits(1, 1)
+			bw.writeBits(uint64(used[0]), 8)
+		}
+		if len(used) == 2 {
+			bw.writeBits(uint64(used[1]), 8)
+			pc.lengths[used[0]], pc.lengths[used[1]] = 1, 1
+			pc.codes[used[1]] = 1
+		}
+
+		return pc
+	}
+
+	pc.lengths = huffmanLengths(hist, maxCodeLength)
+	pc.codes = canonicalCodes(pc.lengths)
+
+	// code lengths are themselves Huffman coded, only the literal lengths 0..15 are used
+	clHist := make([]uint32, len(codeLengthCodeOrder))
+	for _, l := range pc.lengths {
+		clHist[l]++
+	}
+	clUsed := 0
+	for _, c := range clHist {
+		if c > 0 {
+			clUsed++
+		}
+	}
+	if clUsed == 1 {
+		// a code length code needs at least two symbols to be coded with non-zero lengths
+		if clHist[0] == 0 {
+			clHist[0] = 1
+		} else {
+			clHist[1] = 1
+		}
+	}
+	clLengths := huffmanLengths(clHist, maxCLCodeLength)
+	clCodes := canonicalCodes(clLengths)
+
+	numCodes := 4
+	for i, s := range codeLengthCodeOrder {
+		if clLengths[s] > 0 && i+1 > numCodes {
+			numCodes = i + 1
+		}
+	}
+
+	bw.writeBits(0, 1) // normal code
+	bw.writeBits(uint64(numCodes-4), 4)
+	for i := 0; i < numCodes; i++ {
+		bw.writeBits(uint64(clLengths[codeLengthCodeOrder[i]]), 3)
+	}
+	bw.writeBits(0, 1) // code lengths are written for the whole alphabet
+
+	for _, l := range pc.lengths {
+		bw.writeCode(clCodes[l], clLengths[l])
+	}
+
+	return pc
+}
+
+// canonicalCodes assigns canonical Huffman codes to the code lengths.
+func canonicalCodes(lengths []uint8) []uint32 {
+	var count [maxCodeLength + 1]uint32
+	for _, l := range lengths {
+		count[l]++
+	}
+	count[0] = 0
+
+	var next [maxCodeLength + 1]uint32
+	code := uint32(0)
+	for l := 1; l <= maxCodeLength; l++ {
+		code = (code + count[l-1]) << 1
+		next[l] = code
+	}
+
+	codes := make([]uint32, len(lengths))
+	for s, l := range lengths {
+		if l > 0 {
+			codes[s] = next[l]
+			next[l]++
+		}
+	}
+
+	return codes
+}
+
+// huffmanLengths computes Huffman code lengths for the histogram limited to maxLength bits.
+// When the tree is too deep, small counts are raised and the tree is rebuilt.
+func huffmanLengths(hist []uint32, maxLength uint8) []uint8 {
+	counts := make([]uint32, len(hist))
+	copy(counts, hist)
+
+	for minCount := uint32(1); ; minCount *= 2 {
+		lengths, depth := buildHuffman(counts)
+		if depth <= maxLength {
+			return lengths
+		}
+
+		for s, c := range counts {
+			if c > 0 && c < minCount {
+				counts[s] = minCount
+			}
+		}
+	}
+}
+
+type huffmanNode struct {
+	weight      uint64
+	order       int
+	symbol      int
+	left, right *huffmanNode
+}
+
+type huffmanHeap []*huffmanNode
+
+func (h huffmanHeap) Len() int { return len(h) }
+func (h huffmanHeap) Less(i, j int) bool {
+	if h[i].weight != h[j].weight {
+		return h[i].weight < h[j].weight
+	}
+	return h[i].order < h[j].order
+}
+func (h huffmanHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *huffmanHeap) Push(x any)   { *h = append(*h, x.(*huffmanNode)) }
+func (h *huffmanHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+// buildHuffman returns code lengths for symbols with non-zero counts and the maximal length.
+// At least two symbols must be used.
+func buildHuffman(counts []uint32) ([]uint8, uint8) {
+	h := &huffmanHeap{}
+	for s, c := range counts {
+		if c > 0 {
+			*h = append(*h, &huffmanNode{weight: uint64(c), order: s, symbol: s})
+		}
+	}
+	sort.Sort(h)
+	heap.Init(h)
+
+	order := len(counts)
+	for h.Len() > 1 {
+		a := heap.Pop(h).(*huffmanNode)
+		b := heap.Pop(h).(*huffmanNode)
+		heap.Push(h, &huffmanNode{weight: a.weight + b.weight, order: order, symbol: -1, left: a, right: b})
+		order++
+	}
+
+	lengths := make([]uint8, len(counts))
+	var maxDepth uint8
+
+	var walk func(n *huffmanNode, depth uint8)
+	walk = func(n *huffmanNode, depth uint8) {
+		if n.symbol >= 0 {
+			lengths[n.symbol] = depth
+			if depth > maxDepth {
+				maxDepth = depth
+			}
+			return
+		}
+		walk(n.left, depth+1)
+		walk(n.right, depth+1)
+	}
+	walk((*h)[0], 0)
+
+	return lengths, maxDepth
+}
@@ -1,56 +1,35 @@
 package model
 
-// Division represents weight divisions
-type Division int
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"pickfighter.com/pkg/domain"
+)
+
+// Division represents weight divisions. It is an alias of the shared domain type,
+// so fighters, the scraper and the gateway parse divisions the same way.
+type Division = domain.Division
 
 // FighterStatus defines a fighter status. Uses to find active fighters
-type FighterStatus string
-
-// String returns the string representation of a Division.
-func (d Division) String() string {
-	switch d {
-	case Flyweight:
-		return "Flyweight"
-	case Bantamweight:
-		return "Bantamweight"
-	case Featherweight:
-		return "Featherweight"
-	case Lightweight:
-		return "Lightweight"
-	case Welterweight:
-		return "Welterweight"
-	case Middleweight:
-		return "Middleweight"
-	case Lightheavyweight:
-		return "Light Heavyweight"
-	case Heavyweight:
-		return "Heavyweight"
-	case WomensStrawweight:
-		return "Women's Strawweight"
-	case WomensFlyweight:
-		return "Women's Flyweight"
-	case WomensBantamweight:
-		return "Women's Bantamweight"
-	case WomensFeatherweight:
-		return "Women's Featherweight"
-	default:
-		return "Unknown"
-	}
-}
+type FighterStatus = domain.Status
 
 const (
-	Flyweight Division = iota
-	Bantamweight
-	Featherweight
-	Lightweight
-	Welterweight
-	Middleweight
-	Lightheavyweight
-	Heavyweight
-	WomensStrawweight
-	WomensFlyweight
-	WomensBantamweight
-	WomensFeatherweight
+	Flyweight           = domain.Flyweight
+	Bantamweight        = domain.Bantamweight
+	Featherweight       = domain.Featherweight
+	Lightweight         = domain.Lightweight
+	Welterweight        = domain.Welterweight
+	Middleweight        = domain.Middleweight
+	Lightheavyweight    = domain.Lightheavyweight
+	Heavyweight         = domain.Heavyweight
+	WomensStrawweight   = domain.WomensStrawweight
+	WomensFlyweight     = domain.WomensFlyweight
+	WomensBantamweight  = domain.WomensBantamweight
+	WomensFeatherweight = domain.WomensFeatherweight
+	Catchweight         = domain.Catchweight
 )
 
 // FighterStats represents statistical information for a fighter
@@ -99,10 +78,91 @@ type Fighter struct {
 	FighterUrl     string        `json:"fighterUrl"`
 	ImageUrl       string        `json:"imageUrl"`
 	Stats          FighterStats  `json:"stats"`
+	// Analytics is computed by the fighters service and is never stored
+	Analytics *FighterAnalytics `json:"analytics,omitempty"`
+}
+
+// FighterAnalytics represents metrics derived from fighter stats.
+// Percentages are in the 0-100 range, Percentiles maps a metric name to the fighter's
+// percentile rank within the division and holds only metrics the fighter has data for.
+type FighterAnalytics struct {
+	StrikingDifferential float32            `json:"strikingDifferential"`
+	FinishRate           float32            `json:"finishRate"`
+	KOPercentage         float32            `json:"koPercentage"`
+	SubPercentage        float32            `json:"subPercentage"`
+	DecPercentage        float32            `json:"decPercentage"`
+	TakedownSuccess      float32            `json:"takedownSuccess"`
+	AvgFightTimeSeconds  int32              `json:"avgFightTimeSeconds"`
+	Percentiles          map[string]float32 `json:"percentiles,omitempty"`
 }
 
-// FightersRequest represents a request for fighters
+// FightersRequest represents a request for fighters.
+// Division is optional, nil means fighters of all divisions.
 type FightersRequest struct {
-	Status      string  `json:"status"`
-	FightersIds []int32 `json:"fighter_ids"`
+	Status      FighterStatus `json:"status"`
+	Division    *Division     `json:"division,omitempty"`
+	FightersIds []int32       `json:"fighter_ids"`
+}
+
+// Limits of a fighters text search. A typeahead sends a query on every keystroke,
+// so one letter queries are rejected and only a short list of the best matches is returned.
+const (
+	TextSearchMinLength    = 2
+	TextSearchMaxLength    = 64
+	TextSearchDefaultLimit = 10
+	TextSearchMaxLimit     = 50
+)
+
+var ErrTextSearchQuery = fmt.Errorf("search query must be %d to %d characters long", TextSearchMinLength, TextSearchMaxLength)
+var ErrTextSearchLimit = errors.New("search limit must not be negative")
+
+// FightersTextSearchRequest represents a search of fighters by name, nickname, hometown or gym.
+// Status and Division narrow the search the same way as in FightersRequest.
+type FightersTextSearchRequest struct {
+	Query    string        `json:"query"`
+	Limit    int32         `json:"limit"`
+	Status   FighterStatus `json:"status"`
+	Division *Division     `json:"division,omitempty"`
+}
+
+// Normalize collapses whitespaces of the query and applies the default limit.
+// Limits above TextSearchMaxLimit are reduced to it. It returns an error if the query
+// is too short or too long, the limit is negative or the status is unknown.
+func (r *FightersTextSearchRequest) Normalize() error {
+	r.Query = strings.Join(strings.Fields(r.Query), " ")
+	if n := utf8.RuneCountInString(r.Query); n < TextSearchMinLength || n > TextSearchMaxLength {
+		return ErrTextSearchQuery
+	}
+
+	switch {
+	case r.Limit < 0:
+		return ErrTextSearchLimit
+	case r.Limit == 0:
+		r.Limit = TextSearchDefaultLimit
+	case r.Limit > TextSearchMaxLimit:
+		r.Limit = TextSearchMaxLimit
+	}
+
+	if r.Status != "" && !r.Status.IsValid() {
+		return domain.ErrUnknownStatus
+	}
+
+	return nil
+}
+
+// FighterImageRequest represents a request for a fighter thumbnail
+type FighterImageRequest struct {
+	FighterId int32  `json:"fighter_id"`
+	Size      string `json:"size"`
+	Format    string `json:"format"`
+}
+
+// FighterImage represents an encoded fighter thumbnail
+type FighterImage struct {
+	FighterId   int32  `json:"fighter_id"`
+	Size        string `json:"size"`
+	Format      string `json:"format"`
+	ContentType string `json:"content_type"`
+	ETag        string `json:"etag"`
+	Data        []byte `json:"-"`
 }
@@ -1,6 +1,9 @@
 package model
 
-import "pickfighter.com/gen"
+import (
+	"pickfighter.com/gen"
+	"pickfighter.com/pkg/domain"
+)
 
 // FighterToProto converts a single Fighter struct into a generated proto counterpart.
 func FighterToProto(f *Fighter) *gen.Fighter {
@@ -8,8 +11,8 @@ func FighterToProto(f *Fighter) *gen.Fighter {
 		FighterId:      f.FighterId,
 		Name:           f.Name,
 		NickName:       f.NickName,
-		Division:       int32(f.Division),
-		Status:         string(f.Status),
+		Division:       f.Division.Proto(),
+		Status:         f.Status.String(),
 		Hometown:       f.Hometown,
 		TrainsAt:       f.TrainsAt,
 		FightingStyle:  f.FightingStyle,
@@ -26,6 +29,7 @@ func FighterToProto(f *Fighter) *gen.Fighter {
 		FighterUrl:     f.FighterUrl,
 		ImageUrl:       f.ImageUrl,
 		Stats:          FighterStatsrToProto(&f.Stats),
+		Analytics:      FighterAnalyticsToProto(f.Analytics),
 	}
 }
 
@@ -52,6 +56,8 @@ func FighterFromProto(f *gen.Fighter) *Fighter {
 		TrainsAt:       f.TrainsAt,
 		FightingStyle:  f.FightingStyle,
 		Age:            int8(f.Age),
+		Height:         f.Height,
+		Weight:         f.Weight,
 		OctagonDebut:   f.OctagonDebut,
 		DebutTimestamp: int(f.DebutTimestamp),
 		Reach:          f.Reach,
@@ -62,9 +68,30 @@ func FighterFromProto(f *gen.Fighter) *Fighter {
 		FighterUrl:     f.FighterUrl,
 		ImageUrl:       f.ImageUrl,
 		Stats:          *FighterStatsFromProto(f.Stats),
+		Analytics:      FighterAnalyticsFromProto(f.Analytics),
 	}
 }
 
+// ParseFighterProto converts a generated proto counterpart into a single Fighter struct like FighterFromProto,
+// but rejects unknown divisions and statuses. It is used for fighters sent by clients of the fighters service.
+func ParseFighterProto(p *gen.Fighter) (*Fighter, error) {
+	status, err := statusFromProto(p.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	division, err := domain.DivisionFromProto(p.Division)
+	if err != nil {
+		return nil, err
+	}
+
+	f := FighterFromProto(p)
+	f.Status = status
+	f.Division = division
+
+	return f, nil
+}
+
 // FightersFromProto converts a slice of generated proto counterparts into a slice of Fighter structs.
 func FightersFromProto(fs []*gen.Fighter) []*Fighter {
 	var fighters = make([]*Fighter, len(fs))
@@ -102,7 +129,12 @@ func FighterStatsrToProto(f *FighterStats) *gen.FighterStats {
 }
 
 // FighterStatsFromProto converts a generated proto counterpart into a single Fighter stats struct..
+// Missing stats are converted into empty ones.
 func FighterStatsFromProto(f *gen.FighterStats) *FighterStats {
+	if f == nil {
+		return &FighterStats{}
+	}
+
 	return &FighterStats{
 		StatId:               f.StatId,
 		FighterId:            f.FighterId,
@@ -126,18 +158,165 @@ func FighterStatsFromProto(f *gen.FighterStats) *FighterStats {
 	}
 }
 
+// FighterAnalyticsToProto converts fighter analytics into a generated proto counterpart.
+// It returns nil if analytics were not computed.
+func FighterAnalyticsToProto(a *FighterAnalytics) *gen.FighterAnalytics {
+	if a == nil {
+		return nil
+	}
+
+	return &gen.FighterAnalytics{
+		StrikingDifferential: a.StrikingDifferential,
+		FinishRate:           a.FinishRate,
+		KoPercentage:         a.KOPercentage,
+		SubPercentage:        a.SubPercentage,
+		DecPercentage:        a.DecPercentage,
+		TakedownSuccess:      a.TakedownSuccess,
+		AvgFightTimeSeconds:  a.AvgFightTimeSeconds,
+		Percentiles:          a.Percentiles,
+	}
+}
+
+// FighterAnalyticsFromProto converts a generated proto counterpart into fighter analytics.
+func FighterAnalyticsFromProto(a *gen.FighterAnalytics) *FighterAnalytics {
+	if a == nil {
+		return nil
+	}
+
+	return &FighterAnalytics{
+		StrikingDifferential: a.StrikingDifferential,
+		FinishRate:           a.FinishRate,
+		KOPercentage:         a.KoPercentage,
+		SubPercentage:        a.SubPercentage,
+		DecPercentage:        a.DecPercentage,
+		TakedownSuccess:      a.TakedownSuccess,
+		AvgFightTimeSeconds:  a.AvgFightTimeSeconds,
+		Percentiles:          a.Percentiles,
+	}
+}
+
 func FightersReqToProto(freq FightersRequest) *gen.FightersRequest {
 	req := &gen.FightersRequest{
-		Status: freq.Status,
+		Status: freq.Status.String(),
 	}
 
 	if freq.FightersIds != nil && len(freq.FightersIds) > 0 {
 		req.FightersIds = freq.FightersIds
 	}
 
+	if freq.Division != nil {
+		division := freq.Division.Proto()
+		req.Division = &division
+	}
+
+	return req
+}
+
+// FightersReqFromProto converts a generated proto request into a FightersRequest.
+// It returns an error wrapping domain.ErrUnknownStatus or domain.ErrUnknownDivision for unknown filters.
+func FightersReqFromProto(p *gen.FightersRequest) (*FightersRequest, error) {
+	status, err := statusFromProto(p.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	division, err := divisionFromProto(p.Division)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FightersRequest{
+		Status:      status,
+		Division:    division,
+		FightersIds: p.FightersIds,
+	}, nil
+}
+
+// FightersTextSearchReqToProto converts a FightersTextSearchRequest struct into a generated proto counterpart.
+func FightersTextSearchReqToProto(r *FightersTextSearchRequest) *gen.FightersTextSearchRequest {
+	req := &gen.FightersTextSearchRequest{
+		Query:  r.Query,
+		Limit:  r.Limit,
+		Status: r.Status.String(),
+	}
+
+	if r.Division != nil {
+		division := r.Division.Proto()
+		req.Division = &division
+	}
+
 	return req
 }
 
+// FightersTextSearchReqFromProto converts a generated proto request into a FightersTextSearchRequest.
+// It returns an error wrapping domain.ErrUnknownStatus or domain.ErrUnknownDivision for unknown filters.
+func FightersTextSearchReqFromProto(p *gen.FightersTextSearchRequest) (*FightersTextSearchRequest, error) {
+	status, err := statusFromProto(p.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	division, err := divisionFromProto(p.Division)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FightersTextSearchRequest{
+		Query:    p.Query,
+		Limit:    p.Limit,
+		Status:   status,
+		Division: division,
+	}, nil
+}
+
+// statusFromProto parses a proto fighter status. An empty status stays empty,
+// it means that the status is not known or not filtered by.
+func statusFromProto(s string) (FighterStatus, error) {
+	if s == "" {
+		return "", nil
+	}
+
+	return domain.ParseStatus(s)
+}
+
+// divisionFromProto parses an optional proto division, nil stays nil.
+func divisionFromProto(v *int32) (*Division, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	division, err := domain.DivisionFromProto(*v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &division, nil
+}
+
+// FighterImageToProto converts a FighterImage struct into a generated proto counterpart.
+func FighterImageToProto(img *FighterImage) *gen.FighterImageResponse {
+	return &gen.FighterImageResponse{
+		FighterId:   img.FighterId,
+		Size:        img.Size,
+		Format:      img.Format,
+		ContentType: img.ContentType,
+		Etag:        img.ETag,
+		Data:        img.Data,
+	}
+}
+
+// FighterImageFromProto converts a generated proto counterpart into a FighterImage struct.
+func FighterImageFromProto(p *gen.FighterImageResponse) *FighterImage {
+	return &FighterImage{
+		FighterId:   p.FighterId,
+		Size:        p.Size,
+		Format:      p.Format,
+		ContentType: p.ContentType,
+		ETag:        p.Etag,
+		Data:        p.Data,
+	}
+}
+
 // HealthStatusToProto converts HealthStatus model to gen.HealthResponse
 func HealthStatusToProto(status *HealthStatus) *gen.HealthResponse {
 	return &gen.HealthResponse{
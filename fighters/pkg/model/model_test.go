@@ -1,10 +1,12 @@
 package model
 
 import (
+	"strings"
 	"testing"
 
 	"pickfighter.com/gen"
 	"github.com/stretchr/testify/assert"
+	"pickfighter.com/pkg/domain"
 )
 
 func TestFighterString(t *testing.T) {
@@ -854,6 +856,17 @@ func TestFightersReqToProto(t *testing.T) {
 				Status: "",
 			},
 		},
+		{
+			name: "Case with Division",
+			input: FightersRequest{
+				Status:   "Active",
+				Division: divisionPtr(Flyweight),
+			},
+			expected: &gen.FightersRequest{
+				Status:   "Active",
+				Division: int32Ptr(0),
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -863,3 +876,209 @@ func TestFightersReqToProto(t *testing.T) {
 		})
 	}
 }
+
+func TestFightersReqFromProto(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *gen.FightersRequest
+		expected    *FightersRequest
+		expectedErr error
+	}{
+		{
+			name: "Without Division",
+			input: &gen.FightersRequest{
+				Status:      "Active",
+				FightersIds: []int32{1, 2},
+			},
+			expected: &FightersRequest{
+				Status:      "Active",
+				FightersIds: []int32{1, 2},
+			},
+		},
+		{
+			name: "With Division",
+			input: &gen.FightersRequest{
+				Division: int32Ptr(3),
+			},
+			expected: &FightersRequest{
+				Division: divisionPtr(Lightweight),
+			},
+		},
+		{
+			name: "Status is normalized",
+			input: &gen.FightersRequest{
+				Status: "not_fighting",
+			},
+			expected: &FightersRequest{
+				Status: domain.StatusNotFighting,
+			},
+		},
+		{
+			name: "Unknown Status",
+			input: &gen.FightersRequest{
+				Status: "Active' OR '1'='1",
+			},
+			expectedErr: domain.ErrUnknownStatus,
+		},
+		{
+			name: "Unknown Division",
+			input: &gen.FightersRequest{
+				Division: int32Ptr(99),
+			},
+			expectedErr: domain.ErrUnknownDivision,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := FightersReqFromProto(tc.input)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestParseFighterProto(t *testing.T) {
+	f, err := ParseFighterProto(&gen.Fighter{Name: "Fabio Agu", Division: 5, Status: "active"})
+	assert.NoError(t, err)
+	assert.Equal(t, Division(Middleweight), f.Division)
+	assert.Equal(t, domain.StatusActive, f.Status)
+
+	f, err = ParseFighterProto(&gen.Fighter{Name: "Fabio Agu", Division: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, FighterStatus(""), f.Status)
+
+	_, err = ParseFighterProto(&gen.Fighter{Name: "Fabio Agu", Division: 5, Status: "Inactive"})
+	assert.ErrorIs(t, err, domain.ErrUnknownStatus)
+
+	_, err = ParseFighterProto(&gen.Fighter{Name: "Fabio Agu", Division: -1})
+	assert.ErrorIs(t, err, domain.ErrUnknownDivision)
+}
+
+func TestFighterImageProto(t *testing.T) {
+	img := &FighterImage{
+		FighterId:   7,
+		Size:        "small",
+		Format:      "webp",
+		ContentType: "image/webp",
+		ETag:        "abc",
+		Data:        []byte{1, 2, 3},
+	}
+
+	p := FighterImageToProto(img)
+	assert.Equal(t, &gen.FighterImageResponse{
+		FighterId:   7,
+		Size:        "small",
+		Format:      "webp",
+		ContentType: "image/webp",
+		Etag:        "abc",
+		Data:        []byte{1, 2, 3},
+	}, p)
+	assert.Equal(t, img, FighterImageFromProto(p))
+}
+
+func TestFighterAnalyticsProto(t *testing.T) {
+	assert.Nil(t, FighterAnalyticsToProto(nil))
+	assert.Nil(t, FighterAnalyticsFromProto(nil))
+
+	a := &FighterAnalytics{
+		StrikingDifferential: -1.5,
+		FinishRate:           75,
+		KOPercentage:         50,
+		SubPercentage:        25,
+		DecPercentage:        25,
+		TakedownSuccess:      33.33,
+		AvgFightTimeSeconds:  538,
+		Percentiles:          map[string]float32{"finishRate": 90.5},
+	}
+
+	f := FighterFromProto(FighterToProto(&Fighter{FighterId: 1, Analytics: a}))
+	assert.Equal(t, a, f.Analytics)
+}
+
+func TestFightersTextSearchReqProto(t *testing.T) {
+	req := &FightersTextSearchRequest{
+		Query:    "izzy",
+		Limit:    5,
+		Status:   "Active",
+		Division: divisionPtr(Middleweight),
+	}
+
+	p := FightersTextSearchReqToProto(req)
+	assert.Equal(t, &gen.FightersTextSearchRequest{
+		Query:    "izzy",
+		Limit:    5,
+		Status:   "Active",
+		Division: int32Ptr(5),
+	}, p)
+	actual, err := FightersTextSearchReqFromProto(p)
+	assert.NoError(t, err)
+	assert.Equal(t, req, actual)
+
+	_, err = FightersTextSearchReqFromProto(&gen.FightersTextSearchRequest{Query: "izzy", Status: "Inactive"})
+	assert.ErrorIs(t, err, domain.ErrUnknownStatus)
+
+	_, err = FightersTextSearchReqFromProto(&gen.FightersTextSearchRequest{Query: "izzy", Division: int32Ptr(99)})
+	assert.ErrorIs(t, err, domain.ErrUnknownDivision)
+
+	p = FightersTextSearchReqToProto(&FightersTextSearchRequest{Query: "izzy"})
+	assert.Nil(t, p.Division)
+}
+
+func TestFightersTextSearchRequestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      FightersTextSearchRequest
+		expected FightersTextSearchRequest
+		err      error
+	}{
+		{
+			name:     "Default limit",
+			req:      FightersTextSearchRequest{Query: "  Israel   Adesanya "},
+			expected: FightersTextSearchRequest{Query: "Israel Adesanya", Limit: TextSearchDefaultLimit},
+		},
+		{
+			name:     "Max limit",
+			req:      FightersTextSearchRequest{Query: "Jó", Limit: 1000, Status: "Active"},
+			expected: FightersTextSearchRequest{Query: "Jó", Limit: TextSearchMaxLimit, Status: "Active"},
+		},
+		{
+			name: "Too short",
+			req:  FightersTextSearchRequest{Query: " K "},
+			err:  ErrTextSearchQuery,
+		},
+		{
+			name: "Too long",
+			req:  FightersTextSearchRequest{Query: strings.Repeat("a", TextSearchMaxLength+1)},
+			err:  ErrTextSearchQuery,
+		},
+		{
+			name: "Negative limit",
+			req:  FightersTextSearchRequest{Query: "Khabib", Limit: -1},
+			err:  ErrTextSearchLimit,
+		},
+		{
+			name: "Unknown status",
+			req:  FightersTextSearchRequest{Query: "Khabib", Status: "inactive"},
+			err:  domain.ErrUnknownStatus,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Normalize()
+			assert.Equal(t, tc.err, err)
+			if err == nil {
+				assert.Equal(t, tc.expected, tc.req)
+			}
+		})
+	}
+}
+
+func divisionPtr(d Division) *Division {
+	return &d
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
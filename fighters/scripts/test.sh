@@ -6,8 +6,13 @@ packages=(
     "./internal/handler/grpc"
     "./internal/controller/fighters"
     "./internal/repository/psql"
+    "./pkg/analytics"
+    "./pkg/blob"
     "./pkg/cfg"
+    "./pkg/dedupe"
     "./pkg/errors"
+    "./pkg/export"
+    "./pkg/images"
     "./pkg/model"
 )
 
@@ -32,6 +32,7 @@ type RegisterRequest struct {
 	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Token    string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
 	TermsOk  bool   `protobuf:"varint,5,opt,name=termsOk,proto3" json:"termsOk,omitempty"`
+	Locale   string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *RegisterRequest) Reset() {
@@ -101,6 +102,13 @@ func (x *RegisterRequest) GetTermsOk() bool {
 	return false
 }
 
+func (x *RegisterRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -358,9 +366,13 @@ type AuthenticateResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokenId        string                 `protobuf:"bytes,1,opt,name=tokenId,proto3" json:"tokenId,omitempty"`
-	AccessToken    string                 `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ExpirationTime,proto3" json:"ExpirationTime,omitempty"`
+	TokenId               string                 `protobuf:"bytes,1,opt,name=tokenId,proto3" json:"tokenId,omitempty"`
+	AccessToken           string                 `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	ExpirationTime        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ExpirationTime,proto3" json:"ExpirationTime,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	RefreshExpirationTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=refreshExpirationTime,proto3" json:"refreshExpirationTime,omitempty"`
+	Challenge             *TwoFactorChallenge    `protobuf:"bytes,6,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	RecoveryCodes         []string               `protobuf:"bytes,7,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"`
 }
 
 func (x *AuthenticateResponse) Reset() {
@@ -416,16 +428,46 @@ func (x *AuthenticateResponse) GetExpirationTime() *timestamppb.Timestamp {
 	return nil
 }
 
-type PasswordResetRequest struct {
+func (x *AuthenticateResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthenticateResponse) GetRefreshExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshExpirationTime
+	}
+	return nil
+}
+
+func (x *AuthenticateResponse) GetChallenge() *TwoFactorChallenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *AuthenticateResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type TwoFactorChallenge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Token              string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpirationTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expirationTime,proto3" json:"expirationTime,omitempty"`
+	EnrollmentRequired bool                   `protobuf:"varint,3,opt,name=enrollmentRequired,proto3" json:"enrollmentRequired,omitempty"`
 }
 
-func (x *PasswordResetRequest) Reset() {
-	*x = PasswordResetRequest{}
+func (x *TwoFactorChallenge) Reset() {
+	*x = TwoFactorChallenge{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -433,13 +475,13 @@ func (x *PasswordResetRequest) Reset() {
 	}
 }
 
-func (x *PasswordResetRequest) String() string {
+func (x *TwoFactorChallenge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordResetRequest) ProtoMessage() {}
+func (*TwoFactorChallenge) ProtoMessage() {}
 
-func (x *PasswordResetRequest) ProtoReflect() protoreflect.Message {
+func (x *TwoFactorChallenge) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -451,28 +493,44 @@ func (x *PasswordResetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordResetRequest.ProtoReflect.Descriptor instead.
-func (*PasswordResetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TwoFactorChallenge.ProtoReflect.Descriptor instead.
+func (*TwoFactorChallenge) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PasswordResetRequest) GetEmail() string {
+func (x *TwoFactorChallenge) GetToken() string {
 	if x != nil {
-		return x.Email
+		return x.Token
 	}
 	return ""
 }
 
-type PasswordResetResponse struct {
+func (x *TwoFactorChallenge) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *TwoFactorChallenge) GetEnrollmentRequired() bool {
+	if x != nil {
+		return x.EnrollmentRequired
+	}
+	return false
+}
+
+type RefreshTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *emptypb.Empty `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	UserAgent    string `protobuf:"bytes,2,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	IpAddress    string `protobuf:"bytes,3,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
 }
 
-func (x *PasswordResetResponse) Reset() {
-	*x = PasswordResetResponse{}
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,13 +538,13 @@ func (x *PasswordResetResponse) Reset() {
 	}
 }
 
-func (x *PasswordResetResponse) String() string {
+func (x *RefreshTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordResetResponse) ProtoMessage() {}
+func (*RefreshTokenRequest) ProtoMessage() {}
 
-func (x *PasswordResetResponse) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -498,30 +556,45 @@ func (x *PasswordResetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordResetResponse.ProtoReflect.Descriptor instead.
-func (*PasswordResetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PasswordResetResponse) GetResponse() *emptypb.Empty {
+func (x *RefreshTokenRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Response
+		return x.RefreshToken
 	}
-	return nil
+	return ""
 }
 
-type PasswordRecoveryRequest struct {
+func (x *RefreshTokenRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *RefreshTokenRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type RevokeTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token           string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Password        string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	ConfirmPassword string `protobuf:"bytes,3,opt,name=confirmPassword,proto3" json:"confirmPassword,omitempty"`
+	TokenId   string `protobuf:"bytes,1,opt,name=tokenId,proto3" json:"tokenId,omitempty"`
+	UserId    int32  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	SessionId string `protobuf:"bytes,3,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (x *PasswordRecoveryRequest) Reset() {
-	*x = PasswordRecoveryRequest{}
+func (x *RevokeTokenRequest) Reset() {
+	*x = RevokeTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -529,13 +602,13 @@ func (x *PasswordRecoveryRequest) Reset() {
 	}
 }
 
-func (x *PasswordRecoveryRequest) String() string {
+func (x *RevokeTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordRecoveryRequest) ProtoMessage() {}
+func (*RevokeTokenRequest) ProtoMessage() {}
 
-func (x *PasswordRecoveryRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokeTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -547,42 +620,50 @@ func (x *PasswordRecoveryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordRecoveryRequest.ProtoReflect.Descriptor instead.
-func (*PasswordRecoveryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PasswordRecoveryRequest) GetToken() string {
+func (x *RevokeTokenRequest) GetTokenId() string {
 	if x != nil {
-		return x.Token
+		return x.TokenId
 	}
 	return ""
 }
 
-func (x *PasswordRecoveryRequest) GetPassword() string {
+func (x *RevokeTokenRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Password
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *PasswordRecoveryRequest) GetConfirmPassword() string {
+func (x *RevokeTokenRequest) GetSessionId() string {
 	if x != nil {
-		return x.ConfirmPassword
+		return x.SessionId
 	}
 	return ""
 }
 
-type PasswordRecoveryResponse struct {
+func (x *RevokeTokenRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RevokeUserTokensRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *emptypb.Empty `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	UserId int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Before int64 `protobuf:"varint,2,opt,name=before,proto3" json:"before,omitempty"`
 }
 
-func (x *PasswordRecoveryResponse) Reset() {
-	*x = PasswordRecoveryResponse{}
+func (x *RevokeUserTokensRequest) Reset() {
+	*x = RevokeUserTokensRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -590,13 +671,13 @@ func (x *PasswordRecoveryResponse) Reset() {
 	}
 }
 
-func (x *PasswordRecoveryResponse) String() string {
+func (x *RevokeUserTokensRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordRecoveryResponse) ProtoMessage() {}
+func (*RevokeUserTokensRequest) ProtoMessage() {}
 
-func (x *PasswordRecoveryResponse) ProtoReflect() protoreflect.Message {
+func (x *RevokeUserTokensRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -608,29 +689,35 @@ func (x *PasswordRecoveryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordRecoveryResponse.ProtoReflect.Descriptor instead.
-func (*PasswordRecoveryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeUserTokensRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserTokensRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PasswordRecoveryResponse) GetResponse() *emptypb.Empty {
+func (x *RevokeUserTokensRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Response
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type ProfileRequest struct {
+func (x *RevokeUserTokensRequest) GetBefore() int64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+type RevokeTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Response *emptypb.Empty `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *ProfileRequest) Reset() {
-	*x = ProfileRequest{}
+func (x *RevokeTokenResponse) Reset() {
+	*x = RevokeTokenResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -638,13 +725,13 @@ func (x *ProfileRequest) Reset() {
 	}
 }
 
-func (x *ProfileRequest) String() string {
+func (x *RevokeTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileRequest) ProtoMessage() {}
+func (*RevokeTokenResponse) ProtoMessage() {}
 
-func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokeTokenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -656,35 +743,31 @@ func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileRequest.ProtoReflect.Descriptor instead.
-func (*ProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ProfileRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *ProfileRequest) GetName() string {
+func (x *RevokeTokenResponse) GetResponse() *emptypb.Empty {
 	if x != nil {
-		return x.Name
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-type ProfileResponse struct {
+type TokenStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	TokenId   string `protobuf:"bytes,1,opt,name=tokenId,proto3" json:"tokenId,omitempty"`
+	UserId    int32  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	IssuedAt  int64  `protobuf:"varint,3,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	SessionId string `protobuf:"bytes,4,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
 }
 
-func (x *ProfileResponse) Reset() {
-	*x = ProfileResponse{}
+func (x *TokenStatusRequest) Reset() {
+	*x = TokenStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,13 +775,13 @@ func (x *ProfileResponse) Reset() {
 	}
 }
 
-func (x *ProfileResponse) String() string {
+func (x *TokenStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileResponse) ProtoMessage() {}
+func (*TokenStatusRequest) ProtoMessage() {}
 
-func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *TokenStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,36 +793,50 @@ func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
-func (*ProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenStatusRequest.ProtoReflect.Descriptor instead.
+func (*TokenStatusRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ProfileResponse) GetUser() *User {
+func (x *TokenStatusRequest) GetTokenId() string {
 	if x != nil {
-		return x.User
+		return x.TokenId
 	}
-	return nil
+	return ""
 }
 
-type User struct {
+func (x *TokenStatusRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TokenStatusRequest) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *TokenStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type TokenStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Rank      string `protobuf:"bytes,4,opt,name=rank,proto3" json:"rank,omitempty"`
-	Claim     string `protobuf:"bytes,5,opt,name=claim,proto3" json:"claim,omitempty"`
-	Roles     uint64 `protobuf:"varint,6,opt,name=roles,proto3" json:"roles,omitempty"`
-	Flags     uint64 `protobuf:"varint,7,opt,name=flags,proto3" json:"flags,omitempty"`
-	CreatedAt int64  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt int64  `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Revoked       bool  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevokedBefore int64 `protobuf:"varint,2,opt,name=revokedBefore,proto3" json:"revokedBefore,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *TokenStatusResponse) Reset() {
+	*x = TokenStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -747,13 +844,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *TokenStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*TokenStatusResponse) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *TokenStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -765,85 +862,36 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenStatusResponse.ProtoReflect.Descriptor instead.
+func (*TokenStatusResponse) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *User) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *User) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *User) GetRank() string {
-	if x != nil {
-		return x.Rank
-	}
-	return ""
-}
-
-func (x *User) GetClaim() string {
-	if x != nil {
-		return x.Claim
-	}
-	return ""
-}
-
-func (x *User) GetRoles() uint64 {
-	if x != nil {
-		return x.Roles
-	}
-	return 0
-}
-
-func (x *User) GetFlags() uint64 {
-	if x != nil {
-		return x.Flags
-	}
-	return 0
-}
-
-func (x *User) GetCreatedAt() int64 {
+func (x *TokenStatusResponse) GetRevoked() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.Revoked
 	}
-	return 0
+	return false
 }
 
-func (x *User) GetUpdatedAt() int64 {
+func (x *TokenStatusResponse) GetRevokedBefore() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.RevokedBefore
 	}
 	return 0
 }
 
-type CreateEventRequest struct {
+type OAuthLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Fights []*Fight `protobuf:"bytes,2,rep,name=fights,proto3" json:"fights,omitempty"`
+	Provider   string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	RememberMe bool   `protobuf:"varint,2,opt,name=rememberMe,proto3" json:"rememberMe,omitempty"`
 }
 
-func (x *CreateEventRequest) Reset() {
-	*x = CreateEventRequest{}
+func (x *OAuthLoginRequest) Reset() {
+	*x = OAuthLoginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,13 +899,13 @@ func (x *CreateEventRequest) Reset() {
 	}
 }
 
-func (x *CreateEventRequest) String() string {
+func (x *OAuthLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventRequest) ProtoMessage() {}
+func (*OAuthLoginRequest) ProtoMessage() {}
 
-func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+func (x *OAuthLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,35 +917,37 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
-func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OAuthLoginRequest.ProtoReflect.Descriptor instead.
+func (*OAuthLoginRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateEventRequest) GetName() string {
+func (x *OAuthLoginRequest) GetProvider() string {
 	if x != nil {
-		return x.Name
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *CreateEventRequest) GetFights() []*Fight {
+func (x *OAuthLoginRequest) GetRememberMe() bool {
 	if x != nil {
-		return x.Fights
+		return x.RememberMe
 	}
-	return nil
+	return false
 }
 
-type CreateEventResponse struct {
+type OAuthLoginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventId int32 `protobuf:"varint,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	AuthUrl   string `protobuf:"bytes,1,opt,name=authUrl,proto3" json:"authUrl,omitempty"`
+	State     string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (x *CreateEventResponse) Reset() {
-	*x = CreateEventResponse{}
+func (x *OAuthLoginResponse) Reset() {
+	*x = OAuthLoginResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -905,13 +955,13 @@ func (x *CreateEventResponse) Reset() {
 	}
 }
 
-func (x *CreateEventResponse) String() string {
+func (x *OAuthLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventResponse) ProtoMessage() {}
+func (*OAuthLoginResponse) ProtoMessage() {}
 
-func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
+func (x *OAuthLoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -923,28 +973,46 @@ func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
-func (*CreateEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OAuthLoginResponse.ProtoReflect.Descriptor instead.
+func (*OAuthLoginResponse) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateEventResponse) GetEventId() int32 {
+func (x *OAuthLoginResponse) GetAuthUrl() string {
 	if x != nil {
-		return x.EventId
+		return x.AuthUrl
+	}
+	return ""
+}
+
+func (x *OAuthLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OAuthLoginResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-type GetEventsRequest struct {
+type OAuthCallbackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *emptypb.Empty `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Provider  string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State     string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	UserAgent string `protobuf:"bytes,4,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	IpAddress string `protobuf:"bytes,5,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
 }
 
-func (x *GetEventsRequest) Reset() {
-	*x = GetEventsRequest{}
+func (x *OAuthCallbackRequest) Reset() {
+	*x = OAuthCallbackRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -952,13 +1020,13 @@ func (x *GetEventsRequest) Reset() {
 	}
 }
 
-func (x *GetEventsRequest) String() string {
+func (x *OAuthCallbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsRequest) ProtoMessage() {}
+func (*OAuthCallbackRequest) ProtoMessage() {}
 
-func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *OAuthCallbackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,29 +1038,59 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OAuthCallbackRequest.ProtoReflect.Descriptor instead.
+func (*OAuthCallbackRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetEventsRequest) GetResponse() *emptypb.Empty {
+func (x *OAuthCallbackRequest) GetProvider() string {
 	if x != nil {
-		return x.Response
+		return x.Provider
 	}
-	return nil
+	return ""
 }
 
-type GetEventsResponse struct {
+func (x *OAuthCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OAuthCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OAuthCallbackRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *OAuthCallbackRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type TwoFactorLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count  int32    `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
-	Events []*Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challengeToken,proto3" json:"challengeToken,omitempty"`
+	Code           string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	UserAgent      string `protobuf:"bytes,3,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	IpAddress      string `protobuf:"bytes,4,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
 }
 
-func (x *GetEventsResponse) Reset() {
-	*x = GetEventsResponse{}
+func (x *TwoFactorLoginRequest) Reset() {
+	*x = TwoFactorLoginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1000,13 +1098,13 @@ func (x *GetEventsResponse) Reset() {
 	}
 }
 
-func (x *GetEventsResponse) String() string {
+func (x *TwoFactorLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsResponse) ProtoMessage() {}
+func (*TwoFactorLoginRequest) ProtoMessage() {}
 
-func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *TwoFactorLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1018,38 +1116,50 @@ func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TwoFactorLoginRequest.ProtoReflect.Descriptor instead.
+func (*TwoFactorLoginRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetEventsResponse) GetCount() int32 {
+func (x *TwoFactorLoginRequest) GetChallengeToken() string {
 	if x != nil {
-		return x.Count
+		return x.ChallengeToken
 	}
-	return 0
+	return ""
 }
 
-func (x *GetEventsResponse) GetEvents() []*Event {
+func (x *TwoFactorLoginRequest) GetCode() string {
 	if x != nil {
-		return x.Events
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type CreateBetRequest struct {
+func (x *TwoFactorLoginRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *TwoFactorLoginRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type TOTPEnrollRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BetId     int32 `protobuf:"varint,1,opt,name=betId,proto3" json:"betId,omitempty"`
-	FightId   int32 `protobuf:"varint,2,opt,name=fightId,proto3" json:"fightId,omitempty"`
-	UserId    int32 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
-	FighterId int32 `protobuf:"varint,4,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
+	UserId         int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ChallengeToken string `protobuf:"bytes,2,opt,name=challengeToken,proto3" json:"challengeToken,omitempty"`
 }
 
-func (x *CreateBetRequest) Reset() {
-	*x = CreateBetRequest{}
+func (x *TOTPEnrollRequest) Reset() {
+	*x = TOTPEnrollRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1057,13 +1167,13 @@ func (x *CreateBetRequest) Reset() {
 	}
 }
 
-func (x *CreateBetRequest) String() string {
+func (x *TOTPEnrollRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBetRequest) ProtoMessage() {}
+func (*TOTPEnrollRequest) ProtoMessage() {}
 
-func (x *CreateBetRequest) ProtoReflect() protoreflect.Message {
+func (x *TOTPEnrollRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1075,49 +1185,36 @@ func (x *CreateBetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBetRequest.ProtoReflect.Descriptor instead.
-func (*CreateBetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TOTPEnrollRequest.ProtoReflect.Descriptor instead.
+func (*TOTPEnrollRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateBetRequest) GetBetId() int32 {
-	if x != nil {
-		return x.BetId
-	}
-	return 0
-}
-
-func (x *CreateBetRequest) GetFightId() int32 {
-	if x != nil {
-		return x.FightId
-	}
-	return 0
-}
-
-func (x *CreateBetRequest) GetUserId() int32 {
+func (x *TOTPEnrollRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *CreateBetRequest) GetFighterId() int32 {
+func (x *TOTPEnrollRequest) GetChallengeToken() string {
 	if x != nil {
-		return x.FighterId
+		return x.ChallengeToken
 	}
-	return 0
+	return ""
 }
 
-type CreateBetResponse struct {
+type TOTPEnrollResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BetId int32 `protobuf:"varint,1,opt,name=betId,proto3" json:"betId,omitempty"`
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri    string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *CreateBetResponse) Reset() {
-	*x = CreateBetResponse{}
+func (x *TOTPEnrollResponse) Reset() {
+	*x = TOTPEnrollResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1125,13 +1222,13 @@ func (x *CreateBetResponse) Reset() {
 	}
 }
 
-func (x *CreateBetResponse) String() string {
+func (x *TOTPEnrollResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBetResponse) ProtoMessage() {}
+func (*TOTPEnrollResponse) ProtoMessage() {}
 
-func (x *CreateBetResponse) ProtoReflect() protoreflect.Message {
+func (x *TOTPEnrollResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1143,28 +1240,36 @@ func (x *CreateBetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBetResponse.ProtoReflect.Descriptor instead.
-func (*CreateBetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TOTPEnrollResponse.ProtoReflect.Descriptor instead.
+func (*TOTPEnrollResponse) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateBetResponse) GetBetId() int32 {
+func (x *TOTPEnrollResponse) GetSecret() string {
 	if x != nil {
-		return x.BetId
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-type BetsRequest struct {
+func (x *TOTPEnrollResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type TOTPCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserId int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *BetsRequest) Reset() {
-	*x = BetsRequest{}
+func (x *TOTPCodeRequest) Reset() {
+	*x = TOTPCodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1172,13 +1277,13 @@ func (x *BetsRequest) Reset() {
 	}
 }
 
-func (x *BetsRequest) String() string {
+func (x *TOTPCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BetsRequest) ProtoMessage() {}
+func (*TOTPCodeRequest) ProtoMessage() {}
 
-func (x *BetsRequest) ProtoReflect() protoreflect.Message {
+func (x *TOTPCodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1190,29 +1295,33 @@ func (x *BetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BetsRequest.ProtoReflect.Descriptor instead.
-func (*BetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TOTPCodeRequest.ProtoReflect.Descriptor instead.
+func (*TOTPCodeRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *BetsRequest) GetUserId() int32 {
+func (x *TOTPCodeRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type BetsResponse struct {
+func (x *TOTPCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type TOTPDisableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Count int32  `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
-	Bets  []*Bet `protobuf:"bytes,2,rep,name=bets,proto3" json:"bets,omitempty"`
 }
 
-func (x *BetsResponse) Reset() {
-	*x = BetsResponse{}
+func (x *TOTPDisableResponse) Reset() {
+	*x = TOTPDisableResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1220,13 +1329,13 @@ func (x *BetsResponse) Reset() {
 	}
 }
 
-func (x *BetsResponse) String() string {
+func (x *TOTPDisableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BetsResponse) ProtoMessage() {}
+func (*TOTPDisableResponse) ProtoMessage() {}
 
-func (x *BetsResponse) ProtoReflect() protoreflect.Message {
+func (x *TOTPDisableResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1238,37 +1347,21 @@ func (x *BetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BetsResponse.ProtoReflect.Descriptor instead.
-func (*BetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TOTPDisableResponse.ProtoReflect.Descriptor instead.
+func (*TOTPDisableResponse) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *BetsResponse) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *BetsResponse) GetBets() []*Bet {
-	if x != nil {
-		return x.Bets
-	}
-	return nil
-}
-
-type FightResultRequest struct {
+type RecoveryCodesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FightId    int32 `protobuf:"varint,1,opt,name=fightId,proto3" json:"fightId,omitempty"`
-	WinnerId   int32 `protobuf:"varint,2,opt,name=winnerId,proto3" json:"winnerId,omitempty"`
-	NotContest bool  `protobuf:"varint,3,opt,name=notContest,proto3" json:"notContest,omitempty"`
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
 }
 
-func (x *FightResultRequest) Reset() {
-	*x = FightResultRequest{}
+func (x *RecoveryCodesResponse) Reset() {
+	*x = RecoveryCodesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1276,13 +1369,13 @@ func (x *FightResultRequest) Reset() {
 	}
 }
 
-func (x *FightResultRequest) String() string {
+func (x *RecoveryCodesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FightResultRequest) ProtoMessage() {}
+func (*RecoveryCodesResponse) ProtoMessage() {}
 
-func (x *FightResultRequest) ProtoReflect() protoreflect.Message {
+func (x *RecoveryCodesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1294,42 +1387,38 @@ func (x *FightResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FightResultRequest.ProtoReflect.Descriptor instead.
-func (*FightResultRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RecoveryCodesResponse) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FightResultRequest) GetFightId() int32 {
-	if x != nil {
-		return x.FightId
-	}
-	return 0
-}
-
-func (x *FightResultRequest) GetWinnerId() int32 {
-	if x != nil {
-		return x.WinnerId
-	}
-	return 0
-}
-
-func (x *FightResultRequest) GetNotContest() bool {
+func (x *RecoveryCodesResponse) GetCodes() []string {
 	if x != nil {
-		return x.NotContest
+		return x.Codes
 	}
-	return false
+	return nil
 }
 
-type FightResultResponse struct {
+type OutboxEmail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FightId int32 `protobuf:"varint,1,opt,name=fightId,proto3" json:"fightId,omitempty"`
-}
-
-func (x *FightResultResponse) Reset() {
-	*x = FightResultResponse{}
+	EmailId        int64  `protobuf:"varint,1,opt,name=emailId,proto3" json:"emailId,omitempty"`
+	Subject        string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	RecipientEmail string `protobuf:"bytes,3,opt,name=recipientEmail,proto3" json:"recipientEmail,omitempty"`
+	RecipientName  string `protobuf:"bytes,4,opt,name=recipientName,proto3" json:"recipientName,omitempty"`
+	Locale         string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	Status         string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int32  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  int64  `protobuf:"varint,8,opt,name=nextAttemptAt,proto3" json:"nextAttemptAt,omitempty"`
+	LastError      string `protobuf:"bytes,9,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt      int64  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	SentAt         int64  `protobuf:"varint,11,opt,name=sentAt,proto3" json:"sentAt,omitempty"`
+}
+
+func (x *OutboxEmail) Reset() {
+	*x = OutboxEmail{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1337,13 +1426,13 @@ func (x *FightResultResponse) Reset() {
 	}
 }
 
-func (x *FightResultResponse) String() string {
+func (x *OutboxEmail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FightResultResponse) ProtoMessage() {}
+func (*OutboxEmail) ProtoMessage() {}
 
-func (x *FightResultResponse) ProtoReflect() protoreflect.Message {
+func (x *OutboxEmail) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1355,150 +1444,153 @@ func (x *FightResultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FightResultResponse.ProtoReflect.Descriptor instead.
-func (*FightResultResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutboxEmail.ProtoReflect.Descriptor instead.
+func (*OutboxEmail) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FightResultResponse) GetFightId() int32 {
+func (x *OutboxEmail) GetEmailId() int64 {
 	if x != nil {
-		return x.FightId
+		return x.EmailId
 	}
 	return 0
 }
 
-type Fight struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FightId       int32 `protobuf:"varint,1,opt,name=fightId,proto3" json:"fightId,omitempty"`
-	EventId       int32 `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
-	FighterRedId  int32 `protobuf:"varint,3,opt,name=fighterRedId,proto3" json:"fighterRedId,omitempty"`
-	FighterBlueId int32 `protobuf:"varint,4,opt,name=fighterBlueId,proto3" json:"fighterBlueId,omitempty"`
-	IsDone        bool  `protobuf:"varint,5,opt,name=isDone,proto3" json:"isDone,omitempty"`
-	IsCanceled    bool  `protobuf:"varint,6,opt,name=isCanceled,proto3" json:"isCanceled,omitempty"`
-	NotContest    bool  `protobuf:"varint,7,opt,name=notContest,proto3" json:"notContest,omitempty"`
-	Result        int32 `protobuf:"varint,8,opt,name=result,proto3" json:"result,omitempty"`
-	CreatedAt     int64 `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	FightDate     int64 `protobuf:"varint,10,opt,name=fightDate,proto3" json:"fightDate,omitempty"`
+func (x *OutboxEmail) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
 }
 
-func (x *Fight) Reset() {
-	*x = Fight{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pickfighter_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *OutboxEmail) GetRecipientEmail() string {
+	if x != nil {
+		return x.RecipientEmail
 	}
+	return ""
 }
 
-func (x *Fight) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OutboxEmail) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
 }
 
-func (*Fight) ProtoMessage() {}
-
-func (x *Fight) ProtoReflect() protoreflect.Message {
-	mi := &file_pickfighter_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OutboxEmail) GetLocale() string {
+	if x != nil {
+		return x.Locale
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Fight.ProtoReflect.Descriptor instead.
-func (*Fight) Descriptor() ([]byte, []int) {
-	return file_pickfighter_proto_rawDescGZIP(), []int{23}
+func (x *OutboxEmail) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *Fight) GetFightId() int32 {
+func (x *OutboxEmail) GetAttempts() int32 {
 	if x != nil {
-		return x.FightId
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *Fight) GetEventId() int32 {
+func (x *OutboxEmail) GetNextAttemptAt() int64 {
 	if x != nil {
-		return x.EventId
+		return x.NextAttemptAt
 	}
 	return 0
 }
 
-func (x *Fight) GetFighterRedId() int32 {
+func (x *OutboxEmail) GetLastError() string {
 	if x != nil {
-		return x.FighterRedId
+		return x.LastError
 	}
-	return 0
+	return ""
 }
 
-func (x *Fight) GetFighterBlueId() int32 {
+func (x *OutboxEmail) GetCreatedAt() int64 {
 	if x != nil {
-		return x.FighterBlueId
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *Fight) GetIsDone() bool {
+func (x *OutboxEmail) GetSentAt() int64 {
 	if x != nil {
-		return x.IsDone
+		return x.SentAt
 	}
-	return false
+	return 0
 }
 
-func (x *Fight) GetIsCanceled() bool {
-	if x != nil {
-		return x.IsCanceled
-	}
-	return false
+type EmailOutboxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *Fight) GetNotContest() bool {
-	if x != nil {
-		return x.NotContest
+func (x *EmailOutboxRequest) Reset() {
+	*x = EmailOutboxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *Fight) GetResult() int32 {
-	if x != nil {
-		return x.Result
+func (x *EmailOutboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailOutboxRequest) ProtoMessage() {}
+
+func (x *EmailOutboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Fight) GetCreatedAt() int64 {
+// Deprecated: Use EmailOutboxRequest.ProtoReflect.Descriptor instead.
+func (*EmailOutboxRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EmailOutboxRequest) GetStatus() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *Fight) GetFightDate() int64 {
+func (x *EmailOutboxRequest) GetLimit() int32 {
 	if x != nil {
-		return x.FightDate
+		return x.Limit
 	}
 	return 0
 }
 
-type Event struct {
+type EmailOutboxResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventId int32    `protobuf:"varint,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
-	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Fights  []*Fight `protobuf:"bytes,3,rep,name=fights,proto3" json:"fights,omitempty"`
-	IsDone  bool     `protobuf:"varint,4,opt,name=isDone,proto3" json:"isDone,omitempty"`
+	Emails []*OutboxEmail `protobuf:"bytes,1,rep,name=emails,proto3" json:"emails,omitempty"`
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
+func (x *EmailOutboxResponse) Reset() {
+	*x = EmailOutboxResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1506,13 +1598,13 @@ func (x *Event) Reset() {
 	}
 }
 
-func (x *Event) String() string {
+func (x *EmailOutboxResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*EmailOutboxResponse) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
+func (x *EmailOutboxResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1524,53 +1616,28 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmailOutboxResponse.ProtoReflect.Descriptor instead.
+func (*EmailOutboxResponse) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Event) GetEventId() int32 {
-	if x != nil {
-		return x.EventId
-	}
-	return 0
-}
-
-func (x *Event) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Event) GetFights() []*Fight {
+func (x *EmailOutboxResponse) GetEmails() []*OutboxEmail {
 	if x != nil {
-		return x.Fights
+		return x.Emails
 	}
 	return nil
 }
 
-func (x *Event) GetIsDone() bool {
-	if x != nil {
-		return x.IsDone
-	}
-	return false
-}
-
-// TODO change Bet and BetRequest models
-type Bet struct {
+type ResendEmailRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BetId     int32 `protobuf:"varint,1,opt,name=betId,proto3" json:"betId,omitempty"`
-	FightId   int32 `protobuf:"varint,2,opt,name=fightId,proto3" json:"fightId,omitempty"`
-	UserId    int32 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
-	FighterId int32 `protobuf:"varint,4,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
+	EmailId int64 `protobuf:"varint,1,opt,name=emailId,proto3" json:"emailId,omitempty"`
 }
 
-func (x *Bet) Reset() {
-	*x = Bet{}
+func (x *ResendEmailRequest) Reset() {
+	*x = ResendEmailRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1578,13 +1645,13 @@ func (x *Bet) Reset() {
 	}
 }
 
-func (x *Bet) String() string {
+func (x *ResendEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bet) ProtoMessage() {}
+func (*ResendEmailRequest) ProtoMessage() {}
 
-func (x *Bet) ProtoReflect() protoreflect.Message {
+func (x *ResendEmailRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1596,69 +1663,28 @@ func (x *Bet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bet.ProtoReflect.Descriptor instead.
-func (*Bet) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResendEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResendEmailRequest) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Bet) GetBetId() int32 {
-	if x != nil {
-		return x.BetId
-	}
-	return 0
-}
-
-func (x *Bet) GetFightId() int32 {
-	if x != nil {
-		return x.FightId
-	}
-	return 0
-}
-
-func (x *Bet) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *Bet) GetFighterId() int32 {
+func (x *ResendEmailRequest) GetEmailId() int64 {
 	if x != nil {
-		return x.FighterId
+		return x.EmailId
 	}
 	return 0
 }
 
-type Fighter struct {
+type ResendEmailResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FighterId      int32         `protobuf:"varint,1,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
-	Name           string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	NickName       string        `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
-	Division       int32         `protobuf:"varint,4,opt,name=division,proto3" json:"division,omitempty"`
-	Status         string        `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Hometown       string        `protobuf:"bytes,6,opt,name=hometown,proto3" json:"hometown,omitempty"`
-	TrainsAt       string        `protobuf:"bytes,7,opt,name=trainsAt,proto3" json:"trainsAt,omitempty"`
-	FightingStyle  string        `protobuf:"bytes,8,opt,name=fightingStyle,proto3" json:"fightingStyle,omitempty"`
-	Age            int32         `protobuf:"varint,9,opt,name=age,proto3" json:"age,omitempty"`
-	Height         float32       `protobuf:"fixed32,10,opt,name=height,proto3" json:"height,omitempty"`
-	Weight         float32       `protobuf:"fixed32,11,opt,name=weight,proto3" json:"weight,omitempty"`
-	OctagonDebut   string        `protobuf:"bytes,12,opt,name=octagonDebut,proto3" json:"octagonDebut,omitempty"`
-	DebutTimestamp int32         `protobuf:"varint,13,opt,name=debutTimestamp,proto3" json:"debutTimestamp,omitempty"`
-	Reach          float32       `protobuf:"fixed32,14,opt,name=reach,proto3" json:"reach,omitempty"`
-	LegReach       float32       `protobuf:"fixed32,15,opt,name=legReach,proto3" json:"legReach,omitempty"`
-	Wins           int32         `protobuf:"varint,16,opt,name=wins,proto3" json:"wins,omitempty"`
-	Loses          int32         `protobuf:"varint,17,opt,name=loses,proto3" json:"loses,omitempty"`
-	Draw           int32         `protobuf:"varint,18,opt,name=draw,proto3" json:"draw,omitempty"`
-	FighterUrl     string        `protobuf:"bytes,19,opt,name=fighterUrl,proto3" json:"fighterUrl,omitempty"`
-	ImageUrl       string        `protobuf:"bytes,20,opt,name=imageUrl,proto3" json:"imageUrl,omitempty"`
-	Stats          *FighterStats `protobuf:"bytes,21,opt,name=stats,proto3" json:"stats,omitempty"`
+	Email *OutboxEmail `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 }
 
-func (x *Fighter) Reset() {
-	*x = Fighter{}
+func (x *ResendEmailResponse) Reset() {
+	*x = ResendEmailResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pickfighter_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1666,13 +1692,13 @@ func (x *Fighter) Reset() {
 	}
 }
 
-func (x *Fighter) String() string {
+func (x *ResendEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Fighter) ProtoMessage() {}
+func (*ResendEmailResponse) ProtoMessage() {}
 
-func (x *Fighter) ProtoReflect() protoreflect.Message {
+func (x *ResendEmailResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pickfighter_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1684,201 +1710,217 @@ func (x *Fighter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Fighter.ProtoReflect.Descriptor instead.
-func (*Fighter) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResendEmailResponse.ProtoReflect.Descriptor instead.
+func (*ResendEmailResponse) Descriptor() ([]byte, []int) {
 	return file_pickfighter_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Fighter) GetFighterId() int32 {
+func (x *ResendEmailResponse) GetEmail() *OutboxEmail {
 	if x != nil {
-		return x.FighterId
+		return x.Email
 	}
-	return 0
+	return nil
 }
 
-func (x *Fighter) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type RoleChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Role    string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	ActorId int32  `protobuf:"varint,3,opt,name=actorId,proto3" json:"actorId,omitempty"`
+	Reason  string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *Fighter) GetNickName() string {
-	if x != nil {
-		return x.NickName
+func (x *RoleChangeRequest) Reset() {
+	*x = RoleChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Fighter) GetDivision() int32 {
-	if x != nil {
-		return x.Division
-	}
-	return 0
+func (x *RoleChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Fighter) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (*RoleChangeRequest) ProtoMessage() {}
+
+func (x *RoleChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Fighter) GetHometown() string {
-	if x != nil {
-		return x.Hometown
-	}
-	return ""
+// Deprecated: Use RoleChangeRequest.ProtoReflect.Descriptor instead.
+func (*RoleChangeRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Fighter) GetTrainsAt() string {
+func (x *RoleChangeRequest) GetUserId() int32 {
 	if x != nil {
-		return x.TrainsAt
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *Fighter) GetFightingStyle() string {
+func (x *RoleChangeRequest) GetRole() string {
 	if x != nil {
-		return x.FightingStyle
+		return x.Role
 	}
 	return ""
 }
 
-func (x *Fighter) GetAge() int32 {
+func (x *RoleChangeRequest) GetActorId() int32 {
 	if x != nil {
-		return x.Age
+		return x.ActorId
 	}
 	return 0
 }
 
-func (x *Fighter) GetHeight() float32 {
+func (x *RoleChangeRequest) GetReason() string {
 	if x != nil {
-		return x.Height
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *Fighter) GetWeight() float32 {
-	if x != nil {
-		return x.Weight
-	}
-	return 0
+type RoleChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuditId   int64    `protobuf:"varint,1,opt,name=auditId,proto3" json:"auditId,omitempty"`
+	UserId    int32    `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	ActorId   int32    `protobuf:"varint,3,opt,name=actorId,proto3" json:"actorId,omitempty"`
+	Action    string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Role      string   `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Reason    string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Roles     []string `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
+	CreatedAt int64    `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *Fighter) GetOctagonDebut() string {
-	if x != nil {
-		return x.OctagonDebut
+func (x *RoleChange) Reset() {
+	*x = RoleChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Fighter) GetDebutTimestamp() int32 {
-	if x != nil {
-		return x.DebutTimestamp
-	}
-	return 0
+func (x *RoleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Fighter) GetReach() float32 {
-	if x != nil {
-		return x.Reach
+func (*RoleChange) ProtoMessage() {}
+
+func (x *RoleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Fighter) GetLegReach() float32 {
+// Deprecated: Use RoleChange.ProtoReflect.Descriptor instead.
+func (*RoleChange) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RoleChange) GetAuditId() int64 {
 	if x != nil {
-		return x.LegReach
+		return x.AuditId
 	}
 	return 0
 }
 
-func (x *Fighter) GetWins() int32 {
+func (x *RoleChange) GetUserId() int32 {
 	if x != nil {
-		return x.Wins
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *Fighter) GetLoses() int32 {
+func (x *RoleChange) GetActorId() int32 {
 	if x != nil {
-		return x.Loses
+		return x.ActorId
 	}
 	return 0
 }
 
-func (x *Fighter) GetDraw() int32 {
+func (x *RoleChange) GetAction() string {
 	if x != nil {
-		return x.Draw
+		return x.Action
 	}
-	return 0
+	return ""
 }
 
-func (x *Fighter) GetFighterUrl() string {
+func (x *RoleChange) GetRole() string {
 	if x != nil {
-		return x.FighterUrl
+		return x.Role
 	}
 	return ""
 }
 
-func (x *Fighter) GetImageUrl() string {
+func (x *RoleChange) GetReason() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Fighter) GetStats() *FighterStats {
+func (x *RoleChange) GetRoles() []string {
 	if x != nil {
-		return x.Stats
+		return x.Roles
 	}
 	return nil
 }
 
-type FighterStats struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	StatId               int32   `protobuf:"varint,1,opt,name=statId,proto3" json:"statId,omitempty"`
-	FighterId            int32   `protobuf:"varint,2,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
-	TotalSigStrLanded    int32   `protobuf:"varint,3,opt,name=totalSigStrLanded,proto3" json:"totalSigStrLanded,omitempty"`
-	TotalSigStrAttempted int32   `protobuf:"varint,4,opt,name=totalSigStrAttempted,proto3" json:"totalSigStrAttempted,omitempty"`
-	StrAccuracy          int32   `protobuf:"varint,5,opt,name=strAccuracy,proto3" json:"strAccuracy,omitempty"`
-	TotalTkdLanded       int32   `protobuf:"varint,6,opt,name=totalTkdLanded,proto3" json:"totalTkdLanded,omitempty"`
-	TotalTkdAttempted    int32   `protobuf:"varint,7,opt,name=totalTkdAttempted,proto3" json:"totalTkdAttempted,omitempty"`
-	TkdAccuracy          int32   `protobuf:"varint,8,opt,name=tkdAccuracy,proto3" json:"tkdAccuracy,omitempty"`
-	SigStrLanded         float32 `protobuf:"fixed32,9,opt,name=sigStrLanded,proto3" json:"sigStrLanded,omitempty"`
-	SigStrAbs            float32 `protobuf:"fixed32,10,opt,name=sigStrAbs,proto3" json:"sigStrAbs,omitempty"`
-	SigStrDefense        int32   `protobuf:"varint,11,opt,name=sigStrDefense,proto3" json:"sigStrDefense,omitempty"`
-	TakedownDefense      int32   `protobuf:"varint,12,opt,name=takedownDefense,proto3" json:"takedownDefense,omitempty"`
-	TakedownAvg          float32 `protobuf:"fixed32,13,opt,name=takedownAvg,proto3" json:"takedownAvg,omitempty"`
-	SubmissionAvg        float32 `protobuf:"fixed32,14,opt,name=submissionAvg,proto3" json:"submissionAvg,omitempty"`
-	KnockdownAvg         float32 `protobuf:"fixed32,15,opt,name=knockdownAvg,proto3" json:"knockdownAvg,omitempty"`
-	AvgFightTime         string  `protobuf:"bytes,16,opt,name=avgFightTime,proto3" json:"avgFightTime,omitempty"`
-	WinByKO              int32   `protobuf:"varint,17,opt,name=winByKO,proto3" json:"winByKO,omitempty"`
-	WinBySub             int32   `protobuf:"varint,18,opt,name=winBySub,proto3" json:"winBySub,omitempty"`
-	WinByDec             int32   `protobuf:"varint,19,opt,name=winByDec,proto3" json:"winByDec,omitempty"`
+func (x *RoleChange) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (x *FighterStats) Reset() {
-	*x = FighterStats{}
+type RoleChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Change *RoleChange `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+}
+
+func (x *RoleChangeResponse) Reset() {
+	*x = RoleChangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pickfighter_proto_msgTypes[27]
+		mi := &file_pickfighter_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FighterStats) String() string {
+func (x *RoleChangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FighterStats) ProtoMessage() {}
+func (*RoleChangeResponse) ProtoMessage() {}
 
-func (x *FighterStats) ProtoReflect() protoreflect.Message {
-	mi := &file_pickfighter_proto_msgTypes[27]
+func (x *RoleChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1889,170 +1931,146 @@ func (x *FighterStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FighterStats.ProtoReflect.Descriptor instead.
-func (*FighterStats) Descriptor() ([]byte, []int) {
-	return file_pickfighter_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *FighterStats) GetStatId() int32 {
-	if x != nil {
-		return x.StatId
-	}
-	return 0
+// Deprecated: Use RoleChangeResponse.ProtoReflect.Descriptor instead.
+func (*RoleChangeResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *FighterStats) GetFighterId() int32 {
+func (x *RoleChangeResponse) GetChange() *RoleChange {
 	if x != nil {
-		return x.FighterId
+		return x.Change
 	}
-	return 0
+	return nil
 }
 
-func (x *FighterStats) GetTotalSigStrLanded() int32 {
-	if x != nil {
-		return x.TotalSigStrLanded
-	}
-	return 0
-}
+type RoleAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *FighterStats) GetTotalSigStrAttempted() int32 {
-	if x != nil {
-		return x.TotalSigStrAttempted
-	}
-	return 0
+	UserId int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *FighterStats) GetStrAccuracy() int32 {
-	if x != nil {
-		return x.StrAccuracy
+func (x *RoleAuditRequest) Reset() {
+	*x = RoleAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *FighterStats) GetTotalTkdLanded() int32 {
-	if x != nil {
-		return x.TotalTkdLanded
-	}
-	return 0
+func (x *RoleAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FighterStats) GetTotalTkdAttempted() int32 {
-	if x != nil {
-		return x.TotalTkdAttempted
-	}
-	return 0
-}
+func (*RoleAuditRequest) ProtoMessage() {}
 
-func (x *FighterStats) GetTkdAccuracy() int32 {
-	if x != nil {
-		return x.TkdAccuracy
+func (x *RoleAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FighterStats) GetSigStrLanded() float32 {
-	if x != nil {
-		return x.SigStrLanded
-	}
-	return 0
+// Deprecated: Use RoleAuditRequest.ProtoReflect.Descriptor instead.
+func (*RoleAuditRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *FighterStats) GetSigStrAbs() float32 {
+func (x *RoleAuditRequest) GetUserId() int32 {
 	if x != nil {
-		return x.SigStrAbs
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *FighterStats) GetSigStrDefense() int32 {
+func (x *RoleAuditRequest) GetLimit() int32 {
 	if x != nil {
-		return x.SigStrDefense
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *FighterStats) GetTakedownDefense() int32 {
-	if x != nil {
-		return x.TakedownDefense
-	}
-	return 0
-}
+type RoleAuditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *FighterStats) GetTakedownAvg() float32 {
-	if x != nil {
-		return x.TakedownAvg
-	}
-	return 0
+	Changes []*RoleChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *FighterStats) GetSubmissionAvg() float32 {
-	if x != nil {
-		return x.SubmissionAvg
+func (x *RoleAuditResponse) Reset() {
+	*x = RoleAuditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *FighterStats) GetKnockdownAvg() float32 {
-	if x != nil {
-		return x.KnockdownAvg
-	}
-	return 0
+func (x *RoleAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FighterStats) GetAvgFightTime() string {
-	if x != nil {
-		return x.AvgFightTime
-	}
-	return ""
-}
+func (*RoleAuditResponse) ProtoMessage() {}
 
-func (x *FighterStats) GetWinByKO() int32 {
-	if x != nil {
-		return x.WinByKO
+func (x *RoleAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FighterStats) GetWinBySub() int32 {
-	if x != nil {
-		return x.WinBySub
-	}
-	return 0
+// Deprecated: Use RoleAuditResponse.ProtoReflect.Descriptor instead.
+func (*RoleAuditResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *FighterStats) GetWinByDec() int32 {
+func (x *RoleAuditResponse) GetChanges() []*RoleChange {
 	if x != nil {
-		return x.WinByDec
+		return x.Changes
 	}
-	return 0
+	return nil
 }
 
-type FightersRequest struct {
+type PasswordResetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status      string  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	FightersIds []int32 `protobuf:"varint,2,rep,packed,name=fightersIds,proto3" json:"fightersIds,omitempty"`
+	Email  string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
-func (x *FightersRequest) Reset() {
-	*x = FightersRequest{}
+func (x *PasswordResetRequest) Reset() {
+	*x = PasswordResetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pickfighter_proto_msgTypes[28]
+		mi := &file_pickfighter_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FightersRequest) String() string {
+func (x *PasswordResetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FightersRequest) ProtoMessage() {}
+func (*PasswordResetRequest) ProtoMessage() {}
 
-func (x *FightersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pickfighter_proto_msgTypes[28]
+func (x *PasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2063,50 +2081,50 @@ func (x *FightersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FightersRequest.ProtoReflect.Descriptor instead.
-func (*FightersRequest) Descriptor() ([]byte, []int) {
-	return file_pickfighter_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use PasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*PasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *FightersRequest) GetStatus() string {
+func (x *PasswordResetRequest) GetEmail() string {
 	if x != nil {
-		return x.Status
+		return x.Email
 	}
 	return ""
 }
 
-func (x *FightersRequest) GetFightersIds() []int32 {
+func (x *PasswordResetRequest) GetLocale() string {
 	if x != nil {
-		return x.FightersIds
+		return x.Locale
 	}
-	return nil
+	return ""
 }
 
-type FightersResponse struct {
+type PasswordResetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fighters []*Fighter `protobuf:"bytes,1,rep,name=fighters,proto3" json:"fighters,omitempty"`
+	Response *emptypb.Empty `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *FightersResponse) Reset() {
-	*x = FightersResponse{}
+func (x *PasswordResetResponse) Reset() {
+	*x = PasswordResetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pickfighter_proto_msgTypes[29]
+		mi := &file_pickfighter_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FightersResponse) String() string {
+func (x *PasswordResetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FightersResponse) ProtoMessage() {}
+func (*PasswordResetResponse) ProtoMessage() {}
 
-func (x *FightersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pickfighter_proto_msgTypes[29]
+func (x *PasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2117,43 +2135,45 @@ func (x *FightersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FightersResponse.ProtoReflect.Descriptor instead.
-func (*FightersResponse) Descriptor() ([]byte, []int) {
-	return file_pickfighter_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*PasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *FightersResponse) GetFighters() []*Fighter {
+func (x *PasswordResetResponse) GetResponse() *emptypb.Empty {
 	if x != nil {
-		return x.Fighters
+		return x.Response
 	}
 	return nil
 }
 
-type FightersCountResponse struct {
+type PasswordRecoveryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Token           string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password        string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ConfirmPassword string `protobuf:"bytes,3,opt,name=confirmPassword,proto3" json:"confirmPassword,omitempty"`
 }
 
-func (x *FightersCountResponse) Reset() {
-	*x = FightersCountResponse{}
+func (x *PasswordRecoveryRequest) Reset() {
+	*x = PasswordRecoveryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pickfighter_proto_msgTypes[30]
+		mi := &file_pickfighter_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FightersCountResponse) String() string {
+func (x *PasswordRecoveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FightersCountResponse) ProtoMessage() {}
+func (*PasswordRecoveryRequest) ProtoMessage() {}
 
-func (x *FightersCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pickfighter_proto_msgTypes[30]
+func (x *PasswordRecoveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,49 +2184,57 @@ func (x *FightersCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FightersCountResponse.ProtoReflect.Descriptor instead.
-func (*FightersCountResponse) Descriptor() ([]byte, []int) {
-	return file_pickfighter_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use PasswordRecoveryRequest.ProtoReflect.Descriptor instead.
+func (*PasswordRecoveryRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *FightersCountResponse) GetCount() int32 {
+func (x *PasswordRecoveryRequest) GetToken() string {
 	if x != nil {
-		return x.Count
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type HealthResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AppDevVersion string `protobuf:"bytes,1,opt,name=app_dev_version,json=appDevVersion,proto3" json:"app_dev_version,omitempty"`
-	AppName       string `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	AppRunDate    int64  `protobuf:"varint,3,opt,name=app_run_date,json=appRunDate,proto3" json:"app_run_date,omitempty"`
-	AppTimeAlive  int64  `protobuf:"varint,4,opt,name=app_time_alive,json=appTimeAlive,proto3" json:"app_time_alive,omitempty"`
-	Healthy       bool   `protobuf:"varint,5,opt,name=healthy,proto3" json:"healthy,omitempty"`
-	Message       string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp     string `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+func (x *PasswordRecoveryRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-func (x *HealthResponse) Reset() {
-	*x = HealthResponse{}
+func (x *PasswordRecoveryRequest) GetConfirmPassword() string {
+	if x != nil {
+		return x.ConfirmPassword
+	}
+	return ""
+}
+
+type PasswordRecoveryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response *emptypb.Empty `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *PasswordRecoveryResponse) Reset() {
+	*x = PasswordRecoveryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pickfighter_proto_msgTypes[31]
+		mi := &file_pickfighter_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthResponse) String() string {
+func (x *PasswordRecoveryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthResponse) ProtoMessage() {}
+func (*PasswordRecoveryResponse) ProtoMessage() {}
 
-func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pickfighter_proto_msgTypes[31]
+func (x *PasswordRecoveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2217,509 +2245,5429 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
-func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_pickfighter_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PasswordRecoveryResponse.ProtoReflect.Descriptor instead.
+func (*PasswordRecoveryResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *HealthResponse) GetAppDevVersion() string {
+func (x *PasswordRecoveryResponse) GetResponse() *emptypb.Empty {
 	if x != nil {
-		return x.AppDevVersion
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-func (x *HealthResponse) GetAppName() string {
-	if x != nil {
-		return x.AppName
+type ProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ProfileRequest) Reset() {
+	*x = ProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *HealthResponse) GetAppRunDate() int64 {
-	if x != nil {
-		return x.AppRunDate
+func (x *ProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileRequest) ProtoMessage() {}
+
+func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *HealthResponse) GetAppTimeAlive() int64 {
+// Deprecated: Use ProfileRequest.ProtoReflect.Descriptor instead.
+func (*ProfileRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProfileRequest) GetUserId() int32 {
 	if x != nil {
-		return x.AppTimeAlive
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *HealthResponse) GetHealthy() bool {
+func (x *ProfileRequest) GetName() string {
 	if x != nil {
-		return x.Healthy
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *HealthResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+type ProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ProfileResponse) Reset() {
+	*x = ProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *HealthResponse) GetTimestamp() string {
+func (x *ProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileResponse) ProtoMessage() {}
+
+func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
+func (*ProfileResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ProfileResponse) GetUser() *User {
 	if x != nil {
-		return x.Timestamp
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-var File_pickfighter_proto protoreflect.FileDescriptor
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pickfighter_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x70, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x6b, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x6b, 0x22, 0x22, 0x0a, 0x10, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x2e, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x4d, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f,
-	0x02, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x22, 0x96, 0x01, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x42, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x45, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x4b, 0x0a, 0x15, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x17, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4e, 0x0a, 0x18, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x0f, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xda, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x6c,
-	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
-	0x0a, 0x06, 0x66, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06,
-	0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x66, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x2f,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
-	0x46, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x78, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66,
-	0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x11,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x0b, 0x42, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3e,
-	0x0a, 0x0c, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x62, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x04, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x04, 0x62, 0x65, 0x74, 0x73, 0x22, 0x6a,
-	0x0a, 0x12, 0x46, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x46, 0x69,
-	0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x22, 0xb1, 0x02, 0x0a, 0x05,
-	0x46, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x67,
-	0x68, 0x74, 0x65, 0x72, 0x52, 0x65, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x52, 0x65, 0x64, 0x49, 0x64, 0x12, 0x24, 0x0a,
-	0x0d, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x42, 0x6c, 0x75, 0x65, 0x49, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x42, 0x6c, 0x75,
-	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
-	0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x69, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
-	0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x65, 0x22,
-	0x6d, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x67, 0x68, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06,
-	0x66, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x44, 0x6f, 0x6e, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x22, 0x6b,
-	0x0a, 0x03, 0x42, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66,
-	0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69,
-	0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc8, 0x04, 0x0a, 0x07,
-	0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x67, 0x68,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63,
-	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63,
-	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x6d,
-	0x65, 0x74, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6d,
-	0x65, 0x74, 0x6f, 0x77, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x41,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x41,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x67, 0x68, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x79,
-	0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x67, 0x68, 0x74, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x63, 0x74,
-	0x61, 0x67, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6f, 0x63, 0x74, 0x61, 0x67, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x74, 0x12, 0x26, 0x0a,
-	0x0e, 0x64, 0x65, 0x62, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x63, 0x68, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x72, 0x65, 0x61, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
-	0x65, 0x67, 0x52, 0x65, 0x61, 0x63, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c,
-	0x65, 0x67, 0x52, 0x65, 0x61, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x6f, 0x73, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x6f, 0x73, 0x65,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72, 0x61, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72,
-	0x55, 0x72, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x67, 0x68, 0x74,
-	0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
-	0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
-	0x6c, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xb4, 0x05, 0x0a, 0x0c, 0x46, 0x69, 0x67, 0x68, 0x74,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a,
-	0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x53, 0x74, 0x72, 0x4c, 0x61, 0x6e, 0x64,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
-	0x69, 0x67, 0x53, 0x74, 0x72, 0x4c, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x53, 0x74, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x53, 0x69, 0x67, 0x53, 0x74, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63,
-	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6b, 0x64, 0x4c, 0x61, 0x6e,
-	0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x54, 0x6b, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x54, 0x6b, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6b, 0x64, 0x41, 0x74,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6b, 0x64, 0x41, 0x63,
-	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6b,
-	0x64, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x67,
-	0x53, 0x74, 0x72, 0x4c, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0c, 0x73, 0x69, 0x67, 0x53, 0x74, 0x72, 0x4c, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x53, 0x74, 0x72, 0x41, 0x62, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x09, 0x73, 0x69, 0x67, 0x53, 0x74, 0x72, 0x41, 0x62, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73,
-	0x69, 0x67, 0x53, 0x74, 0x72, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x53, 0x74, 0x72, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73,
-	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x66,
-	0x65, 0x6e, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x61, 0x6b, 0x65,
-	0x64, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74,
-	0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x41, 0x76, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0b, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x41, 0x76, 0x67, 0x12, 0x24, 0x0a,
-	0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x76, 0x67, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x41, 0x76, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6b, 0x6e, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
-	0x41, 0x76, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6b, 0x6e, 0x6f, 0x63, 0x6b,
-	0x64, 0x6f, 0x77, 0x6e, 0x41, 0x76, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x67, 0x46, 0x69,
-	0x67, 0x68, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
-	0x76, 0x67, 0x46, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77,
-	0x69, 0x6e, 0x42, 0x79, 0x4b, 0x4f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x69,
-	0x6e, 0x42, 0x79, 0x4b, 0x4f, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x42, 0x79, 0x53, 0x75,
-	0x62, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x42, 0x79, 0x53, 0x75,
-	0x62, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x42, 0x79, 0x44, 0x65, 0x63, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x42, 0x79, 0x44, 0x65, 0x63, 0x22, 0x4b, 0x0a,
-	0x0f, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x67, 0x68,
-	0x74, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x66,
-	0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x10, 0x46, 0x69,
-	0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
-	0x0a, 0x08, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x52, 0x08, 0x66, 0x69, 0x67, 0x68,
-	0x74, 0x65, 0x72, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x65,
-	0x76, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x61, 0x70, 0x70, 0x44, 0x65, 0x76, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x70, 0x70,
-	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x61, 0x70, 0x70, 0x52, 0x75, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x61,
-	0x70, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x76,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x32, 0xa8, 0x03, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x10, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3e, 0x0a, 0x0d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x12, 0x15, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x46, 0x0a, 0x0f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc8,
-	0x02, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x38, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x13,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
-	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x73, 0x12, 0x0c, 0x2e, 0x42,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x42, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x13, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x46, 0x69,
-	0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x36, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc1, 0x01, 0x0a, 0x0f, 0x46, 0x69,
-	0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a,
-	0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
-	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73,
-	0x12, 0x10, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a,
-	0x04, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	UserId      int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	SessionId   string `protobuf:"bytes,2,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	OldPassword string `protobuf:"bytes,3,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	NewPassword string `protobuf:"bytes,4,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 }
 
-var (
-	file_pickfighter_proto_rawDescOnce sync.Once
-	file_pickfighter_proto_rawDescData = file_pickfighter_proto_rawDesc
-)
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_pickfighter_proto_rawDescGZIP() []byte {
-	file_pickfighter_proto_rawDescOnce.Do(func() {
-		file_pickfighter_proto_rawDescData = protoimpl.X.CompressGZIP(file_pickfighter_proto_rawDescData)
-	})
-	return file_pickfighter_proto_rawDescData
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_pickfighter_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
-var file_pickfighter_proto_goTypes = []any{
-	(*RegisterRequest)(nil),          // 0: RegisterRequest
-	(*RegisterResponse)(nil),         // 1: RegisterResponse
-	(*RegisterConfirmRequest)(nil),   // 2: RegisterConfirmRequest
-	(*RegisterConfirmResponse)(nil),  // 3: RegisterConfirmResponse
-	(*AuthenticateRequest)(nil),      // 4: AuthenticateRequest
-	(*AuthenticateResponse)(nil),     // 5: AuthenticateResponse
-	(*PasswordResetRequest)(nil),     // 6: PasswordResetRequest
-	(*PasswordResetResponse)(nil),    // 7: PasswordResetResponse
-	(*PasswordRecoveryRequest)(nil),  // 8: PasswordRecoveryRequest
-	(*PasswordRecoveryResponse)(nil), // 9: PasswordRecoveryResponse
-	(*ProfileRequest)(nil),           // 10: ProfileRequest
-	(*ProfileResponse)(nil),          // 11: ProfileResponse
-	(*User)(nil),                     // 12: User
-	(*CreateEventRequest)(nil),       // 13: CreateEventRequest
-	(*CreateEventResponse)(nil),      // 14: CreateEventResponse
-	(*GetEventsRequest)(nil),         // 15: GetEventsRequest
-	(*GetEventsResponse)(nil),        // 16: GetEventsResponse
-	(*CreateBetRequest)(nil),         // 17: CreateBetRequest
-	(*CreateBetResponse)(nil),        // 18: CreateBetResponse
-	(*BetsRequest)(nil),              // 19: BetsRequest
-	(*BetsResponse)(nil),             // 20: BetsResponse
-	(*FightResultRequest)(nil),       // 21: FightResultRequest
-	(*FightResultResponse)(nil),      // 22: FightResultResponse
-	(*Fight)(nil),                    // 23: Fight
-	(*Event)(nil),                    // 24: Event
-	(*Bet)(nil),                      // 25: Bet
-	(*Fighter)(nil),                  // 26: Fighter
-	(*FighterStats)(nil),             // 27: FighterStats
-	(*FightersRequest)(nil),          // 28: FightersRequest
-	(*FightersResponse)(nil),         // 29: FightersResponse
-	(*FightersCountResponse)(nil),    // 30: FightersCountResponse
-	(*HealthResponse)(nil),           // 31: HealthResponse
-	(*emptypb.Empty)(nil),            // 32: google.protobuf.Empty
-	(*timestamppb.Timestamp)(nil),    // 33: google.protobuf.Timestamp
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_pickfighter_proto_depIdxs = []int32{
-	32, // 0: RegisterConfirmResponse.response:type_name -> google.protobuf.Empty
-	33, // 1: AuthenticateResponse.ExpirationTime:type_name -> google.protobuf.Timestamp
-	32, // 2: PasswordResetResponse.response:type_name -> google.protobuf.Empty
-	32, // 3: PasswordRecoveryResponse.response:type_name -> google.protobuf.Empty
-	12, // 4: ProfileResponse.user:type_name -> User
-	23, // 5: CreateEventRequest.fights:type_name -> Fight
-	32, // 6: GetEventsRequest.response:type_name -> google.protobuf.Empty
-	24, // 7: GetEventsResponse.events:type_name -> Event
-	25, // 8: BetsResponse.bets:type_name -> Bet
-	23, // 9: Event.fights:type_name -> Fight
-	27, // 10: Fighter.stats:type_name -> FighterStats
-	26, // 11: FightersResponse.fighters:type_name -> Fighter
-	0,  // 12: AuthService.Register:input_type -> RegisterRequest
-	2,  // 13: AuthService.RegisterConfirm:input_type -> RegisterConfirmRequest
-	4,  // 14: AuthService.Login:input_type -> AuthenticateRequest
-	6,  // 15: AuthService.PasswordReset:input_type -> PasswordResetRequest
-	8,  // 16: AuthService.PasswordRecover:input_type -> PasswordRecoveryRequest
-	10, // 17: AuthService.Profile:input_type -> ProfileRequest
-	32, // 18: AuthService.HealthCheck:input_type -> google.protobuf.Empty
-	13, // 19: EventService.CreateEvent:input_type -> CreateEventRequest
-	15, // 20: EventService.GetEvents:input_type -> GetEventsRequest
-	17, // 21: EventService.CreateBet:input_type -> CreateBetRequest
-	19, // 22: EventService.GetBets:input_type -> BetsRequest
-	21, // 23: EventService.SetResult:input_type -> FightResultRequest
-	32, // 24: EventService.HealthCheck:input_type -> google.protobuf.Empty
-	28, // 25: FightersService.SearchFightersCount:input_type -> FightersRequest
-	28, // 26: FightersService.SearchFighters:input_type -> FightersRequest
-	32, // 27: FightersService.HealthCheck:input_type -> google.protobuf.Empty
-	1,  // 28: AuthService.Register:output_type -> RegisterResponse
-	3,  // 29: AuthService.RegisterConfirm:output_type -> RegisterConfirmResponse
-	5,  // 30: AuthService.Login:output_type -> AuthenticateResponse
-	7,  // 31: AuthService.PasswordReset:output_type -> PasswordResetResponse
-	9,  // 32: AuthService.PasswordRecover:output_type -> PasswordRecoveryResponse
-	11, // 33: AuthService.Profile:output_type -> ProfileResponse
-	31, // 34: AuthService.HealthCheck:output_type -> HealthResponse
-	14, // 35: EventService.CreateEvent:output_type -> CreateEventResponse
-	16, // 36: EventService.GetEvents:output_type -> GetEventsResponse
-	18, // 37: EventService.CreateBet:output_type -> CreateBetResponse
-	20, // 38: EventService.GetBets:output_type -> BetsResponse
-	22, // 39: EventService.SetResult:output_type -> FightResultResponse
-	31, // 40: EventService.HealthCheck:output_type -> HealthResponse
-	30, // 41: FightersService.SearchFightersCount:output_type -> FightersCountResponse
-	29, // 42: FightersService.SearchFighters:output_type -> FightersResponse
-	31, // 43: FightersService.HealthCheck:output_type -> HealthResponse
-	28, // [28:44] is the sub-list for method output_type
-	12, // [12:28] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{38}
 }
 
-func init() { file_pickfighter_proto_init() }
-func file_pickfighter_proto_init() {
-	if File_pickfighter_proto != nil {
-		return
+func (x *ChangePasswordRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pickfighter_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterRequest); i {
+	return 0
+}
+
+func (x *ChangePasswordRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{39}
+}
+
+type ChangeEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Locale   string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *ChangeEmailRequest) Reset() {
+	*x = ChangeEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmailRequest) ProtoMessage() {}
+
+func (x *ChangeEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmailRequest.ProtoReflect.Descriptor instead.
+func (*ChangeEmailRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChangeEmailRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangeEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ChangeEmailRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ChangeEmailRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ChangeEmailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *ChangeEmailResponse) Reset() {
+	*x = ChangeEmailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmailResponse) ProtoMessage() {}
+
+func (x *ChangeEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmailResponse.ProtoReflect.Descriptor instead.
+func (*ChangeEmailResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ChangeEmailResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ChangeEmailResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ConfirmEmailChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ConfirmEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConfirmEmailChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Email  string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *ConfirmEmailChangeResponse) Reset() {
+	*x = ConfirmEmailChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ConfirmEmailChangeResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ConfirmEmailChangeResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *AccountRequest) Reset() {
+	*x = AccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRequest) ProtoMessage() {}
+
+func (x *AccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRequest.ProtoReflect.Descriptor instead.
+func (*AccountRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AccountRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UserIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider  string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Subject   string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *UserIdentity) Reset() {
+	*x = UserIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIdentity) ProtoMessage() {}
+
+func (x *UserIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIdentity.ProtoReflect.Descriptor instead.
+func (*UserIdentity) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UserIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AccountExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User               *User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Identities         []*UserIdentity `protobuf:"bytes,2,rep,name=identities,proto3" json:"identities,omitempty"`
+	TwoFactorEnabled   bool            `protobuf:"varint,3,opt,name=twoFactorEnabled,proto3" json:"twoFactorEnabled,omitempty"`
+	TwoFactorEnabledAt int64           `protobuf:"varint,4,opt,name=twoFactorEnabledAt,proto3" json:"twoFactorEnabledAt,omitempty"`
+	RoleChanges        []*RoleChange   `protobuf:"bytes,5,rep,name=roleChanges,proto3" json:"roleChanges,omitempty"`
+}
+
+func (x *AccountExportResponse) Reset() {
+	*x = AccountExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountExportResponse) ProtoMessage() {}
+
+func (x *AccountExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountExportResponse.ProtoReflect.Descriptor instead.
+func (*AccountExportResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AccountExportResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AccountExportResponse) GetIdentities() []*UserIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *AccountExportResponse) GetTwoFactorEnabled() bool {
+	if x != nil {
+		return x.TwoFactorEnabled
+	}
+	return false
+}
+
+func (x *AccountExportResponse) GetTwoFactorEnabledAt() int64 {
+	if x != nil {
+		return x.TwoFactorEnabledAt
+	}
+	return 0
+}
+
+func (x *AccountExportResponse) GetRoleChanges() []*RoleChange {
+	if x != nil {
+		return x.RoleChanges
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteAccountRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeleteAfter int64 `protobuf:"varint,1,opt,name=deleteAfter,proto3" json:"deleteAfter,omitempty"`
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteAccountResponse) GetDeleteAfter() int64 {
+	if x != nil {
+		return x.DeleteAfter
+	}
+	return 0
+}
+
+type CancelAccountDeletionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelAccountDeletionResponse) Reset() {
+	*x = CancelAccountDeletionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelAccountDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionResponse) ProtoMessage() {}
+
+func (x *CancelAccountDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionResponse.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{49}
+}
+
+type DueAccountDeletionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *DueAccountDeletionsRequest) Reset() {
+	*x = DueAccountDeletionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DueAccountDeletionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueAccountDeletionsRequest) ProtoMessage() {}
+
+func (x *DueAccountDeletionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueAccountDeletionsRequest.ProtoReflect.Descriptor instead.
+func (*DueAccountDeletionsRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DueAccountDeletionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DueAccountDeletionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []int32 `protobuf:"varint,1,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
+}
+
+func (x *DueAccountDeletionsResponse) Reset() {
+	*x = DueAccountDeletionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DueAccountDeletionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueAccountDeletionsResponse) ProtoMessage() {}
+
+func (x *DueAccountDeletionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueAccountDeletionsResponse.ProtoReflect.Descriptor instead.
+func (*DueAccountDeletionsResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DueAccountDeletionsResponse) GetUserIds() []int32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type AnonymizeAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AnonymizeAccountResponse) Reset() {
+	*x = AnonymizeAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnonymizeAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeAccountResponse) ProtoMessage() {}
+
+func (x *AnonymizeAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeAccountResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeAccountResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{52}
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int32  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email       string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Rank        string `protobuf:"bytes,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Claim       string `protobuf:"bytes,5,opt,name=claim,proto3" json:"claim,omitempty"`
+	Roles       uint64 `protobuf:"varint,6,opt,name=roles,proto3" json:"roles,omitempty"`
+	Flags       uint64 `protobuf:"varint,7,opt,name=flags,proto3" json:"flags,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt   int64  `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	DeleteAfter int64  `protobuf:"varint,10,opt,name=deleteAfter,proto3" json:"deleteAfter,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *User) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *User) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *User) GetRoles() uint64 {
+	if x != nil {
+		return x.Roles
+	}
+	return 0
+}
+
+func (x *User) GetFlags() uint64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *User) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *User) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *User) GetDeleteAfter() int64 {
+	if x != nil {
+		return x.DeleteAfter
+	}
+	return 0
+}
+
+type CreateEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fights []*Fight `protobuf:"bytes,2,rep,name=fights,proto3" json:"fights,omitempty"`
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetFights() []*Fight {
+	if x != nil {
+		return x.Fights
+	}
+	return nil
+}
+
+type CreateEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId int32 `protobuf:"varint,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+}
+
+func (x *CreateEventResponse) Reset() {
+	*x = CreateEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventResponse) ProtoMessage() {}
+
+func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateEventResponse) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type GetEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response *emptypb.Empty `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsRequest) ProtoMessage() {}
+
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetEventsRequest) GetResponse() *emptypb.Empty {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type GetEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int32    `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+	Events []*Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GetEventsResponse) Reset() {
+	*x = GetEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsResponse) ProtoMessage() {}
+
+func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetEventsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CreateBetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BetId     int32 `protobuf:"varint,1,opt,name=betId,proto3" json:"betId,omitempty"`
+	FightId   int32 `protobuf:"varint,2,opt,name=fightId,proto3" json:"fightId,omitempty"`
+	UserId    int32 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	FighterId int32 `protobuf:"varint,4,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
+}
+
+func (x *CreateBetRequest) Reset() {
+	*x = CreateBetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBetRequest) ProtoMessage() {}
+
+func (x *CreateBetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBetRequest.ProtoReflect.Descriptor instead.
+func (*CreateBetRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateBetRequest) GetBetId() int32 {
+	if x != nil {
+		return x.BetId
+	}
+	return 0
+}
+
+func (x *CreateBetRequest) GetFightId() int32 {
+	if x != nil {
+		return x.FightId
+	}
+	return 0
+}
+
+func (x *CreateBetRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateBetRequest) GetFighterId() int32 {
+	if x != nil {
+		return x.FighterId
+	}
+	return 0
+}
+
+type CreateBetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BetId int32 `protobuf:"varint,1,opt,name=betId,proto3" json:"betId,omitempty"`
+}
+
+func (x *CreateBetResponse) Reset() {
+	*x = CreateBetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBetResponse) ProtoMessage() {}
+
+func (x *CreateBetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBetResponse.ProtoReflect.Descriptor instead.
+func (*CreateBetResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateBetResponse) GetBetId() int32 {
+	if x != nil {
+		return x.BetId
+	}
+	return 0
+}
+
+type BetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int32 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *BetsRequest) Reset() {
+	*x = BetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BetsRequest) ProtoMessage() {}
+
+func (x *BetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BetsRequest.ProtoReflect.Descriptor instead.
+func (*BetsRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BetsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type BetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32  `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+	Bets  []*Bet `protobuf:"bytes,2,rep,name=bets,proto3" json:"bets,omitempty"`
+}
+
+func (x *BetsResponse) Reset() {
+	*x = BetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BetsResponse) ProtoMessage() {}
+
+func (x *BetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BetsResponse.ProtoReflect.Descriptor instead.
+func (*BetsResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *BetsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BetsResponse) GetBets() []*Bet {
+	if x != nil {
+		return x.Bets
+	}
+	return nil
+}
+
+type AnonymizeBetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bets int32 `protobuf:"varint,1,opt,name=bets,proto3" json:"bets,omitempty"`
+}
+
+func (x *AnonymizeBetsResponse) Reset() {
+	*x = AnonymizeBetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnonymizeBetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeBetsResponse) ProtoMessage() {}
+
+func (x *AnonymizeBetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeBetsResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeBetsResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AnonymizeBetsResponse) GetBets() int32 {
+	if x != nil {
+		return x.Bets
+	}
+	return 0
+}
+
+type FightResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FightId    int32  `protobuf:"varint,1,opt,name=fightId,proto3" json:"fightId,omitempty"`
+	WinnerId   int32  `protobuf:"varint,2,opt,name=winnerId,proto3" json:"winnerId,omitempty"`
+	NotContest bool   `protobuf:"varint,3,opt,name=notContest,proto3" json:"notContest,omitempty"`
+	Method     string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Round      int32  `protobuf:"varint,5,opt,name=round,proto3" json:"round,omitempty"`
+	Time       string `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *FightResultRequest) Reset() {
+	*x = FightResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FightResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FightResultRequest) ProtoMessage() {}
+
+func (x *FightResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FightResultRequest.ProtoReflect.Descriptor instead.
+func (*FightResultRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FightResultRequest) GetFightId() int32 {
+	if x != nil {
+		return x.FightId
+	}
+	return 0
+}
+
+func (x *FightResultRequest) GetWinnerId() int32 {
+	if x != nil {
+		return x.WinnerId
+	}
+	return 0
+}
+
+func (x *FightResultRequest) GetNotContest() bool {
+	if x != nil {
+		return x.NotContest
+	}
+	return false
+}
+
+func (x *FightResultRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *FightResultRequest) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *FightResultRequest) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type FightResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FightId int32 `protobuf:"varint,1,opt,name=fightId,proto3" json:"fightId,omitempty"`
+}
+
+func (x *FightResultResponse) Reset() {
+	*x = FightResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FightResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FightResultResponse) ProtoMessage() {}
+
+func (x *FightResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FightResultResponse.ProtoReflect.Descriptor instead.
+func (*FightResultResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FightResultResponse) GetFightId() int32 {
+	if x != nil {
+		return x.FightId
+	}
+	return 0
+}
+
+// ScrapedResult is a bout result read from an event page, fighters are identified by their profile URLs.
+// The winner URL is empty for a draw or a no contest.
+type ScrapedResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedUrl     string `protobuf:"bytes,1,opt,name=redUrl,proto3" json:"redUrl,omitempty"`
+	BlueUrl    string `protobuf:"bytes,2,opt,name=blueUrl,proto3" json:"blueUrl,omitempty"`
+	WinnerUrl  string `protobuf:"bytes,3,opt,name=winnerUrl,proto3" json:"winnerUrl,omitempty"`
+	NotContest bool   `protobuf:"varint,4,opt,name=notContest,proto3" json:"notContest,omitempty"`
+	Method     string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Round      int32  `protobuf:"varint,6,opt,name=round,proto3" json:"round,omitempty"`
+	Time       string `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *ScrapedResult) Reset() {
+	*x = ScrapedResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScrapedResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrapedResult) ProtoMessage() {}
+
+func (x *ScrapedResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrapedResult.ProtoReflect.Descriptor instead.
+func (*ScrapedResult) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ScrapedResult) GetRedUrl() string {
+	if x != nil {
+		return x.RedUrl
+	}
+	return ""
+}
+
+func (x *ScrapedResult) GetBlueUrl() string {
+	if x != nil {
+		return x.BlueUrl
+	}
+	return ""
+}
+
+func (x *ScrapedResult) GetWinnerUrl() string {
+	if x != nil {
+		return x.WinnerUrl
+	}
+	return ""
+}
+
+func (x *ScrapedResult) GetNotContest() bool {
+	if x != nil {
+		return x.NotContest
+	}
+	return false
+}
+
+func (x *ScrapedResult) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ScrapedResult) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ScrapedResult) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type ScrapedResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventName string           `protobuf:"bytes,1,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	EventUrl  string           `protobuf:"bytes,2,opt,name=eventUrl,proto3" json:"eventUrl,omitempty"`
+	Results   []*ScrapedResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	AutoApply bool             `protobuf:"varint,4,opt,name=autoApply,proto3" json:"autoApply,omitempty"`
+}
+
+func (x *ScrapedResultsRequest) Reset() {
+	*x = ScrapedResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScrapedResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrapedResultsRequest) ProtoMessage() {}
+
+func (x *ScrapedResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrapedResultsRequest.ProtoReflect.Descriptor instead.
+func (*ScrapedResultsRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ScrapedResultsRequest) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *ScrapedResultsRequest) GetEventUrl() string {
+	if x != nil {
+		return x.EventUrl
+	}
+	return ""
+}
+
+func (x *ScrapedResultsRequest) GetResults() []*ScrapedResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ScrapedResultsRequest) GetAutoApply() bool {
+	if x != nil {
+		return x.AutoApply
+	}
+	return false
+}
+
+type ScrapedResultOutcome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result   *ScrapedResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	FightId  int32          `protobuf:"varint,2,opt,name=fightId,proto3" json:"fightId,omitempty"`
+	Status   string         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Message  string         `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	ReviewId int32          `protobuf:"varint,5,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+}
+
+func (x *ScrapedResultOutcome) Reset() {
+	*x = ScrapedResultOutcome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScrapedResultOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrapedResultOutcome) ProtoMessage() {}
+
+func (x *ScrapedResultOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrapedResultOutcome.ProtoReflect.Descriptor instead.
+func (*ScrapedResultOutcome) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ScrapedResultOutcome) GetResult() *ScrapedResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ScrapedResultOutcome) GetFightId() int32 {
+	if x != nil {
+		return x.FightId
+	}
+	return 0
+}
+
+func (x *ScrapedResultOutcome) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScrapedResultOutcome) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ScrapedResultOutcome) GetReviewId() int32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+type ScrapedResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcomes []*ScrapedResultOutcome `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+}
+
+func (x *ScrapedResultsResponse) Reset() {
+	*x = ScrapedResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScrapedResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrapedResultsResponse) ProtoMessage() {}
+
+func (x *ScrapedResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrapedResultsResponse.ProtoReflect.Descriptor instead.
+func (*ScrapedResultsResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ScrapedResultsResponse) GetOutcomes() []*ScrapedResultOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+type ResultChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Existing string `protobuf:"bytes,2,opt,name=existing,proto3" json:"existing,omitempty"`
+	Scraped  string `protobuf:"bytes,3,opt,name=scraped,proto3" json:"scraped,omitempty"`
+}
+
+func (x *ResultChange) Reset() {
+	*x = ResultChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultChange) ProtoMessage() {}
+
+func (x *ResultChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultChange.ProtoReflect.Descriptor instead.
+func (*ResultChange) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ResultChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ResultChange) GetExisting() string {
+	if x != nil {
+		return x.Existing
+	}
+	return ""
+}
+
+func (x *ResultChange) GetScraped() string {
+	if x != nil {
+		return x.Scraped
+	}
+	return ""
+}
+
+type ResultReview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewId      int32               `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	FightId       int32               `protobuf:"varint,2,opt,name=fightId,proto3" json:"fightId,omitempty"`
+	EventId       int32               `protobuf:"varint,3,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	EventName     string              `protobuf:"bytes,4,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	FighterRedId  int32               `protobuf:"varint,5,opt,name=fighterRedId,proto3" json:"fighterRedId,omitempty"`
+	FighterBlueId int32               `protobuf:"varint,6,opt,name=fighterBlueId,proto3" json:"fighterBlueId,omitempty"`
+	Status        string              `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ExistingDone  bool                `protobuf:"varint,8,opt,name=existingDone,proto3" json:"existingDone,omitempty"`
+	Existing      *FightResultRequest `protobuf:"bytes,9,opt,name=existing,proto3" json:"existing,omitempty"`
+	Scraped       *FightResultRequest `protobuf:"bytes,10,opt,name=scraped,proto3" json:"scraped,omitempty"`
+	Changes       []*ResultChange     `protobuf:"bytes,11,rep,name=changes,proto3" json:"changes,omitempty"`
+	SourceUrl     string              `protobuf:"bytes,12,opt,name=sourceUrl,proto3" json:"sourceUrl,omitempty"`
+	CreatedAt     int64               `protobuf:"varint,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ReviewedAt    int64               `protobuf:"varint,14,opt,name=reviewedAt,proto3" json:"reviewedAt,omitempty"`
+}
+
+func (x *ResultReview) Reset() {
+	*x = ResultReview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultReview) ProtoMessage() {}
+
+func (x *ResultReview) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultReview.ProtoReflect.Descriptor instead.
+func (*ResultReview) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ResultReview) GetReviewId() int32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ResultReview) GetFightId() int32 {
+	if x != nil {
+		return x.FightId
+	}
+	return 0
+}
+
+func (x *ResultReview) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ResultReview) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *ResultReview) GetFighterRedId() int32 {
+	if x != nil {
+		return x.FighterRedId
+	}
+	return 0
+}
+
+func (x *ResultReview) GetFighterBlueId() int32 {
+	if x != nil {
+		return x.FighterBlueId
+	}
+	return 0
+}
+
+func (x *ResultReview) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ResultReview) GetExistingDone() bool {
+	if x != nil {
+		return x.ExistingDone
+	}
+	return false
+}
+
+func (x *ResultReview) GetExisting() *FightResultRequest {
+	if x != nil {
+		return x.Existing
+	}
+	return nil
+}
+
+func (x *ResultReview) GetScraped() *FightResultRequest {
+	if x != nil {
+		return x.Scraped
+	}
+	return nil
+}
+
+func (x *ResultReview) GetChanges() []*ResultChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ResultReview) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *ResultReview) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ResultReview) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+type ResultReviewsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ResultReviewsRequest) Reset() {
+	*x = ResultReviewsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultReviewsRequest) ProtoMessage() {}
+
+func (x *ResultReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ResultReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ResultReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ResultReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ResultReviewsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviews []*ResultReview `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+}
+
+func (x *ResultReviewsResponse) Reset() {
+	*x = ResultReviewsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultReviewsResponse) ProtoMessage() {}
+
+func (x *ResultReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ResultReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ResultReviewsResponse) GetReviews() []*ResultReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type ReviewResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewId int32 `protobuf:"varint,1,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	Approve  bool  `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (x *ReviewResultRequest) Reset() {
+	*x = ReviewResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewResultRequest) ProtoMessage() {}
+
+func (x *ReviewResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewResultRequest.ProtoReflect.Descriptor instead.
+func (*ReviewResultRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReviewResultRequest) GetReviewId() int32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewResultRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type ReviewResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review *ResultReview `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *ReviewResultResponse) Reset() {
+	*x = ReviewResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewResultResponse) ProtoMessage() {}
+
+func (x *ReviewResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewResultResponse.ProtoReflect.Descriptor instead.
+func (*ReviewResultResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReviewResultResponse) GetReview() *ResultReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type MergeFightersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SurvivorId  int32 `protobuf:"varint,1,opt,name=survivorId,proto3" json:"survivorId,omitempty"`
+	DuplicateId int32 `protobuf:"varint,2,opt,name=duplicateId,proto3" json:"duplicateId,omitempty"`
+}
+
+func (x *MergeFightersRequest) Reset() {
+	*x = MergeFightersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeFightersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeFightersRequest) ProtoMessage() {}
+
+func (x *MergeFightersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeFightersRequest.ProtoReflect.Descriptor instead.
+func (*MergeFightersRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MergeFightersRequest) GetSurvivorId() int32 {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return 0
+}
+
+func (x *MergeFightersRequest) GetDuplicateId() int32 {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return 0
+}
+
+type MergeFightersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fights int32 `protobuf:"varint,1,opt,name=fights,proto3" json:"fights,omitempty"`
+	Bets   int32 `protobuf:"varint,2,opt,name=bets,proto3" json:"bets,omitempty"`
+}
+
+func (x *MergeFightersResponse) Reset() {
+	*x = MergeFightersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeFightersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeFightersResponse) ProtoMessage() {}
+
+func (x *MergeFightersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeFightersResponse.ProtoReflect.Descriptor instead.
+func (*MergeFightersResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MergeFightersResponse) GetFights() int32 {
+	if x != nil {
+		return x.Fights
+	}
+	return 0
+}
+
+func (x *MergeFightersResponse) GetBets() int32 {
+	if x != nil {
+		return x.Bets
+	}
+	return 0
+}
+
+type Fight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FightId       int32  `protobuf:"varint,1,opt,name=fightId,proto3" json:"fightId,omitempty"`
+	EventId       int32  `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	FighterRedId  int32  `protobuf:"varint,3,opt,name=fighterRedId,proto3" json:"fighterRedId,omitempty"`
+	FighterBlueId int32  `protobuf:"varint,4,opt,name=fighterBlueId,proto3" json:"fighterBlueId,omitempty"`
+	IsDone        bool   `protobuf:"varint,5,opt,name=isDone,proto3" json:"isDone,omitempty"`
+	IsCanceled    bool   `protobuf:"varint,6,opt,name=isCanceled,proto3" json:"isCanceled,omitempty"`
+	NotContest    bool   `protobuf:"varint,7,opt,name=notContest,proto3" json:"notContest,omitempty"`
+	Result        int32  `protobuf:"varint,8,opt,name=result,proto3" json:"result,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	FightDate     int64  `protobuf:"varint,10,opt,name=fightDate,proto3" json:"fightDate,omitempty"`
+	Method        string `protobuf:"bytes,11,opt,name=method,proto3" json:"method,omitempty"`
+	Round         int32  `protobuf:"varint,12,opt,name=round,proto3" json:"round,omitempty"`
+	Time          string `protobuf:"bytes,13,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Fight) Reset() {
+	*x = Fight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fight) ProtoMessage() {}
+
+func (x *Fight) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fight.ProtoReflect.Descriptor instead.
+func (*Fight) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Fight) GetFightId() int32 {
+	if x != nil {
+		return x.FightId
+	}
+	return 0
+}
+
+func (x *Fight) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *Fight) GetFighterRedId() int32 {
+	if x != nil {
+		return x.FighterRedId
+	}
+	return 0
+}
+
+func (x *Fight) GetFighterBlueId() int32 {
+	if x != nil {
+		return x.FighterBlueId
+	}
+	return 0
+}
+
+func (x *Fight) GetIsDone() bool {
+	if x != nil {
+		return x.IsDone
+	}
+	return false
+}
+
+func (x *Fight) GetIsCanceled() bool {
+	if x != nil {
+		return x.IsCanceled
+	}
+	return false
+}
+
+func (x *Fight) GetNotContest() bool {
+	if x != nil {
+		return x.NotContest
+	}
+	return false
+}
+
+func (x *Fight) GetResult() int32 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *Fight) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Fight) GetFightDate() int64 {
+	if x != nil {
+		return x.FightDate
+	}
+	return 0
+}
+
+func (x *Fight) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Fight) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Fight) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId int32    `protobuf:"varint,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Fights  []*Fight `protobuf:"bytes,3,rep,name=fights,proto3" json:"fights,omitempty"`
+	IsDone  bool     `protobuf:"varint,4,opt,name=isDone,proto3" json:"isDone,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Event) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetFights() []*Fight {
+	if x != nil {
+		return x.Fights
+	}
+	return nil
+}
+
+func (x *Event) GetIsDone() bool {
+	if x != nil {
+		return x.IsDone
+	}
+	return false
+}
+
+// TODO change Bet and BetRequest models
+type Bet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BetId     int32 `protobuf:"varint,1,opt,name=betId,proto3" json:"betId,omitempty"`
+	FightId   int32 `protobuf:"varint,2,opt,name=fightId,proto3" json:"fightId,omitempty"`
+	UserId    int32 `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	FighterId int32 `protobuf:"varint,4,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
+}
+
+func (x *Bet) Reset() {
+	*x = Bet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bet) ProtoMessage() {}
+
+func (x *Bet) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bet.ProtoReflect.Descriptor instead.
+func (*Bet) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Bet) GetBetId() int32 {
+	if x != nil {
+		return x.BetId
+	}
+	return 0
+}
+
+func (x *Bet) GetFightId() int32 {
+	if x != nil {
+		return x.FightId
+	}
+	return 0
+}
+
+func (x *Bet) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Bet) GetFighterId() int32 {
+	if x != nil {
+		return x.FighterId
+	}
+	return 0
+}
+
+type Fighter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FighterId      int32             `protobuf:"varint,1,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
+	Name           string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NickName       string            `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
+	Division       int32             `protobuf:"varint,4,opt,name=division,proto3" json:"division,omitempty"`
+	Status         string            `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Hometown       string            `protobuf:"bytes,6,opt,name=hometown,proto3" json:"hometown,omitempty"`
+	TrainsAt       string            `protobuf:"bytes,7,opt,name=trainsAt,proto3" json:"trainsAt,omitempty"`
+	FightingStyle  string            `protobuf:"bytes,8,opt,name=fightingStyle,proto3" json:"fightingStyle,omitempty"`
+	Age            int32             `protobuf:"varint,9,opt,name=age,proto3" json:"age,omitempty"`
+	Height         float32           `protobuf:"fixed32,10,opt,name=height,proto3" json:"height,omitempty"`
+	Weight         float32           `protobuf:"fixed32,11,opt,name=weight,proto3" json:"weight,omitempty"`
+	OctagonDebut   string            `protobuf:"bytes,12,opt,name=octagonDebut,proto3" json:"octagonDebut,omitempty"`
+	DebutTimestamp int32             `protobuf:"varint,13,opt,name=debutTimestamp,proto3" json:"debutTimestamp,omitempty"`
+	Reach          float32           `protobuf:"fixed32,14,opt,name=reach,proto3" json:"reach,omitempty"`
+	LegReach       float32           `protobuf:"fixed32,15,opt,name=legReach,proto3" json:"legReach,omitempty"`
+	Wins           int32             `protobuf:"varint,16,opt,name=wins,proto3" json:"wins,omitempty"`
+	Loses          int32             `protobuf:"varint,17,opt,name=loses,proto3" json:"loses,omitempty"`
+	Draw           int32             `protobuf:"varint,18,opt,name=draw,proto3" json:"draw,omitempty"`
+	FighterUrl     string            `protobuf:"bytes,19,opt,name=fighterUrl,proto3" json:"fighterUrl,omitempty"`
+	ImageUrl       string            `protobuf:"bytes,20,opt,name=imageUrl,proto3" json:"imageUrl,omitempty"`
+	Stats          *FighterStats     `protobuf:"bytes,21,opt,name=stats,proto3" json:"stats,omitempty"`
+	Analytics      *FighterAnalytics `protobuf:"bytes,22,opt,name=analytics,proto3" json:"analytics,omitempty"`
+}
+
+func (x *Fighter) Reset() {
+	*x = Fighter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fighter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fighter) ProtoMessage() {}
+
+func (x *Fighter) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fighter.ProtoReflect.Descriptor instead.
+func (*Fighter) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Fighter) GetFighterId() int32 {
+	if x != nil {
+		return x.FighterId
+	}
+	return 0
+}
+
+func (x *Fighter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Fighter) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+func (x *Fighter) GetDivision() int32 {
+	if x != nil {
+		return x.Division
+	}
+	return 0
+}
+
+func (x *Fighter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Fighter) GetHometown() string {
+	if x != nil {
+		return x.Hometown
+	}
+	return ""
+}
+
+func (x *Fighter) GetTrainsAt() string {
+	if x != nil {
+		return x.TrainsAt
+	}
+	return ""
+}
+
+func (x *Fighter) GetFightingStyle() string {
+	if x != nil {
+		return x.FightingStyle
+	}
+	return ""
+}
+
+func (x *Fighter) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *Fighter) GetHeight() float32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Fighter) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Fighter) GetOctagonDebut() string {
+	if x != nil {
+		return x.OctagonDebut
+	}
+	return ""
+}
+
+func (x *Fighter) GetDebutTimestamp() int32 {
+	if x != nil {
+		return x.DebutTimestamp
+	}
+	return 0
+}
+
+func (x *Fighter) GetReach() float32 {
+	if x != nil {
+		return x.Reach
+	}
+	return 0
+}
+
+func (x *Fighter) GetLegReach() float32 {
+	if x != nil {
+		return x.LegReach
+	}
+	return 0
+}
+
+func (x *Fighter) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Fighter) GetLoses() int32 {
+	if x != nil {
+		return x.Loses
+	}
+	return 0
+}
+
+func (x *Fighter) GetDraw() int32 {
+	if x != nil {
+		return x.Draw
+	}
+	return 0
+}
+
+func (x *Fighter) GetFighterUrl() string {
+	if x != nil {
+		return x.FighterUrl
+	}
+	return ""
+}
+
+func (x *Fighter) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Fighter) GetStats() *FighterStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Fighter) GetAnalytics() *FighterAnalytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+type FighterAnalytics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrikingDifferential float32            `protobuf:"fixed32,1,opt,name=strikingDifferential,proto3" json:"strikingDifferential,omitempty"`
+	FinishRate           float32            `protobuf:"fixed32,2,opt,name=finishRate,proto3" json:"finishRate,omitempty"`
+	KoPercentage         float32            `protobuf:"fixed32,3,opt,name=koPercentage,proto3" json:"koPercentage,omitempty"`
+	SubPercentage        float32            `protobuf:"fixed32,4,opt,name=subPercentage,proto3" json:"subPercentage,omitempty"`
+	DecPercentage        float32            `protobuf:"fixed32,5,opt,name=decPercentage,proto3" json:"decPercentage,omitempty"`
+	TakedownSuccess      float32            `protobuf:"fixed32,6,opt,name=takedownSuccess,proto3" json:"takedownSuccess,omitempty"`
+	AvgFightTimeSeconds  int32              `protobuf:"varint,7,opt,name=avgFightTimeSeconds,proto3" json:"avgFightTimeSeconds,omitempty"`
+	Percentiles          map[string]float32 `protobuf:"bytes,8,rep,name=percentiles,proto3" json:"percentiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+}
+
+func (x *FighterAnalytics) Reset() {
+	*x = FighterAnalytics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FighterAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FighterAnalytics) ProtoMessage() {}
+
+func (x *FighterAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FighterAnalytics.ProtoReflect.Descriptor instead.
+func (*FighterAnalytics) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FighterAnalytics) GetStrikingDifferential() float32 {
+	if x != nil {
+		return x.StrikingDifferential
+	}
+	return 0
+}
+
+func (x *FighterAnalytics) GetFinishRate() float32 {
+	if x != nil {
+		return x.FinishRate
+	}
+	return 0
+}
+
+func (x *FighterAnalytics) GetKoPercentage() float32 {
+	if x != nil {
+		return x.KoPercentage
+	}
+	return 0
+}
+
+func (x *FighterAnalytics) GetSubPercentage() float32 {
+	if x != nil {
+		return x.SubPercentage
+	}
+	return 0
+}
+
+func (x *FighterAnalytics) GetDecPercentage() float32 {
+	if x != nil {
+		return x.DecPercentage
+	}
+	return 0
+}
+
+func (x *FighterAnalytics) GetTakedownSuccess() float32 {
+	if x != nil {
+		return x.TakedownSuccess
+	}
+	return 0
+}
+
+func (x *FighterAnalytics) GetAvgFightTimeSeconds() int32 {
+	if x != nil {
+		return x.AvgFightTimeSeconds
+	}
+	return 0
+}
+
+func (x *FighterAnalytics) GetPercentiles() map[string]float32 {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+type FighterStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatId               int32   `protobuf:"varint,1,opt,name=statId,proto3" json:"statId,omitempty"`
+	FighterId            int32   `protobuf:"varint,2,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
+	TotalSigStrLanded    int32   `protobuf:"varint,3,opt,name=totalSigStrLanded,proto3" json:"totalSigStrLanded,omitempty"`
+	TotalSigStrAttempted int32   `protobuf:"varint,4,opt,name=totalSigStrAttempted,proto3" json:"totalSigStrAttempted,omitempty"`
+	StrAccuracy          int32   `protobuf:"varint,5,opt,name=strAccuracy,proto3" json:"strAccuracy,omitempty"`
+	TotalTkdLanded       int32   `protobuf:"varint,6,opt,name=totalTkdLanded,proto3" json:"totalTkdLanded,omitempty"`
+	TotalTkdAttempted    int32   `protobuf:"varint,7,opt,name=totalTkdAttempted,proto3" json:"totalTkdAttempted,omitempty"`
+	TkdAccuracy          int32   `protobuf:"varint,8,opt,name=tkdAccuracy,proto3" json:"tkdAccuracy,omitempty"`
+	SigStrLanded         float32 `protobuf:"fixed32,9,opt,name=sigStrLanded,proto3" json:"sigStrLanded,omitempty"`
+	SigStrAbs            float32 `protobuf:"fixed32,10,opt,name=sigStrAbs,proto3" json:"sigStrAbs,omitempty"`
+	SigStrDefense        int32   `protobuf:"varint,11,opt,name=sigStrDefense,proto3" json:"sigStrDefense,omitempty"`
+	TakedownDefense      int32   `protobuf:"varint,12,opt,name=takedownDefense,proto3" json:"takedownDefense,omitempty"`
+	TakedownAvg          float32 `protobuf:"fixed32,13,opt,name=takedownAvg,proto3" json:"takedownAvg,omitempty"`
+	SubmissionAvg        float32 `protobuf:"fixed32,14,opt,name=submissionAvg,proto3" json:"submissionAvg,omitempty"`
+	KnockdownAvg         float32 `protobuf:"fixed32,15,opt,name=knockdownAvg,proto3" json:"knockdownAvg,omitempty"`
+	AvgFightTime         string  `protobuf:"bytes,16,opt,name=avgFightTime,proto3" json:"avgFightTime,omitempty"`
+	WinByKO              int32   `protobuf:"varint,17,opt,name=winByKO,proto3" json:"winByKO,omitempty"`
+	WinBySub             int32   `protobuf:"varint,18,opt,name=winBySub,proto3" json:"winBySub,omitempty"`
+	WinByDec             int32   `protobuf:"varint,19,opt,name=winByDec,proto3" json:"winByDec,omitempty"`
+}
+
+func (x *FighterStats) Reset() {
+	*x = FighterStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FighterStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FighterStats) ProtoMessage() {}
+
+func (x *FighterStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FighterStats.ProtoReflect.Descriptor instead.
+func (*FighterStats) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *FighterStats) GetStatId() int32 {
+	if x != nil {
+		return x.StatId
+	}
+	return 0
+}
+
+func (x *FighterStats) GetFighterId() int32 {
+	if x != nil {
+		return x.FighterId
+	}
+	return 0
+}
+
+func (x *FighterStats) GetTotalSigStrLanded() int32 {
+	if x != nil {
+		return x.TotalSigStrLanded
+	}
+	return 0
+}
+
+func (x *FighterStats) GetTotalSigStrAttempted() int32 {
+	if x != nil {
+		return x.TotalSigStrAttempted
+	}
+	return 0
+}
+
+func (x *FighterStats) GetStrAccuracy() int32 {
+	if x != nil {
+		return x.StrAccuracy
+	}
+	return 0
+}
+
+func (x *FighterStats) GetTotalTkdLanded() int32 {
+	if x != nil {
+		return x.TotalTkdLanded
+	}
+	return 0
+}
+
+func (x *FighterStats) GetTotalTkdAttempted() int32 {
+	if x != nil {
+		return x.TotalTkdAttempted
+	}
+	return 0
+}
+
+func (x *FighterStats) GetTkdAccuracy() int32 {
+	if x != nil {
+		return x.TkdAccuracy
+	}
+	return 0
+}
+
+func (x *FighterStats) GetSigStrLanded() float32 {
+	if x != nil {
+		return x.SigStrLanded
+	}
+	return 0
+}
+
+func (x *FighterStats) GetSigStrAbs() float32 {
+	if x != nil {
+		return x.SigStrAbs
+	}
+	return 0
+}
+
+func (x *FighterStats) GetSigStrDefense() int32 {
+	if x != nil {
+		return x.SigStrDefense
+	}
+	return 0
+}
+
+func (x *FighterStats) GetTakedownDefense() int32 {
+	if x != nil {
+		return x.TakedownDefense
+	}
+	return 0
+}
+
+func (x *FighterStats) GetTakedownAvg() float32 {
+	if x != nil {
+		return x.TakedownAvg
+	}
+	return 0
+}
+
+func (x *FighterStats) GetSubmissionAvg() float32 {
+	if x != nil {
+		return x.SubmissionAvg
+	}
+	return 0
+}
+
+func (x *FighterStats) GetKnockdownAvg() float32 {
+	if x != nil {
+		return x.KnockdownAvg
+	}
+	return 0
+}
+
+func (x *FighterStats) GetAvgFightTime() string {
+	if x != nil {
+		return x.AvgFightTime
+	}
+	return ""
+}
+
+func (x *FighterStats) GetWinByKO() int32 {
+	if x != nil {
+		return x.WinByKO
+	}
+	return 0
+}
+
+func (x *FighterStats) GetWinBySub() int32 {
+	if x != nil {
+		return x.WinBySub
+	}
+	return 0
+}
+
+func (x *FighterStats) GetWinByDec() int32 {
+	if x != nil {
+		return x.WinByDec
+	}
+	return 0
+}
+
+type FightersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      string  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FightersIds []int32 `protobuf:"varint,2,rep,packed,name=fightersIds,proto3" json:"fightersIds,omitempty"`
+	Division    *int32  `protobuf:"varint,3,opt,name=division,proto3,oneof" json:"division,omitempty"`
+}
+
+func (x *FightersRequest) Reset() {
+	*x = FightersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FightersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FightersRequest) ProtoMessage() {}
+
+func (x *FightersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FightersRequest.ProtoReflect.Descriptor instead.
+func (*FightersRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *FightersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FightersRequest) GetFightersIds() []int32 {
+	if x != nil {
+		return x.FightersIds
+	}
+	return nil
+}
+
+func (x *FightersRequest) GetDivision() int32 {
+	if x != nil && x.Division != nil {
+		return *x.Division
+	}
+	return 0
+}
+
+type FightersTextSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query    string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit    int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Status   string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Division *int32 `protobuf:"varint,4,opt,name=division,proto3,oneof" json:"division,omitempty"`
+}
+
+func (x *FightersTextSearchRequest) Reset() {
+	*x = FightersTextSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FightersTextSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FightersTextSearchRequest) ProtoMessage() {}
+
+func (x *FightersTextSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FightersTextSearchRequest.ProtoReflect.Descriptor instead.
+func (*FightersTextSearchRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *FightersTextSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *FightersTextSearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FightersTextSearchRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FightersTextSearchRequest) GetDivision() int32 {
+	if x != nil && x.Division != nil {
+		return *x.Division
+	}
+	return 0
+}
+
+type FightersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fighters []*Fighter `protobuf:"bytes,1,rep,name=fighters,proto3" json:"fighters,omitempty"`
+}
+
+func (x *FightersResponse) Reset() {
+	*x = FightersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FightersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FightersResponse) ProtoMessage() {}
+
+func (x *FightersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FightersResponse.ProtoReflect.Descriptor instead.
+func (*FightersResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *FightersResponse) GetFighters() []*Fighter {
+	if x != nil {
+		return x.Fighters
+	}
+	return nil
+}
+
+type FightersCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FightersCountResponse) Reset() {
+	*x = FightersCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FightersCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FightersCountResponse) ProtoMessage() {}
+
+func (x *FightersCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FightersCountResponse.ProtoReflect.Descriptor instead.
+func (*FightersCountResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FightersCountResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type UpsertFightersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created int32 `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed  int32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *UpsertFightersResponse) Reset() {
+	*x = UpsertFightersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertFightersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertFightersResponse) ProtoMessage() {}
+
+func (x *UpsertFightersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertFightersResponse.ProtoReflect.Descriptor instead.
+func (*UpsertFightersResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpsertFightersResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *UpsertFightersResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *UpsertFightersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type FighterImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FighterId int32  `protobuf:"varint,1,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
+	Size      string `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	Format    string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *FighterImageRequest) Reset() {
+	*x = FighterImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FighterImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FighterImageRequest) ProtoMessage() {}
+
+func (x *FighterImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FighterImageRequest.ProtoReflect.Descriptor instead.
+func (*FighterImageRequest) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *FighterImageRequest) GetFighterId() int32 {
+	if x != nil {
+		return x.FighterId
+	}
+	return 0
+}
+
+func (x *FighterImageRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *FighterImageRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type FighterImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FighterId   int32  `protobuf:"varint,1,opt,name=fighterId,proto3" json:"fighterId,omitempty"`
+	Size        string `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	Format      string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Etag        string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	Data        []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *FighterImageResponse) Reset() {
+	*x = FighterImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FighterImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FighterImageResponse) ProtoMessage() {}
+
+func (x *FighterImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FighterImageResponse.ProtoReflect.Descriptor instead.
+func (*FighterImageResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *FighterImageResponse) GetFighterId() int32 {
+	if x != nil {
+		return x.FighterId
+	}
+	return 0
+}
+
+func (x *FighterImageResponse) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *FighterImageResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *FighterImageResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *FighterImageResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *FighterImageResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type HealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppDevVersion string `protobuf:"bytes,1,opt,name=app_dev_version,json=appDevVersion,proto3" json:"app_dev_version,omitempty"`
+	AppName       string `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	AppRunDate    int64  `protobuf:"varint,3,opt,name=app_run_date,json=appRunDate,proto3" json:"app_run_date,omitempty"`
+	AppTimeAlive  int64  `protobuf:"varint,4,opt,name=app_time_alive,json=appTimeAlive,proto3" json:"app_time_alive,omitempty"`
+	Healthy       bool   `protobuf:"varint,5,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Message       string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     string `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pickfighter_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pickfighter_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_pickfighter_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *HealthResponse) GetAppDevVersion() string {
+	if x != nil {
+		return x.AppDevVersion
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetAppRunDate() int64 {
+	if x != nil {
+		return x.AppRunDate
+	}
+	return 0
+}
+
+func (x *HealthResponse) GetAppTimeAlive() int64 {
+	if x != nil {
+		return x.AppTimeAlive
+	}
+	return 0
+}
+
+func (x *HealthResponse) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *HealthResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+var File_pickfighter_proto protoreflect.FileDescriptor
+
+var file_pickfighter_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x70, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x6b, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x02, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x49, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xe5, 0x02, 0x0a, 0x14, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x42, 0x0a,
+	0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x50, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x15, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x22, 0x9e, 0x01, 0x0a, 0x12, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x42, 0x0a,
+	0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x22, 0x75, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x49, 0x0a,
+	0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x4f, 0x0a,
+	0x11, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x22, 0x62,
+	0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8f, 0x01,
+	0x0a, 0x15, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x53, 0x0a, 0x11, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3e, 0x0a, 0x12, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x22, 0x3d, 0x0a, 0x0f, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x54, 0x4f, 0x54, 0x50, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xd5, 0x02, 0x0a, 0x0b, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x74, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41,
+	0x74, 0x22, 0x42, 0x0a, 0x12, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3b, 0x0a, 0x13, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x49, 0x64, 0x22, 0x39, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x78, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x71, 0x0a,
+	0x11, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0xd0, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x75, 0x64, 0x69, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x61, 0x75, 0x64, 0x69, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x40,
+	0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x3a, 0x0a, 0x11, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x14,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x22, 0x4b, 0x0a, 0x15, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x75, 0x0a, 0x17, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4e, 0x0a, 0x18, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x76, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x31, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4a, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x22, 0x28, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x0c,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x19, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x39, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x1f, 0x0a, 0x1d,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a,
+	0x1a, 0x44, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x37, 0x0a, 0x1b, 0x44, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfc, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x06, 0x66, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x66, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22,
+	0x2f, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x78, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x29, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x0b, 0x42, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x3e, 0x0a, 0x0c, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x62, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x04, 0x62, 0x65, 0x74, 0x73, 0x22,
+	0x2b, 0x0a, 0x15, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x42, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x65, 0x74, 0x73, 0x22, 0xac, 0x01, 0x0a,
+	0x12, 0x46, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e,
+	0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x46,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x22, 0xc1, 0x01, 0x0a,
+	0x0d, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x75, 0x65, 0x55, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x75, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x99, 0x01, 0x0a, 0x15, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x55, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xa6, 0x01, 0x0a,
+	0x14, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x16, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x73, 0x22, 0x5a, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x22, 0xe7,
+	0x03, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69,
+	0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x52, 0x65, 0x64, 0x49, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x52, 0x65, 0x64, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x42, 0x6c, 0x75, 0x65,
+	0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x72, 0x42, 0x6c, 0x75, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x6e, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44,
+	0x6f, 0x6e, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x73, 0x63, 0x72, 0x61,
+	0x70, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x40,
+	0x0a, 0x15, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x22, 0x4b, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x3d, 0x0a,
+	0x14, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x58, 0x0a, 0x14,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76, 0x6f, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x46,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x66, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x65, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x62, 0x65, 0x74, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x05,
+	0x46, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x52, 0x65, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x52, 0x65, 0x64, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x42, 0x6c, 0x75, 0x65, 0x49, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x42, 0x6c, 0x75,
+	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
+	0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x6d, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74,
+	0x52, 0x06, 0x66, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x44, 0x6f,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x44, 0x6f, 0x6e, 0x65,
+	0x22, 0x6b, 0x0a, 0x03, 0x42, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x66, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf9, 0x04,
+	0x0a, 0x07, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x6f, 0x6d, 0x65, 0x74, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x6f, 0x6d, 0x65, 0x74, 0x6f, 0x77, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x73, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x73, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x67, 0x68, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x79, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x67, 0x68,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6f,
+	0x63, 0x74, 0x61, 0x67, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x63, 0x74, 0x61, 0x67, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x64, 0x65, 0x62, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x75, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x72, 0x65, 0x61, 0x63, 0x68, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x65, 0x67, 0x52, 0x65, 0x61, 0x63, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x6c, 0x65, 0x67, 0x52, 0x65, 0x61, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x6f,
+	0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72, 0x61, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x55, 0x72, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x46, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x09,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x22, 0xb8, 0x03, 0x0a, 0x10, 0x46, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x32,
+	0x0a, 0x14, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x73, 0x74,
+	0x72, 0x69, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6b, 0x6f, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6b, 0x6f, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x73,
+	0x75, 0x62, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x64, 0x65, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x74, 0x61, 0x6b,
+	0x65, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x13,
+	0x61, 0x76, 0x67, 0x46, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x61, 0x76, 0x67, 0x46, 0x69,
+	0x67, 0x68, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x44,
+	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb4, 0x05, 0x0a, 0x0c, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x53, 0x74, 0x72, 0x4c, 0x61, 0x6e, 0x64, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x67,
+	0x53, 0x74, 0x72, 0x4c, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x53, 0x74, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x53, 0x74, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x74, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12,
+	0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6b, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6b,
+	0x64, 0x4c, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x54, 0x6b, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6b, 0x64, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6b, 0x64, 0x41, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6b, 0x64, 0x41,
+	0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x53, 0x74,
+	0x72, 0x4c, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x73,
+	0x69, 0x67, 0x53, 0x74, 0x72, 0x4c, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x53, 0x74, 0x72, 0x41, 0x62, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x53, 0x74, 0x72, 0x41, 0x62, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x69, 0x67,
+	0x53, 0x74, 0x72, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x73, 0x69, 0x67, 0x53, 0x74, 0x72, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x66, 0x65, 0x6e,
+	0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x6f,
+	0x77, 0x6e, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x6b,
+	0x65, 0x64, 0x6f, 0x77, 0x6e, 0x41, 0x76, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b,
+	0x74, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x77, 0x6e, 0x41, 0x76, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x76, 0x67, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x76,
+	0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6b, 0x6e, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x41, 0x76,
+	0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6b, 0x6e, 0x6f, 0x63, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x41, 0x76, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x67, 0x46, 0x69, 0x67, 0x68,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x76, 0x67,
+	0x46, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x6e,
+	0x42, 0x79, 0x4b, 0x4f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x42,
+	0x79, 0x4b, 0x4f, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x42, 0x79, 0x53, 0x75, 0x62, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x42, 0x79, 0x53, 0x75, 0x62, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x42, 0x79, 0x44, 0x65, 0x63, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x42, 0x79, 0x44, 0x65, 0x63, 0x22, 0x79, 0x0a, 0x0f, 0x46,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x72, 0x73, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x19, 0x46, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x10, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x66, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x46,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x52, 0x08, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0x2d, 0x0a, 0x15, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x64, 0x0a, 0x16, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x13, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0xed, 0x01, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x5f, 0x64, 0x65,
+	0x76, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x70, 0x70, 0x44, 0x65, 0x76, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x70, 0x70,
+	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x61, 0x70, 0x70, 0x52, 0x75, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x61,
+	0x70, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x41, 0x6c, 0x69, 0x76,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x32, 0xac, 0x0f, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x10, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x14, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x0b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x13, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d,
+	0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x15, 0x2e,
+	0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a,
+	0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x12, 0x2e, 0x54, 0x4f, 0x54,
+	0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x54, 0x4f, 0x54, 0x50, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x54, 0x4f, 0x54, 0x50, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x0b, 0x54, 0x4f, 0x54, 0x50, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x54,
+	0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x54, 0x4f, 0x54, 0x50, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x10, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x0f, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x13, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x15, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x44, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x44, 0x75, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x44, 0x75, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69,
+	0x7a, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x12, 0x13, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4f, 0x75, 0x74, 0x62,
+	0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x13,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x12, 0x11, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x0f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xb0, 0x05, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x12, 0x11,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x73,
+	0x12, 0x0c, 0x2e, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x74, 0x73, 0x12, 0x0c, 0x2e, 0x42, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x42, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x41, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x69, 0x7a, 0x65, 0x42, 0x65, 0x74, 0x73, 0x12, 0x0c, 0x2e, 0x42, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d,
+	0x69, 0x7a, 0x65, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x13, 0x2e, 0x46,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x16, 0x2e, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x14, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x0d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x12, 0x15,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x46, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xac, 0x03, 0x0a, 0x0f, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x13, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x10, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x46,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x72, 0x73, 0x42, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x2e, 0x46, 0x69, 0x67, 0x68,
+	0x74, 0x65, 0x72, 0x73, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x46, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x46,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x0e, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x73, 0x12, 0x08, 0x2e, 0x46, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x1a, 0x17, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x46, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12,
+	0x3b, 0x0a, 0x0c, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x46, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pickfighter_proto_rawDescOnce sync.Once
+	file_pickfighter_proto_rawDescData = file_pickfighter_proto_rawDesc
+)
+
+func file_pickfighter_proto_rawDescGZIP() []byte {
+	file_pickfighter_proto_rawDescOnce.Do(func() {
+		file_pickfighter_proto_rawDescData = protoimpl.X.CompressGZIP(file_pickfighter_proto_rawDescData)
+	})
+	return file_pickfighter_proto_rawDescData
+}
+
+var file_pickfighter_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
+var file_pickfighter_proto_goTypes = []any{
+	(*RegisterRequest)(nil),               // 0: RegisterRequest
+	(*RegisterResponse)(nil),              // 1: RegisterResponse
+	(*RegisterConfirmRequest)(nil),        // 2: RegisterConfirmRequest
+	(*RegisterConfirmResponse)(nil),       // 3: RegisterConfirmResponse
+	(*AuthenticateRequest)(nil),           // 4: AuthenticateRequest
+	(*AuthenticateResponse)(nil),          // 5: AuthenticateResponse
+	(*TwoFactorChallenge)(nil),            // 6: TwoFactorChallenge
+	(*RefreshTokenRequest)(nil),           // 7: RefreshTokenRequest
+	(*RevokeTokenRequest)(nil),            // 8: RevokeTokenRequest
+	(*RevokeUserTokensRequest)(nil),       // 9: RevokeUserTokensRequest
+	(*RevokeTokenResponse)(nil),           // 10: RevokeTokenResponse
+	(*TokenStatusRequest)(nil),            // 11: TokenStatusRequest
+	(*TokenStatusResponse)(nil),           // 12: TokenStatusResponse
+	(*OAuthLoginRequest)(nil),             // 13: OAuthLoginRequest
+	(*OAuthLoginResponse)(nil),            // 14: OAuthLoginResponse
+	(*OAuthCallbackRequest)(nil),          // 15: OAuthCallbackRequest
+	(*TwoFactorLoginRequest)(nil),         // 16: TwoFactorLoginRequest
+	(*TOTPEnrollRequest)(nil),             // 17: TOTPEnrollRequest
+	(*TOTPEnrollResponse)(nil),            // 18: TOTPEnrollResponse
+	(*TOTPCodeRequest)(nil),               // 19: TOTPCodeRequest
+	(*TOTPDisableResponse)(nil),           // 20: TOTPDisableResponse
+	(*RecoveryCodesResponse)(nil),         // 21: RecoveryCodesResponse
+	(*OutboxEmail)(nil),                   // 22: OutboxEmail
+	(*EmailOutboxRequest)(nil),            // 23: EmailOutboxRequest
+	(*EmailOutboxResponse)(nil),           // 24: EmailOutboxResponse
+	(*ResendEmailRequest)(nil),            // 25: ResendEmailRequest
+	(*ResendEmailResponse)(nil),           // 26: ResendEmailResponse
+	(*RoleChangeRequest)(nil),             // 27: RoleChangeRequest
+	(*RoleChange)(nil),                    // 28: RoleChange
+	(*RoleChangeResponse)(nil),            // 29: RoleChangeResponse
+	(*RoleAuditRequest)(nil),              // 30: RoleAuditRequest
+	(*RoleAuditResponse)(nil),             // 31: RoleAuditResponse
+	(*PasswordResetRequest)(nil),          // 32: PasswordResetRequest
+	(*PasswordResetResponse)(nil),         // 33: PasswordResetResponse
+	(*PasswordRecoveryRequest)(nil),       // 34: PasswordRecoveryRequest
+	(*PasswordRecoveryResponse)(nil),      // 35: PasswordRecoveryResponse
+	(*ProfileRequest)(nil),                // 36: ProfileRequest
+	(*ProfileResponse)(nil),               // 37: ProfileResponse
+	(*ChangePasswordRequest)(nil),         // 38: ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),        // 39: ChangePasswordResponse
+	(*ChangeEmailRequest)(nil),            // 40: ChangeEmailRequest
+	(*ChangeEmailResponse)(nil),           // 41: ChangeEmailResponse
+	(*ConfirmEmailChangeRequest)(nil),     // 42: ConfirmEmailChangeRequest
+	(*ConfirmEmailChangeResponse)(nil),    // 43: ConfirmEmailChangeResponse
+	(*AccountRequest)(nil),                // 44: AccountRequest
+	(*UserIdentity)(nil),                  // 45: UserIdentity
+	(*AccountExportResponse)(nil),         // 46: AccountExportResponse
+	(*DeleteAccountRequest)(nil),          // 47: DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),         // 48: DeleteAccountResponse
+	(*CancelAccountDeletionResponse)(nil), // 49: CancelAccountDeletionResponse
+	(*DueAccountDeletionsRequest)(nil),    // 50: DueAccountDeletionsRequest
+	(*DueAccountDeletionsResponse)(nil),   // 51: DueAccountDeletionsResponse
+	(*AnonymizeAccountResponse)(nil),      // 52: AnonymizeAccountResponse
+	(*User)(nil),                          // 53: User
+	(*CreateEventRequest)(nil),            // 54: CreateEventRequest
+	(*CreateEventResponse)(nil),           // 55: CreateEventResponse
+	(*GetEventsRequest)(nil),              // 56: GetEventsRequest
+	(*GetEventsResponse)(nil),             // 57: GetEventsResponse
+	(*CreateBetRequest)(nil),              // 58: CreateBetRequest
+	(*CreateBetResponse)(nil),             // 59: CreateBetResponse
+	(*BetsRequest)(nil),                   // 60: BetsRequest
+	(*BetsResponse)(nil),                  // 61: BetsResponse
+	(*AnonymizeBetsResponse)(nil),         // 62: AnonymizeBetsResponse
+	(*FightResultRequest)(nil),            // 63: FightResultRequest
+	(*FightResultResponse)(nil),           // 64: FightResultResponse
+	(*ScrapedResult)(nil),                 // 65: ScrapedResult
+	(*ScrapedResultsRequest)(nil),         // 66: ScrapedResultsRequest
+	(*ScrapedResultOutcome)(nil),          // 67: ScrapedResultOutcome
+	(*ScrapedResultsResponse)(nil),        // 68: ScrapedResultsResponse
+	(*ResultChange)(nil),                  // 69: ResultChange
+	(*ResultReview)(nil),                  // 70: ResultReview
+	(*ResultReviewsRequest)(nil),          // 71: ResultReviewsRequest
+	(*ResultReviewsResponse)(nil),         // 72: ResultReviewsResponse
+	(*ReviewResultRequest)(nil),           // 73: ReviewResultRequest
+	(*ReviewResultResponse)(nil),          // 74: ReviewResultResponse
+	(*MergeFightersRequest)(nil),          // 75: MergeFightersRequest
+	(*MergeFightersResponse)(nil),         // 76: MergeFightersResponse
+	(*Fight)(nil),                         // 77: Fight
+	(*Event)(nil),                         // 78: Event
+	(*Bet)(nil),                           // 79: Bet
+	(*Fighter)(nil),                       // 80: Fighter
+	(*FighterAnalytics)(nil),              // 81: FighterAnalytics
+	(*FighterStats)(nil),                  // 82: FighterStats
+	(*FightersRequest)(nil),               // 83: FightersRequest
+	(*FightersTextSearchRequest)(nil),     // 84: FightersTextSearchRequest
+	(*FightersResponse)(nil),              // 85: FightersResponse
+	(*FightersCountResponse)(nil),         // 86: FightersCountResponse
+	(*UpsertFightersResponse)(nil),        // 87: UpsertFightersResponse
+	(*FighterImageRequest)(nil),           // 88: FighterImageRequest
+	(*FighterImageResponse)(nil),          // 89: FighterImageResponse
+	(*HealthResponse)(nil),                // 90: HealthResponse
+	nil,                                   // 91: FighterAnalytics.PercentilesEntry
+	(*emptypb.Empty)(nil),                 // 92: google.protobuf.Empty
+	(*timestamppb.Timestamp)(nil),         // 93: google.protobuf.Timestamp
+}
+var file_pickfighter_proto_depIdxs = []int32{
+	92, // 0: RegisterConfirmResponse.response:type_name -> google.protobuf.Empty
+	93, // 1: AuthenticateResponse.ExpirationTime:type_name -> google.protobuf.Timestamp
+	93, // 2: AuthenticateResponse.refreshExpirationTime:type_name -> google.protobuf.Timestamp
+	6,  // 3: AuthenticateResponse.challenge:type_name -> TwoFactorChallenge
+	93, // 4: TwoFactorChallenge.expirationTime:type_name -> google.protobuf.Timestamp
+	92, // 5: RevokeTokenResponse.response:type_name -> google.protobuf.Empty
+	22, // 6: EmailOutboxResponse.emails:type_name -> OutboxEmail
+	22, // 7: ResendEmailResponse.email:type_name -> OutboxEmail
+	28, // 8: RoleChangeResponse.change:type_name -> RoleChange
+	28, // 9: RoleAuditResponse.changes:type_name -> RoleChange
+	92, // 10: PasswordResetResponse.response:type_name -> google.protobuf.Empty
+	92, // 11: PasswordRecoveryResponse.response:type_name -> google.protobuf.Empty
+	53, // 12: ProfileResponse.user:type_name -> User
+	53, // 13: AccountExportResponse.user:type_name -> User
+	45, // 14: AccountExportResponse.identities:type_name -> UserIdentity
+	28, // 15: AccountExportResponse.roleChanges:type_name -> RoleChange
+	77, // 16: CreateEventRequest.fights:type_name -> Fight
+	92, // 17: GetEventsRequest.response:type_name -> google.protobuf.Empty
+	78, // 18: GetEventsResponse.events:type_name -> Event
+	79, // 19: BetsResponse.bets:type_name -> Bet
+	65, // 20: ScrapedResultsRequest.results:type_name -> ScrapedResult
+	65, // 21: ScrapedResultOutcome.result:type_name -> ScrapedResult
+	67, // 22: ScrapedResultsResponse.outcomes:type_name -> ScrapedResultOutcome
+	63, // 23: ResultReview.existing:type_name -> FightResultRequest
+	63, // 24: ResultReview.scraped:type_name -> FightResultRequest
+	69, // 25: ResultReview.changes:type_name -> ResultChange
+	70, // 26: ResultReviewsResponse.reviews:type_name -> ResultReview
+	70, // 27: ReviewResultResponse.review:type_name -> ResultReview
+	77, // 28: Event.fights:type_name -> Fight
+	82, // 29: Fighter.stats:type_name -> FighterStats
+	81, // 30: Fighter.analytics:type_name -> FighterAnalytics
+	91, // 31: FighterAnalytics.percentiles:type_name -> FighterAnalytics.PercentilesEntry
+	80, // 32: FightersResponse.fighters:type_name -> Fighter
+	0,  // 33: AuthService.Register:input_type -> RegisterRequest
+	2,  // 34: AuthService.RegisterConfirm:input_type -> RegisterConfirmRequest
+	4,  // 35: AuthService.Login:input_type -> AuthenticateRequest
+	7,  // 36: AuthService.RefreshToken:input_type -> RefreshTokenRequest
+	8,  // 37: AuthService.RevokeToken:input_type -> RevokeTokenRequest
+	9,  // 38: AuthService.RevokeUserTokens:input_type -> RevokeUserTokensRequest
+	11, // 39: AuthService.TokenStatus:input_type -> TokenStatusRequest
+	13, // 40: AuthService.OAuthLogin:input_type -> OAuthLoginRequest
+	15, // 41: AuthService.OAuthCallback:input_type -> OAuthCallbackRequest
+	16, // 42: AuthService.TwoFactorLogin:input_type -> TwoFactorLoginRequest
+	17, // 43: AuthService.TOTPEnroll:input_type -> TOTPEnrollRequest
+	19, // 44: AuthService.TOTPActivate:input_type -> TOTPCodeRequest
+	19, // 45: AuthService.TOTPDisable:input_type -> TOTPCodeRequest
+	19, // 46: AuthService.RegenerateRecoveryCodes:input_type -> TOTPCodeRequest
+	32, // 47: AuthService.PasswordReset:input_type -> PasswordResetRequest
+	34, // 48: AuthService.PasswordRecover:input_type -> PasswordRecoveryRequest
+	36, // 49: AuthService.Profile:input_type -> ProfileRequest
+	36, // 50: AuthService.UpdateProfile:input_type -> ProfileRequest
+	38, // 51: AuthService.ChangePassword:input_type -> ChangePasswordRequest
+	40, // 52: AuthService.ChangeEmail:input_type -> ChangeEmailRequest
+	42, // 53: AuthService.ConfirmEmailChange:input_type -> ConfirmEmailChangeRequest
+	44, // 54: AuthService.ExportAccount:input_type -> AccountRequest
+	47, // 55: AuthService.DeleteAccount:input_type -> DeleteAccountRequest
+	44, // 56: AuthService.CancelAccountDeletion:input_type -> AccountRequest
+	50, // 57: AuthService.DueAccountDeletions:input_type -> DueAccountDeletionsRequest
+	44, // 58: AuthService.AnonymizeAccount:input_type -> AccountRequest
+	23, // 59: AuthService.EmailOutbox:input_type -> EmailOutboxRequest
+	25, // 60: AuthService.ResendEmail:input_type -> ResendEmailRequest
+	27, // 61: AuthService.GrantRole:input_type -> RoleChangeRequest
+	27, // 62: AuthService.RevokeRole:input_type -> RoleChangeRequest
+	30, // 63: AuthService.RoleAudit:input_type -> RoleAuditRequest
+	92, // 64: AuthService.HealthCheck:input_type -> google.protobuf.Empty
+	54, // 65: EventService.CreateEvent:input_type -> CreateEventRequest
+	56, // 66: EventService.GetEvents:input_type -> GetEventsRequest
+	58, // 67: EventService.CreateBet:input_type -> CreateBetRequest
+	60, // 68: EventService.GetBets:input_type -> BetsRequest
+	60, // 69: EventService.ExportBets:input_type -> BetsRequest
+	60, // 70: EventService.AnonymizeBets:input_type -> BetsRequest
+	63, // 71: EventService.SetResult:input_type -> FightResultRequest
+	66, // 72: EventService.SubmitScrapedResults:input_type -> ScrapedResultsRequest
+	71, // 73: EventService.ResultReviews:input_type -> ResultReviewsRequest
+	73, // 74: EventService.ReviewResult:input_type -> ReviewResultRequest
+	75, // 75: EventService.MergeFighters:input_type -> MergeFightersRequest
+	92, // 76: EventService.HealthCheck:input_type -> google.protobuf.Empty
+	83, // 77: FightersService.SearchFightersCount:input_type -> FightersRequest
+	83, // 78: FightersService.SearchFighters:input_type -> FightersRequest
+	84, // 79: FightersService.SearchFightersByText:input_type -> FightersTextSearchRequest
+	83, // 80: FightersService.ExportFighters:input_type -> FightersRequest
+	80, // 81: FightersService.UpsertFighters:input_type -> Fighter
+	88, // 82: FightersService.FighterImage:input_type -> FighterImageRequest
+	92, // 83: FightersService.HealthCheck:input_type -> google.protobuf.Empty
+	1,  // 84: AuthService.Register:output_type -> RegisterResponse
+	3,  // 85: AuthService.RegisterConfirm:output_type -> RegisterConfirmResponse
+	5,  // 86: AuthService.Login:output_type -> AuthenticateResponse
+	5,  // 87: AuthService.RefreshToken:output_type -> AuthenticateResponse
+	10, // 88: AuthService.RevokeToken:output_type -> RevokeTokenResponse
+	10, // 89: AuthService.RevokeUserTokens:output_type -> RevokeTokenResponse
+	12, // 90: AuthService.TokenStatus:output_type -> TokenStatusResponse
+	14, // 91: AuthService.OAuthLogin:output_type -> OAuthLoginResponse
+	5,  // 92: AuthService.OAuthCallback:output_type -> AuthenticateResponse
+	5,  // 93: AuthService.TwoFactorLogin:output_type -> AuthenticateResponse
+	18, // 94: AuthService.TOTPEnroll:output_type -> TOTPEnrollResponse
+	21, // 95: AuthService.TOTPActivate:output_type -> RecoveryCodesResponse
+	20, // 96: AuthService.TOTPDisable:output_type -> TOTPDisableResponse
+	21, // 97: AuthService.RegenerateRecoveryCodes:output_type -> RecoveryCodesResponse
+	33, // 98: AuthService.PasswordReset:output_type -> PasswordResetResponse
+	35, // 99: AuthService.PasswordRecover:output_type -> PasswordRecoveryResponse
+	37, // 100: AuthService.Profile:output_type -> ProfileResponse
+	37, // 101: AuthService.UpdateProfile:output_type -> ProfileResponse
+	39, // 102: AuthService.ChangePassword:output_type -> ChangePasswordResponse
+	41, // 103: AuthService.ChangeEmail:output_type -> ChangeEmailResponse
+	43, // 104: AuthService.ConfirmEmailChange:output_type -> ConfirmEmailChangeResponse
+	46, // 105: AuthService.ExportAccount:output_type -> AccountExportResponse
+	48, // 106: AuthService.DeleteAccount:output_type -> DeleteAccountResponse
+	49, // 107: AuthService.CancelAccountDeletion:output_type -> CancelAccountDeletionResponse
+	51, // 108: AuthService.DueAccountDeletions:output_type -> DueAccountDeletionsResponse
+	52, // 109: AuthService.AnonymizeAccount:output_type -> AnonymizeAccountResponse
+	24, // 110: AuthService.EmailOutbox:output_type -> EmailOutboxResponse
+	26, // 111: AuthService.ResendEmail:output_type -> ResendEmailResponse
+	29, // 112: AuthService.GrantRole:output_type -> RoleChangeResponse
+	29, // 113: AuthService.RevokeRole:output_type -> RoleChangeResponse
+	31, // 114: AuthService.RoleAudit:output_type -> RoleAuditResponse
+	90, // 115: AuthService.HealthCheck:output_type -> HealthResponse
+	55, // 116: EventService.CreateEvent:output_type -> CreateEventResponse
+	57, // 117: EventService.GetEvents:output_type -> GetEventsResponse
+	59, // 118: EventService.CreateBet:output_type -> CreateBetResponse
+	61, // 119: EventService.GetBets:output_type -> BetsResponse
+	61, // 120: EventService.ExportBets:output_type -> BetsResponse
+	62, // 121: EventService.AnonymizeBets:output_type -> AnonymizeBetsResponse
+	64, // 122: EventService.SetResult:output_type -> FightResultResponse
+	68, // 123: EventService.SubmitScrapedResults:output_type -> ScrapedResultsResponse
+	72, // 124: EventService.ResultReviews:output_type -> ResultReviewsResponse
+	74, // 125: EventService.ReviewResult:output_type -> ReviewResultResponse
+	76, // 126: EventService.MergeFighters:output_type -> MergeFightersResponse
+	90, // 127: EventService.HealthCheck:output_type -> HealthResponse
+	86, // 128: FightersService.SearchFightersCount:output_type -> FightersCountResponse
+	85, // 129: FightersService.SearchFighters:output_type -> FightersResponse
+	85, // 130: FightersService.SearchFightersByText:output_type -> FightersResponse
+	80, // 131: FightersService.ExportFighters:output_type -> Fighter
+	87, // 132: FightersService.UpsertFighters:output_type -> UpsertFightersResponse
+	89, // 133: FightersService.FighterImage:output_type -> FighterImageResponse
+	90, // 134: FightersService.HealthCheck:output_type -> HealthResponse
+	84, // [84:135] is the sub-list for method output_type
+	33, // [33:84] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_pickfighter_proto_init() }
+func file_pickfighter_proto_init() {
+	if File_pickfighter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pickfighter_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterConfirmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterConfirmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*AuthenticateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*AuthenticateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*TwoFactorChallenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeUserTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*TokenStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*TokenStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*OAuthLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*OAuthLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*OAuthCallbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*TwoFactorLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*TOTPEnrollRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*TOTPEnrollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*TOTPCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*TOTPDisableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*RecoveryCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*OutboxEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*EmailOutboxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*EmailOutboxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ResendEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ResendEmailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RoleChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*RoleChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*RoleChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*RoleAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*RoleAuditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*PasswordResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*PasswordResetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*PasswordRecoveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*PasswordRecoveryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2730,8 +7678,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterResponse); i {
+		file_pickfighter_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePasswordResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2742,8 +7690,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterConfirmRequest); i {
+		file_pickfighter_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeEmailRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2754,8 +7702,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterConfirmResponse); i {
+		file_pickfighter_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeEmailResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2766,8 +7714,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*AuthenticateRequest); i {
+		file_pickfighter_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmEmailChangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2778,8 +7726,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*AuthenticateResponse); i {
+		file_pickfighter_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmEmailChangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2790,8 +7738,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*PasswordResetRequest); i {
+		file_pickfighter_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2802,8 +7750,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*PasswordResetResponse); i {
+		file_pickfighter_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*UserIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2814,8 +7762,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*PasswordRecoveryRequest); i {
+		file_pickfighter_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2826,8 +7774,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*PasswordRecoveryResponse); i {
+		file_pickfighter_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2838,8 +7786,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ProfileRequest); i {
+		file_pickfighter_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2850,8 +7798,8 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*ProfileResponse); i {
+		file_pickfighter_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelAccountDeletionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2862,7 +7810,43 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*DueAccountDeletionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*DueAccountDeletionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*AnonymizeAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*User); i {
 			case 0:
 				return &v.state
@@ -2874,7 +7858,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateEventRequest); i {
 			case 0:
 				return &v.state
@@ -2886,7 +7870,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateEventResponse); i {
 			case 0:
 				return &v.state
@@ -2898,7 +7882,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[56].Exporter = func(v any, i int) any {
 			switch v := v.(*GetEventsRequest); i {
 			case 0:
 				return &v.state
@@ -2910,7 +7894,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[57].Exporter = func(v any, i int) any {
 			switch v := v.(*GetEventsResponse); i {
 			case 0:
 				return &v.state
@@ -2922,7 +7906,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateBetRequest); i {
 			case 0:
 				return &v.state
@@ -2934,7 +7918,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[18].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[59].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateBetResponse); i {
 			case 0:
 				return &v.state
@@ -2946,7 +7930,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[19].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*BetsRequest); i {
 			case 0:
 				return &v.state
@@ -2958,7 +7942,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[20].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[61].Exporter = func(v any, i int) any {
 			switch v := v.(*BetsResponse); i {
 			case 0:
 				return &v.state
@@ -2970,7 +7954,19 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[21].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*AnonymizeBetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[63].Exporter = func(v any, i int) any {
 			switch v := v.(*FightResultRequest); i {
 			case 0:
 				return &v.state
@@ -2982,7 +7978,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[22].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[64].Exporter = func(v any, i int) any {
 			switch v := v.(*FightResultResponse); i {
 			case 0:
 				return &v.state
@@ -2994,7 +7990,151 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[23].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*ScrapedResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*ScrapedResultsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*ScrapedResultOutcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ScrapedResultsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*ResultChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ResultReview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ResultReviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*ResultReviewsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*ReviewResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*ReviewResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeFightersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeFightersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[77].Exporter = func(v any, i int) any {
 			switch v := v.(*Fight); i {
 			case 0:
 				return &v.state
@@ -3006,7 +8146,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[24].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[78].Exporter = func(v any, i int) any {
 			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
@@ -3018,7 +8158,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[25].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[79].Exporter = func(v any, i int) any {
 			switch v := v.(*Bet); i {
 			case 0:
 				return &v.state
@@ -3030,7 +8170,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[26].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[80].Exporter = func(v any, i int) any {
 			switch v := v.(*Fighter); i {
 			case 0:
 				return &v.state
@@ -3042,7 +8182,19 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[27].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*FighterAnalytics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[82].Exporter = func(v any, i int) any {
 			switch v := v.(*FighterStats); i {
 			case 0:
 				return &v.state
@@ -3054,7 +8206,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[28].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[83].Exporter = func(v any, i int) any {
 			switch v := v.(*FightersRequest); i {
 			case 0:
 				return &v.state
@@ -3066,7 +8218,19 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[29].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*FightersTextSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[85].Exporter = func(v any, i int) any {
 			switch v := v.(*FightersResponse); i {
 			case 0:
 				return &v.state
@@ -3078,7 +8242,7 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[30].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[86].Exporter = func(v any, i int) any {
 			switch v := v.(*FightersCountResponse); i {
 			case 0:
 				return &v.state
@@ -3090,7 +8254,43 @@ func file_pickfighter_proto_init() {
 				return nil
 			}
 		}
-		file_pickfighter_proto_msgTypes[31].Exporter = func(v any, i int) any {
+		file_pickfighter_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*UpsertFightersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*FighterImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*FighterImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pickfighter_proto_msgTypes[90].Exporter = func(v any, i int) any {
 			switch v := v.(*HealthResponse); i {
 			case 0:
 				return &v.state
@@ -3103,13 +8303,15 @@ func file_pickfighter_proto_init() {
 			}
 		}
 	}
+	file_pickfighter_proto_msgTypes[83].OneofWrappers = []any{}
+	file_pickfighter_proto_msgTypes[84].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pickfighter_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   92,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -20,13 +20,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_Register_FullMethodName        = "/AuthService/Register"
-	AuthService_RegisterConfirm_FullMethodName = "/AuthService/RegisterConfirm"
-	AuthService_Login_FullMethodName           = "/AuthService/Login"
-	AuthService_PasswordReset_FullMethodName   = "/AuthService/PasswordReset"
-	AuthService_PasswordRecover_FullMethodName = "/AuthService/PasswordRecover"
-	AuthService_Profile_FullMethodName         = "/AuthService/Profile"
-	AuthService_HealthCheck_FullMethodName     = "/AuthService/HealthCheck"
+	AuthService_Register_FullMethodName                = "/AuthService/Register"
+	AuthService_RegisterConfirm_FullMethodName         = "/AuthService/RegisterConfirm"
+	AuthService_Login_FullMethodName                   = "/AuthService/Login"
+	AuthService_RefreshToken_FullMethodName            = "/AuthService/RefreshToken"
+	AuthService_RevokeToken_FullMethodName             = "/AuthService/RevokeToken"
+	AuthService_RevokeUserTokens_FullMethodName        = "/AuthService/RevokeUserTokens"
+	AuthService_TokenStatus_FullMethodName             = "/AuthService/TokenStatus"
+	AuthService_OAuthLogin_FullMethodName              = "/AuthService/OAuthLogin"
+	AuthService_OAuthCallback_FullMethodName           = "/AuthService/OAuthCallback"
+	AuthService_TwoFactorLogin_FullMethodName          = "/AuthService/TwoFactorLogin"
+	AuthService_TOTPEnroll_FullMethodName              = "/AuthService/TOTPEnroll"
+	AuthService_TOTPActivate_FullMethodName            = "/AuthService/TOTPActivate"
+	AuthService_TOTPDisable_FullMethodName             = "/AuthService/TOTPDisable"
+	AuthService_RegenerateRecoveryCodes_FullMethodName = "/AuthService/RegenerateRecoveryCodes"
+	AuthService_PasswordReset_FullMethodName           = "/AuthService/PasswordReset"
+	AuthService_PasswordRecover_FullMethodName         = "/AuthService/PasswordRecover"
+	AuthService_Profile_FullMethodName                 = "/AuthService/Profile"
+	AuthService_UpdateProfile_FullMethodName           = "/AuthService/UpdateProfile"
+	AuthService_ChangePassword_FullMethodName          = "/AuthService/ChangePassword"
+	AuthService_ChangeEmail_FullMethodName             = "/AuthService/ChangeEmail"
+	AuthService_ConfirmEmailChange_FullMethodName      = "/AuthService/ConfirmEmailChange"
+	AuthService_ExportAccount_FullMethodName           = "/AuthService/ExportAccount"
+	AuthService_DeleteAccount_FullMethodName           = "/AuthService/DeleteAccount"
+	AuthService_CancelAccountDeletion_FullMethodName   = "/AuthService/CancelAccountDeletion"
+	AuthService_DueAccountDeletions_FullMethodName     = "/AuthService/DueAccountDeletions"
+	AuthService_AnonymizeAccount_FullMethodName        = "/AuthService/AnonymizeAccount"
+	AuthService_EmailOutbox_FullMethodName             = "/AuthService/EmailOutbox"
+	AuthService_ResendEmail_FullMethodName             = "/AuthService/ResendEmail"
+	AuthService_GrantRole_FullMethodName               = "/AuthService/GrantRole"
+	AuthService_RevokeRole_FullMethodName              = "/AuthService/RevokeRole"
+	AuthService_RoleAudit_FullMethodName               = "/AuthService/RoleAudit"
+	AuthService_HealthCheck_FullMethodName             = "/AuthService/HealthCheck"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -36,9 +61,34 @@ type AuthServiceClient interface {
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	RegisterConfirm(ctx context.Context, in *RegisterConfirmRequest, opts ...grpc.CallOption) (*RegisterConfirmResponse, error)
 	Login(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
+	RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
+	TokenStatus(ctx context.Context, in *TokenStatusRequest, opts ...grpc.CallOption) (*TokenStatusResponse, error)
+	OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error)
+	OAuthCallback(ctx context.Context, in *OAuthCallbackRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	TwoFactorLogin(ctx context.Context, in *TwoFactorLoginRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	TOTPEnroll(ctx context.Context, in *TOTPEnrollRequest, opts ...grpc.CallOption) (*TOTPEnrollResponse, error)
+	TOTPActivate(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*RecoveryCodesResponse, error)
+	TOTPDisable(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*TOTPDisableResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*RecoveryCodesResponse, error)
 	PasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*PasswordResetResponse, error)
 	PasswordRecover(ctx context.Context, in *PasswordRecoveryRequest, opts ...grpc.CallOption) (*PasswordRecoveryResponse, error)
 	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	UpdateProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*ChangeEmailResponse, error)
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error)
+	ExportAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountExportResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	CancelAccountDeletion(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*CancelAccountDeletionResponse, error)
+	DueAccountDeletions(ctx context.Context, in *DueAccountDeletionsRequest, opts ...grpc.CallOption) (*DueAccountDeletionsResponse, error)
+	AnonymizeAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AnonymizeAccountResponse, error)
+	EmailOutbox(ctx context.Context, in *EmailOutboxRequest, opts ...grpc.CallOption) (*EmailOutboxResponse, error)
+	ResendEmail(ctx context.Context, in *ResendEmailRequest, opts ...grpc.CallOption) (*ResendEmailResponse, error)
+	GrantRole(ctx context.Context, in *RoleChangeRequest, opts ...grpc.CallOption) (*RoleChangeResponse, error)
+	RevokeRole(ctx context.Context, in *RoleChangeRequest, opts ...grpc.CallOption) (*RoleChangeResponse, error)
+	RoleAudit(ctx context.Context, in *RoleAuditRequest, opts ...grpc.CallOption) (*RoleAuditResponse, error)
 	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 }
 
@@ -80,6 +130,116 @@ func (c *authServiceClient) Login(ctx context.Context, in *AuthenticateRequest,
 	return out, nil
 }
 
+func (c *authServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeUserTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) TokenStatus(ctx context.Context, in *TokenStatusRequest, opts ...grpc.CallOption) (*TokenStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenStatusResponse)
+	err := c.cc.Invoke(ctx, AuthService_TokenStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_OAuthLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) OAuthCallback(ctx context.Context, in *OAuthCallbackRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateResponse)
+	err := c.cc.Invoke(ctx, AuthService_OAuthCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) TwoFactorLogin(ctx context.Context, in *TwoFactorLoginRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateResponse)
+	err := c.cc.Invoke(ctx, AuthService_TwoFactorLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) TOTPEnroll(ctx context.Context, in *TOTPEnrollRequest, opts ...grpc.CallOption) (*TOTPEnrollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TOTPEnrollResponse)
+	err := c.cc.Invoke(ctx, AuthService_TOTPEnroll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) TOTPActivate(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*RecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, AuthService_TOTPActivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) TOTPDisable(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*TOTPDisableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TOTPDisableResponse)
+	err := c.cc.Invoke(ctx, AuthService_TOTPDisable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RegenerateRecoveryCodes(ctx context.Context, in *TOTPCodeRequest, opts ...grpc.CallOption) (*RecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, AuthService_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) PasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*PasswordResetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PasswordResetResponse)
@@ -110,6 +270,146 @@ func (c *authServiceClient) Profile(ctx context.Context, in *ProfileRequest, opt
 	return out, nil
 }
 
+func (c *authServiceClient) UpdateProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*ChangeEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeEmailResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChangeEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ExportAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountExportResponse)
+	err := c.cc.Invoke(ctx, AuthService_ExportAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CancelAccountDeletion(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*CancelAccountDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAccountDeletionResponse)
+	err := c.cc.Invoke(ctx, AuthService_CancelAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DueAccountDeletions(ctx context.Context, in *DueAccountDeletionsRequest, opts ...grpc.CallOption) (*DueAccountDeletionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DueAccountDeletionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_DueAccountDeletions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AnonymizeAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AnonymizeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_AnonymizeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmailOutbox(ctx context.Context, in *EmailOutboxRequest, opts ...grpc.CallOption) (*EmailOutboxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmailOutboxResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmailOutbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResendEmail(ctx context.Context, in *ResendEmailRequest, opts ...grpc.CallOption) (*ResendEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendEmailResponse)
+	err := c.cc.Invoke(ctx, AuthService_ResendEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GrantRole(ctx context.Context, in *RoleChangeRequest, opts ...grpc.CallOption) (*RoleChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_GrantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeRole(ctx context.Context, in *RoleChangeRequest, opts ...grpc.CallOption) (*RoleChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RoleAudit(ctx context.Context, in *RoleAuditRequest, opts ...grpc.CallOption) (*RoleAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleAuditResponse)
+	err := c.cc.Invoke(ctx, AuthService_RoleAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthResponse)
@@ -127,9 +427,34 @@ type AuthServiceServer interface {
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	RegisterConfirm(context.Context, *RegisterConfirmRequest) (*RegisterConfirmResponse, error)
 	Login(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*AuthenticateResponse, error)
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error)
+	RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeTokenResponse, error)
+	TokenStatus(context.Context, *TokenStatusRequest) (*TokenStatusResponse, error)
+	OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error)
+	OAuthCallback(context.Context, *OAuthCallbackRequest) (*AuthenticateResponse, error)
+	TwoFactorLogin(context.Context, *TwoFactorLoginRequest) (*AuthenticateResponse, error)
+	TOTPEnroll(context.Context, *TOTPEnrollRequest) (*TOTPEnrollResponse, error)
+	TOTPActivate(context.Context, *TOTPCodeRequest) (*RecoveryCodesResponse, error)
+	TOTPDisable(context.Context, *TOTPCodeRequest) (*TOTPDisableResponse, error)
+	RegenerateRecoveryCodes(context.Context, *TOTPCodeRequest) (*RecoveryCodesResponse, error)
 	PasswordReset(context.Context, *PasswordResetRequest) (*PasswordResetResponse, error)
 	PasswordRecover(context.Context, *PasswordRecoveryRequest) (*PasswordRecoveryResponse, error)
 	Profile(context.Context, *ProfileRequest) (*ProfileResponse, error)
+	UpdateProfile(context.Context, *ProfileRequest) (*ProfileResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	ChangeEmail(context.Context, *ChangeEmailRequest) (*ChangeEmailResponse, error)
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error)
+	ExportAccount(context.Context, *AccountRequest) (*AccountExportResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	CancelAccountDeletion(context.Context, *AccountRequest) (*CancelAccountDeletionResponse, error)
+	DueAccountDeletions(context.Context, *DueAccountDeletionsRequest) (*DueAccountDeletionsResponse, error)
+	AnonymizeAccount(context.Context, *AccountRequest) (*AnonymizeAccountResponse, error)
+	EmailOutbox(context.Context, *EmailOutboxRequest) (*EmailOutboxResponse, error)
+	ResendEmail(context.Context, *ResendEmailRequest) (*ResendEmailResponse, error)
+	GrantRole(context.Context, *RoleChangeRequest) (*RoleChangeResponse, error)
+	RevokeRole(context.Context, *RoleChangeRequest) (*RoleChangeResponse, error)
+	RoleAudit(context.Context, *RoleAuditRequest) (*RoleAuditResponse, error)
 	HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
@@ -150,6 +475,39 @@ func (UnimplementedAuthServiceServer) RegisterConfirm(context.Context, *Register
 func (UnimplementedAuthServiceServer) Login(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*AuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserTokens not implemented")
+}
+func (UnimplementedAuthServiceServer) TokenStatus(context.Context, *TokenStatusRequest) (*TokenStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenStatus not implemented")
+}
+func (UnimplementedAuthServiceServer) OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OAuthLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) OAuthCallback(context.Context, *OAuthCallbackRequest) (*AuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OAuthCallback not implemented")
+}
+func (UnimplementedAuthServiceServer) TwoFactorLogin(context.Context, *TwoFactorLoginRequest) (*AuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwoFactorLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) TOTPEnroll(context.Context, *TOTPEnrollRequest) (*TOTPEnrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TOTPEnroll not implemented")
+}
+func (UnimplementedAuthServiceServer) TOTPActivate(context.Context, *TOTPCodeRequest) (*RecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TOTPActivate not implemented")
+}
+func (UnimplementedAuthServiceServer) TOTPDisable(context.Context, *TOTPCodeRequest) (*TOTPDisableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TOTPDisable not implemented")
+}
+func (UnimplementedAuthServiceServer) RegenerateRecoveryCodes(context.Context, *TOTPCodeRequest) (*RecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
 func (UnimplementedAuthServiceServer) PasswordReset(context.Context, *PasswordResetRequest) (*PasswordResetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PasswordReset not implemented")
 }
@@ -159,6 +517,48 @@ func (UnimplementedAuthServiceServer) PasswordRecover(context.Context, *Password
 func (UnimplementedAuthServiceServer) Profile(context.Context, *ProfileRequest) (*ProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Profile not implemented")
 }
+func (UnimplementedAuthServiceServer) UpdateProfile(context.Context, *ProfileRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangeEmail(context.Context, *ChangeEmailRequest) (*ChangeEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmail not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) ExportAccount(context.Context, *AccountRequest) (*AccountExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) CancelAccountDeletion(context.Context, *AccountRequest) (*CancelAccountDeletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedAuthServiceServer) DueAccountDeletions(context.Context, *DueAccountDeletionsRequest) (*DueAccountDeletionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DueAccountDeletions not implemented")
+}
+func (UnimplementedAuthServiceServer) AnonymizeAccount(context.Context, *AccountRequest) (*AnonymizeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) EmailOutbox(context.Context, *EmailOutboxRequest) (*EmailOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmailOutbox not implemented")
+}
+func (UnimplementedAuthServiceServer) ResendEmail(context.Context, *ResendEmailRequest) (*ResendEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendEmail not implemented")
+}
+func (UnimplementedAuthServiceServer) GrantRole(context.Context, *RoleChangeRequest) (*RoleChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeRole(context.Context, *RoleChangeRequest) (*RoleChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedAuthServiceServer) RoleAudit(context.Context, *RoleAuditRequest) (*RoleAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleAudit not implemented")
+}
 func (UnimplementedAuthServiceServer) HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -237,96 +637,590 @@ func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_PasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordResetRequest)
+func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).PasswordReset(ctx, in)
+		return srv.(AuthServiceServer).RefreshToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_PasswordReset_FullMethodName,
+		FullMethod: AuthService_RefreshToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).PasswordReset(ctx, req.(*PasswordResetRequest))
+		return srv.(AuthServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_PasswordRecover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordRecoveryRequest)
+func _AuthService_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).PasswordRecover(ctx, in)
+		return srv.(AuthServiceServer).RevokeToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_PasswordRecover_FullMethodName,
+		FullMethod: AuthService_RevokeToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).PasswordRecover(ctx, req.(*PasswordRecoveryRequest))
+		return srv.(AuthServiceServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProfileRequest)
+func _AuthService_RevokeUserTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserTokensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).Profile(ctx, in)
+		return srv.(AuthServiceServer).RevokeUserTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_Profile_FullMethodName,
+		FullMethod: AuthService_RevokeUserTokens_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).Profile(ctx, req.(*ProfileRequest))
+		return srv.(AuthServiceServer).RevokeUserTokens(ctx, req.(*RevokeUserTokensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _AuthService_TokenStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServiceServer).HealthCheck(ctx, in)
+		return srv.(AuthServiceServer).TokenStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthService_HealthCheck_FullMethodName,
+		FullMethod: AuthService_TokenStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).HealthCheck(ctx, req.(*emptypb.Empty))
+		return srv.(AuthServiceServer).TokenStatus(ctx, req.(*TokenStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AuthService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "AuthService",
-	HandlerType: (*AuthServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _AuthService_OAuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).OAuthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_OAuthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).OAuthLogin(ctx, req.(*OAuthLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_OAuthCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).OAuthCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_OAuthCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).OAuthCallback(ctx, req.(*OAuthCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_TwoFactorLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).TwoFactorLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_TwoFactorLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).TwoFactorLogin(ctx, req.(*TwoFactorLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_TOTPEnroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPEnrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).TOTPEnroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_TOTPEnroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).TOTPEnroll(ctx, req.(*TOTPEnrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_TOTPActivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).TOTPActivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_TOTPActivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).TOTPActivate(ctx, req.(*TOTPCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_TOTPDisable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).TOTPDisable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_TOTPDisable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).TOTPDisable(ctx, req.(*TOTPCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RegenerateRecoveryCodes(ctx, req.(*TOTPCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_PasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).PasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_PasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).PasswordReset(ctx, req.(*PasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_PasswordRecover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).PasswordRecover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_PasswordRecover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).PasswordRecover(ctx, req.(*PasswordRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Profile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Profile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangeEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangeEmail(ctx, req.(*ChangeEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmEmailChange(ctx, req.(*ConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ExportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExportAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExportAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CancelAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CancelAccountDeletion(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DueAccountDeletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DueAccountDeletionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DueAccountDeletions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DueAccountDeletions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DueAccountDeletions(ctx, req.(*DueAccountDeletionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AnonymizeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AnonymizeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_AnonymizeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AnonymizeAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmailOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmailOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmailOutbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmailOutbox(ctx, req.(*EmailOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResendEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResendEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResendEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResendEmail(ctx, req.(*ResendEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GrantRole(ctx, req.(*RoleChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeRole(ctx, req.(*RoleChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RoleAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RoleAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RoleAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RoleAudit(ctx, req.(*RoleAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).HealthCheck(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _AuthService_Register_Handler,
+		},
+		{
+			MethodName: "RegisterConfirm",
+			Handler:    _AuthService_RegisterConfirm_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _AuthService_Login_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _AuthService_RevokeToken_Handler,
+		},
+		{
+			MethodName: "RevokeUserTokens",
+			Handler:    _AuthService_RevokeUserTokens_Handler,
+		},
+		{
+			MethodName: "TokenStatus",
+			Handler:    _AuthService_TokenStatus_Handler,
+		},
+		{
+			MethodName: "OAuthLogin",
+			Handler:    _AuthService_OAuthLogin_Handler,
+		},
+		{
+			MethodName: "OAuthCallback",
+			Handler:    _AuthService_OAuthCallback_Handler,
+		},
+		{
+			MethodName: "TwoFactorLogin",
+			Handler:    _AuthService_TwoFactorLogin_Handler,
+		},
+		{
+			MethodName: "TOTPEnroll",
+			Handler:    _AuthService_TOTPEnroll_Handler,
+		},
 		{
-			MethodName: "Register",
-			Handler:    _AuthService_Register_Handler,
+			MethodName: "TOTPActivate",
+			Handler:    _AuthService_TOTPActivate_Handler,
 		},
 		{
-			MethodName: "RegisterConfirm",
-			Handler:    _AuthService_RegisterConfirm_Handler,
+			MethodName: "TOTPDisable",
+			Handler:    _AuthService_TOTPDisable_Handler,
 		},
 		{
-			MethodName: "Login",
-			Handler:    _AuthService_Login_Handler,
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _AuthService_RegenerateRecoveryCodes_Handler,
 		},
 		{
 			MethodName: "PasswordReset",
@@ -340,6 +1234,62 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Profile",
 			Handler:    _AuthService_Profile_Handler,
 		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _AuthService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AuthService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _AuthService_ChangeEmail_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _AuthService_ConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "ExportAccount",
+			Handler:    _AuthService_ExportAccount_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _AuthService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _AuthService_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "DueAccountDeletions",
+			Handler:    _AuthService_DueAccountDeletions_Handler,
+		},
+		{
+			MethodName: "AnonymizeAccount",
+			Handler:    _AuthService_AnonymizeAccount_Handler,
+		},
+		{
+			MethodName: "EmailOutbox",
+			Handler:    _AuthService_EmailOutbox_Handler,
+		},
+		{
+			MethodName: "ResendEmail",
+			Handler:    _AuthService_ResendEmail_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _AuthService_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _AuthService_RevokeRole_Handler,
+		},
+		{
+			MethodName: "RoleAudit",
+			Handler:    _AuthService_RoleAudit_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _AuthService_HealthCheck_Handler,
@@ -350,12 +1300,18 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EventService_CreateEvent_FullMethodName = "/EventService/CreateEvent"
-	EventService_GetEvents_FullMethodName   = "/EventService/GetEvents"
-	EventService_CreateBet_FullMethodName   = "/EventService/CreateBet"
-	EventService_GetBets_FullMethodName     = "/EventService/GetBets"
-	EventService_SetResult_FullMethodName   = "/EventService/SetResult"
-	EventService_HealthCheck_FullMethodName = "/EventService/HealthCheck"
+	EventService_CreateEvent_FullMethodName          = "/EventService/CreateEvent"
+	EventService_GetEvents_FullMethodName            = "/EventService/GetEvents"
+	EventService_CreateBet_FullMethodName            = "/EventService/CreateBet"
+	EventService_GetBets_FullMethodName              = "/EventService/GetBets"
+	EventService_ExportBets_FullMethodName           = "/EventService/ExportBets"
+	EventService_AnonymizeBets_FullMethodName        = "/EventService/AnonymizeBets"
+	EventService_SetResult_FullMethodName            = "/EventService/SetResult"
+	EventService_SubmitScrapedResults_FullMethodName = "/EventService/SubmitScrapedResults"
+	EventService_ResultReviews_FullMethodName        = "/EventService/ResultReviews"
+	EventService_ReviewResult_FullMethodName         = "/EventService/ReviewResult"
+	EventService_MergeFighters_FullMethodName        = "/EventService/MergeFighters"
+	EventService_HealthCheck_FullMethodName          = "/EventService/HealthCheck"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -366,7 +1322,13 @@ type EventServiceClient interface {
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
 	CreateBet(ctx context.Context, in *CreateBetRequest, opts ...grpc.CallOption) (*CreateBetResponse, error)
 	GetBets(ctx context.Context, in *BetsRequest, opts ...grpc.CallOption) (*BetsResponse, error)
+	ExportBets(ctx context.Context, in *BetsRequest, opts ...grpc.CallOption) (*BetsResponse, error)
+	AnonymizeBets(ctx context.Context, in *BetsRequest, opts ...grpc.CallOption) (*AnonymizeBetsResponse, error)
 	SetResult(ctx context.Context, in *FightResultRequest, opts ...grpc.CallOption) (*FightResultResponse, error)
+	SubmitScrapedResults(ctx context.Context, in *ScrapedResultsRequest, opts ...grpc.CallOption) (*ScrapedResultsResponse, error)
+	ResultReviews(ctx context.Context, in *ResultReviewsRequest, opts ...grpc.CallOption) (*ResultReviewsResponse, error)
+	ReviewResult(ctx context.Context, in *ReviewResultRequest, opts ...grpc.CallOption) (*ReviewResultResponse, error)
+	MergeFighters(ctx context.Context, in *MergeFightersRequest, opts ...grpc.CallOption) (*MergeFightersResponse, error)
 	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 }
 
@@ -418,6 +1380,26 @@ func (c *eventServiceClient) GetBets(ctx context.Context, in *BetsRequest, opts
 	return out, nil
 }
 
+func (c *eventServiceClient) ExportBets(ctx context.Context, in *BetsRequest, opts ...grpc.CallOption) (*BetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BetsResponse)
+	err := c.cc.Invoke(ctx, EventService_ExportBets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AnonymizeBets(ctx context.Context, in *BetsRequest, opts ...grpc.CallOption) (*AnonymizeBetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeBetsResponse)
+	err := c.cc.Invoke(ctx, EventService_AnonymizeBets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) SetResult(ctx context.Context, in *FightResultRequest, opts ...grpc.CallOption) (*FightResultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FightResultResponse)
@@ -428,6 +1410,46 @@ func (c *eventServiceClient) SetResult(ctx context.Context, in *FightResultReque
 	return out, nil
 }
 
+func (c *eventServiceClient) SubmitScrapedResults(ctx context.Context, in *ScrapedResultsRequest, opts ...grpc.CallOption) (*ScrapedResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScrapedResultsResponse)
+	err := c.cc.Invoke(ctx, EventService_SubmitScrapedResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ResultReviews(ctx context.Context, in *ResultReviewsRequest, opts ...grpc.CallOption) (*ResultReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultReviewsResponse)
+	err := c.cc.Invoke(ctx, EventService_ResultReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ReviewResult(ctx context.Context, in *ReviewResultRequest, opts ...grpc.CallOption) (*ReviewResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResultResponse)
+	err := c.cc.Invoke(ctx, EventService_ReviewResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) MergeFighters(ctx context.Context, in *MergeFightersRequest, opts ...grpc.CallOption) (*MergeFightersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeFightersResponse)
+	err := c.cc.Invoke(ctx, EventService_MergeFighters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthResponse)
@@ -446,7 +1468,13 @@ type EventServiceServer interface {
 	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
 	CreateBet(context.Context, *CreateBetRequest) (*CreateBetResponse, error)
 	GetBets(context.Context, *BetsRequest) (*BetsResponse, error)
+	ExportBets(context.Context, *BetsRequest) (*BetsResponse, error)
+	AnonymizeBets(context.Context, *BetsRequest) (*AnonymizeBetsResponse, error)
 	SetResult(context.Context, *FightResultRequest) (*FightResultResponse, error)
+	SubmitScrapedResults(context.Context, *ScrapedResultsRequest) (*ScrapedResultsResponse, error)
+	ResultReviews(context.Context, *ResultReviewsRequest) (*ResultReviewsResponse, error)
+	ReviewResult(context.Context, *ReviewResultRequest) (*ReviewResultResponse, error)
+	MergeFighters(context.Context, *MergeFightersRequest) (*MergeFightersResponse, error)
 	HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
@@ -470,9 +1498,27 @@ func (UnimplementedEventServiceServer) CreateBet(context.Context, *CreateBetRequ
 func (UnimplementedEventServiceServer) GetBets(context.Context, *BetsRequest) (*BetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBets not implemented")
 }
+func (UnimplementedEventServiceServer) ExportBets(context.Context, *BetsRequest) (*BetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBets not implemented")
+}
+func (UnimplementedEventServiceServer) AnonymizeBets(context.Context, *BetsRequest) (*AnonymizeBetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeBets not implemented")
+}
 func (UnimplementedEventServiceServer) SetResult(context.Context, *FightResultRequest) (*FightResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetResult not implemented")
 }
+func (UnimplementedEventServiceServer) SubmitScrapedResults(context.Context, *ScrapedResultsRequest) (*ScrapedResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitScrapedResults not implemented")
+}
+func (UnimplementedEventServiceServer) ResultReviews(context.Context, *ResultReviewsRequest) (*ResultReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResultReviews not implemented")
+}
+func (UnimplementedEventServiceServer) ReviewResult(context.Context, *ReviewResultRequest) (*ReviewResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewResult not implemented")
+}
+func (UnimplementedEventServiceServer) MergeFighters(context.Context, *MergeFightersRequest) (*MergeFightersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeFighters not implemented")
+}
 func (UnimplementedEventServiceServer) HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -569,6 +1615,42 @@ func _EventService_GetBets_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_ExportBets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ExportBets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ExportBets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ExportBets(ctx, req.(*BetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AnonymizeBets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AnonymizeBets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AnonymizeBets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AnonymizeBets(ctx, req.(*BetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_SetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FightResultRequest)
 	if err := dec(in); err != nil {
@@ -587,6 +1669,78 @@ func _EventService_SetResult_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_SubmitScrapedResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrapedResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SubmitScrapedResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SubmitScrapedResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SubmitScrapedResults(ctx, req.(*ScrapedResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ResultReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ResultReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ResultReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ResultReviews(ctx, req.(*ResultReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReviewResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReviewResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ReviewResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReviewResult(ctx, req.(*ReviewResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_MergeFighters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeFightersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).MergeFighters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_MergeFighters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).MergeFighters(ctx, req.(*MergeFightersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -628,10 +1782,34 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBets",
 			Handler:    _EventService_GetBets_Handler,
 		},
+		{
+			MethodName: "ExportBets",
+			Handler:    _EventService_ExportBets_Handler,
+		},
+		{
+			MethodName: "AnonymizeBets",
+			Handler:    _EventService_AnonymizeBets_Handler,
+		},
 		{
 			MethodName: "SetResult",
 			Handler:    _EventService_SetResult_Handler,
 		},
+		{
+			MethodName: "SubmitScrapedResults",
+			Handler:    _EventService_SubmitScrapedResults_Handler,
+		},
+		{
+			MethodName: "ResultReviews",
+			Handler:    _EventService_ResultReviews_Handler,
+		},
+		{
+			MethodName: "ReviewResult",
+			Handler:    _EventService_ReviewResult_Handler,
+		},
+		{
+			MethodName: "MergeFighters",
+			Handler:    _EventService_MergeFighters_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _EventService_HealthCheck_Handler,
@@ -642,9 +1820,13 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	FightersService_SearchFightersCount_FullMethodName = "/FightersService/SearchFightersCount"
-	FightersService_SearchFighters_FullMethodName      = "/FightersService/SearchFighters"
-	FightersService_HealthCheck_FullMethodName         = "/FightersService/HealthCheck"
+	FightersService_SearchFightersCount_FullMethodName  = "/FightersService/SearchFightersCount"
+	FightersService_SearchFighters_FullMethodName       = "/FightersService/SearchFighters"
+	FightersService_SearchFightersByText_FullMethodName = "/FightersService/SearchFightersByText"
+	FightersService_ExportFighters_FullMethodName       = "/FightersService/ExportFighters"
+	FightersService_UpsertFighters_FullMethodName       = "/FightersService/UpsertFighters"
+	FightersService_FighterImage_FullMethodName         = "/FightersService/FighterImage"
+	FightersService_HealthCheck_FullMethodName          = "/FightersService/HealthCheck"
 )
 
 // FightersServiceClient is the client API for FightersService service.
@@ -653,6 +1835,10 @@ const (
 type FightersServiceClient interface {
 	SearchFightersCount(ctx context.Context, in *FightersRequest, opts ...grpc.CallOption) (*FightersCountResponse, error)
 	SearchFighters(ctx context.Context, in *FightersRequest, opts ...grpc.CallOption) (*FightersResponse, error)
+	SearchFightersByText(ctx context.Context, in *FightersTextSearchRequest, opts ...grpc.CallOption) (*FightersResponse, error)
+	ExportFighters(ctx context.Context, in *FightersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Fighter], error)
+	UpsertFighters(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Fighter, UpsertFightersResponse], error)
+	FighterImage(ctx context.Context, in *FighterImageRequest, opts ...grpc.CallOption) (*FighterImageResponse, error)
 	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 }
 
@@ -684,6 +1870,58 @@ func (c *fightersServiceClient) SearchFighters(ctx context.Context, in *Fighters
 	return out, nil
 }
 
+func (c *fightersServiceClient) SearchFightersByText(ctx context.Context, in *FightersTextSearchRequest, opts ...grpc.CallOption) (*FightersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FightersResponse)
+	err := c.cc.Invoke(ctx, FightersService_SearchFightersByText_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fightersServiceClient) ExportFighters(ctx context.Context, in *FightersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Fighter], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FightersService_ServiceDesc.Streams[0], FightersService_ExportFighters_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FightersRequest, Fighter]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FightersService_ExportFightersClient = grpc.ServerStreamingClient[Fighter]
+
+func (c *fightersServiceClient) UpsertFighters(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Fighter, UpsertFightersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FightersService_ServiceDesc.Streams[1], FightersService_UpsertFighters_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Fighter, UpsertFightersResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FightersService_UpsertFightersClient = grpc.ClientStreamingClient[Fighter, UpsertFightersResponse]
+
+func (c *fightersServiceClient) FighterImage(ctx context.Context, in *FighterImageRequest, opts ...grpc.CallOption) (*FighterImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FighterImageResponse)
+	err := c.cc.Invoke(ctx, FightersService_FighterImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fightersServiceClient) HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthResponse)
@@ -700,6 +1938,10 @@ func (c *fightersServiceClient) HealthCheck(ctx context.Context, in *emptypb.Emp
 type FightersServiceServer interface {
 	SearchFightersCount(context.Context, *FightersRequest) (*FightersCountResponse, error)
 	SearchFighters(context.Context, *FightersRequest) (*FightersResponse, error)
+	SearchFightersByText(context.Context, *FightersTextSearchRequest) (*FightersResponse, error)
+	ExportFighters(*FightersRequest, grpc.ServerStreamingServer[Fighter]) error
+	UpsertFighters(grpc.ClientStreamingServer[Fighter, UpsertFightersResponse]) error
+	FighterImage(context.Context, *FighterImageRequest) (*FighterImageResponse, error)
 	HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error)
 	mustEmbedUnimplementedFightersServiceServer()
 }
@@ -717,6 +1959,18 @@ func (UnimplementedFightersServiceServer) SearchFightersCount(context.Context, *
 func (UnimplementedFightersServiceServer) SearchFighters(context.Context, *FightersRequest) (*FightersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchFighters not implemented")
 }
+func (UnimplementedFightersServiceServer) SearchFightersByText(context.Context, *FightersTextSearchRequest) (*FightersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFightersByText not implemented")
+}
+func (UnimplementedFightersServiceServer) ExportFighters(*FightersRequest, grpc.ServerStreamingServer[Fighter]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportFighters not implemented")
+}
+func (UnimplementedFightersServiceServer) UpsertFighters(grpc.ClientStreamingServer[Fighter, UpsertFightersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UpsertFighters not implemented")
+}
+func (UnimplementedFightersServiceServer) FighterImage(context.Context, *FighterImageRequest) (*FighterImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FighterImage not implemented")
+}
 func (UnimplementedFightersServiceServer) HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -777,6 +2031,60 @@ func _FightersService_SearchFighters_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FightersService_SearchFightersByText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FightersTextSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FightersServiceServer).SearchFightersByText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FightersService_SearchFightersByText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FightersServiceServer).SearchFightersByText(ctx, req.(*FightersTextSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FightersService_ExportFighters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FightersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FightersServiceServer).ExportFighters(m, &grpc.GenericServerStream[FightersRequest, Fighter]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FightersService_ExportFightersServer = grpc.ServerStreamingServer[Fighter]
+
+func _FightersService_UpsertFighters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FightersServiceServer).UpsertFighters(&grpc.GenericServerStream[Fighter, UpsertFightersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FightersService_UpsertFightersServer = grpc.ClientStreamingServer[Fighter, UpsertFightersResponse]
+
+func _FightersService_FighterImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FighterImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FightersServiceServer).FighterImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FightersService_FighterImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FightersServiceServer).FighterImage(ctx, req.(*FighterImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FightersService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -810,11 +2118,30 @@ var FightersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchFighters",
 			Handler:    _FightersService_SearchFighters_Handler,
 		},
+		{
+			MethodName: "SearchFightersByText",
+			Handler:    _FightersService_SearchFightersByText_Handler,
+		},
+		{
+			MethodName: "FighterImage",
+			Handler:    _FightersService_FighterImage_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _FightersService_HealthCheck_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportFighters",
+			Handler:       _FightersService_ExportFighters_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpsertFighters",
+			Handler:       _FightersService_UpsertFighters_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "pickfighter.proto",
 }
@@ -103,6 +103,7 @@ func runServe(cmd *cobra.Command, args []string) {
 	eventGateway := eventgateway.New(registry)
 	fightersGateway := fightersgateway.New(registry)
 	ctl := pickfighter.New(authGateway, eventGateway, fightersGateway)
+	go ctl.RunAccountAnonymizer(ctx)
 	h := httphandler.New(ctl)
 	app := service.New(h)
 
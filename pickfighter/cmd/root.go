@@ -100,8 +100,17 @@ func setConfigDefaults() {
 
 	// auth config
 	viper.SetDefault("auth.cookie_name", "pf_api_token")
+	viper.SetDefault("auth.refresh_cookie_name", "pf_refresh_token")
+	viper.SetDefault("auth.revocation.cache_ttl", 30*time.Second)
 	viper.SetDefault("auth.jwt.cert", "")
 	viper.SetDefault("auth.jwt.key", "")
+	viper.SetDefault("auth.oidc.state_cookie_name", "pf_oauth_state")
+	// where the browser is sent after a provider login, the tokens are returned as JSON if empty
+	viper.SetDefault("auth.oidc.login_redirect", "")
+	viper.SetDefault("auth.totp.challenge_cookie_name", "pf_2fa_challenge")
+	// accounts whose deletion is due are anonymized in batches by the gateway
+	viper.SetDefault("account.anonymize_interval", time.Hour)
+	viper.SetDefault("account.anonymize_batch", 50)
 }
 
 // bindViperPersistentFlag binds a Viper configuration flag to a persistent Cobra command flag.
@@ -0,0 +1,113 @@
+package pickfighter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spf13/viper"
+	authmodel "pickfighter.com/auth/pkg/model"
+	"pickfighter.com/pickfighter/internal/gateway"
+	gatewaymodel "pickfighter.com/pickfighter/pkg/model"
+	logs "pickfighter.com/pkg/logger"
+)
+
+// ExportAccount assembles the personal data kept about the user by the auth and event services.
+func (c *Controller) ExportAccount(ctx context.Context, userId int32) (*gatewaymodel.AccountArchive, error) {
+	account, err := c.authGateway.ExportAccount(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	bets, err := c.eventGateway.ExportBets(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gatewaymodel.AccountArchive{
+		ExportedAt: time.Now().Unix(),
+		Account:    account,
+		Bets:       bets,
+	}, nil
+}
+
+// DeleteAccount schedules the deletion of the user's account, which logs the user out everywhere.
+// Cached statuses of the user's tokens are dropped, so they are rejected by this gateway at once.
+func (c *Controller) DeleteAccount(ctx context.Context, req *authmodel.DeleteAccountRequest) (*authmodel.AccountDeletion, error) {
+	deletion, err := c.authGateway.DeleteAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.revocations.forgetUser(req.UserId)
+
+	return deletion, nil
+}
+
+// CancelAccountDeletion keeps the user's account whose deletion is scheduled using the authGateway.
+func (c *Controller) CancelAccountDeletion(ctx context.Context, userId int32) error {
+	return c.authGateway.CancelAccountDeletion(ctx, userId)
+}
+
+// RunAccountAnonymizer anonymizes accounts whose deletion is due every `account.anonymize_interval`
+// until the context is done. Every replica of the gateway runs it, the auth service claims due accounts
+// for one of them at a time, so an account is never anonymized by two replicas at once.
+func (c *Controller) RunAccountAnonymizer(ctx context.Context) {
+	interval := viper.GetDuration("account.anonymize_interval")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logs.Infof("Account anonymizer started, running every %s", interval)
+
+	for {
+		if _, err := c.AnonymizeDueAccounts(ctx); err != nil {
+			logs.Errorf("Failed to anonymize deleted accounts: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			logs.Info("Account anonymizer stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// AnonymizeDueAccounts anonymizes up to `account.anonymize_batch` accounts whose grace period is over
+// and returns the number of anonymized ones. The bets of a user are moved to a pseudonym before the
+// credentials are removed, so an account failing halfway is still due and is retried by the first run
+// after its claim passes. Moving the bets again is harmless, the user has none left by then.
+func (c *Controller) AnonymizeDueAccounts(ctx context.Context) (int, error) {
+	userIds, err := c.authGateway.DueAccountDeletions(ctx, viper.GetInt32("account.anonymize_batch"))
+	if err != nil {
+		return 0, err
+	}
+
+	var anonymized int
+	for _, userId := range userIds {
+		if ctx.Err() != nil {
+			break
+		}
+
+		bets, err := c.eventGateway.AnonymizeBets(ctx, userId)
+		if err != nil {
+			logs.Errorf("Failed to anonymize bets of User [%d]: %s", userId, err)
+			continue
+		}
+
+		err = c.authGateway.AnonymizeAccount(ctx, userId)
+		if errors.Is(err, gateway.ErrConflict) {
+			logs.Debugf("User [%d] is not due for anonymization anymore: %s", userId, err)
+			continue
+		} else if err != nil {
+			logs.Errorf("Failed to anonymize User [%d]: %s", userId, err)
+			continue
+		}
+
+		logs.Infof("Anonymized User [%d] with %d bets", userId, bets)
+		anonymized++
+	}
+
+	return anonymized, nil
+}
@@ -0,0 +1,159 @@
+package pickfighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pickfighter.com/pickfighter/internal/gateway"
+)
+
+// anonymizerLog records the anonymization calls of the fake gateways in their order.
+type anonymizerLog struct {
+	calls []string
+}
+
+// fakeDeletionAuth hands out due accounts and anonymizes them, failing the users in fail once.
+// Other calls of the auth service are not expected.
+type fakeDeletionAuth struct {
+	authGateway
+	log        *anonymizerLog
+	due        []int32
+	fail       map[int32]error
+	anonymized map[int32]bool
+}
+
+func (g *fakeDeletionAuth) DueAccountDeletions(ctx context.Context, limit int32) ([]int32, error) {
+	var due []int32
+	for _, userId := range g.due {
+		if !g.anonymized[userId] {
+			due = append(due, userId)
+		}
+	}
+
+	return due, nil
+}
+
+func (g *fakeDeletionAuth) AnonymizeAccount(ctx context.Context, userId int32) error {
+	g.log.calls = append(g.log.calls, fmt.Sprintf("account %d", userId))
+
+	if err, ok := g.fail[userId]; ok {
+		delete(g.fail, userId)
+		return err
+	}
+	if g.anonymized[userId] {
+		return gateway.ErrConflict
+	}
+	g.anonymized[userId] = true
+
+	return nil
+}
+
+// fakeDeletionEvents moves the bets of users to pseudonyms, a user has bets until they are moved once.
+type fakeDeletionEvents struct {
+	eventGateway
+	log  *anonymizerLog
+	bets map[int32]int32
+	fail map[int32]error
+}
+
+func (g *fakeDeletionEvents) AnonymizeBets(ctx context.Context, userId int32) (int32, error) {
+	g.log.calls = append(g.log.calls, fmt.Sprintf("bets %d", userId))
+
+	if err, ok := g.fail[userId]; ok {
+		delete(g.fail, userId)
+		return 0, err
+	}
+
+	moved := g.bets[userId]
+	g.bets[userId] = 0
+
+	return moved, nil
+}
+
+func newTestAnonymizer(t *testing.T, due ...int32) (*Controller, *fakeDeletionAuth, *fakeDeletionEvents) {
+	t.Helper()
+
+	viper.Set("account.anonymize_batch", 50)
+	t.Cleanup(viper.Reset)
+
+	log := &anonymizerLog{}
+	auth := &fakeDeletionAuth{log: log, due: due, fail: map[int32]error{}, anonymized: map[int32]bool{}}
+	events := &fakeDeletionEvents{log: log, bets: map[int32]int32{}, fail: map[int32]error{}}
+
+	return New(auth, events, nil), auth, events
+}
+
+func TestAnonymizeDueAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Bets before credentials", func(t *testing.T) {
+		c, auth, events := newTestAnonymizer(t, 7, 8)
+		events.bets[7] = 3
+
+		n, err := c.AnonymizeDueAccounts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+
+		// the credentials are removed only after the bets of the user are moved
+		assert.Equal(t, []string{"bets 7", "account 7", "bets 8", "account 8"}, auth.log.calls)
+		assert.Zero(t, events.bets[7])
+	})
+
+	t.Run("Bets failure keeps the credentials", func(t *testing.T) {
+		c, auth, events := newTestAnonymizer(t, 7, 8)
+		events.fail[7] = errors.New("event service unavailable")
+
+		n, err := c.AnonymizeDueAccounts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		// user 7 stays due with the credentials, other users are not held up
+		assert.Equal(t, []string{"bets 7", "bets 8", "account 8"}, auth.log.calls)
+		assert.False(t, auth.anonymized[7])
+		assert.True(t, auth.anonymized[8])
+	})
+
+	t.Run("Idempotent retry", func(t *testing.T) {
+		c, auth, events := newTestAnonymizer(t, 7)
+		events.bets[7] = 3
+		auth.fail[7] = errors.New("auth service unavailable")
+
+		n, err := c.AnonymizeDueAccounts(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		assert.Zero(t, events.bets[7], "the bets are moved before the failure")
+
+		// the account is still due, the retry moves no bets and finishes the anonymization
+		n, err = c.AnonymizeDueAccounts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.True(t, auth.anonymized[7])
+
+		assert.Equal(t, []string{"bets 7", "account 7", "bets 7", "account 7"}, auth.log.calls)
+
+		// an account anonymized by another run in the meantime is skipped
+		auth.due = []int32{8}
+		auth.fail[8] = gateway.ErrConflict
+
+		n, err = c.AnonymizeDueAccounts(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("Cancelled context", func(t *testing.T) {
+		c, auth, _ := newTestAnonymizer(t, 7)
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		n, err := c.AnonymizeDueAccounts(cancelled)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		assert.Empty(t, auth.log.calls)
+	})
+}
@@ -12,6 +12,9 @@ import (
 
 type fightersGateway interface {
 	SearchFighters(ctx context.Context, req fightersmodel.FightersRequest) ([]*fightersmodel.Fighter, error)
+	SearchFightersByText(ctx context.Context, req *fightersmodel.FightersTextSearchRequest) ([]*fightersmodel.Fighter, error)
+	ExportFighters(ctx context.Context, req fightersmodel.FightersRequest, fn func(*fightersmodel.Fighter) error) error
+	FighterImage(ctx context.Context, req *fightersmodel.FighterImageRequest) (*fightersmodel.FighterImage, error)
 	ServiceHealthCheck() (*model.HealthStatus, error)
 }
 
@@ -19,9 +22,34 @@ type authGateway interface {
 	Register(ctx context.Context, req *authmodel.RegisterRequest) (*authmodel.UserCredentials, error)
 	ConfirmRegistration(ctx context.Context, token string) (bool, error)
 	Login(ctx context.Context, req *authmodel.AuthenticateRequest) (*authmodel.AuthenticateResult, error)
+	RefreshToken(ctx context.Context, req *authmodel.RefreshTokenRequest) (*authmodel.AuthenticateResult, error)
+	RevokeToken(ctx context.Context, req *authmodel.RevokeTokenRequest) error
+	RevokeUserTokens(ctx context.Context, req *authmodel.RevokeUserTokensRequest) error
+	TokenStatus(ctx context.Context, req *authmodel.TokenStatusRequest) (*authmodel.TokenStatus, error)
 	ResetPassword(ctx context.Context, req *authmodel.ResetPasswordRequest) (bool, error)
 	PasswordRecover(ctx context.Context, req *authmodel.RecoverPasswordRequest) (bool, error)
 	GetCurrentUser(ctx context.Context) (*authmodel.User, error)
+	UpdateProfile(ctx context.Context, req *authmodel.UserRequest) (*authmodel.User, error)
+	ChangePassword(ctx context.Context, req *authmodel.ChangePasswordRequest) error
+	ChangeEmail(ctx context.Context, req *authmodel.ChangeEmailRequest) (*authmodel.ChangeEmailResult, error)
+	ConfirmEmailChange(ctx context.Context, req *authmodel.ConfirmEmailChangeRequest) (*authmodel.EmailChange, error)
+	ExportAccount(ctx context.Context, userId int32) (*authmodel.AccountExport, error)
+	DeleteAccount(ctx context.Context, req *authmodel.DeleteAccountRequest) (*authmodel.AccountDeletion, error)
+	CancelAccountDeletion(ctx context.Context, userId int32) error
+	DueAccountDeletions(ctx context.Context, limit int32) ([]int32, error)
+	AnonymizeAccount(ctx context.Context, userId int32) error
+	EmailOutbox(ctx context.Context, req *authmodel.EmailOutboxRequest) ([]*authmodel.OutboxEmail, error)
+	ResendEmail(ctx context.Context, req *authmodel.ResendEmailRequest) (*authmodel.OutboxEmail, error)
+	GrantRole(ctx context.Context, req *authmodel.RoleChangeRequest) (*authmodel.RoleChange, error)
+	RevokeRole(ctx context.Context, req *authmodel.RoleChangeRequest) (*authmodel.RoleChange, error)
+	RoleAudit(ctx context.Context, req *authmodel.RoleAuditRequest) ([]*authmodel.RoleChange, error)
+	OAuthLogin(ctx context.Context, req *authmodel.OAuthLoginRequest) (*authmodel.OAuthLoginResult, error)
+	OAuthCallback(ctx context.Context, req *authmodel.OAuthCallbackRequest) (*authmodel.AuthenticateResult, error)
+	TwoFactorLogin(ctx context.Context, req *authmodel.TwoFactorLoginRequest) (*authmodel.AuthenticateResult, error)
+	TOTPEnroll(ctx context.Context, req *authmodel.TOTPEnrollRequest) (*authmodel.TOTPEnrollment, error)
+	TOTPActivate(ctx context.Context, req *authmodel.TOTPCodeRequest) ([]string, error)
+	TOTPDisable(ctx context.Context, req *authmodel.TOTPCodeRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, req *authmodel.TOTPCodeRequest) ([]string, error)
 	ServiceHealthCheck() (*model.HealthStatus, error)
 }
 
@@ -30,7 +58,11 @@ type eventGateway interface {
 	SearchEvents(ctx context.Context) (*eventmodel.EventsResponse, error)
 	CreateBet(ctx context.Context, req *eventmodel.Bet) (*eventmodel.Bet, error)
 	SearchBets(ctx context.Context, userId int32) (*eventmodel.BetsResponse, error)
+	ExportBets(ctx context.Context, userId int32) ([]*eventmodel.Bet, error)
+	AnonymizeBets(ctx context.Context, userId int32) (int32, error)
 	SetResult(ctx context.Context, req *eventmodel.FightResultRequest) (int32, error)
+	ResultReviews(ctx context.Context, req *eventmodel.ResultReviewsRequest) ([]*eventmodel.ResultReview, error)
+	ReviewResult(ctx context.Context, req *eventmodel.ReviewResultRequest) (*eventmodel.ResultReview, error)
 	ServiceHealthCheck() (*model.HealthStatus, error)
 }
 
@@ -39,6 +71,7 @@ type Controller struct {
 	authGateway     authGateway
 	eventGateway    eventGateway
 	fightersGateway fightersGateway
+	revocations     *revocationCache
 }
 
 // New creates new Controller instance
@@ -47,6 +80,7 @@ func New(authGateway authGateway, eventGateway eventGateway, fightersGateway fig
 		authGateway,
 		eventGateway,
 		fightersGateway,
+		newRevocationCache(),
 	}
 }
 
@@ -62,6 +96,32 @@ func (c *Controller) SearchFighters(ctx context.Context, req fightersmodel.Fight
 	return fighters, nil
 }
 
+// SearchFightersByText searches fighters by name, nickname, hometown or gym using the fightersGateway.
+func (c *Controller) SearchFightersByText(ctx context.Context, req *fightersmodel.FightersTextSearchRequest) ([]*fightersmodel.Fighter, error) {
+	fighters, err := c.fightersGateway.SearchFightersByText(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return fighters, nil
+}
+
+// ExportFighters streams fighters matching the request using the fightersGateway,
+// fn is called for every fighter in the order they are received.
+func (c *Controller) ExportFighters(ctx context.Context, req fightersmodel.FightersRequest, fn func(*fightersmodel.Fighter) error) error {
+	return c.fightersGateway.ExportFighters(ctx, req, fn)
+}
+
+// FighterImage retrieves a fighter thumbnail using the fightersGateway.
+func (c *Controller) FighterImage(ctx context.Context, req *fightersmodel.FighterImageRequest) (*fightersmodel.FighterImage, error) {
+	img, err := c.fightersGateway.FighterImage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return img, nil
+}
+
 // * * * * * Auth Controller Methods * * * * *
 
 // Register handles the registration of a new user. It takes a context and a
@@ -95,6 +155,16 @@ func (c *Controller) Login(ctx context.Context, req *authmodel.AuthenticateReque
 	return token, nil
 }
 
+// RefreshToken exchanges a refresh token for new access and refresh tokens.
+func (c *Controller) RefreshToken(ctx context.Context, req *authmodel.RefreshTokenRequest) (*authmodel.AuthenticateResult, error) {
+	token, err := c.authGateway.RefreshToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
 // ResetPassword resets a user's password with the provided request details.
 func (c *Controller) ResetPassword(ctx context.Context, req *authmodel.ResetPasswordRequest) (bool, error) {
 	ok, err := c.authGateway.ResetPassword(ctx, req)
@@ -125,6 +195,81 @@ func (c *Controller) GetCurrentUser(ctx context.Context) (*authmodel.User, error
 	return user, nil
 }
 
+// UpdateProfile changes the display name of a user using the authGateway.
+func (c *Controller) UpdateProfile(ctx context.Context, req *authmodel.UserRequest) (*authmodel.User, error) {
+	return c.authGateway.UpdateProfile(ctx, req)
+}
+
+// ChangeEmail requests a confirmation of an email change using the authGateway.
+func (c *Controller) ChangeEmail(ctx context.Context, req *authmodel.ChangeEmailRequest) (*authmodel.ChangeEmailResult, error) {
+	return c.authGateway.ChangeEmail(ctx, req)
+}
+
+// ConfirmEmailChange swaps the email of a user with a confirmation token using the authGateway.
+func (c *Controller) ConfirmEmailChange(ctx context.Context, req *authmodel.ConfirmEmailChangeRequest) (*authmodel.EmailChange, error) {
+	return c.authGateway.ConfirmEmailChange(ctx, req)
+}
+
+// EmailOutbox returns queued emails with their delivery status using the authGateway.
+func (c *Controller) EmailOutbox(ctx context.Context, req *authmodel.EmailOutboxRequest) ([]*authmodel.OutboxEmail, error) {
+	return c.authGateway.EmailOutbox(ctx, req)
+}
+
+// ResendEmail sends a pending or dead outbox email again using the authGateway.
+func (c *Controller) ResendEmail(ctx context.Context, req *authmodel.ResendEmailRequest) (*authmodel.OutboxEmail, error) {
+	return c.authGateway.ResendEmail(ctx, req)
+}
+
+// GrantRole grants a role to a user using the authGateway.
+func (c *Controller) GrantRole(ctx context.Context, req *authmodel.RoleChangeRequest) (*authmodel.RoleChange, error) {
+	return c.authGateway.GrantRole(ctx, req)
+}
+
+// RevokeRole revokes a role of a user using the authGateway.
+func (c *Controller) RevokeRole(ctx context.Context, req *authmodel.RoleChangeRequest) (*authmodel.RoleChange, error) {
+	return c.authGateway.RevokeRole(ctx, req)
+}
+
+// RoleAudit returns role changes using the authGateway.
+func (c *Controller) RoleAudit(ctx context.Context, req *authmodel.RoleAuditRequest) ([]*authmodel.RoleChange, error) {
+	return c.authGateway.RoleAudit(ctx, req)
+}
+
+// OAuthLogin starts a login with an OpenID Connect provider using the authGateway.
+func (c *Controller) OAuthLogin(ctx context.Context, req *authmodel.OAuthLoginRequest) (*authmodel.OAuthLoginResult, error) {
+	return c.authGateway.OAuthLogin(ctx, req)
+}
+
+// OAuthCallback completes a login with an OpenID Connect provider using the authGateway.
+func (c *Controller) OAuthCallback(ctx context.Context, req *authmodel.OAuthCallbackRequest) (*authmodel.AuthenticateResult, error) {
+	return c.authGateway.OAuthCallback(ctx, req)
+}
+
+// TwoFactorLogin completes a two-factor login challenge using the authGateway.
+func (c *Controller) TwoFactorLogin(ctx context.Context, req *authmodel.TwoFactorLoginRequest) (*authmodel.AuthenticateResult, error) {
+	return c.authGateway.TwoFactorLogin(ctx, req)
+}
+
+// TOTPEnroll enrolls an authenticator using the authGateway.
+func (c *Controller) TOTPEnroll(ctx context.Context, req *authmodel.TOTPEnrollRequest) (*authmodel.TOTPEnrollment, error) {
+	return c.authGateway.TOTPEnroll(ctx, req)
+}
+
+// TOTPActivate enables an enrolled authenticator using the authGateway.
+func (c *Controller) TOTPActivate(ctx context.Context, req *authmodel.TOTPCodeRequest) ([]string, error) {
+	return c.authGateway.TOTPActivate(ctx, req)
+}
+
+// TOTPDisable disables two-factor authentication using the authGateway.
+func (c *Controller) TOTPDisable(ctx context.Context, req *authmodel.TOTPCodeRequest) error {
+	return c.authGateway.TOTPDisable(ctx, req)
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes using the authGateway.
+func (c *Controller) RegenerateRecoveryCodes(ctx context.Context, req *authmodel.TOTPCodeRequest) ([]string, error) {
+	return c.authGateway.RegenerateRecoveryCodes(ctx, req)
+}
+
 // * * * * * Events Controller Methods * * * * *
 
 func (c *Controller) CreateEvent(ctx context.Context, req *eventmodel.EventRequest) (*eventmodel.Event, error) {
@@ -184,3 +329,13 @@ func (c *Controller) SetResult(ctx context.Context, req *eventmodel.FightResultR
 
 	return id, nil
 }
+
+// ResultReviews returns scraped results queued for an admin approval using the eventGateway.
+func (c *Controller) ResultReviews(ctx context.Context, req *eventmodel.ResultReviewsRequest) ([]*eventmodel.ResultReview, error) {
+	return c.eventGateway.ResultReviews(ctx, req)
+}
+
+// ReviewResult approves or rejects a queued scraped result using the eventGateway.
+func (c *Controller) ReviewResult(ctx context.Context, req *eventmodel.ReviewResultRequest) (*eventmodel.ResultReview, error) {
+	return c.eventGateway.ReviewResult(ctx, req)
+}
@@ -0,0 +1,143 @@
+package pickfighter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	authmodel "pickfighter.com/auth/pkg/model"
+)
+
+// maxRevocationEntries is the size of the revocation cache at which expired entries are swept,
+// random entries are evicted if it is still full then.
+const maxRevocationEntries = 10000
+
+// revocationCache keeps statuses of access tokens checked with the auth service by their JWT ID.
+// Revoked tokens stay revoked until they expire, valid tokens are checked again after auth.revocation.cache_ttl,
+// so a token revoked through another gateway is rejected after that delay at most.
+type revocationCache struct {
+	mu      sync.Mutex
+	entries map[string]revocationEntry
+}
+
+type revocationEntry struct {
+	userId  int32
+	revoked bool
+	until   time.Time
+}
+
+func newRevocationCache() *revocationCache {
+	return &revocationCache{entries: make(map[string]revocationEntry)}
+}
+
+// get returns the cached status of the token, ok is false if it is not cached or outdated.
+func (c *revocationCache) get(tokenId string, now time.Time) (revoked, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[tokenId]
+	if !ok || !now.Before(e.until) {
+		return false, false
+	}
+
+	return e.revoked, true
+}
+
+// set caches the status of the token. A full cache drops expired entries first and evicts random ones
+// if nothing expired, the map iteration order is random. An evicted token is checked with the auth service again,
+// so evicting a revoked token never lets it through.
+func (c *revocationCache) set(tokenId string, e revocationEntry, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[tokenId]; !ok && len(c.entries) >= maxRevocationEntries {
+		for id, old := range c.entries {
+			if !now.Before(old.until) {
+				delete(c.entries, id)
+			}
+		}
+
+		for id := range c.entries {
+			if len(c.entries) < maxRevocationEntries {
+				break
+			}
+			delete(c.entries, id)
+		}
+	}
+
+	c.entries[tokenId] = e
+}
+
+// forgetUser drops cached statuses of the user's tokens, so they are checked again.
+func (c *revocationCache) forgetUser(userId int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, e := range c.entries {
+		if e.userId == userId {
+			delete(c.entries, id)
+		}
+	}
+}
+
+// IsTokenRevoked tells whether an access token expiring at expiresAt was revoked, statuses are cached locally.
+func (c *Controller) IsTokenRevoked(ctx context.Context, req *authmodel.TokenStatusRequest, expiresAt time.Time) (bool, error) {
+	now := time.Now()
+	if revoked, ok := c.revocations.get(req.TokenId, now); ok {
+		return revoked, nil
+	}
+
+	status, err := c.authGateway.TokenStatus(ctx, req)
+	if err != nil {
+		return false, err
+	}
+
+	until := now.Add(viper.GetDuration("auth.revocation.cache_ttl"))
+	if status.Revoked || until.After(expiresAt) {
+		until = expiresAt
+	}
+
+	c.revocations.set(req.TokenId, revocationEntry{userId: req.UserId, revoked: status.Revoked, until: until}, now)
+
+	return status.Revoked, nil
+}
+
+// RevokeToken revokes an access token and the refresh tokens of its session, the token is rejected
+// by this gateway at once.
+func (c *Controller) RevokeToken(ctx context.Context, req *authmodel.RevokeTokenRequest) error {
+	if err := c.authGateway.RevokeToken(ctx, req); err != nil {
+		return err
+	}
+
+	c.revocations.set(req.TokenId, revocationEntry{
+		userId:  req.UserId,
+		revoked: true,
+		until:   time.Unix(req.ExpiresAt, 0),
+	}, time.Now())
+
+	return nil
+}
+
+// RevokeUserTokens logs the user out everywhere by revoking all tokens issued before req.Before.
+func (c *Controller) RevokeUserTokens(ctx context.Context, req *authmodel.RevokeUserTokensRequest) error {
+	if err := c.authGateway.RevokeUserTokens(ctx, req); err != nil {
+		return err
+	}
+
+	c.revocations.forgetUser(req.UserId)
+
+	return nil
+}
+
+// ChangePassword changes the password of a user, which logs out the other sessions of the user.
+// Cached statuses of the user's tokens are dropped, so the tokens of those sessions are rejected at once.
+func (c *Controller) ChangePassword(ctx context.Context, req *authmodel.ChangePasswordRequest) error {
+	if err := c.authGateway.ChangePassword(ctx, req); err != nil {
+		return err
+	}
+
+	c.revocations.forgetUser(req.UserId)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package pickfighter
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevocationCacheSet(t *testing.T) {
+	now := time.Now()
+	c := newRevocationCache()
+
+	// expired entries are swept first
+	for i := 0; i < maxRevocationEntries; i++ {
+		c.set("expired-"+strconv.Itoa(i), revocationEntry{until: now.Add(-time.Second)}, now)
+	}
+	c.set("fresh", revocationEntry{revoked: true, until: now.Add(time.Hour)}, now)
+	assert.Len(t, c.entries, 1)
+
+	// a cache full of valid entries evicts some of them instead of growing
+	for i := 0; i < maxRevocationEntries*2; i++ {
+		c.set("valid-"+strconv.Itoa(i), revocationEntry{until: now.Add(time.Hour)}, now)
+	}
+	assert.Len(t, c.entries, maxRevocationEntries)
+
+	revoked, ok := c.get("valid-"+strconv.Itoa(maxRevocationEntries*2-1), now)
+	assert.True(t, ok, "the last entry is always cached")
+	assert.False(t, revoked)
+
+	// updating a cached token never evicts
+	c.set("valid-"+strconv.Itoa(maxRevocationEntries*2-1), revocationEntry{revoked: true, until: now.Add(time.Hour)}, now)
+	assert.Len(t, c.entries, maxRevocationEntries)
+}
@@ -2,10 +2,14 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	authmodel "pickfighter.com/auth/pkg/model"
 	"pickfighter.com/gen"
 	"pickfighter.com/internal/grpcutil"
+	"pickfighter.com/pickfighter/internal/gateway"
 	"pickfighter.com/pkg/discovery"
 	"pickfighter.com/pkg/model"
 )
@@ -84,6 +88,75 @@ func (g *Gateway) Login(ctx context.Context, req *authmodel.AuthenticateRequest)
 	return token, nil
 }
 
+// RefreshToken exchanges a refresh token for new access and refresh tokens via the auth-service.
+// It returns gateway.ErrUnauthorized if the refresh token is invalid, expired, revoked or reused.
+func (g *Gateway) RefreshToken(ctx context.Context, req *authmodel.RefreshTokenRequest) (*authmodel.AuthenticateResult, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.RefreshToken(ctx, authmodel.RefreshTokenRequestToProto(req))
+	if status.Code(err) == codes.Unauthenticated {
+		return nil, fmt.Errorf("%w: %s", gateway.ErrUnauthorized, status.Convert(err).Message())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return authmodel.AuthenticateResultFromProto(resp), nil
+}
+
+// RevokeToken revokes an access token by its JWT ID via the auth-service.
+func (g *Gateway) RevokeToken(ctx context.Context, req *authmodel.RevokeTokenRequest) error {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	_, err = client.RevokeToken(ctx, authmodel.RevokeTokenRequestToProto(req))
+
+	return err
+}
+
+// RevokeUserTokens revokes all tokens issued to the user before a timestamp via the auth-service.
+func (g *Gateway) RevokeUserTokens(ctx context.Context, req *authmodel.RevokeUserTokensRequest) error {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	_, err = client.RevokeUserTokens(ctx, authmodel.RevokeUserTokensRequestToProto(req))
+
+	return err
+}
+
+// TokenStatus checks whether an access token is revoked via the auth-service.
+func (g *Gateway) TokenStatus(ctx context.Context, req *authmodel.TokenStatusRequest) (*authmodel.TokenStatus, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.TokenStatus(ctx, authmodel.TokenStatusRequestToProto(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return authmodel.TokenStatusFromProto(resp), nil
+}
+
 // ResetPassword initiates a password reset process via the auth-service.
 // It establishes a gRPC connection, sends the password reset request,
 // and returns true if the request was successfully processed.
@@ -96,7 +169,7 @@ func (g *Gateway) ResetPassword(ctx context.Context, req *authmodel.ResetPasswor
 
 	client := gen.NewAuthServiceClient(conn)
 
-	passResetReq := &gen.PasswordResetRequest{Email: req.Email}
+	passResetReq := &gen.PasswordResetRequest{Email: req.Email, Locale: req.Locale}
 	_, err = client.PasswordReset(ctx, passResetReq)
 	if err != nil {
 		return false, err
@@ -150,3 +223,483 @@ func (g *Gateway) GetCurrentUser(ctx context.Context) (*authmodel.User, error) {
 
 	return user, nil
 }
+
+// EmailOutbox requests queued emails with their delivery status from the auth-service.
+func (g *Gateway) EmailOutbox(ctx context.Context, req *authmodel.EmailOutboxRequest) ([]*authmodel.OutboxEmail, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.EmailOutbox(ctx, &gen.EmailOutboxRequest{Status: req.Status, Limit: req.Limit})
+	if err != nil {
+		return nil, err
+	}
+
+	return authmodel.OutboxEmailsFromProto(resp.Emails), nil
+}
+
+// ResendEmail sends a pending or dead outbox email again via the auth-service.
+// It returns gateway.ErrNotFound for an unknown email and gateway.ErrConflict if it was already sent.
+func (g *Gateway) ResendEmail(ctx context.Context, req *authmodel.ResendEmailRequest) (*authmodel.OutboxEmail, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.ResendEmail(ctx, &gen.ResendEmailRequest{EmailId: req.EmailId})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return nil, fmt.Errorf("%w: %s", gateway.ErrNotFound, status.Convert(err).Message())
+	case codes.FailedPrecondition:
+		return nil, fmt.Errorf("%w: %s", gateway.ErrConflict, status.Convert(err).Message())
+	default:
+		return nil, err
+	}
+
+	return authmodel.OutboxEmailFromProto(resp.Email), nil
+}
+
+// OAuthLogin starts a login with an OpenID Connect provider via the auth-service.
+// It returns gateway.ErrNotFound if the provider is not configured.
+func (g *Gateway) OAuthLogin(ctx context.Context, req *authmodel.OAuthLoginRequest) (*authmodel.OAuthLoginResult, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.OAuthLogin(ctx, &gen.OAuthLoginRequest{Provider: req.Provider, RememberMe: req.RememberMe})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return nil, fmt.Errorf("%w: %s", gateway.ErrNotFound, status.Convert(err).Message())
+	default:
+		return nil, err
+	}
+
+	return authmodel.OAuthLoginResultFromProto(resp), nil
+}
+
+// OAuthCallback completes a login with an OpenID Connect provider via the auth-service.
+// It returns gateway.ErrNotFound if the provider is not configured and gateway.ErrUnauthorized
+// if the login state, the code or the ID token is invalid.
+func (g *Gateway) OAuthCallback(ctx context.Context, req *authmodel.OAuthCallbackRequest) (*authmodel.AuthenticateResult, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.OAuthCallback(ctx, authmodel.OAuthCallbackRequestToProto(req))
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return nil, fmt.Errorf("%w: %s", gateway.ErrNotFound, status.Convert(err).Message())
+	case codes.Unauthenticated:
+		return nil, fmt.Errorf("%w: %s", gateway.ErrUnauthorized, status.Convert(err).Message())
+	default:
+		return nil, err
+	}
+
+	return authmodel.AuthenticateResultFromProto(resp), nil
+}
+
+// twoFactorError converts two-factor errors of the auth-service to gateway errors.
+func twoFactorError(err error) error {
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.Unauthenticated:
+		return fmt.Errorf("%w: %s", gateway.ErrUnauthorized, status.Convert(err).Message())
+	case codes.ResourceExhausted:
+		return fmt.Errorf("%w: %s", gateway.ErrTooManyRequests, status.Convert(err).Message())
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", gateway.ErrConflict, status.Convert(err).Message())
+	default:
+		return err
+	}
+}
+
+// TwoFactorLogin completes a login challenge with a TOTP code or a recovery code via the auth-service.
+// It returns gateway.ErrUnauthorized for invalid challenges and codes, gateway.ErrTooManyRequests
+// while the authenticator is locked and gateway.ErrConflict if no authenticator is enrolled.
+func (g *Gateway) TwoFactorLogin(ctx context.Context, req *authmodel.TwoFactorLoginRequest) (*authmodel.AuthenticateResult, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.TwoFactorLogin(ctx, authmodel.TwoFactorLoginRequestToProto(req))
+	if err := twoFactorError(err); err != nil {
+		return nil, err
+	}
+
+	return authmodel.AuthenticateResultFromProto(resp), nil
+}
+
+// TOTPEnroll enrolls an authenticator via the auth-service.
+// It returns gateway.ErrUnauthorized for invalid challenges and gateway.ErrConflict if an authenticator is enabled.
+func (g *Gateway) TOTPEnroll(ctx context.Context, req *authmodel.TOTPEnrollRequest) (*authmodel.TOTPEnrollment, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.TOTPEnroll(ctx, &gen.TOTPEnrollRequest{UserId: req.UserId, ChallengeToken: req.ChallengeToken})
+	if err := twoFactorError(err); err != nil {
+		return nil, err
+	}
+
+	return &authmodel.TOTPEnrollment{Secret: resp.Secret, Uri: resp.Uri}, nil
+}
+
+// TOTPActivate enables an enrolled authenticator via the auth-service and returns the recovery codes.
+func (g *Gateway) TOTPActivate(ctx context.Context, req *authmodel.TOTPCodeRequest) ([]string, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.TOTPActivate(ctx, &gen.TOTPCodeRequest{UserId: req.UserId, Code: req.Code})
+	if err := twoFactorError(err); err != nil {
+		return nil, err
+	}
+
+	return resp.Codes, nil
+}
+
+// TOTPDisable disables two-factor authentication via the auth-service.
+func (g *Gateway) TOTPDisable(ctx context.Context, req *authmodel.TOTPCodeRequest) error {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	_, err = client.TOTPDisable(ctx, &gen.TOTPCodeRequest{UserId: req.UserId, Code: req.Code})
+
+	return twoFactorError(err)
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes via the auth-service and returns the new ones.
+func (g *Gateway) RegenerateRecoveryCodes(ctx context.Context, req *authmodel.TOTPCodeRequest) ([]string, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.RegenerateRecoveryCodes(ctx, &gen.TOTPCodeRequest{UserId: req.UserId, Code: req.Code})
+	if err := twoFactorError(err); err != nil {
+		return nil, err
+	}
+
+	return resp.Codes, nil
+}
+
+// roleChangeError converts role change errors of the auth-service to gateway errors.
+func roleChangeError(err error) error {
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.InvalidArgument:
+		return fmt.Errorf("%w: %s", gateway.ErrInvalidArgument, status.Convert(err).Message())
+	case codes.PermissionDenied:
+		return fmt.Errorf("%w: %s", gateway.ErrForbidden, status.Convert(err).Message())
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", gateway.ErrNotFound, status.Convert(err).Message())
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", gateway.ErrConflict, status.Convert(err).Message())
+	default:
+		return err
+	}
+}
+
+// GrantRole grants a role to a user via the auth-service. It returns gateway.ErrInvalidArgument
+// for unknown roles, gateway.ErrForbidden if the actor may not manage roles or changes own roles,
+// gateway.ErrNotFound for an unknown user and gateway.ErrConflict if the user has the role.
+func (g *Gateway) GrantRole(ctx context.Context, req *authmodel.RoleChangeRequest) (*authmodel.RoleChange, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.GrantRole(ctx, authmodel.RoleChangeRequestToProto(req))
+	if err := roleChangeError(err); err != nil {
+		return nil, err
+	}
+
+	return authmodel.RoleChangeFromProto(resp.Change), nil
+}
+
+// RevokeRole revokes a role of a user via the auth-service. It returns the same errors as GrantRole,
+// gateway.ErrConflict if the user does not have the role.
+func (g *Gateway) RevokeRole(ctx context.Context, req *authmodel.RoleChangeRequest) (*authmodel.RoleChange, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.RevokeRole(ctx, authmodel.RoleChangeRequestToProto(req))
+	if err := roleChangeError(err); err != nil {
+		return nil, err
+	}
+
+	return authmodel.RoleChangeFromProto(resp.Change), nil
+}
+
+// RoleAudit requests role changes from the auth-service, the newest first.
+func (g *Gateway) RoleAudit(ctx context.Context, req *authmodel.RoleAuditRequest) ([]*authmodel.RoleChange, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.RoleAudit(ctx, &gen.RoleAuditRequest{UserId: req.UserId, Limit: req.Limit})
+	if err != nil {
+		return nil, err
+	}
+
+	return authmodel.RoleChangesFromProto(resp.Changes), nil
+}
+
+// accountError converts profile, password and email change errors of the auth-service to gateway errors.
+func accountError(err error) error {
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.InvalidArgument:
+		return fmt.Errorf("%w: %s", gateway.ErrInvalidArgument, status.Convert(err).Message())
+	case codes.PermissionDenied:
+		return fmt.Errorf("%w: %s", gateway.ErrForbidden, status.Convert(err).Message())
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", gateway.ErrNotFound, status.Convert(err).Message())
+	case codes.AlreadyExists:
+		return fmt.Errorf("%w: %s", gateway.ErrConflict, status.Convert(err).Message())
+	default:
+		return err
+	}
+}
+
+// UpdateProfile changes the display name of a user via the auth-service. It returns gateway.ErrInvalidArgument
+// for empty or too long names and gateway.ErrConflict if another user has the name.
+func (g *Gateway) UpdateProfile(ctx context.Context, req *authmodel.UserRequest) (*authmodel.User, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.UpdateProfile(ctx, &gen.ProfileRequest{UserId: req.UserId, Name: req.Name})
+	if err := accountError(err); err != nil {
+		return nil, err
+	}
+
+	return authmodel.UserFromProto(resp), nil
+}
+
+// ChangePassword changes the password of a user via the auth-service, other sessions of the user are logged out.
+// It returns gateway.ErrForbidden if the old password is wrong and gateway.ErrInvalidArgument for a short new one.
+func (g *Gateway) ChangePassword(ctx context.Context, req *authmodel.ChangePasswordRequest) error {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	_, err = client.ChangePassword(ctx, &gen.ChangePasswordRequest{
+		UserId:      req.UserId,
+		SessionId:   req.SessionId,
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+	})
+
+	return accountError(err)
+}
+
+// ChangeEmail requests a confirmation of an email change sent to the new address via the auth-service.
+// It returns gateway.ErrForbidden if the password is wrong, gateway.ErrInvalidArgument for invalid addresses
+// and gateway.ErrConflict if another user has the address.
+func (g *Gateway) ChangeEmail(ctx context.Context, req *authmodel.ChangeEmailRequest) (*authmodel.ChangeEmailResult, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.ChangeEmail(ctx, &gen.ChangeEmailRequest{
+		UserId:   req.UserId,
+		Email:    req.Email,
+		Password: req.Password,
+		Locale:   req.Locale,
+	})
+	if err := accountError(err); err != nil {
+		return nil, err
+	}
+
+	return &authmodel.ChangeEmailResult{Email: resp.Email, ExpiresAt: resp.ExpiresAt}, nil
+}
+
+// ConfirmEmailChange swaps the email of a user with a confirmation token via the auth-service.
+// It returns gateway.ErrNotFound for unknown or expired tokens and gateway.ErrConflict
+// if another user took the address in the meantime.
+func (g *Gateway) ConfirmEmailChange(ctx context.Context, req *authmodel.ConfirmEmailChangeRequest) (*authmodel.EmailChange, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.ConfirmEmailChange(ctx, &gen.ConfirmEmailChangeRequest{Token: req.Token})
+	if err := accountError(err); err != nil {
+		return nil, err
+	}
+
+	return &authmodel.EmailChange{UserId: resp.UserId, Email: resp.Email}, nil
+}
+
+// accountDeletionError converts account export and deletion errors of the auth-service to gateway errors.
+func accountDeletionError(err error) error {
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.PermissionDenied:
+		return fmt.Errorf("%w: %s", gateway.ErrForbidden, status.Convert(err).Message())
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", gateway.ErrNotFound, status.Convert(err).Message())
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", gateway.ErrConflict, status.Convert(err).Message())
+	default:
+		return err
+	}
+}
+
+// ExportAccount requests the personal data kept about a user from the auth-service.
+// It returns gateway.ErrNotFound for an unknown user.
+func (g *Gateway) ExportAccount(ctx context.Context, userId int32) (*authmodel.AccountExport, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.ExportAccount(ctx, &gen.AccountRequest{UserId: userId})
+	if err := accountDeletionError(err); err != nil {
+		return nil, err
+	}
+
+	return authmodel.AccountExportFromProto(resp), nil
+}
+
+// DeleteAccount schedules the deletion of a user's account via the auth-service. It returns
+// gateway.ErrForbidden if the password is wrong and gateway.ErrNotFound for an unknown user.
+func (g *Gateway) DeleteAccount(ctx context.Context, req *authmodel.DeleteAccountRequest) (*authmodel.AccountDeletion, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.DeleteAccount(ctx, &gen.DeleteAccountRequest{UserId: req.UserId, Password: req.Password})
+	if err := accountDeletionError(err); err != nil {
+		return nil, err
+	}
+
+	return &authmodel.AccountDeletion{DeleteAfter: resp.DeleteAfter}, nil
+}
+
+// CancelAccountDeletion keeps an account whose deletion is scheduled via the auth-service.
+// It returns gateway.ErrConflict if no deletion is scheduled.
+func (g *Gateway) CancelAccountDeletion(ctx context.Context, userId int32) error {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	_, err = client.CancelAccountDeletion(ctx, &gen.AccountRequest{UserId: userId})
+
+	return accountDeletionError(err)
+}
+
+// DueAccountDeletions claims up to limit users whose accounts are to be anonymized from the auth-service.
+func (g *Gateway) DueAccountDeletions(ctx context.Context, limit int32) ([]int32, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	resp, err := client.DueAccountDeletions(ctx, &gen.DueAccountDeletionsRequest{Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.UserIds, nil
+}
+
+// AnonymizeAccount deletes the credentials and identifying data of a user whose deletion is due
+// via the auth-service. It returns gateway.ErrConflict if the deletion is not due.
+func (g *Gateway) AnonymizeAccount(ctx context.Context, userId int32) error {
+	conn, err := grpcutil.ServiceConnection(ctx, "auth-service", g.registry)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gen.NewAuthServiceClient(conn)
+
+	_, err = client.AnonymizeAccount(ctx, &gen.AccountRequest{UserId: userId})
+
+	return accountDeletionError(err)
+}
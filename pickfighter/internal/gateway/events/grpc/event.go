@@ -2,10 +2,14 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	eventmodel "pickfighter.com/events/pkg/model"
 	"pickfighter.com/gen"
 	"pickfighter.com/internal/grpcutil"
+	"pickfighter.com/pickfighter/internal/gateway"
 	"pickfighter.com/pkg/discovery"
 )
 
@@ -114,3 +118,83 @@ func (g *Gateway) SetResult(ctx context.Context, req *eventmodel.FightResultRequ
 
 	return resp.FightId, nil
 }
+
+// ResultReviews requests scraped results queued for an admin approval from the Event service.
+func (g *Gateway) ResultReviews(ctx context.Context, req *eventmodel.ResultReviewsRequest) ([]*eventmodel.ResultReview, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "event-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewEventServiceClient(conn)
+
+	resp, err := client.ResultReviews(ctx, &gen.ResultReviewsRequest{Status: req.Status, Limit: req.Limit})
+	if err != nil {
+		return nil, err
+	}
+
+	return eventmodel.ResultReviewsFromProto(resp.Reviews), nil
+}
+
+// ReviewResult approves or rejects a queued scraped result in the Event service.
+// It returns gateway.ErrNotFound for an unknown review and gateway.ErrConflict if it was already reviewed.
+func (g *Gateway) ReviewResult(ctx context.Context, req *eventmodel.ReviewResultRequest) (*eventmodel.ResultReview, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "event-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewEventServiceClient(conn)
+
+	resp, err := client.ReviewResult(ctx, &gen.ReviewResultRequest{ReviewId: req.ReviewId, Approve: req.Approve})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return nil, fmt.Errorf("%w: %s", gateway.ErrNotFound, status.Convert(err).Message())
+	case codes.FailedPrecondition:
+		return nil, fmt.Errorf("%w: %s", gateway.ErrConflict, status.Convert(err).Message())
+	default:
+		return nil, err
+	}
+
+	return eventmodel.ResultReviewFromProto(resp.Review), nil
+}
+
+// ExportBets requests all bets of a user from the event-service.
+func (g *Gateway) ExportBets(ctx context.Context, userId int32) ([]*eventmodel.Bet, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "event-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewEventServiceClient(conn)
+
+	resp, err := client.ExportBets(ctx, &gen.BetsRequest{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+
+	return eventmodel.BetsFromProto(resp.Bets), nil
+}
+
+// AnonymizeBets moves the bets of a deleted user to a pseudonym via the event-service
+// and returns the number of moved bets.
+func (g *Gateway) AnonymizeBets(ctx context.Context, userId int32) (int32, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "event-service", g.registry)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	client := gen.NewEventServiceClient(conn)
+
+	resp, err := client.AnonymizeBets(ctx, &gen.BetsRequest{UserId: userId})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Bets, nil
+}
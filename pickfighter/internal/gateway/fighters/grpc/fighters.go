@@ -2,10 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	fightersmodel "pickfighter.com/fighters/pkg/model"
 	"pickfighter.com/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"pickfighter.com/internal/grpcutil"
+	"pickfighter.com/pickfighter/internal/gateway"
 	"pickfighter.com/pkg/discovery"
 )
 
@@ -41,3 +46,79 @@ func (g *Gateway) SearchFighters(ctx context.Context, req fightersmodel.Fighters
 
 	return fighters, nil
 }
+
+// SearchFightersByText searches fighters by name, nickname, hometown or gym in the Fighters service.
+// Fighters are returned in the order of relevance.
+func (g *Gateway) SearchFightersByText(ctx context.Context, req *fightersmodel.FightersTextSearchRequest) ([]*fightersmodel.Fighter, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "fighters-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewFightersServiceClient(conn)
+
+	resp, err := client.SearchFightersByText(ctx, fightersmodel.FightersTextSearchReqToProto(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return fightersmodel.FightersFromProto(resp.Fighters), nil
+}
+
+// ExportFighters streams fighters matching the request from the Fighters service.
+// Every received fighter is passed to fn as soon as it arrives, so the caller can write it
+// to the output without waiting for the whole list. Streaming stops on the first error returned by fn.
+func (g *Gateway) ExportFighters(ctx context.Context, req fightersmodel.FightersRequest, fn func(*fightersmodel.Fighter) error) error {
+	conn, err := grpcutil.ServiceConnection(ctx, "fighters-service", g.registry)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gen.NewFightersServiceClient(conn)
+
+	stream, err := client.ExportFighters(ctx, fightersmodel.FightersReqToProto(req))
+	if err != nil {
+		return err
+	}
+
+	for {
+		f, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(fightersmodel.FighterFromProto(f)); err != nil {
+			return err
+		}
+	}
+}
+
+// FighterImage requests a stored fighter thumbnail from the Fighters service.
+// It returns gateway.ErrNotFound when the thumbnail does not exist.
+func (g *Gateway) FighterImage(ctx context.Context, req *fightersmodel.FighterImageRequest) (*fightersmodel.FighterImage, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "fighters-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := gen.NewFightersServiceClient(conn)
+
+	resp, err := client.FighterImage(ctx, &gen.FighterImageRequest{
+		FighterId: req.FighterId,
+		Size:      req.Size,
+		Format:    req.Format,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, gateway.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return fightersmodel.FighterImageFromProto(resp), nil
+}
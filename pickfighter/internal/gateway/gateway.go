@@ -0,0 +1,18 @@
+package gateway
+
+import "errors"
+
+var (
+	// ErrNotFound is returned when the requested data is not found by the service behind the gateway.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when the service behind the gateway refuses the request in the current state of the data.
+	ErrConflict = errors.New("conflict")
+	// ErrUnauthorized is returned when the service behind the gateway rejects the credentials of the request.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is returned when the service behind the gateway denies the request to its actor.
+	ErrForbidden = errors.New("forbidden")
+	// ErrInvalidArgument is returned when the service behind the gateway rejects the arguments of the request.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrTooManyRequests is returned when the service behind the gateway refuses the request after too many attempts.
+	ErrTooManyRequests = errors.New("too many requests")
+)
@@ -2,34 +2,49 @@ package http
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"github.com/spf13/viper"
 	authmodel "pickfighter.com/auth/pkg/model"
 	eventmodel "pickfighter.com/events/pkg/model"
+	"pickfighter.com/fighters/pkg/export"
+	"pickfighter.com/fighters/pkg/images"
 	fightersmodel "pickfighter.com/fighters/pkg/model"
+	"pickfighter.com/pickfighter/internal/gateway"
+	"pickfighter.com/pkg/domain"
 	"pickfighter.com/pkg/httplib"
+	logs "pickfighter.com/pkg/logger"
 	"pickfighter.com/pkg/model"
-	"pickfighter.com/pkg/utils"
 
 	internalErr "pickfighter.com/pickfighter/pkg/errors"
 )
 
 // * * * * * Fighters Handlers * * * * *
 
-// GetFighters handles HTTP requests to retrieve fighters based on status.
+// GetFighters handles HTTP requests to retrieve fighters based on status and division.
 func (h *Handler) GetFighters(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	status := utils.Capitalize(r.FormValue("status"))
+	req, code, err := fightersRequest(r)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, code, err)
+		return
+	}
 
-	fighters, err := h.ctrl.SearchFighters(ctx, fightersmodel.FightersRequest{Status: status})
+	fighters, err := h.ctrl.SearchFighters(ctx, req)
 	if err != nil {
 		log.Printf("Repository get error: %v\n", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -42,6 +57,245 @@ func (h *Handler) GetFighters(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// fighterSearchMaxAge is the cache lifetime of search results. A typeahead repeats the same
+// queries while the user edits the input, the results change only after the next roster update.
+const fighterSearchMaxAge = time.Minute
+
+// SearchFighters handles typeahead searches of fighters by name, nickname, hometown or gym,
+// e.g. /fighters/search?q=khabib&limit=5. The query must be 2 to 64 characters long, the limit
+// defaults to 10 and is capped at 50. The status and division filters of GetFighters are supported.
+func (h *Handler) SearchFighters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filters, code, err := fightersRequest(r)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, code, err)
+		return
+	}
+
+	req := &fightersmodel.FightersTextSearchRequest{
+		Query:    r.FormValue("q"),
+		Status:   filters.Status,
+		Division: filters.Division,
+	}
+
+	if l := r.FormValue("limit"); l != "" {
+		limit, err := strconv.ParseInt(l, 10, 32)
+		if err != nil {
+			httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.QueryParamsLimit, err)
+			return
+		}
+		req.Limit = int32(limit)
+	}
+
+	if err := req.Normalize(); err != nil {
+		code := internalErr.QueryParamsSearch
+		if errors.Is(err, fightersmodel.ErrTextSearchLimit) {
+			code = internalErr.QueryParamsLimit
+		}
+
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, code, err)
+		return
+	}
+
+	fighters, err := h.ctrl.SearchFightersByText(ctx, req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.Fighters, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(fighterSearchMaxAge.Seconds())))
+
+	httplib.ResponseJSON(w, httplib.ListResult{
+		Results: fighters,
+		Count:   int32(len(fighters)),
+	})
+}
+
+// ExportFighters handles HTTP requests to export fighters as json, ndjson or csv.
+// It accepts the same filters as GetFighters and streams fighters to the response
+// as they are received from the fighters service.
+func (h *Handler) ExportFighters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	format, err := export.ParseFormat(r.FormValue("format"))
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.QueryParamsFormat, err)
+		return
+	}
+
+	req, code, err := fightersRequest(r)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, code, err)
+		return
+	}
+
+	var ew export.Writer
+	err = h.ctrl.ExportFighters(ctx, req, func(f *fightersmodel.Fighter) error {
+		if ew == nil {
+			ew = startFightersExport(w, format)
+		}
+
+		return ew.Write(f)
+	})
+	if err != nil {
+		if ew == nil {
+			httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.FightersExport, err)
+			return
+		}
+
+		// headers are already sent, the only option is to cut the stream
+		logs.Errorf("Failed to export fighters: %s", err)
+		return
+	}
+
+	if ew == nil {
+		ew = startFightersExport(w, format)
+	}
+
+	if err := ew.Close(); err != nil {
+		logs.Errorf("Failed to finish fighters export: %s", err)
+	}
+}
+
+// fightersRequest builds a FightersRequest from the 'status' and 'division' query parameters.
+// Both are optional, unknown values are rejected with the internal error code of the parameter.
+// A division may be given by its id or by its name, e.g. "light heavyweight".
+func fightersRequest(r *http.Request) (fightersmodel.FightersRequest, int, error) {
+	var req fightersmodel.FightersRequest
+
+	if s := r.FormValue("status"); s != "" {
+		status, err := domain.ParseStatus(s)
+		if err != nil {
+			return req, internalErr.QueryParamsStatus, err
+		}
+		req.Status = status
+	}
+
+	if d := r.FormValue("division"); d != "" {
+		division, err := domain.ParseDivision(d)
+		if err != nil {
+			return req, internalErr.QueryParamsDivision, err
+		}
+		req.Division = &division
+	}
+
+	return req, 0, nil
+}
+
+// startFightersExport writes export response headers and returns a writer for the response body.
+func startFightersExport(w http.ResponseWriter, format export.Format) export.Writer {
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="fighters.%s"`, format))
+	w.WriteHeader(http.StatusOK)
+
+	// NewWriter fails only for unknown formats, the format is already validated
+	ew, _ := export.NewWriter(w, format)
+
+	return ew
+}
+
+// Cache lifetimes of fighter images. Placeholders are cached briefly, so a thumbnail
+// generated by the next update replaces them quickly.
+const (
+	fighterImageMaxAge = 24 * time.Hour
+	placeholderMaxAge  = 5 * time.Minute
+)
+
+// placeholders keeps encoded placeholder images by size and format.
+var placeholders sync.Map
+
+// GetFighterImage serves fighter thumbnails at stable URLs like /fighters/42/image/medium.webp.
+// Responses carry an ETag and Cache-Control headers, a placeholder is served if the thumbnail is missing.
+func (h *Handler) GetFighterImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	id, err := strconv.ParseInt(vars["id"], 10, 32)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.FighterImage, err)
+		return
+	}
+
+	size, err := images.ParseSize(vars["size"])
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.FighterImage, err)
+		return
+	}
+
+	format, err := images.ParseFormat(vars["format"])
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.FighterImage, err)
+		return
+	}
+
+	img, err := h.ctrl.FighterImage(ctx, &fightersmodel.FighterImageRequest{
+		FighterId: int32(id),
+		Size:      string(size),
+		Format:    string(format),
+	})
+	if errors.Is(err, gateway.ErrNotFound) {
+		img, err = placeholderImage(size, format)
+		if err != nil {
+			httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.FighterImage, err)
+			return
+		}
+
+		writeImage(w, r, img, placeholderMaxAge)
+		return
+	} else if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.FighterImage, err)
+		return
+	}
+
+	writeImage(w, r, img, fighterImageMaxAge)
+}
+
+// writeImage writes the image with caching headers or responds with 304 if the client has the same version.
+func writeImage(w http.ResponseWriter, r *http.Request, img *fightersmodel.FighterImage, maxAge time.Duration) {
+	etag := fmt.Sprintf(`"%s"`, img.ETag)
+
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds())))
+	w.Header().Set("ETag", etag)
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", img.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(img.Data)))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(img.Data); err != nil {
+		logs.Errorf("Failed to write fighter image: %s", err)
+	}
+}
+
+// placeholderImage returns the encoded placeholder of the given size and format.
+func placeholderImage(size images.Size, format images.Format) (*fightersmodel.FighterImage, error) {
+	key := string(size) + "." + string(format)
+	if img, ok := placeholders.Load(key); ok {
+		return img.(*fightersmodel.FighterImage), nil
+	}
+
+	data, err := images.EncodeBytes(images.Placeholder(size.Pixels()), format)
+	if err != nil {
+		return nil, err
+	}
+
+	img := &fightersmodel.FighterImage{
+		Size:        string(size),
+		Format:      string(format),
+		ContentType: format.ContentType(),
+		ETag:        "placeholder-" + key,
+		Data:        data,
+	}
+	placeholders.Store(key, img)
+
+	return img, nil
+}
+
 // * * * * * Auth Handlers * * * * *
 
 // Register handles the registration of a new user.
@@ -65,6 +319,7 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 		)
 		return
 	}
+	req.Locale = requestLocale(r, req.Locale)
 
 	credentials, err := h.ctrl.Register(ctx, &req)
 	if err != nil {
@@ -109,6 +364,7 @@ func (h *Handler) ConfirmRegistration(w http.ResponseWriter, r *http.Request) {
 // Login handles the user login process, authenticating the user based on the provided credentials.
 // It validates the email or username and password, checks user activation status,
 // generates a JWT token for the authenticated user, and sets an authentication cookie.
+// Users with two-factor authentication get a challenge to complete with TwoFactorLogin instead.
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
 	defer cancel()
@@ -157,41 +413,555 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	authCookieName := viper.GetString("auth.cookie_name")
+	if token.Challenge != nil {
+		setChallengeCookie(w, r, token.Challenge)
+		httplib.ResponseJSON(w, challengeResult(token.Challenge))
+		return
+	}
+
+	setAuthCookies(w, token)
+	httplib.ResponseJSON(w, tokenResult(token))
+}
+
+// refreshCookiePath limits the refresh token cookie to the token endpoints.
+const refreshCookiePath = "/token"
+
+// RefreshToken handles the exchange of a refresh token for new access and refresh tokens.
+// The refresh token is read from the JSON body or, if it is not there, from the refresh cookie set at login.
+// Both cookies are replaced on success and cleared when the refresh token is rejected,
+// a reused refresh token revokes all tokens issued after the same login.
+func (h *Handler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	var req authmodel.RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
+
+	if req.RefreshToken == "" {
+		if cookie, err := r.Cookie(viper.GetString("auth.refresh_cookie_name")); err == nil {
+			req.RefreshToken = cookie.Value
+		}
+	}
+
+	if req.RefreshToken == "" {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.TokenEmpty,
+			fmt.Errorf("%s", "Empty 'refresh_token'"))
+		return
+	}
+
+	req.UserAgent = r.UserAgent()
+
+	token, err := h.ctrl.RefreshToken(ctx, &req)
+	if errors.Is(err, gateway.ErrUnauthorized) {
+		clearAuthCookies(w)
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, internalErr.TokenRefresh, err)
+		return
+	} else if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.TokenRefresh, err)
+		return
+	}
+
+	setAuthCookies(w, token)
+	httplib.ResponseJSON(w, tokenResult(token))
+}
+
+// tokenResult returns the response of a login or a token refresh.
+func tokenResult(token *authmodel.AuthenticateResult) map[string]any {
+	result := httplib.SuccessfulResultMap()
+	result["token_id"] = token.TokenId
+	result["access_token"] = token.AccessToken
+	result["expires_at"] = token.ExpirationTime
+	result["refresh_token"] = token.RefreshToken
+	result["refresh_expires_at"] = token.RefreshExpirationTime
+
+	return result
+}
+
+// setAuthCookies sets the access token cookie and the refresh token cookie, which is sent
+// only to the token endpoints and is not readable by scripts.
+func setAuthCookies(w http.ResponseWriter, token *authmodel.AuthenticateResult) {
+	http.SetCookie(w, &http.Cookie{
+		Name:    viper.GetString("auth.cookie_name"),
+		Value:   token.AccessToken,
+		Expires: token.ExpirationTime,
+		Path:    "/",
+	})
+
+	if token.RefreshToken != "" {
+		http.SetCookie(w, &http.Cookie{
+			Name:     viper.GetString("auth.refresh_cookie_name"),
+			Value:    token.RefreshToken,
+			Expires:  token.RefreshExpirationTime,
+			Path:     refreshCookiePath,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+		})
+	}
+}
+
+// clearAuthCookies expires the access token and refresh token cookies.
+func clearAuthCookies(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:    viper.GetString("auth.cookie_name"),
+		Value:   "",
+		Expires: time.Now().Add(1 * time.Second),
+		Path:    "/",
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     viper.GetString("auth.refresh_cookie_name"),
+		Value:    "",
+		Expires:  time.Now().Add(1 * time.Second),
+		Path:     refreshCookiePath,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// maxLocaleLength is the length of the longest language tag kept as an email locale.
+const maxLocaleLength = 35
+
+// requestLocale returns the locale of emails sent for the request, the locale of the body
+// if set, otherwise the first language of the Accept-Language header, e.g. "es-MX" for "es-MX,es;q=0.9".
+// The auth service falls back to the default locale for languages it has no templates for.
+func requestLocale(r *http.Request, locale string) string {
+	if locale = strings.TrimSpace(locale); locale == "" {
+		locale, _, _ = strings.Cut(r.Header.Get("Accept-Language"), ",")
+		locale, _, _ = strings.Cut(locale, ";")
+		locale = strings.TrimSpace(locale)
+	}
+
+	// a wildcard or an overlong tag is no locale
+	if locale == "*" || len(locale) > maxLocaleLength {
+		return ""
+	}
+
+	return locale
+}
+
+// oauthCookiePath limits the OAuth state cookie to the provider login endpoints.
+const oauthCookiePath = "/oauth"
+
+// OAuthLogin starts a login with an OpenID Connect provider and redirects to the provider's login page.
+// The state of the login is kept in a cookie to check that the callback comes back to the same browser.
+// The remember_me query parameter asks for a longer-lived refresh token.
+func (h *Handler) OAuthLogin(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	rememberMe, _ := strconv.ParseBool(r.URL.Query().Get("remember_me"))
+
+	res, err := h.ctrl.OAuthLogin(ctx, &authmodel.OAuthLoginRequest{
+		Provider:   mux.Vars(r)["provider"],
+		RememberMe: rememberMe,
+	})
+	if errors.Is(err, gateway.ErrNotFound) {
+		httplib.ErrorResponseJSON(w, http.StatusNotFound, internalErr.OAuthLogin, err)
+		return
+	} else if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.OAuthLogin, err)
+		return
+	}
+
+	// Lax, the callback is a top-level navigation from the provider's site
+	http.SetCookie(w, &http.Cookie{
+		Name:     viper.GetString("auth.oidc.state_cookie_name"),
+		Value:    res.State,
+		Expires:  time.Unix(res.ExpiresAt, 0),
+		Path:     oauthCookiePath,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, res.AuthUrl, http.StatusFound)
+}
+
+// OAuthCallback completes a login with an OpenID Connect provider. The state must match the state cookie
+// set by OAuthLogin. Like Login it sets the auth cookies or the two-factor challenge cookie, then redirects
+// to `auth.oidc.login_redirect` or responds with the tokens or the challenge if it is not configured.
+// The redirect of a challenge has the two_factor query parameter set to "required" or "enroll".
+func (h *Handler) OAuthCallback(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	query := r.URL.Query()
+	state := query.Get("state")
+
+	cookieName := viper.GetString("auth.oidc.state_cookie_name")
+	cookie, err := r.Cookie(cookieName)
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieName,
+		Value:    "",
+		MaxAge:   -1,
+		Path:     oauthCookiePath,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	if providerErr := query.Get("error"); providerErr != "" {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, internalErr.OAuthCallback,
+			fmt.Errorf("provider login failed: %s %s", providerErr, query.Get("error_description")))
+		return
+	}
+
+	if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, internalErr.OAuthCallback,
+			fmt.Errorf("%s", "OAuth state does not match"))
+		return
+	}
+
+	if query.Get("code") == "" {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.OAuthCallback,
+			fmt.Errorf("%s", "Empty 'code'"))
+		return
+	}
+
+	token, err := h.ctrl.OAuthCallback(ctx, &authmodel.OAuthCallbackRequest{
+		Provider:  mux.Vars(r)["provider"],
+		Code:      query.Get("code"),
+		State:     state,
+		UserAgent: r.UserAgent(),
+	})
+	switch {
+	case errors.Is(err, gateway.ErrNotFound):
+		httplib.ErrorResponseJSON(w, http.StatusNotFound, internalErr.OAuthCallback, err)
+		return
+	case errors.Is(err, gateway.ErrUnauthorized):
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, internalErr.OAuthCallback, err)
+		return
+	case err != nil:
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.OAuthCallback, err)
+		return
+	}
+
+	redirect := viper.GetString("auth.oidc.login_redirect")
+
+	if token.Challenge != nil {
+		setChallengeCookie(w, r, token.Challenge)
+
+		if redirect == "" {
+			httplib.ResponseJSON(w, challengeResult(token.Challenge))
+			return
+		}
+
+		u, err := url.Parse(redirect)
+		if err != nil {
+			httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.OAuthCallback, err)
+			return
+		}
+
+		q := u.Query()
+		if token.Challenge.EnrollmentRequired {
+			q.Set("two_factor", "enroll")
+		} else {
+			q.Set("two_factor", "required")
+		}
+		u.RawQuery = q.Encode()
+
+		http.Redirect(w, r, u.String(), http.StatusFound)
+		return
+	}
+
+	setAuthCookies(w, token)
+
+	if redirect != "" {
+		http.Redirect(w, r, redirect, http.StatusFound)
+		return
+	}
+
+	httplib.ResponseJSON(w, tokenResult(token))
+}
+
+// twoFactorCookiePath limits the two-factor challenge cookie to the two-factor login endpoints.
+const twoFactorCookiePath = "/login/2fa"
+
+// challengeResult returns the response of a login waiting for the second factor.
+func challengeResult(c *authmodel.TwoFactorChallengeResult) map[string]any {
+	result := httplib.SuccessfulResultMap()
+	result["two_factor_required"] = true
+	result["challenge_token"] = c.Token
+	result["challenge_expires_at"] = c.ExpirationTime
+	result["enrollment_required"] = c.EnrollmentRequired
+
+	return result
+}
+
+// setChallengeCookie sets the cookie holding the token of a two-factor login challenge.
+func setChallengeCookie(w http.ResponseWriter, r *http.Request, c *authmodel.TwoFactorChallengeResult) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     viper.GetString("auth.totp.challenge_cookie_name"),
+		Value:    c.Token,
+		Expires:  c.ExpirationTime,
+		Path:     twoFactorCookiePath,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// clearChallengeCookie expires the two-factor challenge cookie.
+func clearChallengeCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     viper.GetString("auth.totp.challenge_cookie_name"),
+		Value:    "",
+		MaxAge:   -1,
+		Path:     twoFactorCookiePath,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// challengeToken returns the challenge token of the request, or of the challenge cookie if it is empty.
+func challengeToken(r *http.Request, token string) string {
+	if token != "" {
+		return token
+	}
+
+	if cookie, err := r.Cookie(viper.GetString("auth.totp.challenge_cookie_name")); err == nil {
+		return cookie.Value
+	}
+
+	return ""
+}
+
+// twoFactorErrorStatus returns the HTTP status of a two-factor error of the auth gateway.
+func twoFactorErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, gateway.ErrUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, gateway.ErrTooManyRequests):
+		return http.StatusTooManyRequests
+	case errors.Is(err, gateway.ErrConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// TwoFactorLogin completes a login challenge with a TOTP code or a recovery code. The challenge token
+// is read from the JSON body or from the challenge cookie set at login. On success it sets the auth cookies
+// like Login, the response holds the recovery codes when the login enabled two-factor authentication.
+func (h *Handler) TwoFactorLogin(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	var req authmodel.TwoFactorLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
+
+	req.ChallengeToken = challengeToken(r, req.ChallengeToken)
+	if req.ChallengeToken == "" {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.TwoFactorLogin,
+			fmt.Errorf("%s", "Empty 'challenge_token'"))
+		return
+	}
+
+	if req.Code == "" {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.TwoFactorVerify,
+			fmt.Errorf("%s", "Empty 'code'"))
+		return
+	}
+
+	req.UserAgent = r.UserAgent()
+
+	token, err := h.ctrl.TwoFactorLogin(ctx, &req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, twoFactorErrorStatus(err), internalErr.TwoFactorLogin, err)
+		return
+	}
+
+	clearChallengeCookie(w)
+	setAuthCookies(w, token)
+
+	result := tokenResult(token)
+	if len(token.RecoveryCodes) > 0 {
+		result["recovery_codes"] = token.RecoveryCodes
+	}
+
+	httplib.ResponseJSON(w, result)
+}
+
+// TwoFactorEnroll enrolls an authenticator, either for the logged in user or for the user of a login
+// challenge requiring enrollment, and responds with the secret and its otpauth:// URI for QR codes.
+// The authenticator is enabled by a code, with TwoFactorActivate or TwoFactorLogin respectively.
+func (h *Handler) TwoFactorEnroll(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	var req authmodel.TOTPEnrollRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
+
+	req.UserId, _ = ctx.Value(model.ContextUserId).(int32)
+	if req.UserId == 0 {
+		req.ChallengeToken = challengeToken(r, req.ChallengeToken)
+		if req.ChallengeToken == "" {
+			httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.TwoFactorEnroll,
+				fmt.Errorf("%s", "Empty 'challenge_token'"))
+			return
+		}
+	} else {
+		req.ChallengeToken = ""
+	}
+
+	enrollment, err := h.ctrl.TOTPEnroll(ctx, &req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, twoFactorErrorStatus(err), internalErr.TwoFactorEnroll, err)
+		return
+	}
+
+	result := httplib.SuccessfulResultMap()
+	result["secret"] = enrollment.Secret
+	result["uri"] = enrollment.Uri
+
+	httplib.ResponseJSON(w, result)
+}
+
+// twoFactorCodeRequest decodes the code of a two-factor request of the logged in user.
+// It writes the error response and returns false if the request is invalid.
+func twoFactorCodeRequest(w http.ResponseWriter, r *http.Request) (*authmodel.TOTPCodeRequest, bool) {
+	var req authmodel.TOTPCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return nil, false
+	}
+
+	userId, ok := r.Context().Value(model.ContextUserId).(int32)
+	if !ok || userId == 0 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+			fmt.Errorf("illegal token, user id must be specified"))
+		return nil, false
+	}
+	req.UserId = userId
+
+	if req.Code == "" {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.TwoFactorVerify,
+			fmt.Errorf("%s", "Empty 'code'"))
+		return nil, false
+	}
+
+	return &req, true
+}
+
+// TwoFactorActivate enables the authenticator enrolled by the logged in user with a TOTP code
+// and responds with the recovery codes, which are shown only once.
+func (h *Handler) TwoFactorActivate(w http.ResponseWriter, r *http.Request) {
+	req, ok := twoFactorCodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	recoveryCodes, err := h.ctrl.TOTPActivate(r.Context(), req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, twoFactorErrorStatus(err), internalErr.TwoFactorVerify, err)
+		return
+	}
+
+	result := httplib.SuccessfulResultMap()
+	result["recovery_codes"] = recoveryCodes
+
+	httplib.ResponseJSON(w, result)
+}
+
+// TwoFactorDisable disables two-factor authentication of the logged in user
+// with a TOTP code or a recovery code.
+func (h *Handler) TwoFactorDisable(w http.ResponseWriter, r *http.Request) {
+	req, ok := twoFactorCodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.ctrl.TOTPDisable(r.Context(), req); err != nil {
+		httplib.ErrorResponseJSON(w, twoFactorErrorStatus(err), internalErr.TwoFactor, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, httplib.SuccessfulResultMap())
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the logged in user, proven by a TOTP code
+// or a recovery code, and responds with the new ones.
+func (h *Handler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	req, ok := twoFactorCodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	recoveryCodes, err := h.ctrl.RegenerateRecoveryCodes(r.Context(), req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, twoFactorErrorStatus(err), internalErr.TwoFactor, err)
+		return
+	}
+
+	result := httplib.SuccessfulResultMap()
+	result["recovery_codes"] = recoveryCodes
+
+	httplib.ResponseJSON(w, result)
+}
+
+// Logout handles the user logout process. The access token is revoked until it expires together with
+// the refresh tokens of its session, and expired access token and refresh token cookies are set.
+func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	token, ok := ctx.Value(model.ContextJWTPointer).(jwt.Token)
+	if !ok {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, 320,
+			fmt.Errorf("unable to find request context token"))
+		return
+	}
+
+	req := authmodel.RevokeTokenRequest{
+		TokenId:   token.JwtID(),
+		ExpiresAt: token.Expiration().Unix(),
+	}
+	req.UserId, _ = ctx.Value(model.ContextUserId).(int32)
+	req.SessionId, _ = ctx.Value(model.ContextSessionId).(string)
 
-	http.SetCookie(w, &http.Cookie{
-		Name:    authCookieName,
-		Value:   token.AccessToken,
-		Expires: token.ExpirationTime,
-		Path:    "/",
-	})
+	clearAuthCookies(w)
 
-	result := httplib.SuccessfulResultMap()
-	result["token_id"] = token.TokenId
-	result["access_token"] = token.AccessToken
-	result["expires_at"] = token.ExpirationTime
-	httplib.ResponseJSON(w, result)
+	if err := h.ctrl.RevokeToken(ctx, &req); err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.TokenRevoke, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, httplib.SuccessfulResultMap())
 }
 
-// Logout handles the user logout process by setting an expired cookie.
-func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
-	// ctx := r.Context()
+// LogoutEverywhere logs the current user out on all devices: all access and refresh tokens issued
+// before the optional "before" unix time of the JSON body, or now, are revoked.
+func (h *Handler) LogoutEverywhere(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// token, ok := ctx.Value(model.ContextJWTPointer).(jwt.Token)
-	// if !ok {
-	// 	httplib.ErrorResponseJSON(w, http.StatusBadRequest, 320,
-	// 		fmt.Errorf("unable to find request context token"))
-	// 	return
-	// }
+	var req authmodel.RevokeUserTokensRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
 
-	// * * * * *
+	userId, ok := ctx.Value(model.ContextUserId).(int32)
+	if !ok || userId == 0 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+			fmt.Errorf("illegal token, user id must be specified"))
+		return
+	}
+	req.UserId = userId
 
-	http.SetCookie(w, &http.Cookie{
-		Name:    viper.GetString("auth.cookie_name"),
-		Value:   "",
-		Expires: time.Now().Add(1 * time.Second),
-		Path:    "/",
-	})
+	if err := h.ctrl.RevokeUserTokens(ctx, &req); err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.TokenRevoke, err)
+		return
+	}
+
+	clearAuthCookies(w)
 
 	httplib.ResponseJSON(w, httplib.SuccessfulResultMap())
 }
@@ -222,6 +992,7 @@ func (h *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
 	}
 
 	req.Email = strings.ToLower(req.Email)
+	req.Locale = requestLocale(r, req.Locale)
 
 	_, err := h.ctrl.ResetPassword(ctx, &req)
 	if err != nil {
@@ -314,6 +1085,229 @@ func (h *Handler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// accountErrorStatus maps gateway errors of profile, password and email changes to HTTP statuses.
+func accountErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, gateway.ErrInvalidArgument):
+		return http.StatusBadRequest
+	case errors.Is(err, gateway.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, gateway.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, gateway.ErrConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// UpdateProfile changes the display name of the logged in user, given as "name" of the JSON body,
+// and responds with the updated profile. It responds with 400 for empty or too long names
+// and 409 if another user has the name.
+func (h *Handler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req authmodel.UserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
+
+	userId, ok := ctx.Value(model.ContextUserId).(int32)
+	if !ok || userId == 0 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+			fmt.Errorf("illegal token, user id must be specified"))
+		return
+	}
+	req.UserId = userId
+
+	user, err := h.ctrl.UpdateProfile(ctx, &req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, accountErrorStatus(err), internalErr.AccountProfile, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, authmodel.UserResult{
+		User: *user,
+	})
+}
+
+// ChangePassword changes the password of the logged in user after verifying the old one. The other sessions
+// of the user are logged out, the session of the request stays logged in. It responds with 400 for a short
+// or unconfirmed new password and 403 for a wrong old password.
+func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req authmodel.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
+
+	if len(req.NewPassword) < 6 {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthFormPasswordInvalid,
+			fmt.Errorf("empty body 'new_password'"))
+		return
+	}
+
+	if req.NewPassword != req.RepeatPassword {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthFormPasswordsMismatch,
+			fmt.Errorf("password are not equal"))
+		return
+	}
+
+	userId, ok := ctx.Value(model.ContextUserId).(int32)
+	if !ok || userId == 0 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+			fmt.Errorf("illegal token, user id must be specified"))
+		return
+	}
+	req.UserId = userId
+	req.SessionId, _ = ctx.Value(model.ContextSessionId).(string)
+
+	if err := h.ctrl.ChangePassword(ctx, &req); err != nil {
+		httplib.ErrorResponseJSON(w, accountErrorStatus(err), internalErr.AccountPassword, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, httplib.SuccessfulResultMap())
+}
+
+// ChangeEmail sends a confirmation link to the new email of the logged in user, the email is changed
+// once the link is followed. The current password of the user is required. It responds with 400 for
+// invalid addresses, 403 for a wrong password and 409 if another user has the address.
+func (h *Handler) ChangeEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req authmodel.ChangeEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
+
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	if req.Email == "" {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthFormEmailEmpty,
+			fmt.Errorf("%s", "Empty 'email'"))
+		return
+	}
+
+	userId, ok := ctx.Value(model.ContextUserId).(int32)
+	if !ok || userId == 0 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+			fmt.Errorf("illegal token, user id must be specified"))
+		return
+	}
+	req.UserId = userId
+	req.Locale = requestLocale(r, req.Locale)
+
+	res, err := h.ctrl.ChangeEmail(ctx, &req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, accountErrorStatus(err), internalErr.AccountEmail, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, res)
+}
+
+// ConfirmEmailChange swaps the email of a user with the token of the confirmation link sent to the new address.
+// It responds with 404 for unknown or expired tokens and 409 if another user took the address meanwhile.
+func (h *Handler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req authmodel.ConfirmEmailChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
+
+	if strings.TrimSpace(req.Token) == "" {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.Token, fmt.Errorf("empty 'token'"))
+		return
+	}
+
+	change, err := h.ctrl.ConfirmEmailChange(ctx, &req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, accountErrorStatus(err), internalErr.AccountEmail, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, change)
+}
+
+// ExportAccount responds with a JSON archive of the personal data kept about the logged in user:
+// the profile, linked identities, two-factor state, role changes and bets.
+func (h *Handler) ExportAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, ok := ctx.Value(model.ContextUserId).(int32)
+	if !ok || userId == 0 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+			fmt.Errorf("illegal token, user id must be specified"))
+		return
+	}
+
+	archive, err := h.ctrl.ExportAccount(ctx, userId)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, accountErrorStatus(err), internalErr.AccountExport, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="pickfighter-account-%d.json"`, userId))
+	httplib.ResponseJSON(w, archive)
+}
+
+// DeleteAccount schedules the deletion of the logged in user's account, logs the user out everywhere
+// and responds with the time the account is anonymized after. The "password" of the JSON body is
+// required if the user has one. It responds with 403 for a wrong password.
+func (h *Handler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req authmodel.DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
+
+	userId, ok := ctx.Value(model.ContextUserId).(int32)
+	if !ok || userId == 0 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+			fmt.Errorf("illegal token, user id must be specified"))
+		return
+	}
+	req.UserId = userId
+
+	deletion, err := h.ctrl.DeleteAccount(ctx, &req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, accountErrorStatus(err), internalErr.AccountDeletion, err)
+		return
+	}
+
+	clearAuthCookies(w)
+
+	httplib.ResponseJSON(w, deletion)
+}
+
+// CancelAccountDeletion keeps the logged in user's account whose deletion is scheduled.
+// It responds with 409 if no deletion is scheduled or the grace period is over.
+func (h *Handler) CancelAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, ok := ctx.Value(model.ContextUserId).(int32)
+	if !ok || userId == 0 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+			fmt.Errorf("illegal token, user id must be specified"))
+		return
+	}
+
+	if err := h.ctrl.CancelAccountDeletion(ctx, userId); err != nil {
+		httplib.ErrorResponseJSON(w, accountErrorStatus(err), internalErr.AccountDeletion, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, httplib.SuccessfulResultMap())
+}
+
 // * * * * * Event Handlers * * * * *
 
 func (h *Handler) CreateEvent(w http.ResponseWriter, r *http.Request) {
@@ -447,3 +1441,240 @@ func (h *Handler) AddResult(w http.ResponseWriter, r *http.Request) {
 
 	httplib.ResponseJSON(w, result)
 }
+
+// GetResultReviews lists scraped results queued for an admin approval, the newest first.
+// Every review has the result stored at the moment and the changes the approval makes.
+// The status query parameter filters reviews: pending (default), approved, rejected or all.
+func (h *Handler) GetResultReviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	req := &eventmodel.ResultReviewsRequest{Status: query.Get("status")}
+	switch req.Status {
+	case "":
+		req.Status = eventmodel.ReviewPending
+	case "all":
+		req.Status = ""
+	case eventmodel.ReviewPending, eventmodel.ReviewApproved, eventmodel.ReviewRejected:
+	default:
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.QueryParamsStatus,
+			fmt.Errorf("unknown review status %q", req.Status))
+		return
+	}
+
+	if l := query.Get("limit"); l != "" {
+		limit, err := strconv.ParseInt(l, 10, 32)
+		if err != nil || limit <= 0 {
+			httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.QueryParamsLimit,
+				fmt.Errorf("limit should be a positive number"))
+			return
+		}
+		req.Limit = int32(limit)
+	}
+
+	reviews, err := h.ctrl.ResultReviews(ctx, req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.EventsReviews, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, httplib.ListResult{
+		Results: reviews,
+		Count:   int32(len(reviews)),
+	})
+}
+
+// ReviewResult approves or rejects a queued scraped result, an approved result is set like the results
+// added with /create/result. It responds with 404 for an unknown review and 409 if it was already reviewed.
+func (h *Handler) ReviewResult(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	id, err := strconv.ParseInt(vars["id"], 10, 32)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.EventsReviews, err)
+		return
+	}
+
+	review, err := h.ctrl.ReviewResult(ctx, &eventmodel.ReviewResultRequest{
+		ReviewId: int32(id),
+		Approve:  vars["action"] == "approve",
+	})
+	switch {
+	case errors.Is(err, gateway.ErrNotFound):
+		httplib.ErrorResponseJSON(w, http.StatusNotFound, internalErr.EventsReviews, err)
+		return
+	case errors.Is(err, gateway.ErrConflict):
+		httplib.ErrorResponseJSON(w, http.StatusConflict, internalErr.EventsReviews, err)
+		return
+	case err != nil:
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.EventsReviews, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, review)
+}
+
+// GetEmailOutbox lists queued emails with their delivery status, the newest first.
+// The status query parameter filters emails: pending, sent, dead or all (default).
+func (h *Handler) GetEmailOutbox(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	req := &authmodel.EmailOutboxRequest{Status: query.Get("status")}
+	switch req.Status {
+	case "all":
+		req.Status = ""
+	case "", authmodel.OutboxPending, authmodel.OutboxSent, authmodel.OutboxDead:
+	default:
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.QueryParamsStatus,
+			fmt.Errorf("unknown email status %q", req.Status))
+		return
+	}
+
+	if l := query.Get("limit"); l != "" {
+		limit, err := strconv.ParseInt(l, 10, 32)
+		if err != nil || limit <= 0 {
+			httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.QueryParamsLimit,
+				fmt.Errorf("limit should be a positive number"))
+			return
+		}
+		req.Limit = int32(limit)
+	}
+
+	emails, err := h.ctrl.EmailOutbox(ctx, req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.EmailsOutbox, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, httplib.ListResult{
+		Results: emails,
+		Count:   int32(len(emails)),
+	})
+}
+
+// ResendEmail sends a pending or dead outbox email again right away with a fresh number of attempts.
+// It responds with 404 for an unknown email and 409 if it was already sent.
+func (h *Handler) ResendEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.EmailsOutbox, err)
+		return
+	}
+
+	email, err := h.ctrl.ResendEmail(ctx, &authmodel.ResendEmailRequest{EmailId: id})
+	switch {
+	case errors.Is(err, gateway.ErrNotFound):
+		httplib.ErrorResponseJSON(w, http.StatusNotFound, internalErr.EmailsOutbox, err)
+		return
+	case errors.Is(err, gateway.ErrConflict):
+		httplib.ErrorResponseJSON(w, http.StatusConflict, internalErr.EmailsOutbox, err)
+		return
+	case err != nil:
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.EmailsOutbox, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, email)
+}
+
+// ChangeUserRole grants or revokes a role of a user on behalf of the logged in user, the optional reason
+// of the JSON body is kept in the roles audit. A revoked role logs the user out everywhere.
+// It responds with 400 for an unknown role, 403 for own roles, 404 for an unknown user
+// and 409 if the user already has the granted role or lacks the revoked one.
+func (h *Handler) ChangeUserRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	var req authmodel.RoleChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.AuthDecode, err)
+		return
+	}
+
+	userId, err := strconv.ParseInt(vars["id"], 10, 32)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.RolesChange, err)
+		return
+	}
+	req.UserId = int32(userId)
+	req.Role = vars["role"]
+
+	actorId, ok := ctx.Value(model.ContextUserId).(int32)
+	if !ok || actorId == 0 {
+		httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+			fmt.Errorf("illegal token, user id must be specified"))
+		return
+	}
+	req.ActorId = actorId
+
+	var change *authmodel.RoleChange
+	if vars["action"] == authmodel.RoleGranted {
+		change, err = h.ctrl.GrantRole(ctx, &req)
+	} else {
+		change, err = h.ctrl.RevokeRole(ctx, &req)
+	}
+
+	switch {
+	case errors.Is(err, gateway.ErrInvalidArgument):
+		httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.RolesChange, err)
+		return
+	case errors.Is(err, gateway.ErrForbidden):
+		httplib.ErrorResponseJSON(w, http.StatusForbidden, internalErr.RolesChange, err)
+		return
+	case errors.Is(err, gateway.ErrNotFound):
+		httplib.ErrorResponseJSON(w, http.StatusNotFound, internalErr.RolesChange, err)
+		return
+	case errors.Is(err, gateway.ErrConflict):
+		httplib.ErrorResponseJSON(w, http.StatusConflict, internalErr.RolesChange, err)
+		return
+	case err != nil:
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.RolesChange, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, change)
+}
+
+// GetRoleAudit lists granted and revoked roles with their actors and reasons, the newest first.
+// The user_id query parameter limits the list to the changes of a user.
+func (h *Handler) GetRoleAudit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	var req authmodel.RoleAuditRequest
+
+	if id := query.Get("user_id"); id != "" {
+		userId, err := strconv.ParseInt(id, 10, 32)
+		if err != nil || userId <= 0 {
+			httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.RolesAudit,
+				fmt.Errorf("user_id should be a positive number"))
+			return
+		}
+		req.UserId = int32(userId)
+	}
+
+	if l := query.Get("limit"); l != "" {
+		limit, err := strconv.ParseInt(l, 10, 32)
+		if err != nil || limit <= 0 {
+			httplib.ErrorResponseJSON(w, http.StatusBadRequest, internalErr.QueryParamsLimit,
+				fmt.Errorf("limit should be a positive number"))
+			return
+		}
+		req.Limit = int32(limit)
+	}
+
+	changes, err := h.ctrl.RoleAudit(ctx, &req)
+	if err != nil {
+		httplib.ErrorResponseJSON(w, http.StatusInternalServerError, internalErr.RolesAudit, err)
+		return
+	}
+
+	httplib.ResponseJSON(w, httplib.ListResult{
+		Results: changes,
+		Count:   int32(len(changes)),
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"pickfighter.com/pkg/httplib"
 	"pickfighter.com/pkg/ipaddr"
 	logs "pickfighter.com/pkg/logger"
+	"pickfighter.com/pkg/rbac"
 	"github.com/gorilla/mux"
 	"github.com/spf13/viper"
 )
@@ -121,22 +122,52 @@ func (h *Handler) ApplyRoutes() {
 	h.router.HandleFunc("/register", h.Register).Methods(http.MethodPost)
 	h.router.HandleFunc("/register/confirm", h.ConfirmRegistration).Methods(http.MethodPost)
 	h.router.HandleFunc("/login", h.Login).Methods(http.MethodPost)
+	h.router.HandleFunc("/token/refresh", h.RefreshToken).Methods(http.MethodPost)
 	h.router.HandleFunc("/logout", h.IfLoggedIn(h.Logout)).Methods(http.MethodGet)
+	h.router.HandleFunc("/logout/all", h.IfLoggedIn(h.LogoutEverywhere)).Methods(http.MethodPost)
 	h.router.HandleFunc("/password/reset", h.ResetPassword).Methods(http.MethodPost)
 	h.router.HandleFunc("/password/recover", h.RecoverPassword).Methods(http.MethodPost)
+	h.router.HandleFunc("/oauth/{provider}/login", h.OAuthLogin).Methods(http.MethodGet)
+	h.router.HandleFunc("/oauth/{provider}/callback", h.OAuthCallback).Methods(http.MethodGet)
+	h.router.HandleFunc("/login/2fa", h.TwoFactorLogin).Methods(http.MethodPost)
+	h.router.HandleFunc("/login/2fa/enroll", h.TwoFactorEnroll).Methods(http.MethodPost)
+	h.router.HandleFunc("/2fa/enroll", h.IfLoggedIn(h.TwoFactorEnroll)).Methods(http.MethodPost)
+	h.router.HandleFunc("/2fa/activate", h.IfLoggedIn(h.TwoFactorActivate)).Methods(http.MethodPost)
+	h.router.HandleFunc("/2fa/disable", h.IfLoggedIn(h.TwoFactorDisable)).Methods(http.MethodPost)
+	h.router.HandleFunc("/2fa/recovery-codes", h.IfLoggedIn(h.RegenerateRecoveryCodes)).Methods(http.MethodPost)
 
 	// profile
 	h.router.HandleFunc("/profile", h.IfLoggedIn(h.GetCurrentUser)).Methods(http.MethodGet)
+	h.router.HandleFunc("/profile", h.IfLoggedIn(h.UpdateProfile)).Methods(http.MethodPatch)
+	h.router.HandleFunc("/password/change", h.IfLoggedIn(h.ChangePassword)).Methods(http.MethodPost)
+	h.router.HandleFunc("/email/change", h.IfLoggedIn(h.ChangeEmail)).Methods(http.MethodPost)
+	h.router.HandleFunc("/email/change/confirm", h.ConfirmEmailChange).Methods(http.MethodPost)
+	h.router.HandleFunc("/account/export", h.IfLoggedIn(h.ExportAccount)).Methods(http.MethodPost)
+	h.router.HandleFunc("/account", h.IfLoggedIn(h.DeleteAccount)).Methods(http.MethodDelete)
+	h.router.HandleFunc("/account/restore", h.IfLoggedIn(h.CancelAccountDeletion)).Methods(http.MethodPost)
+
+	// emails
+	h.router.HandleFunc("/emails/outbox", h.RequirePermission(rbac.PermEmailManage, h.GetEmailOutbox)).Methods(http.MethodGet)
+	h.router.HandleFunc("/emails/outbox/{id:[0-9]+}/resend", h.RequirePermission(rbac.PermEmailManage, h.ResendEmail)).Methods(http.MethodPost)
+
+	// roles
+	h.router.HandleFunc("/users/{id:[0-9]+}/roles/{role}/{action:grant|revoke}", h.RequirePermission(rbac.PermRoleManage, h.ChangeUserRole)).Methods(http.MethodPost)
+	h.router.HandleFunc("/roles/audit", h.RequirePermission(rbac.PermRoleManage, h.GetRoleAudit)).Methods(http.MethodGet)
 
 	// events
-	h.router.HandleFunc("/create/event", h.CheckIsAdmin(h.CreateEvent)).Methods(http.MethodPost)
+	h.router.HandleFunc("/create/event", h.RequirePermission(rbac.PermEventCreate, h.CreateEvent)).Methods(http.MethodPost)
 	h.router.HandleFunc("/events", h.GetEvents).Methods(http.MethodGet)
 
-	h.router.HandleFunc("/create/bet", h.IfLoggedIn(h.CreateBet)).Methods(http.MethodPost)
+	h.router.HandleFunc("/create/bet", h.RequirePermission(rbac.PermBetCreate, h.CreateBet)).Methods(http.MethodPost)
 	h.router.HandleFunc("/bets", h.IfLoggedIn(h.GetBets)).Methods(http.MethodGet)
 
-	h.router.HandleFunc("/create/result", h.CheckIsAdmin(h.AddResult)).Methods(http.MethodPost)
+	h.router.HandleFunc("/create/result", h.RequirePermission(rbac.PermResultWrite, h.AddResult)).Methods(http.MethodPost)
+	h.router.HandleFunc("/results/reviews", h.RequirePermission(rbac.PermResultReview, h.GetResultReviews)).Methods(http.MethodGet)
+	h.router.HandleFunc("/results/reviews/{id:[0-9]+}/{action:approve|reject}", h.RequirePermission(rbac.PermResultReview, h.ReviewResult)).Methods(http.MethodPost)
 
 	// fighters
 	h.router.HandleFunc("/fighters", h.GetFighters).Methods(http.MethodGet)
+	h.router.HandleFunc("/fighters/search", h.SearchFighters).Methods(http.MethodGet)
+	h.router.HandleFunc("/fighters/export", h.RequirePermission(rbac.PermFighterExport, h.ExportFighters)).Methods(http.MethodGet)
+	h.router.HandleFunc("/fighters/{id:[0-9]+}/image/{size:[a-z]+}.{format:jpg|jpeg|webp}", h.GetFighterImage).Methods(http.MethodGet)
 }
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
+	authmodel "pickfighter.com/auth/pkg/model"
 	"pickfighter.com/pkg/httplib"
 	logs "pickfighter.com/pkg/logger"
 	"pickfighter.com/pkg/model"
+	"pickfighter.com/pkg/rbac"
 	"github.com/lestrrat-go/jwx/v2/jwa"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"github.com/spf13/viper"
@@ -29,8 +32,8 @@ func (h *Handler) verifyJWT(jwtRawValue string) (jwt.Token, error) {
 }
 
 // IfLoggedIn is a middleware that checks if a user is logged in based on the provided JWT token.
-// If the token is valid, it extracts user information such as user ID and claims, and adds them to the request context.
-// If the token is invalid or missing, it returns an unauthorized response.
+// If the token is valid and not revoked, it extracts user information such as user ID and claims, and adds them to the request context.
+// If the token is invalid, revoked or missing, it returns an unauthorized response.
 func (h *Handler) IfLoggedIn(fn http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -69,6 +72,40 @@ func (h *Handler) IfLoggedIn(fn http.HandlerFunc) http.HandlerFunc {
 			ctx = context.WithValue(ctx, model.ContextUserId, int32(uid))
 		}
 
+		if token.JwtID() == "" {
+			httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+				fmt.Errorf("illegal token, token id must be specified"))
+			return
+		}
+
+		var sessionId string
+		if sid, ok := token.Get(string(model.ContextSessionId)); ok {
+			sessionId, _ = sid.(string)
+		}
+
+		revoked, err := h.ctrl.IsTokenRevoked(ctx, &authmodel.TokenStatusRequest{
+			TokenId:   token.JwtID(),
+			UserId:    int32(uid),
+			IssuedAt:  token.IssuedAt().Unix(),
+			SessionId: sessionId,
+		}, token.Expiration())
+		if err != nil {
+			logs.Errorf("Failed to check token revocation: %s", err)
+			httplib.ErrorResponseJSON(w, http.StatusServiceUnavailable, http.StatusServiceUnavailable,
+				fmt.Errorf("unable to check token"))
+			return
+		}
+
+		if revoked {
+			httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+				fmt.Errorf("token revoked"))
+			return
+		}
+
+		if sessionId != "" {
+			ctx = context.WithValue(ctx, model.ContextSessionId, sessionId)
+		}
+
 		// TODO admin claim?
 		// rootClaim, onBoard := token.Get(string(model.ContextClaim))
 		// if onBoard {
@@ -84,32 +121,55 @@ func (h *Handler) IfLoggedIn(fn http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
-// CheckIsAdmin is a middleware that verifies if the user is logged in as an administrator.
-// It checks the "admin" claim in the JWT (JSON Web Token) stored in the request cookie.
-// If the user is an administrator, the request continues; otherwise, it responds with an error.
-func (h *Handler) CheckIsAdmin(fn http.HandlerFunc) http.HandlerFunc {
-	// TODO mb claim should set in createJWTToken method
+// RequirePermission is a middleware that verifies if the logged in user has the permission.
+// The permissions are read from the "permissions" claim of the access token, which holds the permissions
+// granted by the user's roles when the token was issued. The roles and the permissions are added
+// to the request context. Requests of users without the permission are rejected with 403.
+func (h *Handler) RequirePermission(perm rbac.Permission, fn http.HandlerFunc) http.HandlerFunc {
 	return h.IfLoggedIn(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
 		token, ok := ctx.Value(model.ContextJWTPointer).(jwt.Token)
-		if ok {
-			f, ok := token.Get(string(model.ContextFlags))
-			flag, valid := f.(float64)
-			if !ok || int(flag) != 1 {
-				httplib.ErrorResponseJSON(w, http.StatusMethodNotAllowed, http.StatusMethodNotAllowed,
-					fmt.Errorf("action is allowed only for admins"))
-				return
-			}
-
-			if valid {
-				ctx = context.WithValue(ctx, model.ContextFlags, int(flag))
-			}
-
-		} else {
+		if !ok {
 			logs.Debugf("Failed to get JWT from context")
+			httplib.ErrorResponseJSON(w, http.StatusUnauthorized, http.StatusUnauthorized,
+				fmt.Errorf("unable to find request context token"))
+			return
+		}
+
+		perms := claimStrings(token, model.ContextPermissions)
+		if !slices.Contains(perms, string(perm)) {
+			httplib.ErrorResponseJSON(w, http.StatusForbidden, http.StatusForbidden,
+				fmt.Errorf("action requires %q permission", perm))
+			return
 		}
 
+		ctx = context.WithValue(ctx, model.ContextRoles, claimStrings(token, model.ContextRoles))
+		ctx = context.WithValue(ctx, model.ContextPermissions, perms)
+
 		fn(w, r.WithContext(ctx))
 	})
 }
+
+// claimStrings returns a string list claim of the token, it is decoded as a list of any values.
+func claimStrings(token jwt.Token, claim model.ContextField) []string {
+	v, ok := token.Get(string(claim))
+	if !ok {
+		return nil
+	}
+
+	switch list := v.(type) {
+	case []string:
+		return list
+	case []any:
+		values := make([]string, 0, len(list))
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,192 @@
+package http
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authmodel "pickfighter.com/auth/pkg/model"
+	"pickfighter.com/pickfighter/internal/controller/pickfighter"
+	authgrpc "pickfighter.com/pickfighter/internal/gateway/auth/grpc"
+	"pickfighter.com/pkg/httplib"
+	"pickfighter.com/pkg/model"
+	"pickfighter.com/pkg/rbac"
+)
+
+// fakeAuthGateway answers token status checks, other calls of the auth service are not expected.
+type fakeAuthGateway struct {
+	*authgrpc.Gateway
+	revoked bool
+}
+
+func (g *fakeAuthGateway) TokenStatus(ctx context.Context, req *authmodel.TokenStatusRequest) (*authmodel.TokenStatus, error) {
+	return &authmodel.TokenStatus{Revoked: g.revoked}, nil
+}
+
+// signAccessToken signs an access token of user 7 with the key, claims are added to the token
+// and replace the default ones.
+func signAccessToken(t *testing.T, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+
+	now := time.Now()
+	tok := jwt.New()
+	defaults := map[string]any{
+		jwt.JwtIDKey:                     "token-1",
+		jwt.IssuedAtKey:                  now,
+		jwt.ExpirationKey:                now.Add(time.Hour),
+		string(model.ContextUserId):      7,
+		string(model.ContextSessionId):   "session-1",
+		string(model.ContextRoles):       []string{"user"},
+		string(model.ContextPermissions): []string{string(rbac.PermBetCreate)},
+	}
+	for k, v := range claims {
+		defaults[k] = v
+	}
+	for k, v := range defaults {
+		if v != nil {
+			require.NoError(t, tok.Set(k, v))
+		}
+	}
+
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256, key))
+	require.NoError(t, err)
+
+	return string(signed)
+}
+
+func TestRequirePermission(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	viper.Set("auth.jwt.parse_key", &key.PublicKey)
+	t.Cleanup(viper.Reset)
+
+	tests := []struct {
+		name    string
+		perm    rbac.Permission
+		token   string
+		revoked bool
+		status  int
+	}{
+		{
+			name:   "Granted",
+			perm:   rbac.PermBetCreate,
+			token:  signAccessToken(t, key, nil),
+			status: http.StatusOK,
+		},
+		{
+			name:   "Granted by one of the permissions",
+			perm:   rbac.PermEventCreate,
+			token:  signAccessToken(t, key, map[string]any{string(model.ContextPermissions): []string{string(rbac.PermBetCreate), string(rbac.PermEventCreate)}}),
+			status: http.StatusOK,
+		},
+		{
+			name:   "Missing permission",
+			perm:   rbac.PermEventCreate,
+			token:  signAccessToken(t, key, nil),
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "No permissions claim",
+			perm:   rbac.PermBetCreate,
+			token:  signAccessToken(t, key, map[string]any{string(model.ContextPermissions): nil}),
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "Permissions claim of another type",
+			perm:   rbac.PermBetCreate,
+			token:  signAccessToken(t, key, map[string]any{string(model.ContextPermissions): string(rbac.PermBetCreate)}),
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "No token",
+			perm:   rbac.PermBetCreate,
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "Signed with another key",
+			perm:   rbac.PermBetCreate,
+			token:  signAccessToken(t, otherKey, nil),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "Expired",
+			perm:   rbac.PermBetCreate,
+			token:  signAccessToken(t, key, map[string]any{jwt.ExpirationKey: time.Now().Add(-time.Minute)}),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:    "Revoked",
+			perm:    rbac.PermBetCreate,
+			token:   signAccessToken(t, key, nil),
+			revoked: true,
+			status:  http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(pickfighter.New(&fakeAuthGateway{revoked: tt.revoked}, nil, nil))
+
+			var called bool
+			next := func(w http.ResponseWriter, r *http.Request) {
+				called = true
+
+				ctx := r.Context()
+				assert.Equal(t, int32(7), ctx.Value(model.ContextUserId))
+				assert.Equal(t, []string{"user"}, ctx.Value(model.ContextRoles))
+				assert.Contains(t, ctx.Value(model.ContextPermissions), string(tt.perm))
+
+				w.WriteHeader(http.StatusOK)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/create/bet", nil)
+			if tt.token != "" {
+				req.AddCookie(&http.Cookie{Name: httplib.CookieName, Value: tt.token})
+			}
+
+			rec := httptest.NewRecorder()
+			h.RequirePermission(tt.perm, next)(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, tt.status == http.StatusOK, called)
+		})
+	}
+}
+
+func TestCreateBetRequiresPermission(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	viper.Set("auth.jwt.parse_key", &key.PublicKey)
+	t.Cleanup(viper.Reset)
+
+	h := New(pickfighter.New(&fakeAuthGateway{}, nil, nil))
+	h.ApplyRoutes()
+
+	// a staff token without the user role is rejected before the bet is decoded
+	token := signAccessToken(t, key, map[string]any{
+		string(model.ContextRoles):       []string{"event_editor"},
+		string(model.ContextPermissions): []string{string(rbac.PermEventCreate)},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/create/bet", nil)
+	req.AddCookie(&http.Cookie{Name: httplib.CookieName, Value: token})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), string(rbac.PermBetCreate))
+}
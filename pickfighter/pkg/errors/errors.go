@@ -24,8 +24,13 @@ const (
 	AuthFormPasswordWrong     = 224
 	AuthFormPasswordsMismatch = 225
 
-	QueryParams      = 300
-	QueryParamsToken = 301
+	QueryParams         = 300
+	QueryParamsToken    = 301
+	QueryParamsFormat   = 302
+	QueryParamsDivision = 303
+	QueryParamsStatus   = 304
+	QueryParamsSearch   = 305
+	QueryParamsLimit    = 306
 
 	UserCredentials            = 400
 	UserCredentialsNotExists   = 401
@@ -40,6 +45,8 @@ const (
 	Token        = 600
 	TokenEmpty   = 601
 	TokenExpired = 602
+	TokenRefresh = 603
+	TokenRevoke  = 604
 
 	JSON        = 700
 	JSONDecoder = 701
@@ -51,9 +58,37 @@ const (
 	Events            = 900
 	EventsFightResult = 901
 	EventIsDone       = 902
+	EventsReviews     = 903
+
+	Fighters       = 1002
+	FightersExport = 1003
+	FighterImage   = 1004
 
 	Bets      = 1200
 	CountBets = 1201
+
+	Emails       = 1300
+	EmailsOutbox = 1301
+
+	OAuth         = 1400
+	OAuthLogin    = 1401
+	OAuthCallback = 1402
+
+	TwoFactor       = 1500
+	TwoFactorLogin  = 1501
+	TwoFactorEnroll = 1502
+	TwoFactorVerify = 1503
+
+	Roles       = 1600
+	RolesChange = 1601
+	RolesAudit  = 1602
+
+	Account         = 1700
+	AccountProfile  = 1701
+	AccountPassword = 1702
+	AccountEmail    = 1703
+	AccountExport   = 1704
+	AccountDeletion = 1705
 )
 
 var defaultErrors = DefaultMessagesList{
@@ -70,6 +105,11 @@ var defaultErrors = DefaultMessagesList{
 	AuthFormPasswordWrong:      Error{ErrCode: AuthFormPasswordWrong, Message: "[Auth]: Wrong Password"},
 	AuthFormPasswordsMismatch:  Error{ErrCode: AuthFormPasswordsMismatch, Message: "[Auth]: Passwords mismatch"},
 	QueryParamsToken:           Error{ErrCode: QueryParamsToken, Message: "[Query Params]: Query parameter 'token' should be specified"},
+	QueryParamsFormat:          Error{ErrCode: QueryParamsFormat, Message: "[Query Params]: Query parameter 'format' is invalid"},
+	QueryParamsDivision:        Error{ErrCode: QueryParamsDivision, Message: "[Query Params]: Query parameter 'division' is invalid"},
+	QueryParamsStatus:          Error{ErrCode: QueryParamsStatus, Message: "[Query Params]: Query parameter 'status' is invalid"},
+	QueryParamsSearch:          Error{ErrCode: QueryParamsSearch, Message: "[Query Params]: Query parameter 'q' is invalid"},
+	QueryParamsLimit:           Error{ErrCode: QueryParamsLimit, Message: "[Query Params]: Query parameter 'limit' is invalid"},
 	UserCredentials:            Error{ErrCode: UserCredentials, Message: "[User Credentials]: Failed to get user credentials"},
 	UserCredentialsNotExists:   Error{ErrCode: UserCredentialsNotExists, Message: "[User Credentials]: User with specified login credentials not exists"},
 	UserCredentialsToken:       Error{ErrCode: UserCredentialsToken, Message: "[User Credentials]: User credentials with specified token does not exist"},
@@ -81,14 +121,38 @@ var defaultErrors = DefaultMessagesList{
 	Token:                      Error{ErrCode: Token, Message: "[Token]: Token unknown error"},
 	TokenEmpty:                 Error{ErrCode: TokenEmpty, Message: "[Token]: Token is empty"},
 	TokenExpired:               Error{ErrCode: TokenExpired, Message: "[Token]: Token expired, try to reset password"},
+	TokenRefresh:               Error{ErrCode: TokenRefresh, Message: "[Token]: Failed to refresh token"},
+	TokenRevoke:                Error{ErrCode: TokenRevoke, Message: "[Token]: Failed to revoke token"},
 	JSON:                       Error{ErrCode: JSON, Message: "[JSON]: JSON unknown error"},
 	JSONDecoder:                Error{ErrCode: JSONDecoder, Message: "[JSON]: Decoder error"},
 	DBGetUser:                  Error{ErrCode: DBGetUser, Message: "[DB]: Failed to get user"},
 	Events:                     Error{ErrCode: Events, Message: "[Events]: Decode error"},
 	EventsFightResult:          Error{ErrCode: EventsFightResult, Message: "[Events]: Failed to set fight result"},
 	EventIsDone:                Error{ErrCode: EventIsDone, Message: "[Events]: Failed to set event done"},
+	EventsReviews:              Error{ErrCode: EventsReviews, Message: "[Events]: Failed to review scraped results"},
+	Fighters:                   Error{ErrCode: Fighters, Message: "[Fighters]: Failed to find fighters"},
+	FightersExport:             Error{ErrCode: FightersExport, Message: "[Fighters]: Failed to export fighters"},
+	FighterImage:               Error{ErrCode: FighterImage, Message: "[Fighters]: Failed to get fighter image"},
 	Bets:                       Error{ErrCode: EventIsDone, Message: "[Bets]: Error"},
 	CountBets:                  Error{ErrCode: EventIsDone, Message: "[Bets]: Failed to get bets count"},
+	Emails:                     Error{ErrCode: Emails, Message: "[Emails]: Error"},
+	EmailsOutbox:               Error{ErrCode: EmailsOutbox, Message: "[Emails]: Failed to manage email outbox"},
+	OAuth:                      Error{ErrCode: OAuth, Message: "[OAuth]: Error"},
+	OAuthLogin:                 Error{ErrCode: OAuthLogin, Message: "[OAuth]: Failed to start provider login"},
+	OAuthCallback:              Error{ErrCode: OAuthCallback, Message: "[OAuth]: Failed to complete provider login"},
+	TwoFactor:                  Error{ErrCode: TwoFactor, Message: "[Two-Factor]: Error"},
+	TwoFactorLogin:             Error{ErrCode: TwoFactorLogin, Message: "[Two-Factor]: Failed to complete two-factor login"},
+	TwoFactorEnroll:            Error{ErrCode: TwoFactorEnroll, Message: "[Two-Factor]: Failed to enroll authenticator"},
+	TwoFactorVerify:            Error{ErrCode: TwoFactorVerify, Message: "[Two-Factor]: Failed to verify two-factor code"},
+	Roles:                      Error{ErrCode: Roles, Message: "[Roles]: Error"},
+	RolesChange:                Error{ErrCode: RolesChange, Message: "[Roles]: Failed to change user roles"},
+	RolesAudit:                 Error{ErrCode: RolesAudit, Message: "[Roles]: Failed to get roles audit"},
+	Account:                    Error{ErrCode: Account, Message: "[Account]: Error"},
+	AccountProfile:             Error{ErrCode: AccountProfile, Message: "[Account]: Failed to update profile"},
+	AccountPassword:            Error{ErrCode: AccountPassword, Message: "[Account]: Failed to change password"},
+	AccountEmail:               Error{ErrCode: AccountEmail, Message: "[Account]: Failed to change email"},
+	AccountExport:              Error{ErrCode: AccountExport, Message: "[Account]: Failed to export account"},
+	AccountDeletion:            Error{ErrCode: AccountDeletion, Message: "[Account]: Failed to delete account"},
 }
 
 var unknownError = Error{ErrCode: 9999, Message: "Unknown Error"}
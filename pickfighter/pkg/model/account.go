@@ -0,0 +1,14 @@
+package model
+
+import (
+	authmodel "pickfighter.com/auth/pkg/model"
+	eventmodel "pickfighter.com/events/pkg/model"
+)
+
+// AccountArchive is the export of the personal data kept about a user across the services:
+// the account of the auth service and the bets of the event service.
+type AccountArchive struct {
+	ExportedAt int64                    `json:"exported_at"`
+	Account    *authmodel.AccountExport `json:"account"`
+	Bets       []*eventmodel.Bet        `json:"bets"`
+}
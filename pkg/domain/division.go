@@ -0,0 +1,157 @@
+// Package domain contains fighter domain types shared by the scraper, the fighters service and the gateway.
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Division represents weight divisions. Values are stored in the database,
+// so new divisions must be appended to the end of the list.
+type Division int
+
+const (
+	Flyweight Division = iota
+	Bantamweight
+	Featherweight
+	Lightweight
+	Welterweight
+	Middleweight
+	Lightheavyweight
+	Heavyweight
+	WomensStrawweight
+	WomensFlyweight
+	WomensBantamweight
+	WomensFeatherweight
+	Catchweight
+)
+
+// Divisions is the list of all known divisions.
+var Divisions = []Division{
+	Flyweight, Bantamweight, Featherweight, Lightweight, Welterweight, Middleweight, Lightheavyweight,
+	Heavyweight, WomensStrawweight, WomensFlyweight, WomensBantamweight, WomensFeatherweight, Catchweight,
+}
+
+var ErrUnknownDivision = errors.New("unknown division")
+
+var divisionNames = map[Division]string{
+	Flyweight:           "Flyweight",
+	Bantamweight:        "Bantamweight",
+	Featherweight:       "Featherweight",
+	Lightweight:         "Lightweight",
+	Welterweight:        "Welterweight",
+	Middleweight:        "Middleweight",
+	Lightheavyweight:    "Light Heavyweight",
+	Heavyweight:         "Heavyweight",
+	WomensStrawweight:   "Women's Strawweight",
+	WomensFlyweight:     "Women's Flyweight",
+	WomensBantamweight:  "Women's Bantamweight",
+	WomensFeatherweight: "Women's Featherweight",
+	Catchweight:         "Catchweight",
+}
+
+// divisionsByKey maps normalized division names to divisions.
+var divisionsByKey = func() map[string]Division {
+	m := make(map[string]Division, len(divisionNames)+1)
+	for d, name := range divisionNames {
+		m[divisionKey(name)] = d
+	}
+	// the promotion writes it both ways
+	m[divisionKey("Catch Weight")] = Catchweight
+
+	return m
+}()
+
+// String returns the string representation of a Division.
+func (d Division) String() string {
+	if name, ok := divisionNames[d]; ok {
+		return name
+	}
+
+	return "Unknown"
+}
+
+// IsValid reports whether d is a known division.
+func (d Division) IsValid() bool {
+	_, ok := divisionNames[d]
+	return ok
+}
+
+// ParseDivision converts a division name into a Division. The name is matched case-insensitively
+// and may be written as on fighter profiles, e.g. "Women's Flyweight Division".
+// Numeric division ids are accepted as well.
+func ParseDivision(s string) (Division, error) {
+	if id, err := strconv.Atoi(strings.TrimSpace(s)); err == nil {
+		return DivisionFromId(id)
+	}
+
+	if d, ok := divisionsByKey[divisionKey(s)]; ok {
+		return d, nil
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownDivision, s)
+}
+
+// DivisionFromId converts a numeric division id into a Division.
+func DivisionFromId(id int) (Division, error) {
+	d := Division(id)
+	if !d.IsValid() {
+		return 0, fmt.Errorf("%w: %d", ErrUnknownDivision, id)
+	}
+
+	return d, nil
+}
+
+// DivisionFromProto converts a proto division id into a Division.
+func DivisionFromProto(v int32) (Division, error) {
+	return DivisionFromId(int(v))
+}
+
+// Proto returns the proto representation of a Division.
+func (d Division) Proto() int32 {
+	return int32(d)
+}
+
+// UnmarshalJSON decodes a division from its numeric id or from its name.
+// Divisions are always encoded as numeric ids.
+func (d *Division) UnmarshalJSON(b []byte) error {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	var (
+		parsed Division
+		err    error
+	)
+
+	switch v := v.(type) {
+	case float64:
+		if v != float64(int(v)) {
+			return fmt.Errorf("%w: %v", ErrUnknownDivision, v)
+		}
+		parsed, err = DivisionFromId(int(v))
+	case string:
+		parsed, err = ParseDivision(v)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownDivision, b)
+	}
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// divisionKey normalizes a division name for lookups.
+func divisionKey(s string) string {
+	s = strings.ToLower(strings.Join(strings.Fields(s), " "))
+	s = strings.ReplaceAll(s, "’", "'")
+	s = strings.TrimSuffix(s, " division")
+
+	return s
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDivisionString(t *testing.T) {
+	testCases := []struct {
+		input    Division
+		expected string
+	}{
+		{Flyweight, "Flyweight"},
+		{Lightheavyweight, "Light Heavyweight"},
+		{WomensFeatherweight, "Women's Featherweight"},
+		{Catchweight, "Catchweight"},
+		{Division(-1), "Unknown"},
+		{Division(42), "Unknown"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, tc.input.String())
+	}
+}
+
+func TestParseDivision(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Division
+		err      bool
+	}{
+		{"Flyweight", Flyweight, false},
+		{"Flyweight Division", Flyweight, false},
+		{"  light  heavyweight division ", Lightheavyweight, false},
+		{"Women's Featherweight Division", WomensFeatherweight, false},
+		{"Women’s Strawweight Division", WomensStrawweight, false},
+		{"Catch Weight Division", Catchweight, false},
+		{"catchweight", Catchweight, false},
+		{"3", Lightweight, false},
+		{"12", Catchweight, false},
+		{"13", 0, true},
+		{"-1", 0, true},
+		{"Super Heavyweight", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			d, err := ParseDivision(tc.input)
+			if tc.err {
+				assert.True(t, errors.Is(err, ErrUnknownDivision))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, d)
+		})
+	}
+}
+
+func TestParseDivisionRoundTrip(t *testing.T) {
+	for _, d := range Divisions {
+		parsed, err := ParseDivision(d.String())
+		assert.NoError(t, err)
+		assert.Equal(t, d, parsed)
+
+		fromProto, err := DivisionFromProto(d.Proto())
+		assert.NoError(t, err)
+		assert.Equal(t, d, fromProto)
+	}
+
+	_, err := DivisionFromProto(int32(len(Divisions)))
+	assert.True(t, errors.Is(err, ErrUnknownDivision))
+}
+
+func TestDivisionJSON(t *testing.T) {
+	type fighter struct {
+		Division Division `json:"division"`
+	}
+
+	b, err := json.Marshal(fighter{Division: Catchweight})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"division":12}`, string(b))
+
+	testCases := []struct {
+		input    string
+		expected Division
+		err      bool
+	}{
+		{`{"division":6}`, Lightheavyweight, false},
+		{`{"division":"Bantamweight Division"}`, Bantamweight, false},
+		{`{"division":99}`, 0, true},
+		{`{"division":1.5}`, 0, true},
+		{`{"division":"Openweight"}`, 0, true},
+		{`{"division":true}`, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			var f fighter
+			err := json.Unmarshal([]byte(tc.input), &f)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, f.Division)
+		})
+	}
+}
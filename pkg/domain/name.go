@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeName folds accents, case and punctuation of a fighter name,
+// e.g. "José Aldo" and "Jose  Aldo" are normalized to "jose aldo".
+// Names of the same fighter found on different sites are compared by it.
+func NormalizeName(name string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, name)
+	if err != nil {
+		folded = name
+	}
+
+	folded = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		if r == '\'' || r == '’' || r == '.' {
+			return -1
+		}
+		return ' '
+	}, folded)
+
+	return strings.Join(strings.Fields(folded), " ")
+}
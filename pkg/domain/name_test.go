@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeName(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"José Aldo", "jose aldo"},
+		{"KHABIB  Nurmagomedov", "khabib nurmagomedov"},
+		{"Dan O’Connor", "dan oconnor"},
+		{"Jean-Claude Van Damme", "jean claude van damme"},
+		{"", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NormalizeName(tc.input))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Status defines a fighter status. The zero value means that the status is not known,
+// e.g. the fighter profile does not show it.
+type Status string
+
+const (
+	StatusActive      Status = "Active"
+	StatusNotFighting Status = "Not Fighting"
+	StatusRetired     Status = "Retired"
+)
+
+// Statuses is the list of all known statuses.
+var Statuses = []Status{StatusActive, StatusNotFighting, StatusRetired}
+
+var ErrUnknownStatus = errors.New("unknown fighter status")
+
+// String returns the string representation of a Status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// IsValid reports whether s is a known status.
+func (s Status) IsValid() bool {
+	for _, v := range Statuses {
+		if s == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseStatus converts a string into a Status. The match is case-insensitive,
+// and words may be separated by spaces, dashes or underscores, e.g. "not_fighting".
+func ParseStatus(s string) (Status, error) {
+	key := strings.ToLower(strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), " "))
+
+	for _, v := range Statuses {
+		if key == strings.ToLower(string(v)) {
+			return v, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownStatus, s)
+}
+
+// UnmarshalJSON decodes a status and rejects unknown values. An empty string is decoded
+// into the zero value, so fighters scraped without a status can still be loaded.
+func (s *Status) UnmarshalJSON(b []byte) error {
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	if v == "" {
+		*s = ""
+		return nil
+	}
+
+	parsed, err := ParseStatus(v)
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+	return nil
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStatus(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Status
+		err      bool
+	}{
+		{"Active", StatusActive, false},
+		{"active", StatusActive, false},
+		{"Not Fighting", StatusNotFighting, false},
+		{"not fighting", StatusNotFighting, false},
+		{"not_fighting", StatusNotFighting, false},
+		{"NOT-FIGHTING", StatusNotFighting, false},
+		{" Retired ", StatusRetired, false},
+		{"inactive", "", true},
+		{"", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			s, err := ParseStatus(tc.input)
+			if tc.err {
+				assert.True(t, errors.Is(err, ErrUnknownStatus))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, s)
+			assert.True(t, s.IsValid())
+		})
+	}
+}
+
+func TestStatusJSON(t *testing.T) {
+	type fighter struct {
+		Status Status `json:"status"`
+	}
+
+	for _, s := range append(Statuses, "") {
+		b, err := json.Marshal(fighter{Status: s})
+		assert.NoError(t, err)
+
+		var f fighter
+		assert.NoError(t, json.Unmarshal(b, &f))
+		assert.Equal(t, s, f.Status)
+	}
+
+	var f fighter
+	assert.NoError(t, json.Unmarshal([]byte(`{"status":"not fighting"}`), &f))
+	assert.Equal(t, StatusNotFighting, f.Status)
+
+	err := json.Unmarshal([]byte(`{"status":"Suspended"}`), &f)
+	assert.True(t, errors.Is(err, ErrUnknownStatus))
+}
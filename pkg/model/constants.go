@@ -18,4 +18,7 @@ const (
 	ContextClaim           ContextField = "root_claim"
 	ContextNamespaceClaims ContextField = "ns_claims"
 	ContextJWTPointer      ContextField = "jwt_pointer"
+	ContextSessionId       ContextField = "sid"
+	ContextRoles           ContextField = "roles"
+	ContextPermissions     ContextField = "permissions"
 )
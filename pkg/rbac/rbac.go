@@ -0,0 +1,181 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Role is a role of a user. The roles of a user are stored as a Set, a bitmask of roles.
+type Role uint64
+
+const (
+	RoleUser Role = 1 << iota
+	RoleModerator
+	RoleEventEditor
+	RoleAdmin
+)
+
+// Roles is the list of all known roles, from the least to the most privileged.
+var Roles = []Role{RoleUser, RoleModerator, RoleEventEditor, RoleAdmin}
+
+var roleNames = map[Role]string{
+	RoleUser:        "user",
+	RoleModerator:   "moderator",
+	RoleEventEditor: "event_editor",
+	RoleAdmin:       "admin",
+}
+
+// Permission is a named action a role allows, in the "resource:action" form.
+type Permission string
+
+const (
+	PermBetCreate     Permission = "bet:create"
+	PermEventCreate   Permission = "event:create"
+	PermResultWrite   Permission = "result:write"
+	PermResultReview  Permission = "result:review"
+	PermFighterExport Permission = "fighter:export"
+	PermUserBan       Permission = "user:ban"
+	PermEmailManage   Permission = "email:manage"
+	PermRoleManage    Permission = "role:manage"
+)
+
+// Permissions is the list of all known permissions.
+var Permissions = []Permission{
+	PermBetCreate, PermEventCreate, PermResultWrite, PermResultReview,
+	PermFighterExport, PermUserBan, PermEmailManage, PermRoleManage,
+}
+
+// rolePermissions maps roles to the permissions they grant, admins are granted all of them.
+var rolePermissions = map[Role][]Permission{
+	RoleUser:        {PermBetCreate},
+	RoleModerator:   {PermResultReview, PermUserBan},
+	RoleEventEditor: {PermEventCreate, PermResultWrite, PermResultReview},
+	RoleAdmin:       Permissions,
+}
+
+var ErrUnknownRole = errors.New("unknown role")
+
+// String returns the name of a Role.
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("role(%d)", uint64(r))
+}
+
+// IsValid reports whether r is a known role.
+func (r Role) IsValid() bool {
+	_, ok := roleNames[r]
+	return ok
+}
+
+// Permissions returns the permissions granted by the role.
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+// ParseRole converts a role name into a Role. The match is case-insensitive
+// and words may be separated by spaces, dashes or underscores, e.g. "event-editor".
+func ParseRole(s string) (Role, error) {
+	key := strings.ToLower(strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), "_"))
+
+	for _, r := range Roles {
+		if key == roleNames[r] {
+			return r, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownRole, s)
+}
+
+// Set is a bitmask of roles, as stored in the roles column of users.
+type Set uint64
+
+// Has reports whether the set holds the role.
+func (s Set) Has(r Role) bool {
+	return s&Set(r) != 0
+}
+
+// With returns the set with the role added.
+func (s Set) With(r Role) Set {
+	return s | Set(r)
+}
+
+// Without returns the set with the role removed.
+func (s Set) Without(r Role) Set {
+	return s &^ Set(r)
+}
+
+// Roles returns the known roles of the set, from the least to the most privileged.
+func (s Set) Roles() []Role {
+	var roles []Role
+	for _, r := range Roles {
+		if s.Has(r) {
+			roles = append(roles, r)
+		}
+	}
+
+	return roles
+}
+
+// Names returns the names of the known roles of the set.
+func (s Set) Names() []string {
+	var names []string
+	for _, r := range s.Roles() {
+		names = append(names, r.String())
+	}
+
+	return names
+}
+
+// IsStaff reports whether the set holds a role above the user role.
+func (s Set) IsStaff() bool {
+	return s.Without(RoleUser).Roles() != nil
+}
+
+// Permissions returns the sorted permissions granted by the roles of the set.
+func (s Set) Permissions() []Permission {
+	seen := make(map[Permission]bool)
+	var perms []Permission
+	for _, r := range s.Roles() {
+		for _, p := range r.Permissions() {
+			if !seen[p] {
+				seen[p] = true
+				perms = append(perms, p)
+			}
+		}
+	}
+
+	sort.Slice(perms, func(i, j int) bool { return perms[i] < perms[j] })
+
+	return perms
+}
+
+// Can reports whether a role of the set grants the permission.
+func (s Set) Can(p Permission) bool {
+	for _, r := range s.Roles() {
+		for _, v := range r.Permissions() {
+			if v == p {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// PermissionNames returns the names of the permissions granted by the roles of the set,
+// as they are embedded in the permissions claim of access tokens.
+func (s Set) PermissionNames() []string {
+	var names []string
+	for _, p := range s.Permissions() {
+		names = append(names, string(p))
+	}
+
+	return names
+}
@@ -0,0 +1,83 @@
+package rbac
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRole(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Role
+		err      bool
+	}{
+		{"user", RoleUser, false},
+		{"Moderator", RoleModerator, false},
+		{"event_editor", RoleEventEditor, false},
+		{"event-editor", RoleEventEditor, false},
+		{"Event Editor", RoleEventEditor, false},
+		{" ADMIN ", RoleAdmin, false},
+		{"root", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			r, err := ParseRole(tc.input)
+			if tc.err {
+				assert.True(t, errors.Is(err, ErrUnknownRole))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, r)
+			assert.True(t, r.IsValid())
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	var s Set
+	assert.Nil(t, s.Roles())
+	assert.False(t, s.IsStaff())
+	assert.False(t, s.Can(PermBetCreate))
+
+	s = s.With(RoleUser)
+	assert.True(t, s.Has(RoleUser))
+	assert.False(t, s.IsStaff())
+	assert.Equal(t, []string{"user"}, s.Names())
+	assert.Equal(t, []string{"bet:create"}, s.PermissionNames())
+
+	s = s.With(RoleEventEditor).With(RoleModerator)
+	assert.True(t, s.IsStaff())
+	assert.Equal(t, []string{"user", "moderator", "event_editor"}, s.Names())
+	assert.Equal(t, []string{"bet:create", "event:create", "result:review", "result:write", "user:ban"},
+		s.PermissionNames())
+	assert.True(t, s.Can(PermEventCreate))
+	assert.False(t, s.Can(PermRoleManage))
+
+	s = s.Without(RoleModerator)
+	assert.False(t, s.Has(RoleModerator))
+	assert.False(t, s.Can(PermUserBan))
+	assert.True(t, s.Can(PermResultReview))
+}
+
+func TestAdminPermissions(t *testing.T) {
+	s := Set(0).With(RoleAdmin)
+
+	for _, p := range Permissions {
+		assert.True(t, s.Can(p), p)
+	}
+	assert.Len(t, s.Permissions(), len(Permissions))
+}
+
+func TestUnknownRoles(t *testing.T) {
+	s := Set(1 << 10).With(RoleUser)
+
+	assert.Equal(t, []Role{RoleUser}, s.Roles())
+	assert.False(t, s.IsStaff())
+	assert.Equal(t, "role(1024)", Role(1<<10).String())
+	assert.False(t, Role(1<<10).IsValid())
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"pickfighter.com/scraper/internal/results"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(resultsCmd)
+
+	resultsCmd.Flags().Bool("auto-apply", false, "Set results of fights without a result instead of queuing them for approval")
+	resultsCmd.Flags().StringSlice("event", nil, "Event page URLs to read instead of the current and latest events")
+	resultsCmd.Flags().Int("past-events", results.DefaultPastEvents, "Number of finished events read besides the current one")
+
+	bindViperFlag(resultsCmd, "results.auto_apply", "auto-apply")
+	bindViperFlag(resultsCmd, "results.events", "event")
+	bindViperFlag(resultsCmd, "results.past_events", "past-events")
+}
+
+// resultsCmd represents the results command. It reads results of finished bouts from ufc.com event pages
+// and submits them to the events service, which applies them or queues them for an admin approval.
+var resultsCmd = &cobra.Command{
+	Use:           "results",
+	Short:         "Submit results of finished bouts to the events service",
+	Long:          ``,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return results.Run()
+	},
+}
@@ -3,8 +3,16 @@ package cmd
 import (
 	"fmt"
 	"log"
+	"os"
 	"time"
 
+	"pickfighter.com/scraper/internal/daemon"
+	"pickfighter.com/scraper/internal/fetch"
+	"pickfighter.com/scraper/internal/results"
+	"pickfighter.com/scraper/internal/scraper"
+	"pickfighter.com/scraper/internal/sink"
+	"pickfighter.com/scraper/internal/source"
+	"pickfighter.com/scraper/internal/validate"
 	"pickfighter.com/scraper/pkg/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -31,10 +39,11 @@ var rootCmd = &cobra.Command{
 
 // Execute runs the root command for the scraper service.
 // It executes the necessary logic for the command-line interface,
-// handling errors and logging them if they occur.
+// handling errors and logging them if they occur, a failed command exits with status 1.
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err.Error())
+		os.Exit(1)
 	}
 }
 
@@ -45,11 +54,25 @@ func init() {
 	rootCmd.PersistentFlags().Bool("proxy", false, "Run with proxy")
 	rootCmd.PersistentFlags().Bool("add", false, "Add results to previus fighters collection")
 	rootCmd.PersistentFlags().Int("start", 0, "start page")
+	rootCmd.PersistentFlags().String("sink", "json", "Output sink: json, ndjson or grpc (pushes fighters to the fighters service)")
+	rootCmd.PersistentFlags().String("output", "", "Output file of the json and ndjson sinks, '-' writes ndjson to stdout")
+	rootCmd.PersistentFlags().String("checkpoint", "", "Checkpoint file path, the source name is added before the extension (default is ./collection/checkpoint.json)")
+	rootCmd.PersistentFlags().Bool("resume", false, "Resume the interrupted run from the checkpoint, runs with a single source only")
+	rootCmd.PersistentFlags().Bool("incremental", false, "Skip athlete pages not changed since the last run")
+	rootCmd.PersistentFlags().Int("workers", scraper.DefaultWorkers, "Number of athlete pages scraped at once")
+	rootCmd.PersistentFlags().Int("parallelism", scraper.DefaultParallelism, "Max concurrent requests to a domain")
 
 	bindViperPersistentFlag(rootCmd, "config_path", "config")
 	bindViperPersistentFlag(rootCmd, "proxy", "proxy")
 	bindViperPersistentFlag(rootCmd, "add", "add")
 	bindViperPersistentFlag(rootCmd, "start", "start")
+	bindViperPersistentFlag(rootCmd, "sink.type", "sink")
+	bindViperPersistentFlag(rootCmd, "sink.output", "output")
+	bindViperPersistentFlag(rootCmd, "checkpoint.path", "checkpoint")
+	bindViperPersistentFlag(rootCmd, "checkpoint.resume", "resume")
+	bindViperPersistentFlag(rootCmd, "checkpoint.incremental", "incremental")
+	bindViperPersistentFlag(rootCmd, "scraper.workers", "workers")
+	bindViperPersistentFlag(rootCmd, "scraper.parallelism", "parallelism")
 }
 
 // initConfig initializes the service configuration.
@@ -78,6 +101,47 @@ func setConfigDefaults() {
 	viper.SetDefault("app.name", version.Name)
 	viper.SetDefault("app.version", version.DevVersion)
 	viper.SetDefault("app.run_date", time.Unix(version.RunDate, 0).Format(time.RFC1123))
+
+	// scraping limits, scraper.domains sets parallelism of domains matching a glob: [{glob, parallelism}]
+	viper.SetDefault("scraper.workers", scraper.DefaultWorkers)
+	viper.SetDefault("scraper.parallelism", scraper.DefaultParallelism)
+	viper.SetDefault("scraper.random_delay", scraper.DefaultRandomDelay)
+	viper.SetDefault("scraper.sources", []string{source.NameUFC})
+	viper.SetDefault("scraper.merge", string(sink.MergeFill))
+
+	// resilient fetching, zero budget values are no limits
+	viper.SetDefault("scraper.retry.max_attempts", fetch.DefaultMaxAttempts)
+	viper.SetDefault("scraper.retry.base_delay", fetch.DefaultBaseDelay)
+	viper.SetDefault("scraper.retry.max_delay", fetch.DefaultMaxDelay)
+	viper.SetDefault("scraper.retry.timeout", fetch.DefaultTimeout)
+	viper.SetDefault("scraper.proxy_health.max_failures", fetch.DefaultMaxFailures)
+	viper.SetDefault("scraper.proxy_health.bench", fetch.DefaultBench)
+	viper.SetDefault("scraper.budget.max_requests", 0)
+	viper.SetDefault("scraper.budget.max_errors", 0)
+	viper.SetDefault("scraper.robots", false)
+
+	// validation of scraped fighters, zero thresholds are disabled
+	viper.SetDefault("validation.report", validate.DefaultReportPath)
+	viper.SetDefault("validation.max_invalid", 0)
+	viper.SetDefault("validation.max_invalid_rate", validate.DefaultMaxInvalidRate)
+
+	// results of finished bouts, without auto apply they are queued for an admin approval
+	viper.SetDefault("results.auto_apply", false)
+	viper.SetDefault("results.events", []string{})
+	viper.SetDefault("results.past_events", results.DefaultPastEvents)
+
+	// scrape jobs of the serve command: [{name, kind, schedule, sources, start_url, auto_apply, past_events}],
+	// kinds are athletes, active, events and results, schedules are cron expressions in serve.timezone,
+	// an empty timezone is UTC
+	viper.SetDefault("serve.jobs", daemon.DefaultJobs)
+	viper.SetDefault("serve.timezone", "UTC")
+	viper.SetDefault("serve.address", daemon.DefaultAddress)
+	viper.SetDefault("serve.token", "")
+	viper.SetDefault("serve.history", daemon.DefaultHistoryPath)
+	viper.SetDefault("serve.history_limit", daemon.DefaultHistoryLimit)
+
+	// service discovery of the grpc sink and the events service
+	viper.SetDefault("consul.address", "localhost:8500")
 }
 
 // bindViperPersistentFlag binds a Viper configuration flag to a persistent Cobra command flag.
@@ -86,3 +150,10 @@ func bindViperPersistentFlag(cmd *cobra.Command, viperVal, flagName string) {
 		log.Printf("Failed to bind viper flag: %s", err)
 	}
 }
+
+// bindViperFlag binds a Viper configuration flag to a local Cobra command flag.
+func bindViperFlag(cmd *cobra.Command, viperVal, flagName string) {
+	if err := viper.BindPFlag(viperVal, cmd.Flags().Lookup(flagName)); err != nil {
+		log.Printf("Failed to bind viper flag: %s", err)
+	}
+}
@@ -2,11 +2,35 @@ package cmd
 
 import (
 	"pickfighter.com/scraper/internal/scraper"
+	"pickfighter.com/scraper/internal/sink"
+	"pickfighter.com/scraper/internal/source"
+	"pickfighter.com/scraper/internal/validate"
 	"github.com/spf13/cobra"
 )
 
 func init() {
 	rootCmd.AddCommand(scrapeCmd)
+
+	scrapeCmd.Flags().StringSlice("source", []string{source.NameUFC}, "Sources to scrape in the order of their rank: ufc, ufc-events, sherdog")
+	scrapeCmd.Flags().String("record", "", "Save fetched pages to the directory")
+	scrapeCmd.Flags().String("replay", "", "Serve pages saved by --record from the directory instead of the live site")
+	scrapeCmd.Flags().String("merge", string(sink.MergeFill), "Merge policy of fighters found by several sources: fill (empty fields are filled by lower ranked sources) or override")
+
+	bindViperFlag(scrapeCmd, "scraper.sources", "source")
+	bindViperFlag(scrapeCmd, "scraper.record", "record")
+	bindViperFlag(scrapeCmd, "scraper.replay", "replay")
+	scrapeCmd.Flags().Bool("robots", false, "Respect robots.txt and its Crawl-delay")
+	scrapeCmd.Flags().Int64("max-requests", 0, "Stop the run after the number of requests including retries, 0 means no limit")
+	scrapeCmd.Flags().Int64("max-errors", 0, "Stop the run after the number of requests failed with 429, 5xx or a network error, 0 means no limit")
+	scrapeCmd.Flags().Bool("strict", false, "Drop invalid fighters and fail the run when they exceed validation.max_invalid or validation.max_invalid_rate")
+	scrapeCmd.Flags().String("report", validate.DefaultReportPath, "Path of the validation report")
+
+	bindViperFlag(scrapeCmd, "scraper.merge", "merge")
+	bindViperFlag(scrapeCmd, "scraper.robots", "robots")
+	bindViperFlag(scrapeCmd, "scraper.budget.max_requests", "max-requests")
+	bindViperFlag(scrapeCmd, "scraper.budget.max_errors", "max-errors")
+	bindViperFlag(scrapeCmd, "validation.strict", "strict")
+	bindViperFlag(scrapeCmd, "validation.report", "report")
 }
 
 // scrapeCmd represents the scrape command. It is used to run web-scrapper to update data
@@ -15,7 +39,9 @@ var scrapeCmd = &cobra.Command{
 	Short:            "Run WEB Scraper",
 	Long:             ``,
 	TraverseChildren: true,
-	Run: func(cmd *cobra.Command, args []string) {
-		scraper.Run()
+	SilenceUsage:     true,
+	SilenceErrors:    true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return scraper.Run()
 	},
 }
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"pickfighter.com/scraper/internal/daemon"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().String("address", daemon.DefaultAddress, "Address of the admin endpoint")
+	serveCmd.Flags().String("history", daemon.DefaultHistoryPath, "Path of the job run history")
+
+	bindViperFlag(serveCmd, "serve.address", "address")
+	bindViperFlag(serveCmd, "serve.history", "history")
+}
+
+// serveCmd represents the serve command. It runs scrape jobs on the cron schedules of serve.jobs
+// and serves the admin endpoint to trigger them and view their last runs.
+var serveCmd = &cobra.Command{
+	Use:           "serve",
+	Short:         "Run scrape jobs on schedules",
+	Long:          ``,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return daemon.Serve()
+	},
+}
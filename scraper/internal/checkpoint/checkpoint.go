@@ -0,0 +1,277 @@
+// Package checkpoint keeps the progress of a scraper run on disk, so an interrupted run can be resumed,
+// and the validators of scraped athlete pages, so the next run can skip pages that didn't change.
+package checkpoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultPath is the default location of the checkpoint file.
+const DefaultPath = "./collection/checkpoint.json"
+
+// PathFor returns the checkpoint path of a source, every source keeps its own progress,
+// e.g. ./collection/checkpoint.ufc.json for the default path.
+func PathFor(path, source string) string {
+	if path == "" {
+		path = DefaultPath
+	}
+
+	ext := filepath.Ext(path)
+
+	return strings.TrimSuffix(path, ext) + "." + source + ext
+}
+
+// Page holds the validators of a scraped athlete page.
+type Page struct {
+	// Hash is the content hash of the fighter profile, see Hash.
+	Hash         string    `json:"hash"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	ScrapedAt    time.Time `json:"scraped_at"`
+}
+
+// state is the content of the checkpoint file.
+type state struct {
+	// Started are listing pages the run started, in the visiting order.
+	Started []string `json:"started"`
+	// Listings are listing pages with all athletes visited.
+	Listings map[string]bool `json:"listings"`
+	// Athletes are athlete pages visited by the run.
+	Athletes map[string]bool `json:"athletes"`
+	// Finished is set when the run reached the last listing page.
+	Finished  bool      `json:"finished"`
+	StartedAt time.Time `json:"started_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Pages are kept across runs and used by the incremental mode.
+	Pages map[string]Page `json:"pages"`
+}
+
+// Checkpoint records visited listing and athlete pages of a run. Every change is written to the file
+// right away, a crash loses the page in progress only. Page validators are the exception, they are staged
+// until CommitPages is called, see StagePage.
+type Checkpoint struct {
+	path string
+
+	mu    sync.Mutex
+	state state
+	// staged are validators of pages written to a sink that was not flushed yet.
+	staged map[string]Page
+}
+
+// Open reads the checkpoint file at path, a missing file is an empty checkpoint.
+// If resume is not set, the progress of the previous run is dropped, only page validators are kept.
+// A finished run can't be resumed, a new run is started instead.
+func Open(path string, resume bool) (*Checkpoint, error) {
+	if path == "" {
+		path = DefaultPath
+	}
+
+	cp := &Checkpoint{path: path, staged: make(map[string]Page)}
+
+	file, err := os.Open(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	if err == nil {
+		defer file.Close()
+
+		if err := json.NewDecoder(file).Decode(&cp.state); err != nil {
+			return nil, err
+		}
+	}
+
+	if !resume || cp.state.Finished {
+		cp.state.Started = nil
+		cp.state.Listings = nil
+		cp.state.Athletes = nil
+		cp.state.Finished = false
+		cp.state.StartedAt = time.Now()
+	}
+
+	if cp.state.Listings == nil {
+		cp.state.Listings = make(map[string]bool)
+	}
+	if cp.state.Athletes == nil {
+		cp.state.Athletes = make(map[string]bool)
+	}
+	if cp.state.Pages == nil {
+		cp.state.Pages = make(map[string]Page)
+	}
+
+	return cp, nil
+}
+
+// Current returns the listing page to continue from, it is empty if there is nothing to resume.
+// Athletes are scraped by several workers, so it is the first started page with athletes left to visit,
+// not the last started one.
+func (cp *Checkpoint) Current() string {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	for _, url := range cp.state.Started {
+		if !cp.state.Listings[url] {
+			return url
+		}
+	}
+
+	return ""
+}
+
+// VisitedAthletes returns the number of athlete pages visited by the run.
+func (cp *Checkpoint) VisitedAthletes() int {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	return len(cp.state.Athletes)
+}
+
+// StartListing records the listing page the run is working on.
+func (cp *Checkpoint) StartListing(url string) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	for _, started := range cp.state.Started {
+		if started == url {
+			return nil
+		}
+	}
+
+	cp.state.Started = append(cp.state.Started, url)
+
+	return cp.save()
+}
+
+// CompleteListing records the listing page as done, it is called when all its athletes were visited.
+func (cp *Checkpoint) CompleteListing(url string) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.state.Listings[url] = true
+
+	return cp.save()
+}
+
+// CompleteAthlete records the athlete page as visited.
+func (cp *Checkpoint) CompleteAthlete(url string) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.state.Athletes[url] = true
+
+	return cp.save()
+}
+
+// AthleteDone reports whether the athlete page was visited by the run.
+func (cp *Checkpoint) AthleteDone(url string) bool {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	return cp.state.Athletes[url]
+}
+
+// Page returns validators of the athlete page stored by a previous run.
+func (cp *Checkpoint) Page(url string) (Page, bool) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	p, ok := cp.state.Pages[url]
+
+	return p, ok
+}
+
+// PageChanged reports whether the content hash of the athlete page differs from the one committed
+// by a previous run. A page never scraped before is changed.
+func (cp *Checkpoint) PageChanged(url, hash string) bool {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	prev, ok := cp.state.Pages[url]
+
+	return !ok || prev.Hash != hash
+}
+
+// StagePage keeps validators of the athlete page until CommitPages. The scraper stages a page once its fighter
+// is written to the sink, and validators are committed after the sink is flushed, so a fighter lost in a crash
+// is not skipped by the next incremental run.
+func (cp *Checkpoint) StagePage(url string, p Page) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if p.ScrapedAt.IsZero() {
+		p.ScrapedAt = time.Now()
+	}
+	cp.staged[url] = p
+}
+
+// CommitPages stores staged page validators in the checkpoint file.
+func (cp *Checkpoint) CommitPages() error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if len(cp.staged) == 0 {
+		return nil
+	}
+
+	for url, p := range cp.staged {
+		cp.state.Pages[url] = p
+	}
+	cp.staged = make(map[string]Page)
+
+	return cp.save()
+}
+
+// Finish marks the run as finished, the next run starts from the first listing page even with resume.
+func (cp *Checkpoint) Finish() error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.state.Finished = true
+
+	return cp.save()
+}
+
+// save writes the state to a temporary file and renames it, so the checkpoint is never left half written.
+// It must be called with mu held.
+func (cp *Checkpoint) save() error {
+	cp.state.UpdatedAt = time.Now()
+
+	if err := os.MkdirAll(filepath.Dir(cp.path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(cp.path), filepath.Base(cp.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := json.NewEncoder(tmp).Encode(cp.state); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), cp.path)
+}
+
+// Hash returns the hex encoded sha256 of the content. The scraper hashes the fighter profile markup
+// rather than the whole response, the page layout around it changes on every request.
+func Hash(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,140 @@
+package checkpoint
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathFor(t *testing.T) {
+	assert.Equal(t, "./collection/checkpoint.ufc.json", PathFor("", "ufc"))
+	assert.Equal(t, "/tmp/cp.weekly.sherdog.json", PathFor("/tmp/cp.json", "weekly.sherdog"))
+}
+
+func TestOpenMissing(t *testing.T) {
+	cp, err := Open(filepath.Join(t.TempDir(), "checkpoint.json"), true)
+	require.NoError(t, err)
+
+	assert.Empty(t, cp.Current())
+	assert.Equal(t, 0, cp.VisitedAthletes())
+}
+
+func TestResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := Open(path, false)
+	require.NoError(t, err)
+
+	require.NoError(t, cp.StartListing("/athletes?page=0"))
+	require.NoError(t, cp.StartListing("/athletes?page=1"))
+	require.NoError(t, cp.StartListing("/athletes?page=2"))
+	require.NoError(t, cp.CompleteAthlete("/athlete/a"))
+	require.NoError(t, cp.CompleteAthlete("/athlete/b"))
+	require.NoError(t, cp.CompleteListing("/athletes?page=0"))
+	require.NoError(t, cp.CompleteListing("/athletes?page=2"))
+
+	// the first started page with athletes left, not the last started one
+	resumed, err := Open(path, true)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/athletes?page=1", resumed.Current())
+	assert.Equal(t, 2, resumed.VisitedAthletes())
+	assert.True(t, resumed.AthleteDone("/athlete/a"))
+	assert.False(t, resumed.AthleteDone("/athlete/c"))
+
+	// without resume the progress is dropped
+	fresh, err := Open(path, false)
+	require.NoError(t, err)
+
+	assert.Empty(t, fresh.Current())
+	assert.Equal(t, 0, fresh.VisitedAthletes())
+
+	// a finished run starts over even with resume
+	require.NoError(t, resumed.Finish())
+
+	finished, err := Open(path, true)
+	require.NoError(t, err)
+
+	assert.Empty(t, finished.Current())
+	assert.Equal(t, 0, finished.VisitedAthletes())
+}
+
+func TestPages(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := Open(path, false)
+	require.NoError(t, err)
+
+	hash := Hash([]byte("<div>Athlete</div>"))
+	assert.True(t, cp.PageChanged("/athlete/a", hash), "a page never scraped is changed")
+
+	cp.StagePage("/athlete/a", Page{Hash: hash, ETag: `"v1"`, LastModified: "Mon, 19 Oct 2026 10:00:00 GMT"})
+	assert.True(t, cp.PageChanged("/athlete/a", hash), "staged validators must not be used before the commit")
+
+	_, ok := cp.Page("/athlete/a")
+	assert.False(t, ok)
+
+	// a crash before the commit loses staged validators
+	reopened, err := Open(path, false)
+	require.NoError(t, err)
+	assert.True(t, reopened.PageChanged("/athlete/a", hash))
+
+	require.NoError(t, cp.CommitPages())
+
+	// validators are kept across runs, with or without resume
+	for _, resume := range []bool{false, true} {
+		reopened, err := Open(path, resume)
+		require.NoError(t, err)
+
+		assert.False(t, reopened.PageChanged("/athlete/a", hash))
+		assert.True(t, reopened.PageChanged("/athlete/a", Hash([]byte("<div>Athlete 2</div>"))))
+
+		page, ok := reopened.Page("/athlete/a")
+		require.True(t, ok)
+		assert.Equal(t, `"v1"`, page.ETag)
+		assert.Equal(t, "Mon, 19 Oct 2026 10:00:00 GMT", page.LastModified)
+		assert.False(t, page.ScrapedAt.IsZero())
+	}
+
+	// a new ETag replaces the old one
+	cp.StagePage("/athlete/a", Page{Hash: hash, ETag: `"v2"`})
+	require.NoError(t, cp.CommitPages())
+
+	reopened, err = Open(path, false)
+	require.NoError(t, err)
+
+	page, _ := reopened.Page("/athlete/a")
+	assert.Equal(t, `"v2"`, page.ETag)
+}
+
+func TestSaveAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "nested", "checkpoint.json")
+
+	cp, err := Open(path, false)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, cp.StartListing("/athletes?page="+strconv.Itoa(i)))
+	}
+
+	// only the checkpoint is left, temporary files are renamed over it
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "checkpoint.json", entries[0].Name())
+
+	reopened, err := Open(path, true)
+	require.NoError(t, err)
+	assert.Equal(t, "/athletes?page=0", reopened.Current())
+
+	// a broken file is reported instead of being treated as empty
+	require.NoError(t, os.WriteFile(path, []byte(`{"started": [`), 0o644))
+
+	_, err = Open(path, true)
+	assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		expr string
+		err  bool
+	}{
+		{"* * * * *", false},
+		{"*/15 9-17 * jan-jun mon,fri", false},
+		{"0 22 * * 7", false},
+		{"@weekly", false},
+		{"0 0 * *", true},
+		{"60 * * * *", true},
+		{"*/0 * * * *", true},
+		{"0 5-1 * * *", true},
+		{"0 0 * foo *", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			_, err := Parse(tc.expr)
+			if tc.err {
+				assert.ErrorIs(t, err, ErrInvalidExpression)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	// Saturday
+	from := time.Date(2026, time.October, 17, 21, 30, 15, 0, time.UTC)
+
+	testCases := []struct {
+		expr string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2026, time.October, 17, 21, 31, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, time.October, 17, 22, 0, 0, 0, time.UTC)},
+		{"0 3 * * mon", time.Date(2026, time.October, 19, 3, 0, 0, 0, time.UTC)},
+		{"0 * * * 6,0", time.Date(2026, time.October, 17, 22, 0, 0, 0, time.UTC)},
+		{"0 12 * * 7", time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)},
+		{"*/20 4 1 * *", time.Date(2026, time.November, 1, 4, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		// both days restricted, either of them matches
+		{"0 0 20 * sat", time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			s, err := Parse(tc.expr)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.next, s.Next(from))
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"pickfighter.com/scraper/internal/cron"
+	"pickfighter.com/scraper/internal/results"
+	"pickfighter.com/scraper/internal/scraper"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrUnknownJob = errors.New("unknown job")
+	// ErrRunning is returned when a job is triggered while another one is running, jobs share the output
+	// and the viper config, so they never overlap.
+	ErrRunning = errors.New("another job is running")
+	ErrStopped = errors.New("daemon is stopped")
+)
+
+// Runners run jobs of the daemon, in the serve command they are scraper.Scrape and results.Settle.
+type Runners struct {
+	// Scrape runs athletes, active and events jobs.
+	Scrape func(ctx context.Context, job scraper.Job) (scraper.Summary, error)
+	// Settle runs results jobs.
+	Settle func(ctx context.Context, job results.Job) (results.Counts, error)
+}
+
+// JobStatus is the state of a job shown by the admin endpoint.
+type JobStatus struct {
+	JobConfig
+	Next    *time.Time `json:"next,omitempty"`
+	Running bool       `json:"running"`
+	Last    *Run       `json:"last,omitempty"`
+}
+
+type job struct {
+	cfg      JobConfig
+	schedule *cron.Schedule
+	scrape   scraper.Job
+	settle   results.Job
+}
+
+// Daemon runs jobs on their schedules or on demand, one at a time, and records every run in the history.
+type Daemon struct {
+	jobs    []*job
+	byName  map[string]*job
+	run     Runners
+	history *History
+	loc     *time.Location
+	l       *zap.SugaredLogger
+
+	mu      sync.Mutex
+	ctx     context.Context
+	running string // name of the running job
+	wg      sync.WaitGroup
+}
+
+// New checks the jobs and creates a daemon running them with run. Schedules are evaluated in loc,
+// nil loc is the local time zone.
+func New(cfgs []JobConfig, run Runners, history *History, loc *time.Location, l *zap.SugaredLogger) (*Daemon, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+
+	d := &Daemon{
+		byName:  make(map[string]*job, len(cfgs)),
+		run:     run,
+		history: history,
+		loc:     loc,
+		l:       l,
+	}
+
+	for _, cfg := range cfgs {
+		j, err := cfg.parse()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := d.byName[cfg.Name]; ok {
+			return nil, fmt.Errorf("%w: duplicate job %q", ErrInvalidJob, cfg.Name)
+		}
+
+		d.jobs = append(d.jobs, j)
+		d.byName[cfg.Name] = j
+	}
+
+	return d, nil
+}
+
+// Start schedules the jobs until ctx is done, runs started before are cancelled with ctx.
+// Use Wait to wait for the running job after ctx is done.
+func (d *Daemon) Start(ctx context.Context) {
+	d.mu.Lock()
+	d.ctx = ctx
+	d.mu.Unlock()
+
+	for _, j := range d.jobs {
+		d.wg.Add(1)
+		go d.schedule(ctx, j)
+	}
+}
+
+// Wait waits for the scheduling loops and the running job to stop.
+func (d *Daemon) Wait() {
+	d.wg.Wait()
+}
+
+// Trigger starts the job in the background and returns its run. If another job is running,
+// a skipped run is recorded and ErrRunning is returned.
+func (d *Daemon) Trigger(name string, trigger Trigger) (Run, error) {
+	j, ok := d.byName[name]
+	if !ok {
+		return Run{}, fmt.Errorf("%w %q", ErrUnknownJob, name)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.ctx == nil || d.ctx.Err() != nil {
+		return Run{}, ErrStopped
+	}
+
+	now := time.Now()
+	r := Run{
+		Job:       name,
+		Trigger:   trigger,
+		Status:    StatusRunning,
+		StartedAt: now,
+	}
+
+	if d.running != "" {
+		r.Status = StatusSkipped
+		r.FinishedAt = &now
+		r.Error = fmt.Sprintf("job %q is running", d.running)
+
+		if _, err := d.history.add(r); err != nil {
+			d.l.Errorw("Failed to save run history", "job", name, "error", err)
+		}
+
+		return r, fmt.Errorf("%w: %s", ErrRunning, d.running)
+	}
+
+	r, err := d.history.add(r)
+	if err != nil {
+		d.l.Errorw("Failed to save run history", "job", name, "error", err)
+	}
+
+	d.running = name
+	d.wg.Add(1)
+
+	go d.execute(d.ctx, j, r)
+
+	return r, nil
+}
+
+// Jobs returns the state of all jobs in the config order.
+func (d *Daemon) Jobs() []JobStatus {
+	d.mu.Lock()
+	running := d.running
+	d.mu.Unlock()
+
+	now := time.Now().In(d.loc)
+	statuses := make([]JobStatus, 0, len(d.jobs))
+
+	for _, j := range d.jobs {
+		s := JobStatus{
+			JobConfig: j.cfg,
+			Running:   j.cfg.Name == running,
+		}
+
+		if next := j.schedule.Next(now); !next.IsZero() {
+			s.Next = &next
+		}
+
+		if last, ok := d.history.Last(j.cfg.Name); ok {
+			s.Last = &last
+		}
+
+		statuses = append(statuses, s)
+	}
+
+	return statuses
+}
+
+// History returns the run history of the daemon.
+func (d *Daemon) History() *History {
+	return d.history
+}
+
+// schedule triggers the job at the times of its schedule until ctx is done.
+func (d *Daemon) schedule(ctx context.Context, j *job) {
+	defer d.wg.Done()
+
+	for {
+		next := j.schedule.Next(time.Now().In(d.loc))
+		if next.IsZero() {
+			d.l.Warnw("Job is never scheduled", "job", j.cfg.Name, "schedule", j.cfg.Schedule)
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if _, err := d.Trigger(j.cfg.Name, TriggerSchedule); err != nil {
+			d.l.Warnw("Scheduled job is not run", "job", j.cfg.Name, "error", err)
+		}
+	}
+}
+
+// execute runs the job and records the result of the run.
+func (d *Daemon) execute(ctx context.Context, j *job, r Run) {
+	defer d.wg.Done()
+
+	d.l.Infow("Job started", "job", r.Job, "trigger", r.Trigger, "run", r.ID)
+
+	var err error
+	if j.cfg.Kind == KindResults {
+		var counts results.Counts
+		counts, err = d.run.Settle(ctx, j.settle)
+		r.Results = &counts
+	} else {
+		var summary scraper.Summary
+		summary, err = d.run.Scrape(ctx, j.scrape)
+		r.Counts = summary.Total()
+		r.Fighters = summary.Validation.Fighters
+		r.Invalid = summary.Validation.Invalid
+	}
+
+	finished := time.Now()
+	r.FinishedAt = &finished
+	r.DurationMS = finished.Sub(r.StartedAt).Milliseconds()
+	r.Status = StatusSucceeded
+
+	if err != nil {
+		r.Status = StatusFailed
+		r.Error = err.Error()
+		d.l.Errorw("Job failed", "job", r.Job, "run", r.ID, "duration", finished.Sub(r.StartedAt), "error", err)
+	} else {
+		d.l.Infow("Job finished", "job", r.Job, "run", r.ID, "duration", finished.Sub(r.StartedAt),
+			"written", r.Counts.Written, "failed", r.Counts.Failed)
+	}
+
+	// the run is finished in the history before the next job can start
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := d.history.update(r); err != nil {
+		d.l.Errorw("Failed to save run history", "job", r.Job, "error", err)
+	}
+
+	d.running = ""
+}
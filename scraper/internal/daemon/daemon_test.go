@@ -0,0 +1,294 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"pickfighter.com/scraper/internal/results"
+	"pickfighter.com/scraper/internal/scraper"
+	"pickfighter.com/scraper/internal/source"
+	"pickfighter.com/scraper/internal/validate"
+)
+
+// blockingRun scrapes until release is closed and sends started jobs to the channel.
+type blockingRun struct {
+	started chan scraper.Job
+	release chan struct{}
+	err     error
+}
+
+func newBlockingRun() *blockingRun {
+	return &blockingRun{
+		started: make(chan scraper.Job, 10),
+		release: make(chan struct{}),
+	}
+}
+
+func (b *blockingRun) run(ctx context.Context, job scraper.Job) (scraper.Summary, error) {
+	b.started <- job
+
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+		return scraper.Summary{}, ctx.Err()
+	}
+
+	return scraper.Summary{
+		Sources:    map[string]scraper.Result{"ufc": {Athletes: 3, Written: 2, Failed: 1}},
+		Validation: validate.Report{Fighters: 2, Invalid: 1},
+	}, b.err
+}
+
+// settleRun settles results jobs with fixed counts and sends started jobs to the channel.
+type settleRun struct {
+	started chan results.Job
+}
+
+func (s *settleRun) run(_ context.Context, job results.Job) (results.Counts, error) {
+	s.started <- job
+	return results.Counts{Events: 2, Bouts: 5, Applied: 1, Queued: 3, Unmatched: 1}, nil
+}
+
+func newTestDaemon(t *testing.T, run Runners) (*Daemon, context.CancelFunc) {
+	t.Helper()
+
+	if run.Settle == nil {
+		run.Settle = (&settleRun{started: make(chan results.Job, 10)}).run
+	}
+
+	history, err := OpenHistory("", 0)
+	require.NoError(t, err)
+
+	d, err := New(DefaultJobs, run, history, time.UTC, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d.Start(ctx)
+
+	t.Cleanup(func() {
+		cancel()
+		d.Wait()
+	})
+
+	return d, cancel
+}
+
+func waitRun(t *testing.T, h *History, job string, status Status) Run {
+	t.Helper()
+
+	var last Run
+	require.Eventually(t, func() bool {
+		var ok bool
+		last, ok = h.Last(job)
+		return ok && last.Status == status
+	}, time.Second, time.Millisecond)
+
+	return last
+}
+
+func TestNew(t *testing.T) {
+	l := zap.NewNop().Sugar()
+
+	tests := []struct {
+		name string
+		cfgs []JobConfig
+		err  error
+	}{
+		{"defaults", DefaultJobs, nil},
+		{"no name", []JobConfig{{Kind: KindEvents, Schedule: "@hourly"}}, ErrInvalidJob},
+		{"bad schedule", []JobConfig{{Name: "a", Kind: KindEvents, Schedule: "* *"}}, ErrInvalidJob},
+		{"unknown kind", []JobConfig{{Name: "a", Kind: "fights", Schedule: "@hourly"}}, ErrUnknownKind},
+		{"duplicate", []JobConfig{
+			{Name: "a", Kind: KindEvents, Schedule: "@hourly"},
+			{Name: "a", Kind: KindActive, Schedule: "@daily"},
+		}, ErrInvalidJob},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfgs, Runners{}, nil, nil, l)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestJobConfig(t *testing.T) {
+	byName := make(map[string]scraper.Job)
+	for _, cfg := range DefaultJobs {
+		job, err := cfg.scrapeJob()
+		require.NoError(t, err)
+
+		byName[cfg.Name] = job
+	}
+
+	assert.Equal(t, scraper.Job{Name: "athletes"}, byName["athletes"])
+	assert.Equal(t, scraper.Job{
+		Name:        "active",
+		Sources:     []string{source.NameUFC},
+		StartURL:    source.UFCActiveListingURL,
+		Incremental: true,
+		Append:      true,
+	}, byName["active"])
+	assert.Equal(t, []string{source.NameUFCEvents}, byName["events"].Sources)
+
+	cfg := JobConfig{Name: "results", Kind: KindResults, Schedule: "@hourly", StartURL: "https://www.ufc.com/event/ufc-300", AutoApply: true}
+	assert.Equal(t, results.Job{AutoApply: true, EventURLs: []string{"https://www.ufc.com/event/ufc-300"}}, cfg.resultsJob())
+}
+
+func TestDaemonTrigger(t *testing.T) {
+	b := newBlockingRun()
+	d, _ := newTestDaemon(t, Runners{Scrape: b.run})
+
+	run, err := d.Trigger("active", TriggerManual)
+	require.NoError(t, err)
+	assert.Equal(t, StatusRunning, run.Status)
+	assert.Equal(t, "active", (<-b.started).Name)
+
+	// no overlapping runs, the skipped one is recorded
+	_, err = d.Trigger("events", TriggerSchedule)
+	assert.ErrorIs(t, err, ErrRunning)
+
+	skipped, ok := d.History().Last("events")
+	require.True(t, ok)
+	assert.Equal(t, StatusSkipped, skipped.Status)
+
+	_, err = d.Trigger("fights", TriggerManual)
+	assert.ErrorIs(t, err, ErrUnknownJob)
+
+	close(b.release)
+
+	last := waitRun(t, d.History(), "active", StatusSucceeded)
+	assert.Equal(t, run.ID, last.ID)
+	assert.Equal(t, int64(2), last.Counts.Written)
+	assert.Equal(t, 2, last.Fighters)
+	assert.Equal(t, 1, last.Invalid)
+	assert.NotNil(t, last.FinishedAt)
+
+	b.err = errors.New("budget exceeded")
+	_, err = d.Trigger("events", TriggerManual)
+	require.NoError(t, err)
+
+	failed := waitRun(t, d.History(), "events", StatusFailed)
+	assert.Equal(t, "budget exceeded", failed.Error)
+
+	runs := d.History().Runs("", 10)
+	require.Len(t, runs, 3)
+	assert.Equal(t, []int64{3, 2, 1}, []int64{runs[0].ID, runs[1].ID, runs[2].ID})
+}
+
+func TestDaemonResults(t *testing.T) {
+	s := &settleRun{started: make(chan results.Job, 1)}
+	d, _ := newTestDaemon(t, Runners{Scrape: newBlockingRun().run, Settle: s.run})
+
+	_, err := d.Trigger("results", TriggerManual)
+	require.NoError(t, err)
+	assert.Equal(t, results.Job{PastEvents: results.DefaultPastEvents}, <-s.started)
+
+	last := waitRun(t, d.History(), "results", StatusSucceeded)
+	require.NotNil(t, last.Results)
+	assert.Equal(t, 3, last.Results.Queued)
+	assert.Zero(t, last.Counts, "scrape counts are not set by results jobs")
+}
+
+func TestDaemonStop(t *testing.T) {
+	b := newBlockingRun()
+	d, cancel := newTestDaemon(t, Runners{Scrape: b.run})
+
+	_, err := d.Trigger("athletes", TriggerManual)
+	require.NoError(t, err)
+	<-b.started
+
+	cancel()
+	d.Wait()
+
+	last, _ := d.History().Last("athletes")
+	assert.Equal(t, StatusFailed, last.Status)
+
+	_, err = d.Trigger("athletes", TriggerManual)
+	assert.ErrorIs(t, err, ErrStopped)
+}
+
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.json")
+
+	h, err := OpenHistory(path, 2)
+	require.NoError(t, err)
+
+	for _, job := range []string{"a", "b", "c"} {
+		_, err := h.add(Run{Job: job, Status: StatusRunning})
+		require.NoError(t, err)
+	}
+
+	h, err = OpenHistory(path, 2)
+	require.NoError(t, err)
+
+	runs := h.Runs("", 10)
+	require.Len(t, runs, 2, "history is trimmed to the limit")
+	assert.Equal(t, "c", runs[0].Job)
+	assert.Equal(t, StatusFailed, runs[0].Status, "interrupted runs are failed")
+
+	r, err := h.add(Run{Job: "d"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), r.ID, "IDs continue after the stored runs")
+}
+
+func TestHandler(t *testing.T) {
+	b := newBlockingRun()
+	d, _ := newTestDaemon(t, Runners{Scrape: b.run})
+	h := NewHandler(d, "secret")
+
+	serve := func(method, target, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/health", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/jobs", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/jobs", "wrong").Code)
+
+	w := serve(http.MethodGet, "/jobs", "secret")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var jobs []JobStatus
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &jobs))
+	require.Len(t, jobs, len(DefaultJobs))
+	assert.NotNil(t, jobs[0].Next)
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/jobs/events/run", "secret").Code)
+	<-b.started
+	assert.Equal(t, http.StatusConflict, serve(http.MethodPost, "/jobs/active/run", "secret").Code)
+	assert.Equal(t, http.StatusNotFound, serve(http.MethodPost, "/jobs/fights/run", "secret").Code)
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodGet, "/runs?limit=x", "secret").Code)
+
+	close(b.release)
+	waitRun(t, d.History(), "events", StatusSucceeded)
+
+	w = serve(http.MethodGet, "/runs?job=events&limit=1", "secret")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var runs []Run
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &runs))
+	require.Len(t, runs, 1)
+	assert.Equal(t, StatusSucceeded, runs[0].Status)
+	assert.Equal(t, int64(3), runs[0].Counts.Athletes)
+}
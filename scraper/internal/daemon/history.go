@@ -0,0 +1,185 @@
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"pickfighter.com/scraper/internal/results"
+	"pickfighter.com/scraper/internal/scraper"
+)
+
+// DefaultHistoryPath is the history file used when no path is configured.
+const DefaultHistoryPath = "./collection/history.json"
+
+// DefaultHistoryLimit is the number of runs kept in the history.
+const DefaultHistoryLimit = 500
+
+// Status of a job run.
+type Status string
+
+// Run statuses. A run is skipped if it was triggered while another job was running.
+const (
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusSkipped   Status = "skipped"
+)
+
+// Trigger tells why a job was run.
+type Trigger string
+
+const (
+	TriggerSchedule Trigger = "schedule"
+	TriggerManual   Trigger = "manual"
+)
+
+// Run is a job run in the history.
+type Run struct {
+	ID         int64          `json:"id"`
+	Job        string         `json:"job"`
+	Trigger    Trigger        `json:"trigger"`
+	Status     Status         `json:"status"`
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt *time.Time     `json:"finished_at,omitempty"`
+	DurationMS int64          `json:"duration_ms"`
+	Counts     scraper.Result `json:"counts"`
+	Fighters   int            `json:"fighters"`
+	Invalid    int            `json:"invalid"`
+	// Results are counts of results jobs.
+	Results *results.Counts `json:"results,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// History keeps the last job runs, newest last, and saves them to a file after every change.
+type History struct {
+	path  string
+	limit int
+
+	mu     sync.Mutex
+	runs   []Run
+	lastID int64
+}
+
+// OpenHistory reads the history file at path, a missing file is an empty history and an empty path
+// keeps the history in memory. Runs left running by a stopped daemon are marked as failed.
+// Zero limit means DefaultHistoryLimit.
+func OpenHistory(path string, limit int) (*History, error) {
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+
+	h := &History{
+		path:  path,
+		limit: limit,
+	}
+
+	if path == "" {
+		return h, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &h.runs); err != nil {
+		return nil, err
+	}
+
+	for i := range h.runs {
+		if h.runs[i].Status == StatusRunning {
+			h.runs[i].Status = StatusFailed
+			h.runs[i].Error = "interrupted by the daemon shutdown"
+		}
+
+		h.lastID = max(h.lastID, h.runs[i].ID)
+	}
+
+	return h, nil
+}
+
+// Runs returns up to limit runs of the job, all jobs if job is empty, newest first.
+func (h *History) Runs(job string, limit int) []Run {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	runs := make([]Run, 0, min(limit, len(h.runs)))
+	for i := len(h.runs) - 1; i >= 0 && len(runs) < limit; i-- {
+		if job == "" || h.runs[i].Job == job {
+			runs = append(runs, h.runs[i])
+		}
+	}
+
+	return runs
+}
+
+// Last returns the last run of the job.
+func (h *History) Last(job string) (Run, bool) {
+	runs := h.Runs(job, 1)
+	if len(runs) == 0 {
+		return Run{}, false
+	}
+
+	return runs[0], true
+}
+
+// add stores a new run with the next ID and returns it.
+func (h *History) add(r Run) (Run, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastID++
+	r.ID = h.lastID
+
+	h.runs = append(h.runs, r)
+	if len(h.runs) > h.limit {
+		h.runs = append([]Run(nil), h.runs[len(h.runs)-h.limit:]...)
+	}
+
+	return r, h.save()
+}
+
+// update replaces the stored run with the same ID.
+func (h *History) update(r Run) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i := len(h.runs) - 1; i >= 0; i-- {
+		if h.runs[i].ID == r.ID {
+			h.runs[i] = r
+			break
+		}
+	}
+
+	return h.save()
+}
+
+// save writes the runs to a temporary file and renames it, so a crash doesn't leave a broken history.
+func (h *History) save() error {
+	if h.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(h.runs, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := h.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, h.path)
+}
@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"pickfighter.com/pkg/httplib"
+
+	"github.com/gorilla/mux"
+)
+
+// Internal error codes of the admin endpoint.
+const (
+	codeToken      = 1100
+	codeQueryLimit = 1101
+	codeUnknownJob = 1102
+	codeRunning    = 1103
+	codeStopped    = 1104
+)
+
+// DefaultRunsLimit is the number of runs returned by GET /runs without the limit parameter.
+const DefaultRunsLimit = 20
+
+// NewHandler creates the admin endpoint of the daemon:
+//
+//	GET  /health            - liveness probe
+//	GET  /jobs              - jobs with their next run, running state and last run
+//	GET  /runs?job=&limit=  - last runs, newest first
+//	POST /jobs/{name}/run   - triggers the job, 409 if another job is running
+//
+// If token is set, requests except /health need the "Authorization: Bearer <token>" header.
+func NewHandler(d *Daemon, token string) http.Handler {
+	r := mux.NewRouter()
+
+	r.HandleFunc("/health", func(w http.ResponseWriter, _ *http.Request) {
+		httplib.ResponseJSON(w, map[string]string{"status": "ok"})
+	}).Methods(http.MethodGet)
+
+	admin := r.NewRoute().Subrouter()
+	admin.Use(requireToken(token))
+
+	admin.HandleFunc("/jobs", func(w http.ResponseWriter, _ *http.Request) {
+		httplib.ResponseJSON(w, d.Jobs())
+	}).Methods(http.MethodGet)
+
+	admin.HandleFunc("/runs", func(w http.ResponseWriter, req *http.Request) {
+		limit := DefaultRunsLimit
+		if s := req.URL.Query().Get("limit"); s != "" {
+			var err error
+			if limit, err = strconv.Atoi(s); err != nil || limit <= 0 {
+				httplib.ErrorResponseJSON(w, http.StatusBadRequest, codeQueryLimit, errors.New("limit must be a positive number"))
+				return
+			}
+		}
+
+		httplib.ResponseJSON(w, d.History().Runs(req.URL.Query().Get("job"), limit))
+	}).Methods(http.MethodGet)
+
+	admin.HandleFunc("/jobs/{name}/run", func(w http.ResponseWriter, req *http.Request) {
+		run, err := d.Trigger(mux.Vars(req)["name"], TriggerManual)
+
+		switch {
+		case errors.Is(err, ErrUnknownJob):
+			httplib.ErrorResponseJSON(w, http.StatusNotFound, codeUnknownJob, err)
+		case errors.Is(err, ErrRunning):
+			httplib.ErrorResponseJSON(w, http.StatusConflict, codeRunning, err)
+		case err != nil:
+			httplib.ErrorResponseJSON(w, http.StatusServiceUnavailable, codeStopped, err)
+		default:
+			httplib.ResponseJSON(w, run)
+		}
+	}).Methods(http.MethodPost)
+
+	return r
+}
+
+// requireToken rejects requests without the bearer token, an empty token allows all requests.
+func requireToken(token string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		if token == "" {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				httplib.ErrorResponseJSON(w, http.StatusUnauthorized, codeToken, errors.New("invalid admin token"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
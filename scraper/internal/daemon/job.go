@@ -0,0 +1,129 @@
+// Package daemon runs scraper jobs on cron schedules, keeps the history of their runs
+// and serves an admin endpoint to trigger jobs and view the results.
+package daemon
+
+import (
+	"errors"
+	"fmt"
+
+	"pickfighter.com/scraper/internal/cron"
+	"pickfighter.com/scraper/internal/results"
+	"pickfighter.com/scraper/internal/scraper"
+	"pickfighter.com/scraper/internal/source"
+)
+
+// Kind defines what a job scrapes.
+type Kind string
+
+// Supported job kinds.
+const (
+	// KindAthletes refreshes all athletes of the configured sources and replaces the output.
+	KindAthletes Kind = "athletes"
+	// KindActive refreshes active ufc.com athletes whose pages changed since the last run of the job.
+	KindActive Kind = "active"
+	// KindEvents refreshes fighters of the current ufc.com event card, it is meant for fight nights.
+	KindEvents Kind = "events"
+	// KindResults submits results of finished bouts of the current and the latest ufc.com events
+	// to the events service.
+	KindResults Kind = "results"
+)
+
+var (
+	ErrUnknownKind = errors.New("unknown job kind")
+	ErrInvalidJob  = errors.New("invalid job")
+)
+
+// JobConfig defines a job of the serve.jobs config.
+type JobConfig struct {
+	Name     string `mapstructure:"name" json:"name"`
+	Kind     Kind   `mapstructure:"kind" json:"kind"`
+	Schedule string `mapstructure:"schedule" json:"schedule"`
+	// Sources and StartURL override sources and the first listing page of the kind.
+	Sources  []string `mapstructure:"sources" json:"sources,omitempty"`
+	StartURL string   `mapstructure:"start_url" json:"start_url,omitempty"`
+	// AutoApply and PastEvents are settings of results jobs, see results.Job.
+	AutoApply  bool `mapstructure:"auto_apply" json:"auto_apply,omitempty"`
+	PastEvents int  `mapstructure:"past_events" json:"past_events,omitempty"`
+}
+
+// DefaultJobs refresh all athletes weekly, active athletes daily and event cards hourly on Saturday and Sunday,
+// UFC fight nights in American evenings are Sunday in UTC. Results are checked every 15 minutes between the event
+// card refreshes and queued for an admin approval.
+var DefaultJobs = []JobConfig{
+	{Name: "athletes", Kind: KindAthletes, Schedule: "0 3 * * mon"},
+	{Name: "active", Kind: KindActive, Schedule: "0 5 * * *"},
+	{Name: "events", Kind: KindEvents, Schedule: "0 * * * sat,sun"},
+	{Name: "results", Kind: KindResults, Schedule: "5-59/15 * * * sat,sun", PastEvents: results.DefaultPastEvents},
+}
+
+// scrapeJob converts the config into a scraper job, partial refreshes add fighters to the output.
+func (c JobConfig) scrapeJob() (scraper.Job, error) {
+	job := scraper.Job{
+		Name:     c.Name,
+		Sources:  c.Sources,
+		StartURL: c.StartURL,
+	}
+
+	switch c.Kind {
+	case KindAthletes, KindResults:
+	case KindActive:
+		if len(job.Sources) == 0 {
+			job.Sources = []string{source.NameUFC}
+		}
+		if job.StartURL == "" {
+			job.StartURL = source.UFCActiveListingURL
+		}
+
+		job.Incremental = true
+		job.Append = true
+	case KindEvents:
+		if len(job.Sources) == 0 {
+			job.Sources = []string{source.NameUFCEvents}
+		}
+
+		job.Incremental = true
+		job.Append = true
+	default:
+		return scraper.Job{}, fmt.Errorf("%w %q of job %q", ErrUnknownKind, c.Kind, c.Name)
+	}
+
+	return job, nil
+}
+
+// resultsJob converts the config of a results job.
+func (c JobConfig) resultsJob() results.Job {
+	job := results.Job{
+		AutoApply:  c.AutoApply,
+		PastEvents: c.PastEvents,
+	}
+
+	if c.StartURL != "" {
+		job.EventURLs = []string{c.StartURL}
+	}
+
+	return job
+}
+
+// parse checks the config and parses its schedule.
+func (c JobConfig) parse() (*job, error) {
+	if c.Name == "" {
+		return nil, fmt.Errorf("%w: job without a name", ErrInvalidJob)
+	}
+
+	schedule, err := cron.Parse(c.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrInvalidJob, c.Name, err)
+	}
+
+	scrape, err := c.scrapeJob()
+	if err != nil {
+		return nil, err
+	}
+
+	return &job{
+		cfg:      c,
+		schedule: schedule,
+		scrape:   scrape,
+		settle:   c.resultsJob(),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"pickfighter.com/scraper/internal/results"
+	"pickfighter.com/scraper/internal/scraper"
+	"pickfighter.com/scraper/internal/scraperutil"
+	"pickfighter.com/scraper/pkg/logger"
+
+	"github.com/spf13/viper"
+)
+
+// Defaults of the serve command.
+const (
+	DefaultAddress  = "127.0.0.1:8090"
+	shutdownTimeout = 15 * time.Second
+)
+
+// Serve runs the jobs of serve.jobs with scraper.Scrape and the admin endpoint on serve.address
+// until the process is interrupted. The running job is cancelled on shutdown, if it doesn't stop
+// within 15 seconds the process exits with status 1.
+func Serve() error {
+	if err := logger.Initialize(scraperutil.GetLoggerFlag(true)); err != nil {
+		return fmt.Errorf("initialize logger: %w", err)
+	}
+	l := logger.Get()
+
+	var jobs []JobConfig
+	if err := viper.UnmarshalKey("serve.jobs", &jobs); err != nil {
+		return fmt.Errorf("read serve.jobs: %w", err)
+	}
+
+	loc, err := time.LoadLocation(viper.GetString("serve.timezone"))
+	if err != nil {
+		return fmt.Errorf("read serve.timezone: %w", err)
+	}
+
+	history, err := OpenHistory(viper.GetString("serve.history"), viper.GetInt("serve.history_limit"))
+	if err != nil {
+		return fmt.Errorf("open run history: %w", err)
+	}
+
+	run := Runners{
+		Scrape: func(ctx context.Context, job scraper.Job) (scraper.Summary, error) {
+			return scraper.Scrape(ctx, job, l)
+		},
+		Settle: func(ctx context.Context, job results.Job) (results.Counts, error) {
+			return results.Settle(ctx, job, l)
+		},
+	}
+
+	d, err := New(jobs, run, history, loc, l)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server := &http.Server{
+		Addr:              viper.GetString("serve.address"),
+		Handler:           NewHandler(d, viper.GetString("serve.token")),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
+	d.Start(ctx)
+
+	for _, s := range d.Jobs() {
+		l.Infow("Job scheduled", "job", s.Name, "kind", s.Kind, "schedule", s.Schedule, "next", s.Next)
+	}
+	fmt.Println("Admin endpoint is listening on", server.Addr)
+
+	select {
+	case <-ctx.Done():
+	case err = <-serveErr:
+		stop()
+		err = fmt.Errorf("admin endpoint: %w", err)
+	}
+
+	fmt.Println("Stopping scrape jobs")
+
+	time.AfterFunc(shutdownTimeout, func() {
+		l.Errorw("Failed to shutdown normally, closed after the timeout", "timeout", shutdownTimeout)
+		os.Exit(1)
+	})
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if shutdownErr := server.Shutdown(shutdownCtx); shutdownErr != nil && !errors.Is(shutdownErr, http.ErrServerClosed) {
+		err = errors.Join(err, fmt.Errorf("shutdown admin endpoint: %w", shutdownErr))
+	}
+
+	d.Wait()
+
+	return err
+}
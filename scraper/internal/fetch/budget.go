@@ -0,0 +1,79 @@
+package fetch
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+var ErrBudgetExceeded = errors.New("request budget exceeded")
+
+// BudgetConfig limits requests of a run, zero values disable a limit.
+type BudgetConfig struct {
+	MaxRequests int64
+	// MaxErrors counts failed attempts, requests answered with 429 or 5xx or failed with a network error.
+	MaxErrors int64
+}
+
+// Budget is a transport counting requests and failed attempts. When a limit is reached exceeded is called once
+// and every following request fails with ErrBudgetExceeded without being sent.
+type Budget struct {
+	cfg      BudgetConfig
+	next     http.RoundTripper
+	exceeded func(err error)
+
+	requests atomic.Int64
+	errors   atomic.Int64
+	once     sync.Once
+}
+
+// NewBudget creates a Budget, exceeded may be nil.
+func NewBudget(cfg BudgetConfig, next http.RoundTripper, exceeded func(err error)) *Budget {
+	if exceeded == nil {
+		exceeded = func(error) {}
+	}
+
+	return &Budget{
+		cfg:      cfg,
+		next:     next,
+		exceeded: exceeded,
+	}
+}
+
+// RoundTrip sends the request if the budget allows it.
+func (b *Budget) RoundTrip(req *http.Request) (*http.Response, error) {
+	if b.cfg.MaxErrors > 0 && b.errors.Load() >= b.cfg.MaxErrors {
+		return nil, b.exceed()
+	}
+
+	if n := b.requests.Add(1); b.cfg.MaxRequests > 0 && n > b.cfg.MaxRequests {
+		b.requests.Add(-1)
+		return nil, b.exceed()
+	}
+
+	resp, err := b.next.RoundTrip(req)
+	if Retryable(resp, err) {
+		b.errors.Add(1)
+	}
+
+	return resp, err
+}
+
+// Requests returns the number of sent requests.
+func (b *Budget) Requests() int64 {
+	return b.requests.Load()
+}
+
+// Errors returns the number of failed attempts.
+func (b *Budget) Errors() int64 {
+	return b.errors.Load()
+}
+
+func (b *Budget) exceed() error {
+	b.once.Do(func() {
+		b.exceeded(ErrBudgetExceeded)
+	})
+
+	return ErrBudgetExceeded
+}
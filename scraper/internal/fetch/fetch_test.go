@@ -0,0 +1,229 @@
+package fetch
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testRetry = RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+// statusServer answers requests with the statuses in order, the last one repeats.
+func statusServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int64) {
+	var calls atomic.Int64
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1)) - 1
+		status := statuses[min(n, len(statuses)-1)]
+
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "0")
+		}
+		w.WriteHeader(status)
+		fmt.Fprint(w, http.StatusText(status))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &calls
+}
+
+func get(t *testing.T, rt http.RoundTripper, rawURL string) (*http.Response, error) {
+	t.Helper()
+
+	resp, err := (&http.Client{Transport: rt}).Get(rawURL)
+	if err == nil {
+		t.Cleanup(func() { resp.Body.Close() })
+	}
+
+	return resp, err
+}
+
+func TestRetry(t *testing.T) {
+	testCases := []struct {
+		name     string
+		statuses []int
+		status   int
+		calls    int64
+	}{
+		{"success", []int{200}, 200, 1},
+		{"retried 5xx", []int{503, 502, 200}, 200, 3},
+		{"retried 429", []int{429, 200}, 200, 2},
+		{"attempts exhausted", []int{500}, 500, 3},
+		{"not retried 404", []int{404, 200}, 404, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, calls := statusServer(t, tc.statuses...)
+
+			resp, err := get(t, NewRetry(testRetry, http.DefaultTransport, zap.NewNop().Sugar()), srv.URL)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.status, resp.StatusCode)
+			assert.Equal(t, tc.calls, calls.Load())
+		})
+	}
+}
+
+func TestRetryTimeout(t *testing.T) {
+	var calls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := testRetry
+	cfg.Timeout = 50 * time.Millisecond
+
+	resp, err := get(t, NewRetry(cfg, http.DefaultTransport, zap.NewNop().Sugar()), srv.URL)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), retryAfter(""))
+	assert.Equal(t, 120*time.Second, retryAfter("120"))
+	assert.Equal(t, time.Duration(0), retryAfter("soon"))
+	assert.Equal(t, time.Duration(0), retryAfter(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)))
+
+	d := retryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.InDelta(t, time.Minute, d, float64(2*time.Second))
+}
+
+func TestProxyPool(t *testing.T) {
+	var proxied atomic.Int64
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		io.WriteString(w, "ok")
+	}))
+	t.Cleanup(good.Close)
+
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+
+	goodURL, _ := url.Parse(good.URL)
+	deadURL, _ := url.Parse(dead.URL)
+
+	pool := NewProxyPool([]*url.URL{deadURL, goodURL}, HealthConfig{MaxFailures: 2, Bench: time.Hour},
+		http.DefaultTransport.(*http.Transport), zap.NewNop().Sugar())
+
+	// proxies are random, so requests are sent until the dead one failed twice
+	failures := 0
+	for i := 0; i < 100 && failures < 2; i++ {
+		if _, err := get(t, pool, "http://fighters.test/athlete"); err != nil {
+			failures++
+		}
+	}
+	require.Equal(t, 2, failures)
+
+	before := proxied.Load()
+	for i := 0; i < 10; i++ {
+		_, err := get(t, pool, "http://fighters.test/athlete")
+		require.NoError(t, err, "the dead proxy must be benched after 2 failures")
+	}
+	assert.Equal(t, before+10, proxied.Load())
+
+	// the bench is over, a failure benches the proxy again at once
+	pool.proxies[0].benched = time.Now().Add(-time.Second)
+	pool.proxies[1].benched = time.Now().Add(time.Hour)
+
+	_, err := get(t, pool, "http://fighters.test/athlete")
+	assert.Error(t, err)
+	assert.True(t, pool.proxies[0].benched.After(time.Now()))
+
+	// all proxies are benched, the one recovering first is used
+	resp, err := get(t, pool, "http://fighters.test/athlete")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestBudget(t *testing.T) {
+	srv, calls := statusServer(t, 500, 500, 200)
+
+	var exceeded atomic.Int64
+	budget := NewBudget(BudgetConfig{MaxRequests: 5, MaxErrors: 2}, http.DefaultTransport, func(err error) {
+		assert.ErrorIs(t, err, ErrBudgetExceeded)
+		exceeded.Add(1)
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := get(t, budget, srv.URL)
+		require.NoError(t, err)
+	}
+
+	_, err := get(t, budget, srv.URL)
+	assert.ErrorIs(t, err, ErrBudgetExceeded)
+	_, err = get(t, budget, srv.URL)
+	assert.ErrorIs(t, err, ErrBudgetExceeded)
+
+	assert.Equal(t, int64(2), calls.Load())
+	assert.Equal(t, int64(2), budget.Requests())
+	assert.Equal(t, int64(2), budget.Errors())
+	assert.Equal(t, int64(1), exceeded.Load(), "exceeded must be called once")
+
+	srv, _ = statusServer(t, 200)
+	budget = NewBudget(BudgetConfig{MaxRequests: 1}, http.DefaultTransport, nil)
+
+	_, err = get(t, budget, srv.URL)
+	require.NoError(t, err)
+	_, err = get(t, budget, srv.URL)
+	assert.ErrorIs(t, err, ErrBudgetExceeded)
+	assert.Equal(t, int64(1), budget.Requests())
+}
+
+func TestRobots(t *testing.T) {
+	var robotsCalls atomic.Int64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/robots.txt", func(w http.ResponseWriter, r *http.Request) {
+		robotsCalls.Add(1)
+		fmt.Fprint(w, "User-agent: *\nDisallow: /private\n\nUser-agent: slowbot\nCrawl-delay: 1\n")
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "ok")
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	robots := NewRobots(http.DefaultTransport)
+
+	resp, err := get(t, robots, srv.URL+"/athlete")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = get(t, robots, srv.URL+"/private/page")
+	assert.ErrorIs(t, err, ErrDisallowed)
+	assert.Equal(t, int64(1), robotsCalls.Load(), "robots.txt must be fetched once")
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/athlete", nil)
+	require.NoError(t, err)
+	req.Header.Set("User-Agent", "slowbot")
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		resp, err := robots.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.GreaterOrEqual(t, time.Since(start), time.Second, "requests must be spaced by the crawl delay")
+}
+
+func TestRobotsNotFound(t *testing.T) {
+	srv, _ := statusServer(t, 404, 200)
+
+	_, err := get(t, NewRobots(http.DefaultTransport), srv.URL+"/private")
+	assert.NoError(t, err, "a missing robots.txt allows everything")
+}
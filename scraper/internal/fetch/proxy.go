@@ -0,0 +1,140 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Default proxy health settings.
+const (
+	DefaultMaxFailures = 3
+	DefaultBench       = 5 * time.Minute
+)
+
+// HealthConfig defines when a proxy is benched and for how long.
+type HealthConfig struct {
+	// MaxFailures is the number of consecutive failures benching a proxy.
+	MaxFailures int
+	// Bench is the time a benched proxy isn't used, then it gets a single request to prove it works again.
+	Bench time.Duration
+}
+
+// ProxyPool is a transport sending every request through a random healthy proxy. A proxy failing MaxFailures
+// requests in a row with a network error, 407 or 429 is benched. After the bench time it is used again,
+// a success recovers it, a failure benches it again. If all proxies are benched the one recovering first is used,
+// so the run slows down instead of stopping.
+type ProxyPool struct {
+	cfg     HealthConfig
+	proxies []*proxy
+	l       *zap.SugaredLogger
+
+	mu sync.Mutex
+}
+
+type proxy struct {
+	url       *url.URL
+	transport *http.Transport
+	failures  int
+	benched   time.Time
+}
+
+// NewProxyPool creates a pool of the proxy URLs, every proxy gets a clone of base, so they don't share connections.
+// Zero config values are replaced by defaults.
+func NewProxyPool(urls []*url.URL, cfg HealthConfig, base *http.Transport, l *zap.SugaredLogger) *ProxyPool {
+	if cfg.MaxFailures <= 0 {
+		cfg.MaxFailures = DefaultMaxFailures
+	}
+	if cfg.Bench <= 0 {
+		cfg.Bench = DefaultBench
+	}
+
+	p := &ProxyPool{
+		cfg: cfg,
+		l:   l,
+	}
+
+	for _, u := range urls {
+		t := base.Clone()
+		t.Proxy = http.ProxyURL(u)
+
+		p.proxies = append(p.proxies, &proxy{url: u, transport: t})
+	}
+
+	return p
+}
+
+// RoundTrip sends the request through a proxy of the pool and records the result in its health.
+func (p *ProxyPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	px := p.pick()
+
+	p.l.Infow(px.url.Host, "type", "proxy address")
+
+	resp, err := px.transport.RoundTrip(req)
+	// a cancelled run says nothing about the proxy
+	if !errors.Is(err, context.Canceled) {
+		p.report(px, proxyFailed(resp, err))
+	}
+
+	return resp, err
+}
+
+func (p *ProxyPool) pick() *proxy {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	healthy := make([]*proxy, 0, len(p.proxies))
+	first := p.proxies[0]
+
+	for _, px := range p.proxies {
+		if !px.benched.After(now) {
+			healthy = append(healthy, px)
+		}
+		if px.benched.Before(first.benched) {
+			first = px
+		}
+	}
+
+	if len(healthy) == 0 {
+		p.l.Warnw("All proxies are benched", "type", "proxy", "proxy", first.url.Host)
+		return first
+	}
+
+	return healthy[rand.Intn(len(healthy))]
+}
+
+func (p *ProxyPool) report(px *proxy, failed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !failed {
+		if px.failures >= p.cfg.MaxFailures {
+			p.l.Infow("Proxy recovered", "type", "proxy", "proxy", px.url.Host)
+		}
+
+		px.failures = 0
+		return
+	}
+
+	px.failures++
+	if px.failures >= p.cfg.MaxFailures {
+		px.benched = time.Now().Add(p.cfg.Bench)
+		p.l.Warnw("Proxy benched", "type", "proxy", "proxy", px.url.Host, "failures", px.failures, "until", px.benched)
+	}
+}
+
+// proxyFailed reports whether the result points to a broken or blocked proxy, errors of the site like 5xx don't.
+func proxyFailed(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusProxyAuthRequired || resp.StatusCode == http.StatusTooManyRequests
+}
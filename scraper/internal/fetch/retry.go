@@ -0,0 +1,186 @@
+// Package fetch provides transports making the scraper resilient and polite: retries with backoff,
+// a pool of proxies with health tracking, robots.txt rules and a budget of requests per run.
+// Transports wrap each other, so they are combined in the order the scraper needs.
+package fetch
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Default retry settings.
+const (
+	DefaultMaxAttempts = 4
+	DefaultBaseDelay   = time.Second
+	DefaultMaxDelay    = 30 * time.Second
+	DefaultTimeout     = 30 * time.Second
+)
+
+// RetryConfig defines retries of failed requests. Delays grow exponentially from BaseDelay up to MaxDelay.
+type RetryConfig struct {
+	// MaxAttempts is the number of attempts including the first one, 1 disables retries.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// Timeout limits every attempt including reading the body.
+	Timeout time.Duration
+}
+
+// Retry is a transport retrying GET and HEAD requests failed with 429, 5xx or a network error like a timeout.
+// A Retry-After header of the response replaces the backoff delay if it is longer, both are capped by MaxDelay.
+type Retry struct {
+	cfg  RetryConfig
+	next http.RoundTripper
+	l    *zap.SugaredLogger
+}
+
+// NewRetry creates a Retry, zero config values are replaced by defaults.
+func NewRetry(cfg RetryConfig, next http.RoundTripper, l *zap.SugaredLogger) *Retry {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = DefaultBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = DefaultMaxDelay
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	return &Retry{
+		cfg:  cfg,
+		next: next,
+		l:    l,
+	}
+}
+
+// RoundTrip sends the request until it succeeds, fails with a permanent error or runs out of attempts.
+// The last response or error is returned.
+func (r *Retry) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return r.attempt(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := r.attempt(req)
+		if attempt >= r.cfg.MaxAttempts || !Retryable(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		delay := r.backoff(attempt)
+		if resp != nil {
+			if after := retryAfter(resp.Header.Get("Retry-After")); after > delay {
+				delay = min(after, r.cfg.MaxDelay)
+			}
+
+			// the body is drained, so the connection can be reused by the next attempt
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		r.l.Warnw("Retrying request", "url", req.URL.String(), "attempt", attempt, "delay", delay, "status", status(resp), "error", err)
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends the request once, the attempt context is cancelled when the response body is closed.
+func (r *Retry) attempt(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), r.cfg.Timeout)
+
+	resp, err := r.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// backoff returns the delay before the next attempt: the exponential delay of the attempt,
+// half of it is random, so workers hitting the same error don't retry at the same time.
+func (r *Retry) backoff(attempt int) time.Duration {
+	d := r.cfg.MaxDelay
+	if shift := attempt - 1; shift < 32 {
+		d = min(r.cfg.BaseDelay<<shift, r.cfg.MaxDelay)
+	}
+
+	half := d / 2
+
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// Retryable reports whether a request failed with a temporary error: 429, 5xx, a timeout or a broken connection.
+func Retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		var netErr net.Error
+		return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) ||
+			errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date, 0 means no header.
+func retryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0)
+	}
+
+	return 0
+}
+
+func status(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+
+	return resp.StatusCode
+}
+
+// sleep waits for the delay or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
@@ -0,0 +1,119 @@
+package fetch
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/temoto/robotstxt"
+)
+
+var ErrDisallowed = errors.New("disallowed by robots.txt")
+
+// Robots is a transport respecting robots.txt of every host: disallowed pages fail with ErrDisallowed
+// and requests to a host are spaced by its Crawl-delay. Rules are matched with the User-Agent of the request.
+// robots.txt is fetched with the next transport once per host following redirects, a 4xx allows everything,
+// a 5xx or a failed fetch fails the request and robots.txt is fetched again by the next one.
+type Robots struct {
+	next http.RoundTripper
+
+	mu    sync.Mutex
+	hosts map[string]*robotsHost
+}
+
+type robotsHost struct {
+	mu   sync.Mutex
+	data *robotstxt.RobotsData
+	// next is the earliest time of the next request in the crawl delay.
+	next time.Time
+}
+
+// NewRobots creates a Robots transport.
+func NewRobots(next http.RoundTripper) *Robots {
+	return &Robots{
+		next:  next,
+		hosts: make(map[string]*robotsHost),
+	}
+}
+
+// RoundTrip sends the request if robots.txt allows it, waiting for the crawl delay of the host.
+func (r *Robots) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path == "/robots.txt" {
+		return r.next.RoundTrip(req)
+	}
+
+	h := r.host(req.URL.Scheme + "://" + req.URL.Host)
+	agent := req.Header.Get("User-Agent")
+
+	h.mu.Lock()
+	if h.data == nil {
+		data, err := r.fetch(req)
+		if err != nil {
+			h.mu.Unlock()
+			return nil, err
+		}
+
+		h.data = data
+	}
+
+	if !h.data.TestAgent(req.URL.RequestURI(), agent) {
+		h.mu.Unlock()
+		return nil, fmt.Errorf("%w: %s", ErrDisallowed, req.URL)
+	}
+
+	wait := time.Until(h.next)
+	if delay := h.data.FindGroup(agent).CrawlDelay; delay > 0 {
+		h.next = time.Now().Add(max(wait, 0) + delay)
+	}
+	h.mu.Unlock()
+
+	if wait > 0 {
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.next.RoundTrip(req)
+}
+
+func (r *Robots) host(origin string) *robotsHost {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h, ok := r.hosts[origin]
+	if !ok {
+		h = &robotsHost{}
+		r.hosts[origin] = h
+	}
+
+	return h
+}
+
+// fetch requests robots.txt of the request host, a server error is returned as an error, so it isn't cached.
+func (r *Robots) fetch(req *http.Request) (*robotstxt.RobotsData, error) {
+	robotsReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, req.URL.Scheme+"://"+req.URL.Host+"/robots.txt", nil)
+	if err != nil {
+		return nil, err
+	}
+	robotsReq.Header.Set("User-Agent", req.Header.Get("User-Agent"))
+
+	resp, err := (&http.Client{Transport: r.next}).Do(robotsReq)
+	if err != nil {
+		return nil, fmt.Errorf("fetch robots.txt: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read robots.txt: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, fmt.Errorf("fetch robots.txt: %s", resp.Status)
+	}
+
+	return robotstxt.FromStatusAndBytes(resp.StatusCode, body)
+}
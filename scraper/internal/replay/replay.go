@@ -0,0 +1,175 @@
+// Package replay records fetched pages to disk and serves them back, so the scraper can run
+// and be tested without the live site.
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxNameLength keeps file names of long URLs below file system limits.
+const maxNameLength = 160
+
+var ErrNotRecorded = errors.New("page is not recorded")
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9._=-]+`)
+
+// Path returns the file of the URL in dir. Files are grouped by host and named after the path and query,
+// e.g. www.ufc.com/athlete_khabib-nurmagomedov.http, so recorded pages are easy to find.
+func Path(dir, rawURL string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return path(dir, req), nil
+}
+
+func path(dir string, req *http.Request) string {
+	name := req.URL.EscapedPath()
+	if req.URL.RawQuery != "" {
+		name += "?" + req.URL.RawQuery
+	}
+
+	name = unsafeChars.ReplaceAllString(name, "_")
+	name = strings.Trim(name, "_")
+	if name == "" {
+		name = "index"
+	}
+
+	if len(name) > maxNameLength {
+		sum := sha1.Sum([]byte(req.URL.String()))
+		name = name[:maxNameLength] + "-" + hex.EncodeToString(sum[:4])
+	}
+
+	host := unsafeChars.ReplaceAllString(req.URL.Host, "_")
+
+	return filepath.Join(dir, host, name+".http")
+}
+
+// Recorder is a transport saving responses of the next transport to files in dir, a page fetched again
+// replaces its file. Responses are stored with the status line and headers, so validators and errors are replayed too.
+type Recorder struct {
+	dir  string
+	next http.RoundTripper
+}
+
+// NewRecorder creates a Recorder, the default transport is used if next is nil.
+func NewRecorder(dir string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Recorder{
+		dir:  dir,
+		next: next,
+	}
+}
+
+// RoundTrip fetches the page with the next transport and saves the response.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	// the body is stored decoded, the file must describe it as it is
+	resp.ContentLength = int64(len(body))
+	resp.TransferEncoding = nil
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	dump, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := writeFile(path(r.dir, req), dump); err != nil {
+		return nil, fmt.Errorf("record %s: %w", req.URL, err)
+	}
+
+	return resp, nil
+}
+
+// writeFile writes data to a temporary file and renames it, workers may record pages at the same time.
+func writeFile(name string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), name)
+}
+
+// Replayer is a transport serving responses recorded by a Recorder in dir, nothing is fetched from the network.
+// A page that wasn't recorded fails with ErrNotRecorded.
+type Replayer struct {
+	dir string
+}
+
+// NewReplayer creates a Replayer serving files of dir.
+func NewReplayer(dir string) *Replayer {
+	return &Replayer{dir: dir}
+}
+
+// RoundTrip reads the recorded response of the request URL.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	data, err := os.ReadFile(path(r.dir, req))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotRecorded, req.URL)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+}
+
+// ReadPage returns the recorded body of the URL, tests use it to load recorded pages.
+func ReadPage(dir, rawURL string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := NewReplayer(dir).RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return io.ReadAll(resp.Body)
+}
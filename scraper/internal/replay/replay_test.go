@@ -0,0 +1,75 @@
+package replay
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPath(t *testing.T) {
+	testCases := []struct {
+		url      string
+		expected string
+	}{
+		{"https://www.ufc.com/athlete/khabib-nurmagomedov", "www.ufc.com/athlete_khabib-nurmagomedov.http"},
+		{"https://www.ufc.com/athletes/all?page=2", "www.ufc.com/athletes_all_page=2.http"},
+		{"https://www.ufc.com/", "www.ufc.com/index.http"},
+		{"http://127.0.0.1:8080/athlete/a", "127.0.0.1_8080/athlete_a.http"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			p, err := Path("pages", tc.url)
+			require.NoError(t, err)
+			assert.Equal(t, filepath.Join("pages", tc.expected), p)
+		})
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		io.WriteString(w, "<html>"+r.URL.Path+"</html>")
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	recorder := &http.Client{Transport: NewRecorder(dir, nil)}
+	replayer := &http.Client{Transport: NewReplayer(dir)}
+
+	for _, path := range []string{"/athlete/a", "/missing"} {
+		resp, err := recorder.Get(srv.URL + path)
+		require.NoError(t, err)
+		recorded, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		resp, err = replayer.Get(srv.URL + path)
+		require.NoError(t, err)
+		replayed, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, recorded, replayed)
+
+		if path == "/missing" {
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		} else {
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, `"v1"`, resp.Header.Get("ETag"))
+		}
+	}
+
+	_, err := replayer.Get(srv.URL + "/athlete/b")
+	assert.ErrorIs(t, err, ErrNotRecorded)
+}
@@ -0,0 +1,148 @@
+// Package results reads bout results from ufc.com event pages and submits them to the events service,
+// which matches them to fights by fighter URLs and the event and applies them or queues them for an admin approval.
+package results
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	eventmodel "pickfighter.com/events/pkg/model"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// EventsURL is the ufc.com page listing upcoming and past events.
+const EventsURL = "https://www.ufc.com/events"
+
+// Outcomes of a corner shown on a finished bout.
+const (
+	outcomeWin        = "win"
+	outcomeDraw       = "draw"
+	outcomeNoContest  = "no contest"
+	outcomeNoContest2 = "nc"
+)
+
+const eventHeadline = ".c-card-event--result__headline a[href]"
+
+// EventURLs returns links of the events page: the first upcoming event, which is the event in progress
+// on fight nights, and up to past latest finished events. Links are resolved against base.
+func EventURLs(page *goquery.Selection, base *url.URL, past int) []string {
+	var urls []string
+
+	if href, ok := page.Find("#events-list-upcoming " + eventHeadline).First().Attr("href"); ok {
+		urls = append(urls, resolve(base, href))
+	}
+
+	page.Find("#events-list-past " + eventHeadline).EachWithBreak(func(i int, a *goquery.Selection) bool {
+		if i >= past {
+			return false
+		}
+
+		urls = append(urls, resolve(base, a.AttrOr("href", "")))
+		return true
+	})
+
+	return urls
+}
+
+// ParseEvent reads the event name and results of finished bouts of an event page. Bouts without an outcome
+// are not finished yet and are skipped, fighter links are resolved against base.
+func ParseEvent(page *goquery.Selection, base *url.URL) eventmodel.ScrapedResultsRequest {
+	req := eventmodel.ScrapedResultsRequest{
+		EventName: eventName(page),
+		EventURL:  base.String(),
+	}
+
+	page.Find(".c-listing-fight").Each(func(_ int, fight *goquery.Selection) {
+		if r, ok := parseBout(fight, base); ok {
+			req.Results = append(req.Results, r)
+		}
+	})
+
+	return req
+}
+
+// eventName returns the page title without the site name, e.g. "UFC 300: Pereira vs Hill",
+// or the hero headline if there is no title.
+func eventName(page *goquery.Selection) string {
+	title := clean(page.Find("title").First().Text())
+	if name, _, ok := strings.Cut(title, " | "); ok {
+		title = name
+	}
+
+	if title == "" {
+		title = clean(page.Find(".c-hero__headline").First().Text())
+	}
+
+	return title
+}
+
+func parseBout(fight *goquery.Selection, base *url.URL) (eventmodel.ScrapedResult, bool) {
+	var r eventmodel.ScrapedResult
+
+	r.RedURL = cornerURL(fight, "red", base)
+	r.BlueURL = cornerURL(fight, "blue", base)
+	if r.RedURL == "" || r.BlueURL == "" {
+		return r, false
+	}
+
+	red, blue := cornerOutcome(fight, "red"), cornerOutcome(fight, "blue")
+
+	switch {
+	case red == outcomeWin:
+		r.WinnerURL = r.RedURL
+	case blue == outcomeWin:
+		r.WinnerURL = r.BlueURL
+	case red == outcomeNoContest || red == outcomeNoContest2 || blue == outcomeNoContest || blue == outcomeNoContest2:
+		r.NotContest = true
+	case red == outcomeDraw || blue == outcomeDraw:
+	default:
+		return r, false
+	}
+
+	r.Method = clean(fight.Find(".c-listing-fight__result-text.method").First().Text())
+	r.Time = clean(fight.Find(".c-listing-fight__result-text.time").First().Text())
+	if round, err := strconv.Atoi(clean(fight.Find(".c-listing-fight__result-text.round").First().Text())); err == nil {
+		r.Round = int32(round)
+	}
+
+	return r, true
+}
+
+// cornerURL returns the athlete link of the corner.
+func cornerURL(fight *goquery.Selection, corner string, base *url.URL) string {
+	href := fight.Find(".c-listing-fight__corner-name--"+corner+" a[href*='/athlete/']").First().AttrOr("href", "")
+	if href == "" {
+		return ""
+	}
+
+	return resolve(base, href)
+}
+
+// cornerOutcome returns the lowercased outcome shown in the corner: win, loss, draw or no contest.
+func cornerOutcome(fight *goquery.Selection, corner string) string {
+	outcome := fight.Find(".c-listing-fight__corner-body--" + corner + " .c-listing-fight__outcome-wrapper").First()
+	if outcome.Length() == 0 {
+		outcome = fight.Find(".c-listing-fight__corner--" + corner + " .c-listing-fight__outcome-wrapper").First()
+	}
+
+	return strings.ToLower(clean(outcome.Text()))
+}
+
+// resolve makes the link absolute and drops its query and fragment, fighter URLs are stored without them.
+func resolve(base *url.URL, href string) string {
+	u, err := base.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return ""
+	}
+
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return u.String()
+}
+
+func clean(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,179 @@
+package results
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	eventmodel "pickfighter.com/events/pkg/model"
+)
+
+const eventsPage = `<html><body>
+<div id="events-list-upcoming">
+	<h3 class="c-card-event--result__headline"><a href="/event/ufc-301">Pantoja vs Erceg</a></h3>
+	<h3 class="c-card-event--result__headline"><a href="/event/ufc-302">Makhachev vs Poirier</a></h3>
+</div>
+<div id="events-list-past">
+	<h3 class="c-card-event--result__headline"><a href="/event/ufc-300">Pereira vs Hill</a></h3>
+	<h3 class="c-card-event--result__headline"><a href="/event/ufc-299">O'Malley vs Vera 2</a></h3>
+</div>
+</body></html>`
+
+const eventPage = `<html><head><title>UFC 300: Pereira vs Hill | UFC</title></head><body>
+<div class="c-listing-fight">
+	<div class="c-listing-fight__corner-body--red"><div class="c-listing-fight__outcome-wrapper"> Win </div></div>
+	<div class="c-listing-fight__corner-body--blue"><div class="c-listing-fight__outcome-wrapper">Loss</div></div>
+	<div class="c-listing-fight__corner-name--red"><a href="/athlete/alex-pereira?utm=1">Alex Pereira</a></div>
+	<div class="c-listing-fight__corner-name--blue"><a href="https://www.ufc.com/athlete/jamahal-hill#top">Jamahal Hill</a></div>
+	<div class="c-listing-fight__result-text round">1</div>
+	<div class="c-listing-fight__result-text time">3:14</div>
+	<div class="c-listing-fight__result-text method">KO/TKO</div>
+</div>
+<div class="c-listing-fight">
+	<div class="c-listing-fight__corner--red"><div class="c-listing-fight__outcome-wrapper">Loss</div></div>
+	<div class="c-listing-fight__corner--blue"><div class="c-listing-fight__outcome-wrapper">Win</div></div>
+	<div class="c-listing-fight__corner-name--red"><a href="/athlete/zhang-weili">Zhang Weili</a></div>
+	<div class="c-listing-fight__corner-name--blue"><a href="/athlete/yan-xiaonan">Yan Xiaonan</a></div>
+	<div class="c-listing-fight__result-text method">Decision - Unanimous</div>
+</div>
+<div class="c-listing-fight">
+	<div class="c-listing-fight__corner-body--red"><div class="c-listing-fight__outcome-wrapper">Draw</div></div>
+	<div class="c-listing-fight__corner-body--blue"><div class="c-listing-fight__outcome-wrapper">Draw</div></div>
+	<div class="c-listing-fight__corner-name--red"><a href="/athlete/a">A</a></div>
+	<div class="c-listing-fight__corner-name--blue"><a href="/athlete/b">B</a></div>
+</div>
+<div class="c-listing-fight">
+	<div class="c-listing-fight__corner-body--red"><div class="c-listing-fight__outcome-wrapper">No Contest</div></div>
+	<div class="c-listing-fight__corner-body--blue"><div class="c-listing-fight__outcome-wrapper">No Contest</div></div>
+	<div class="c-listing-fight__corner-name--red"><a href="/athlete/c">C</a></div>
+	<div class="c-listing-fight__corner-name--blue"><a href="/athlete/d">D</a></div>
+	<div class="c-listing-fight__result-text round">2</div>
+</div>
+<div class="c-listing-fight">
+	<div class="c-listing-fight__corner-name--red"><a href="/athlete/e">E</a></div>
+	<div class="c-listing-fight__corner-name--blue"><a href="/athlete/f">F</a></div>
+</div>
+</body></html>`
+
+func parsePage(t *testing.T, page string) *goquery.Selection {
+	t.Helper()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(page))
+	require.NoError(t, err)
+
+	return doc.Selection
+}
+
+func TestEventURLs(t *testing.T) {
+	base, _ := url.Parse("https://www.ufc.com/events")
+
+	tests := []struct {
+		name string
+		past int
+		want []string
+	}{
+		{"current only", 0, []string{"https://www.ufc.com/event/ufc-301"}},
+		{"latest past", 1, []string{"https://www.ufc.com/event/ufc-301", "https://www.ufc.com/event/ufc-300"}},
+		{"all past", 5, []string{
+			"https://www.ufc.com/event/ufc-301", "https://www.ufc.com/event/ufc-300", "https://www.ufc.com/event/ufc-299",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EventURLs(parsePage(t, eventsPage), base, tt.past))
+		})
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	base, _ := url.Parse("https://www.ufc.com/event/ufc-300")
+
+	req := ParseEvent(parsePage(t, eventPage), base)
+
+	assert.Equal(t, "UFC 300: Pereira vs Hill", req.EventName)
+	assert.Equal(t, "https://www.ufc.com/event/ufc-300", req.EventURL)
+	assert.Equal(t, []eventmodel.ScrapedResult{
+		{
+			RedURL:    "https://www.ufc.com/athlete/alex-pereira",
+			BlueURL:   "https://www.ufc.com/athlete/jamahal-hill",
+			WinnerURL: "https://www.ufc.com/athlete/alex-pereira",
+			Method:    "KO/TKO",
+			Round:     1,
+			Time:      "3:14",
+		},
+		{
+			RedURL:    "https://www.ufc.com/athlete/zhang-weili",
+			BlueURL:   "https://www.ufc.com/athlete/yan-xiaonan",
+			WinnerURL: "https://www.ufc.com/athlete/yan-xiaonan",
+			Method:    "Decision - Unanimous",
+		},
+		{
+			RedURL:  "https://www.ufc.com/athlete/a",
+			BlueURL: "https://www.ufc.com/athlete/b",
+		},
+		{
+			RedURL:     "https://www.ufc.com/athlete/c",
+			BlueURL:    "https://www.ufc.com/athlete/d",
+			NotContest: true,
+			Round:      2,
+		},
+	}, req.Results, "the unfinished bout is skipped")
+}
+
+// fakeSubmitter queues every result and records the requests.
+type fakeSubmitter struct {
+	reqs []*eventmodel.ScrapedResultsRequest
+}
+
+func (f *fakeSubmitter) Submit(_ context.Context, req *eventmodel.ScrapedResultsRequest) ([]eventmodel.ScrapedResultOutcome, error) {
+	f.reqs = append(f.reqs, req)
+
+	outcomes := make([]eventmodel.ScrapedResultOutcome, 0, len(req.Results))
+	for i, r := range req.Results {
+		status := eventmodel.ResultQueued
+		if i == 0 {
+			status = eventmodel.ResultUnmatched
+		}
+
+		outcomes = append(outcomes, eventmodel.ScrapedResultOutcome{Result: r, Status: status})
+	}
+
+	return outcomes, nil
+}
+
+func TestSettlerRun(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/events", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(eventsPage))
+	})
+	mux.HandleFunc("/event/ufc-300", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(eventPage))
+	})
+	srv := httptest.NewServer(mux) // ufc-301 is not found
+	defer srv.Close()
+
+	submitter := &fakeSubmitter{}
+	s := NewSettler(srv.Client(), submitter, srv.URL+"/events", zap.NewNop().Sugar())
+
+	counts, err := s.Run(context.Background(), Job{AutoApply: true, PastEvents: 1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ufc-301")
+
+	assert.Equal(t, Counts{Events: 1, Bouts: 4, Queued: 3, Unmatched: 1}, counts)
+	require.Len(t, submitter.reqs, 1)
+	assert.True(t, submitter.reqs[0].AutoApply)
+	assert.Equal(t, srv.URL+"/event/ufc-300", submitter.reqs[0].EventURL)
+
+	counts, err = s.Run(context.Background(), Job{EventURLs: []string{srv.URL + "/event/ufc-300"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, counts.Events)
+	assert.False(t, submitter.reqs[1].AutoApply)
+}
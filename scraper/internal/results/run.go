@@ -0,0 +1,106 @@
+package results
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	eventmodel "pickfighter.com/events/pkg/model"
+	"pickfighter.com/gen"
+	"pickfighter.com/internal/grpcutil"
+	"pickfighter.com/pkg/discovery"
+	"pickfighter.com/pkg/discovery/consul"
+	"pickfighter.com/scraper/internal/fetch"
+	"pickfighter.com/scraper/internal/scraperutil"
+	"pickfighter.com/scraper/pkg/logger"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// GRPC submits results with the SubmitScrapedResults method of the events service found in the registry.
+type GRPC struct {
+	registry discovery.Registry
+}
+
+// NewGRPC creates a Submitter of the events service.
+func NewGRPC(registry discovery.Registry) *GRPC {
+	return &GRPC{registry: registry}
+}
+
+// Submit sends results of the event to the events service.
+func (g *GRPC) Submit(ctx context.Context, req *eventmodel.ScrapedResultsRequest) ([]eventmodel.ScrapedResultOutcome, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "event-service", g.registry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	resp, err := gen.NewEventServiceClient(conn).SubmitScrapedResults(ctx, eventmodel.ScrapedResultsToProto(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return eventmodel.ScrapedResultOutcomesFromProto(resp.Outcomes), nil
+}
+
+// Run initializes the logger and settles results with the viper config until they are submitted
+// or the process is interrupted, see Settle.
+func Run() error {
+	if err := logger.Initialize(scraperutil.GetLoggerFlag(true)); err != nil {
+		return fmt.Errorf("initialize logger: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	job := Job{
+		AutoApply:  viper.GetBool("results.auto_apply"),
+		EventURLs:  viper.GetStringSlice("results.events"),
+		PastEvents: viper.GetInt("results.past_events"),
+	}
+
+	_, err := Settle(ctx, job, logger.Get())
+
+	return err
+}
+
+// Settle reads results of the job events and submits them to the events service found via consul.address.
+// Pages are fetched with the scraper.retry settings and robots.txt is respected with scraper.robots.
+// The counts of outcomes are printed to stderr.
+func Settle(ctx context.Context, job Job, l *zap.SugaredLogger) (Counts, error) {
+	registry, err := consul.NewRegistry(viper.GetString("consul.address"))
+	if err != nil {
+		return Counts{}, fmt.Errorf("create service registry: %w", err)
+	}
+
+	var transport http.RoundTripper = fetch.NewRetry(fetch.RetryConfig{
+		MaxAttempts: viper.GetInt("scraper.retry.max_attempts"),
+		BaseDelay:   viper.GetDuration("scraper.retry.base_delay"),
+		MaxDelay:    viper.GetDuration("scraper.retry.max_delay"),
+		Timeout:     viper.GetDuration("scraper.retry.timeout"),
+	}, http.DefaultTransport, l)
+
+	if viper.GetBool("scraper.robots") {
+		transport = fetch.NewRobots(transport)
+	}
+
+	settler := NewSettler(&http.Client{Transport: transport}, NewGRPC(registry), EventsURL, l)
+
+	counts, err := settler.Run(ctx, job)
+
+	fmt.Fprintf(os.Stderr, "Results: %d events, %d finished bouts, %d applied, %d queued, %d unchanged, %d unmatched, %d failed\n",
+		counts.Events, counts.Bouts, counts.Applied, counts.Queued, counts.Unchanged, counts.Unmatched, counts.Failed)
+	l.Infow("Results settled", "type", "result", "events", counts.Events, "bouts", counts.Bouts,
+		"applied", counts.Applied, "queued", counts.Queued, "unchanged", counts.Unchanged,
+		"unmatched", counts.Unmatched, "failed", counts.Failed)
+
+	if err != nil {
+		l.Errorw("Failed to settle results", "error", err)
+	}
+
+	return counts, err
+}
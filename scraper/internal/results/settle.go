@@ -0,0 +1,176 @@
+package results
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	eventmodel "pickfighter.com/events/pkg/model"
+
+	"github.com/PuerkitoBio/goquery"
+	"go.uber.org/zap"
+)
+
+// DefaultPastEvents is the number of finished events checked besides the event in progress,
+// results are often corrected or filled in after the event.
+const DefaultPastEvents = 1
+
+var ErrNoEvents = errors.New("no events found")
+
+// Submitter sends results of an event to the events service and returns what happened with every result.
+type Submitter interface {
+	Submit(ctx context.Context, req *eventmodel.ScrapedResultsRequest) ([]eventmodel.ScrapedResultOutcome, error)
+}
+
+// Job defines which events are checked and how their results are posted.
+type Job struct {
+	// AutoApply sets results of fights without a result at once, otherwise results are queued
+	// for an admin approval.
+	AutoApply bool
+	// EventURLs overrides events found on the events page.
+	EventURLs []string
+	// PastEvents is the number of finished events checked besides the event in progress.
+	PastEvents int
+}
+
+// Counts sums outcomes of submitted results.
+type Counts struct {
+	Events    int `json:"events"`
+	Bouts     int `json:"bouts"` // finished bouts found on event pages
+	Applied   int `json:"applied"`
+	Queued    int `json:"queued"`
+	Unchanged int `json:"unchanged"`
+	Unmatched int `json:"unmatched"`
+	Failed    int `json:"failed"`
+}
+
+func (c *Counts) add(status string) {
+	switch status {
+	case eventmodel.ResultApplied:
+		c.Applied++
+	case eventmodel.ResultQueued:
+		c.Queued++
+	case eventmodel.ResultUnchanged:
+		c.Unchanged++
+	case eventmodel.ResultUnmatched:
+		c.Unmatched++
+	default:
+		c.Failed++
+	}
+}
+
+// Settler reads results from event pages and submits them event by event.
+type Settler struct {
+	client    *http.Client
+	submitter Submitter
+	eventsURL string
+	l         *zap.SugaredLogger
+}
+
+// NewSettler creates a Settler fetching pages with client, eventsURL is the page listing events.
+func NewSettler(client *http.Client, submitter Submitter, eventsURL string, l *zap.SugaredLogger) *Settler {
+	return &Settler{
+		client:    client,
+		submitter: submitter,
+		eventsURL: eventsURL,
+		l:         l,
+	}
+}
+
+// Run submits results of the event in progress and the latest finished events, or of the events of the job.
+// An event failed to be fetched or submitted doesn't stop the others, the errors are joined.
+func (s *Settler) Run(ctx context.Context, job Job) (Counts, error) {
+	var counts Counts
+
+	urls := job.EventURLs
+	if len(urls) == 0 {
+		page, base, err := s.fetch(ctx, s.eventsURL)
+		if err != nil {
+			return counts, fmt.Errorf("fetch events: %w", err)
+		}
+
+		urls = EventURLs(page, base, job.PastEvents)
+		if len(urls) == 0 {
+			return counts, ErrNoEvents
+		}
+	}
+
+	var errs error
+	for _, u := range urls {
+		if err := s.settleEvent(ctx, u, job, &counts); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("event %s: %w", u, err))
+		}
+
+		if ctx.Err() != nil {
+			return counts, errors.Join(errs, ctx.Err())
+		}
+	}
+
+	return counts, errs
+}
+
+func (s *Settler) settleEvent(ctx context.Context, eventURL string, job Job, counts *Counts) error {
+	page, base, err := s.fetch(ctx, eventURL)
+	if err != nil {
+		return err
+	}
+
+	req := ParseEvent(page, base)
+	req.AutoApply = job.AutoApply
+
+	counts.Events++
+	counts.Bouts += len(req.Results)
+
+	if len(req.Results) == 0 {
+		s.l.Infow("No finished bouts", "event", req.EventName, "url", eventURL)
+		return nil
+	}
+
+	outcomes, err := s.submitter.Submit(ctx, &req)
+	if err != nil {
+		counts.Failed += len(req.Results)
+		return fmt.Errorf("submit results: %w", err)
+	}
+
+	for _, o := range outcomes {
+		counts.add(o.Status)
+
+		fields := []any{"event", req.EventName, "red", o.Result.RedURL, "blue", o.Result.BlueURL, "status", o.Status}
+		switch o.Status {
+		case eventmodel.ResultUnmatched, eventmodel.ResultFailed:
+			s.l.Warnw("Result is not settled", append(fields, "message", o.Message)...)
+		default:
+			s.l.Infow("Result submitted", append(fields, "fight_id", o.FightId, "review_id", o.ReviewId)...)
+		}
+	}
+
+	return nil
+}
+
+// fetch requests the page and parses it, the final URL after redirects is the base of its links.
+func (s *Settler) fetch(ctx context.Context, pageURL string) (*goquery.Selection, *url.URL, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("GET %s: %s", pageURL, resp.Status)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return doc.Selection, resp.Request.URL, nil
+}
@@ -1,19 +1,28 @@
 package scraper
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
-	"math/rand"
-	"regexp"
-	"strconv"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
+	"pickfighter.com/scraper/internal/checkpoint"
+	"pickfighter.com/scraper/internal/fetch"
+	"pickfighter.com/scraper/internal/replay"
 	"pickfighter.com/scraper/internal/scraperutil"
-	data "pickfighter.com/scraper/pkg"
+	"pickfighter.com/scraper/internal/sink"
+	"pickfighter.com/scraper/internal/source"
+	"pickfighter.com/scraper/internal/validate"
 	"pickfighter.com/scraper/pkg/logger"
-	"pickfighter.com/scraper/pkg/model"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/gocolly/colly"
@@ -21,405 +30,917 @@ import (
 	"go.uber.org/zap"
 )
 
-var gc *colly.Collector
-var detailsCollector *colly.Collector
-var collection = model.FightersCollection{}
-var wg sync.WaitGroup
-var l *zap.SugaredLogger
+// Default limits of a scraper run.
+const (
+	DefaultWorkers     = 4
+	DefaultParallelism = 2
+	DefaultRandomDelay = 3 * time.Second
+)
 
+// Config defines proxy credentials and addresses, every request goes through a random healthy proxy.
 type Config struct {
 	Login    string
 	Password string
 	Proxys   []string
 }
 
+// DomainLimit limits concurrent requests to domains matching the glob, e.g. "*ufc.com".
+type DomainLimit struct {
+	Glob        string `mapstructure:"glob"`
+	Parallelism int    `mapstructure:"parallelism"`
+}
+
+// Options defines how the scraper visits pages.
+type Options struct {
+	// Source is the site fighters are scraped from, ufc.com if nil.
+	Source source.Source
+	// StartURL is the first listing page, the first page of the source if empty.
+	StartURL string
+	// Workers is the number of athlete pages scraped at once.
+	Workers int
+	// Parallelism limits concurrent requests to domains not matched by DomainLimits.
+	// All such domains share the limit.
+	Parallelism  int
+	DomainLimits []DomainLimit
+	// RandomDelay is the maximum random delay after every request.
+	RandomDelay time.Duration
+	// Proxy routes requests through proxies, nil means direct requests.
+	Proxy *Config
+	// Incremental skips athletes whose pages didn't change since the last run.
+	Incremental bool
+	// RecordDir saves fetched pages to the directory, see the replay package.
+	RecordDir string
+	// ReplayDir serves pages recorded in the directory instead of fetching them,
+	// there is no delay between replayed requests.
+	ReplayDir string
+	// Retry retries requests failed with 429, 5xx or a timeout, zero values are defaults of the fetch package.
+	Retry fetch.RetryConfig
+	// ProxyHealth benches failing proxies, zero values are defaults of the fetch package.
+	ProxyHealth fetch.HealthConfig
+	// Budget stops the run after the maximum number of requests or failed attempts, zero values are no limits.
+	Budget fetch.BudgetConfig
+	// Robots skips pages disallowed by robots.txt and waits for the Crawl-delay of the site.
+	Robots bool
+}
+
+// Result holds counters of a scraper run.
+type Result struct {
+	Listings   int64 `json:"listings"`   // listing pages visited
+	Athletes   int64 `json:"athletes"`   // athlete pages visited
+	Written    int64 `json:"written"`    // fighters written to the sink
+	Unchanged  int64 `json:"unchanged"`  // athletes not written in the incremental mode
+	Skipped    int64 `json:"skipped"`    // athletes visited before the run was resumed
+	Disallowed int64 `json:"disallowed"` // athlete pages disallowed by robots.txt
+	Failed     int64 `json:"failed"`     // athlete pages not visited or fighters not written
+	Requests   int64 `json:"requests"`   // requests sent including retries
+	Errors     int64 `json:"errors"`     // requests failed with 429, 5xx or a network error
+}
+
+// Scraper scrapes fighters from the athletes listing into a sink. Listing pages are visited one by one,
+// athlete pages are scraped by a pool of workers.
+type Scraper struct {
+	opts Options
+	out  sink.Sink
+	cp   *checkpoint.Checkpoint
+	l    *zap.SugaredLogger
+}
+
+// New creates a Scraper writing fighters to out and recording visited pages in cp.
+// Zero options are replaced with defaults.
+func New(opts Options, out sink.Sink, cp *checkpoint.Checkpoint, l *zap.SugaredLogger) *Scraper {
+	if opts.Source == nil {
+		opts.Source = source.NewUFC(l)
+	}
+	if opts.StartURL == "" {
+		opts.StartURL = opts.Source.ListingURL(0)
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = DefaultWorkers
+	}
+	if opts.Parallelism <= 0 {
+		opts.Parallelism = DefaultParallelism
+	}
+	if opts.ReplayDir != "" {
+		opts.RandomDelay = 0
+	}
+
+	return &Scraper{
+		opts: opts,
+		out:  out,
+		cp:   cp,
+		l:    l,
+	}
+}
+
+// Job overrides settings of the scrape command for a single scrape, the scraper daemon runs jobs on schedules.
+// A zero Job scrapes with the viper config only.
+type Job struct {
+	// Name separates checkpoint files and validation reports of jobs.
+	Name string
+	// Sources overrides scraper.sources.
+	Sources []string
+	// StartURL overrides the first listing page, it is meant for jobs with a single source.
+	StartURL string
+	// Incremental skips athlete pages not changed since the last run of the job.
+	Incremental bool
+	// Append adds fighters to the existing output, jobs refreshing a part of fighters need it.
+	Append bool
+}
+
+// Summary holds results of a scrape by source and its validation report.
+type Summary struct {
+	Sources    map[string]Result
+	Validation validate.Report
+}
+
+// Total sums results of all sources.
+func (s Summary) Total() Result {
+	var t Result
+	for _, r := range s.Sources {
+		t.Listings += r.Listings
+		t.Athletes += r.Athletes
+		t.Written += r.Written
+		t.Unchanged += r.Unchanged
+		t.Skipped += r.Skipped
+		t.Disallowed += r.Disallowed
+		t.Failed += r.Failed
+		t.Requests += r.Requests
+		t.Errors += r.Errors
+	}
+
+	return t
+}
+
 // main function responsible for initializing the web scraping process.
-// It sets up the logger, creates collector instances, defines URL and limits for the main collector,
-// and specifies callback functions for HTML elements. It initiates the web scraping process by visiting
-// the initial URL and waits for the wait group to finish before printing "DONE" to the console and saving
-// the collected data to a JSON file using the saveToJSON function.
-func Run() {
-	useProxy := viper.GetBool("proxy")
+// It sets up the logger and scrapes fighters with the viper config until all listing pages are visited
+// or the process is interrupted, see Scrape.
+func Run() error {
+	logFlag := scraperutil.GetLoggerFlag(viper.GetBool("add"))
+	if err := logger.Initialize(logFlag); err != nil {
+		return fmt.Errorf("initialize logger: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	_, err := Scrape(ctx, Job{}, logger.Get())
+
+	return err
+}
+
+// Scrape sets up the output sink, creates a Scraper configured with viper values and the job for every source
+// and runs them one by one until all listing pages are visited or ctx is cancelled, then it prints the summary
+// and closes the sink. Progress is printed to stderr, so the ndjson sink can write fighters to stdout.
+// Fighters of several sources are merged with the merge policy before they are written to the sink.
+//
+// Visited pages are recorded in the checkpoint file of the source. With resume the run continues from the first
+// unfinished listing page of the interrupted run, in the incremental mode athlete pages that didn't change since
+// the last run are skipped. Both modes add fighters to the existing output. Validators of the written pages are
+// committed to the checkpoints only after the sink is closed successfully. Resume is rejected with several
+// sources, their merged fighters are written only when the sink is closed.
+//
+// Every fighter written to the sink is validated, the report is written to validation.report and its summary
+// is printed on exit. A stopped source fails the scrape. In the strict mode invalid fighters are dropped before
+// the sink and the scrape also fails if they exceed the validation thresholds.
+func Scrape(ctx context.Context, job Job, l *zap.SugaredLogger) (Summary, error) {
 	toAdd := viper.GetBool("add")
-	startPage := viper.GetInt("start")
+	resume := viper.GetBool("checkpoint.resume")
+	incremental := viper.GetBool("checkpoint.incremental") || job.Incremental
 
-	logFlag := scraperutil.GetLoggerFlag(toAdd)
-	if err := logger.Initialize(logFlag); err != nil {
-		fmt.Println("Error while initializing logger: ", err)
-		return
+	summary := Summary{Sources: make(map[string]Result)}
+
+	sinkType, err := sink.ParseType(viper.GetString("sink.type"))
+	if err != nil {
+		return summary, fmt.Errorf("create output sink: %w", err)
 	}
 
-	l = logger.Get()
-	gc = colly.NewCollector()
-	detailsCollector = gc.Clone()
+	names := job.Sources
+	if len(names) == 0 {
+		names = viper.GetStringSlice("scraper.sources")
+	}
 
-	url := "https://www.ufc.com/athletes/all"
+	sources := make([]source.Source, 0, len(names))
+	for _, name := range names {
+		src, err := source.New(name, l)
+		if err != nil {
+			return summary, fmt.Errorf("create source %q: %w", name, err)
+		}
 
-	if startPage > 0 {
-		url = fmt.Sprintf("%s?page=%d", url, startPage)
+		sources = append(sources, src)
 	}
 
-	gc.Limit(&colly.LimitRule{
-		DomainGlob:  "*",
-		RandomDelay: 3 * time.Second,
+	if len(sources) == 0 {
+		sources = append(sources, source.NewUFC(l))
+	}
+
+	// merged fighters are written when the sink is closed, while athletes are recorded as visited right away,
+	// so a resumed run would skip athletes whose fighters were lost with the interrupted one
+	if resume && len(sources) > 1 {
+		return summary, errors.New("--resume can't be used with several sources, merged fighters are written on exit only")
+	}
+
+	policy, err := sink.ParseMergePolicy(viper.GetString("scraper.merge"))
+	if err != nil {
+		return summary, fmt.Errorf("create output sink: %w", err)
+	}
+
+	var thresholds validate.Thresholds
+	if err := viper.UnmarshalKey("validation", &thresholds); err != nil {
+		return summary, fmt.Errorf("read validation thresholds: %w", err)
+	}
+
+	if viper.GetString("scraper.record") != "" && viper.GetString("scraper.replay") != "" {
+		return summary, errors.New("--record and --replay can't be used together")
+	}
+
+	if resume && sinkType == sink.TypeJSON {
+		fmt.Fprintln(os.Stderr, "Warning: the json sink writes fighters on exit only, fighters scraped before a crash are lost, use the ndjson or grpc sink to resume")
+		l.Warnw("Resuming with the json sink", "type", "checkpoint")
+	}
+
+	out, err := sink.New(context.Background(), sink.Config{
+		Type:          sinkType,
+		Output:        viper.GetString("sink.output"),
+		Append:        toAdd || resume || incremental || job.Append,
+		ConsulAddress: viper.GetString("consul.address"),
 	})
+	if err != nil {
+		return summary, fmt.Errorf("create output sink: %w", err)
+	}
+
+	validator := validate.New(nil)
+	out = validator.Sink(out, viper.GetBool("validation.strict"))
 
-	gc.OnRequest(func(r *colly.Request) {
-		if useProxy {
-			login := viper.GetString("Login")
-			password := viper.GetString("Password")
-			proxy := getProxy()
-			proxyUrl := fmt.Sprintf("socks5h://%s:%s@%s", login, password, proxy)
+	closeOut := out.Close
+	sourceOut := func(int) sink.Sink { return out }
 
-			gc.SetProxy(proxyUrl)
-			l.Infow(proxy, "type", "proxy address")
+	if len(sources) > 1 {
+		merge := sink.NewMerge(out, policy)
+		closeOut = merge.Close
+		sourceOut = merge.Source
+	}
+
+	var scrapeErr error
+	var checkpoints []*checkpoint.Checkpoint
+	for rank, src := range sources {
+		res, cp, err := runSource(ctx, job, src, sourceOut(rank), l)
+		summary.Sources[src.Name()] = res
+		if cp != nil {
+			checkpoints = append(checkpoints, cp)
 		}
 
-		r.Headers.Set("User-Agent", "Mozilla/5.0")
-	})
+		if err != nil {
+			scrapeErr = errors.Join(scrapeErr, fmt.Errorf("scrape %s: %w", src.Name(), err))
+		}
 
-	gc.OnHTML("div[class*='flipcard__action'] a[href]", parseAthletesListing)
-	gc.OnHTML("li.pager__item a[href]", moveNextPage)
-	detailsCollector.OnHTML("div[class='hero-profile-wrap']", getData)
+		if ctx.Err() != nil {
+			break
+		}
+	}
 
-	err := gc.Visit(url)
-	if err != nil {
-		log.Fatalf("Error while request: %v", err)
+	if err := closeOut(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error while closing output sink:", err)
+		l.Errorw("Failed to close output sink", "error", err)
+		scrapeErr = errors.Join(scrapeErr, fmt.Errorf("close output sink: %w", err))
+	} else {
+		// fighters are flushed now, their pages can be skipped by the next incremental run
+		for _, cp := range checkpoints {
+			if err := cp.CommitPages(); err != nil {
+				l.Errorw("Failed to save checkpoint", "error", err)
+			}
+		}
 	}
 
-	wg.Wait()
+	summary.Validation = validator.Report()
+
+	return summary, errors.Join(scrapeErr, reportValidation(summary.Validation, thresholds, job, l))
+}
+
+// reportValidation writes the validation report and prints its summary table. In the strict mode a report
+// exceeding the thresholds fails the run, invalid fighters were dropped before the sink.
+func reportValidation(report validate.Report, thresholds validate.Thresholds, job Job, l *zap.SugaredLogger) error {
+	path := viper.GetString("validation.report")
+	if job.Name != "" {
+		ext := filepath.Ext(path)
+		path = strings.TrimSuffix(path, ext) + "." + job.Name + ext
+	}
+	if err := report.WriteFile(path); err != nil {
+		fmt.Fprintln(os.Stderr, "Error while writing validation report:", err)
+		l.Errorw("Failed to write validation report", "path", path, "error", err)
+	}
+
+	fmt.Fprintln(os.Stderr, "Validation report:", path)
+	if err := report.WriteTable(os.Stderr); err != nil {
+		l.Errorw("Failed to print validation summary", "error", err)
+	}
+
+	l.Infow("Validation", "type", "result", "fighters", report.Fighters, "invalid", report.Invalid,
+		"with_warnings", report.WithWarnings, "dropped", report.Dropped)
 
-	fmt.Println("DONE")
-	l.Infow("DONE", "type", "result")
+	err := report.Check(thresholds)
+	if err != nil {
+		l.Errorw("Validation failed", "error", err)
+	}
+
+	if !viper.GetBool("validation.strict") {
+		return nil
+	}
 
-	saveToJSON(collection, toAdd)
+	return err
 }
 
-// parseAthletesListing is a callback function used with colly that extracts athlete URLs from a given colly.HTMLElement 'e'.
-// It increments the wait group and defers its decrement for synchronization. The function extracts the athlete's URL,
-// converts it to an absolute URL, prints it to the console, and then uses a detailsCollector to visit the athlete's detailed page.
-// This function is typically used during web scraping to collect athlete URLs for subsequent detailed data extraction.
-func parseAthletesListing(e *colly.HTMLElement) {
-	wg.Add(1)
-	defer wg.Done()
+// runSource scrapes fighters of the source into out, prints the summary and returns the checkpoint of the source
+// with page validators staged for the written fighters.
+// Jobs keep their own checkpoint files, so a partial refresh doesn't reset progress of a full one.
+func runSource(ctx context.Context, job Job, src source.Source, out sink.Sink, l *zap.SugaredLogger) (Result, *checkpoint.Checkpoint, error) {
+	resume := viper.GetBool("checkpoint.resume")
 
-	athleteURL := e.Attr("href")
-	athleteURL = e.Request.AbsoluteURL(athleteURL)
+	name := src.Name()
+	if job.Name != "" {
+		name = job.Name + "." + name
+	}
+
+	cp, err := checkpoint.Open(checkpoint.PathFor(viper.GetString("checkpoint.path"), name), resume)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error while reading checkpoint of %s: %s\n", src.Name(), err)
+		return Result{}, nil, fmt.Errorf("read checkpoint: %w", err)
+	}
 
-	fmt.Println("Athlete link:", athleteURL)
-	l.Infow(athleteURL, "type", "athlete link")
+	opts := Options{
+		Source:      src,
+		StartURL:    src.ListingURL(viper.GetInt("start")),
+		Workers:     viper.GetInt("scraper.workers"),
+		Parallelism: viper.GetInt("scraper.parallelism"),
+		RandomDelay: viper.GetDuration("scraper.random_delay"),
+		Incremental: viper.GetBool("checkpoint.incremental") || job.Incremental,
+		RecordDir:   viper.GetString("scraper.record"),
+		ReplayDir:   viper.GetString("scraper.replay"),
+		Retry: fetch.RetryConfig{
+			MaxAttempts: viper.GetInt("scraper.retry.max_attempts"),
+			BaseDelay:   viper.GetDuration("scraper.retry.base_delay"),
+			MaxDelay:    viper.GetDuration("scraper.retry.max_delay"),
+			Timeout:     viper.GetDuration("scraper.retry.timeout"),
+		},
+		ProxyHealth: fetch.HealthConfig{
+			MaxFailures: viper.GetInt("scraper.proxy_health.max_failures"),
+			Bench:       viper.GetDuration("scraper.proxy_health.bench"),
+		},
+		Budget: fetch.BudgetConfig{
+			MaxRequests: viper.GetInt64("scraper.budget.max_requests"),
+			MaxErrors:   viper.GetInt64("scraper.budget.max_errors"),
+		},
+		Robots: viper.GetBool("scraper.robots"),
+	}
+
+	if err := viper.UnmarshalKey("scraper.domains", &opts.DomainLimits); err != nil {
+		l.Errorw("Failed to read domain limits", "error", err)
+	}
 
-	detailsCollector.Visit(athleteURL)
+	if job.StartURL != "" {
+		opts.StartURL = job.StartURL
+	}
 
-	// Multithread scrapping
-	// go func() {
-	// 	defer wg.Done()
-	// 	athleteURL := e.Attr("href")
-	// 	athleteURL = e.Request.AbsoluteURL(athleteURL)
+	if viper.GetBool("proxy") {
+		opts.Proxy = &Config{
+			Login:    viper.GetString("Login"),
+			Password: viper.GetString("Password"),
+			Proxys:   viper.GetStringSlice("Proxys"),
+		}
+	}
+
+	if current := cp.Current(); resume && current != "" {
+		opts.StartURL = current
+
+		fmt.Fprintf(os.Stderr, "Resuming %s from %s, %d athletes already visited\n", src.Name(), current, cp.VisitedAthletes())
+		l.Infow(current, "type", "resume", "source", src.Name(), "visited", cp.VisitedAthletes())
+	}
+
+	res, err := New(opts, out, cp, l).Run(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Scraping %s stopped: %s\n", src.Name(), err)
+		l.Errorw("Scraping stopped", "source", src.Name(), "error", err)
+	} else {
+		fmt.Fprintln(os.Stderr, "DONE", src.Name())
+		l.Infow("DONE", "type", "result", "source", src.Name())
+	}
 
-	// 	fmt.Println("Athlete link:", athleteURL)
+	fmt.Fprintf(os.Stderr, "%s listings: %d, athletes: %d, written: %d, unchanged: %d, skipped: %d, disallowed: %d, failed: %d, requests: %d, errors: %d\n",
+		src.Name(), res.Listings, res.Athletes, res.Written, res.Unchanged, res.Skipped, res.Disallowed, res.Failed, res.Requests, res.Errors)
+	l.Infow("Summary", "type", "result", "source", src.Name(), "listings", res.Listings, "athletes", res.Athletes,
+		"written", res.Written, "unchanged", res.Unchanged, "skipped", res.Skipped, "disallowed", res.Disallowed,
+		"failed", res.Failed, "requests", res.Requests, "errors", res.Errors)
 
-	// 	detailsCollector.Visit(athleteURL)
-	// }()
+	return res, cp, err
 }
 
-// getData is a callback function used with colly that extracts fighter data from a given colly.HTMLElement 'e'.
-// It initializes a Fighter model, sets basic information such as name, nickname, URL, and image, and then calls
-// SetDivision and SetStatistic functions to update division and general statistics. Finally, it calls parseData
-// to extract additional details about the fighter and appends the resulting Fighter instance to a FightersCollection.
-// This function is typically used during web scraping to gather comprehensive information about a fighter.
-func getData(e *colly.HTMLElement) {
-	wg.Add(1)
-	defer wg.Done()
+// Run visits listing pages from the start URL and sends athlete pages to the workers, it returns when all
+// queued athletes are scraped. The run is marked as finished in the checkpoint only if the last listing page
+// was reached, a cancelled context stops queuing athletes and aborts requests in progress.
+// An exceeded request budget stops the run the same way with fetch.ErrBudgetExceeded.
+// Validators of the pages of written fighters are staged in the checkpoint, the caller commits them with
+// checkpoint.CommitPages once the sink is flushed.
+func (s *Scraper) Run(ctx context.Context) (Result, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	r := s.newRun(ctx, cancel)
+
+	var wg sync.WaitGroup
+	for i := 0; i < s.opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := range r.jobs {
+				r.scrapeAthlete(j)
+			}
+		}()
+	}
+
+	err := r.visitListings()
 
-	fighterEl := e.DOM.Parent()
+	close(r.jobs)
+	wg.Wait()
 
-	profileEl := fighterEl.Find("div.hero-profile-wrap")
-	statString := profileEl.Find("p.hero-profile__division-body").Text()
+	if ctx.Err() != nil {
+		err = context.Cause(ctx)
+	}
 
-	fighter := model.Fighter{
-		Name:       strings.TrimSpace(profileEl.Find("h1.hero-profile__name").Text()),
-		NickName:   strings.TrimSpace(profileEl.Find("p.hero-profile__nickname").Text()),
-		FighterUrl: e.Request.URL.String(),
-		ImageUrl:   profileEl.Find(".hero-profile__image-wrap img").AttrOr("src", ""),
+	if err == nil {
+		if err := s.cp.Finish(); err != nil {
+			s.l.Errorw("Failed to save checkpoint", "error", err)
+		}
 	}
 
-	data.SetDivision(&fighter, profileEl.Find("p.hero-profile__division-title").Text())
-	data.SetStatistic(&fighter, statString)
+	res := r.counters.result()
+	res.Requests, res.Errors = r.budget.Requests(), r.budget.Errors()
 
-	parseData(&fighter, fighterEl)
+	return res, err
+}
 
-	collection.Fighters = append(collection.Fighters, fighter)
+// job is an athlete page queued for the workers.
+type job struct {
+	url     string
+	listing string
 }
 
-// parseData a unifying function for parsing data from different blocks of information
-func parseData(f *model.Fighter, fighterEl *goquery.Selection) {
-	parseBioFields(f, fighterEl)
-	parseMainStats(f, fighterEl)
-	parseSpecialStats(f, fighterEl)
-	parseWinMethodStats(f, fighterEl)
+// run holds the state of a single Scraper.Run call. Colly collectors remember visited pages,
+// so every run gets its own ones.
+type run struct {
+	*Scraper
+
+	ctx      context.Context
+	cancel   context.CancelCauseFunc
+	budget   *fetch.Budget
+	listing  *colly.Collector
+	details  *colly.Collector
+	jobs     chan job
+	listings *listingTracker
+	counters counters
+
+	// current and next are listing pages, they are used by the listing goroutine only.
+	current string
+	next    string
 }
 
-// parseBioFields parses fighter's data from biography block and sets values to model.Fighter
-func parseBioFields(f *model.Fighter, fighterEl *goquery.Selection) {
-	fields := fighterEl.Find("div.c-bio__info-details")
-	fields.Find("div.c-bio__info-details .c-bio__field").Each(func(index int, bioField *goquery.Selection) {
-		fieldLabel := bioField.Find(".c-bio__label").Text()
-		fieldValue := strings.TrimSpace(bioField.Find(".c-bio__text").Text())
+// newRun creates collectors of a run. The details collector is a clone of the listing one,
+// they share the HTTP client and limits, so the domain parallelism covers both listing and athlete pages.
+// Requests are limited by the retry timeout of every attempt, the collector timeout would cut retries.
+func (s *Scraper) newRun(ctx context.Context, cancel context.CancelCauseFunc) *run {
+	r := &run{
+		Scraper:  s,
+		ctx:      ctx,
+		cancel:   cancel,
+		listing:  colly.NewCollector(colly.UserAgent("Mozilla/5.0")),
+		jobs:     make(chan job),
+		listings: newListingTracker(),
+	}
 
-		switch fieldLabel {
-		case "Age":
-			v, err := strconv.Atoi(fieldValue)
-			if err != nil {
-				l.Errorf("Age conversion error: %s", err)
-			} else {
-				f.Age = int8(v)
-			}
-		case "Status":
-			f.Status = fieldValue
-		case "Hometown":
-			f.Hometown = fieldValue
-		case "Trains at":
-			f.TrainsAt = fieldValue
-		case "Fighting style":
-			f.FightingStyle = fieldValue
-		case "Height":
-			v, err := strconv.ParseFloat(fieldValue, 32)
-			if err != nil {
-				l.Errorf("Height conversion error: %s", err)
-			} else {
-				f.Height = float32(v)
-			}
-		case "Weight":
-			v, err := strconv.ParseFloat(fieldValue, 32)
-			if err != nil {
-				l.Errorf("Weight conversion error:", err)
-			} else {
-				f.Weight = float32(v)
-			}
-		case "Octagon Debut":
-			f.OctagonDebut = fieldValue
-			f.DebutTimestamp = scraperutil.GetDebutTimestamp(fieldValue)
-		case "Reach":
-			v, err := strconv.ParseFloat(fieldValue, 32)
-			if err != nil {
-				l.Error("Reach conversion error:", err)
-			} else {
-				f.Reach = float32(v)
-			}
-		case "Leg reach":
-			v, err := strconv.ParseFloat(fieldValue, 32)
-			if err != nil {
-				l.Error("Leg Reach conversion error:", err)
-			} else {
-				f.LegReach = float32(v)
-			}
-		}
+	r.listing.WithTransport(r.transport())
+	r.listing.SetRequestTimeout(0)
+
+	rules := make([]*colly.LimitRule, 0, len(s.opts.DomainLimits)+1)
+	for _, d := range s.opts.DomainLimits {
+		rules = append(rules, &colly.LimitRule{
+			DomainGlob:  d.Glob,
+			Parallelism: d.Parallelism,
+			RandomDelay: s.opts.RandomDelay,
+		})
+	}
+	rules = append(rules, &colly.LimitRule{
+		DomainGlob:  "*",
+		Parallelism: s.opts.Parallelism,
+		RandomDelay: s.opts.RandomDelay,
 	})
+
+	if err := r.listing.Limits(rules); err != nil {
+		s.l.Errorw("Failed to set domain limits", "error", err)
+	}
+
+	r.details = r.listing.Clone()
+
+	r.listing.OnHTML("html", r.parseListing)
+	r.details.OnRequest(r.setValidators)
+	r.details.OnError(r.keepStatus)
+	r.details.OnHTML("html", r.getData)
+
+	return r
 }
 
-// parseMainStats parses stats from main fighter block and sets values to fighter.Stats
-func parseMainStats(f *model.Fighter, fighterEl *goquery.Selection) {
-	reg := regexp.MustCompile("[^0-9]+")
-	fields := fighterEl.Find("div.stats-records-inner-wrap")
-	fields.Find("div.c-stat-compare__group").Each(func(index int, bioField *goquery.Selection) {
-		fieldLabel := bioField.Find(".c-stat-compare__label").Text()
-		fieldValue := strings.TrimSpace(bioField.Find(".c-stat-compare__number").Text())
-
-		switch fieldLabel {
-		case "Sig. Str. Landed":
-			if fieldValue != "" {
-				v, err := strconv.ParseFloat(fieldValue, 32)
-				if err != nil {
-					l.Error("Sig. Str. Landed conversion error:", err)
-				} else {
-					f.Stats.SigStrLanded = float32(v)
-				}
-			}
-		case "Sig. Str. Absorbed":
-			if fieldValue != "" {
-				v, err := strconv.ParseFloat(fieldValue, 32)
-				if err != nil {
-					l.Error("Sig. Str. Absorbed conversion error:", err)
-				} else {
-					f.Stats.SigStrAbs = float32(v)
-				}
-			}
-		case "Sig. Str. Defense":
-			numericString := reg.ReplaceAllString(fieldValue, "")
-			if numericString != "" {
-				v, err := strconv.Atoi(numericString)
-				if err != nil {
-					l.Error("Sig. Str. Defense conversion error:", err)
-				} else {
-					f.Stats.SigStrDefense = int8(v)
-				}
-			}
-		case "Takedown Defense":
-			numericString := reg.ReplaceAllString(fieldValue, "")
-			v, err := strconv.Atoi(numericString)
-			if err != nil {
-				if fieldValue != "" {
-					l.Error("Takedown Defense conversion error:", err)
-				}
-			} else {
-				f.Stats.TakedownDefense = int8(v)
-			}
-		case "Takedown avg":
-			if fieldValue != "" {
-				v, err := strconv.ParseFloat(fieldValue, 32)
-				if err != nil {
-					l.Error("Takedown avg conversion error:", err)
-				} else {
-					f.Stats.TakedownAvg = float32(v)
-				}
-			}
-		case "Submission avg":
-			if fieldValue != "" {
-				v, err := strconv.ParseFloat(fieldValue, 32)
-				if err != nil {
-					l.Error("Submission avg conversion error:", err)
-				} else {
-					f.Stats.SubmissionAvg = float32(v)
-				}
-			}
-		case "Knockdown Avg":
-			if fieldValue != "" {
-				v, err := strconv.ParseFloat(fieldValue, 32)
-				if err != nil {
-					l.Error("Knockdown Avg conversion error:", err)
-				} else {
-					f.Stats.KnockdownAvg = float32(v)
-				}
-			}
-		case "Average fight time":
-			f.Stats.AvgFightTime = fieldValue
+// visitListings visits listing pages one by one until the last page. Athlete links of a page are queued
+// while the page is visited, so the next page is requested when the workers took all athletes of the current one.
+func (r *run) visitListings() error {
+	for url := r.opts.StartURL; url != ""; url = r.next {
+		if r.ctx.Err() != nil {
+			return context.Cause(r.ctx)
 		}
-	})
-}
 
-// parseSpecialStats parses stats from special fighter block and sets values to fighter.Stats.
-func parseSpecialStats(f *model.Fighter, fighterEl *goquery.Selection) {
-	fields := fighterEl.Find("div.stats-records-inner-wrap")
+		r.current, r.next = url, ""
 
-	fields.Find("div.c-overlap__inner .c-overlap__stats").Each(func(index int, bioField *goquery.Selection) {
-		fieldLabel := bioField.Find("dt.c-overlap__stats-text").Text()
-		fieldValue := strings.TrimSpace(bioField.Find("dd.c-overlap__stats-value").Text())
+		if err := r.cp.StartListing(url); err != nil {
+			r.l.Errorw("Failed to save checkpoint", "error", err)
+		}
 
-		switch fieldLabel {
-		case "Sig. Strikes Landed":
-			v, err := strconv.Atoi(fieldValue)
-			if err != nil {
-				l.Error("Total Sig. Strikes Landed conversion error:", err)
-			} else {
-				f.Stats.TotalSigStrLanded = v
-			}
-		case "Sig. Strikes Attempted":
-			v, err := strconv.Atoi(fieldValue)
-			if err != nil {
-				l.Error("Total Sig. Strikes Attempted conversion error:", err)
-			} else {
-				f.Stats.TotalSigStrAttempted = v
-			}
-		case "Takedowns Landed":
-			v, err := strconv.Atoi(fieldValue)
-			if err != nil {
-				if fieldValue != "" {
-					l.Error("Total Takedowns Landed conversion error:", err)
-				}
-			} else {
-				f.Stats.TotalTkdLanded = v
-			}
-		case "Takedowns Attempted":
-			v, err := strconv.Atoi(fieldValue)
-			if err != nil {
-				l.Error("Total Takedowns Attempted conversion error:", err)
-			} else {
-				f.Stats.TotalTkdAttempted = v
-			}
+		r.listings.start(url)
+
+		err := r.listing.Visit(url)
+		r.counters.listings.Add(1)
+
+		if r.listings.schedule(url, err == nil) {
+			r.completeListing(url)
 		}
-	})
 
-	if f.Stats.TotalTkdAttempted != 0 {
-		f.Stats.TkdAccuracy = int(float64(f.Stats.TotalTkdLanded) / float64(f.Stats.TotalTkdAttempted) * 100)
+		if err != nil {
+			return fmt.Errorf("visit listing page %s: %w", url, err)
+		}
+	}
+
+	return nil
+}
+
+// parseListing is a callback function used with colly that extracts athlete URLs and the next listing page
+// of a listing page with the source. Athlete URLs are converted to absolute URLs and queued for the workers,
+// the next page is visited by visitListings when the current one is done.
+func (r *run) parseListing(e *colly.HTMLElement) {
+	athletes, next := r.opts.Source.Listing(e.DOM)
+
+	for _, href := range athletes {
+		if r.ctx.Err() != nil {
+			return
+		}
+
+		r.queueAthlete(e.Request.AbsoluteURL(href))
 	}
 
-	if f.Stats.TotalSigStrAttempted != 0 {
-		f.Stats.StrAccuracy = int(float64(f.Stats.TotalSigStrLanded) / float64(f.Stats.TotalSigStrAttempted) * 100)
+	if next != "" && r.next == "" {
+		r.next = e.Request.AbsoluteURL(next)
+
+		fmt.Fprintln(os.Stderr, "Next page:", r.next)
+		r.l.Infow(r.next, "type", "next page")
 	}
 }
 
-// parseWinMethodStats parses stats from the win methods block and sets values to fighter.Stats
-func parseWinMethodStats(f *model.Fighter, el *goquery.Selection) {
-	fields := el.Find("div.stats-records-inner-wrap")
+// queueAthlete sends the athlete page to the workers, athletes visited before the run was interrupted are skipped.
+// Queuing stops when the run is cancelled.
+func (r *run) queueAthlete(athleteURL string) {
+	if r.cp.AthleteDone(athleteURL) {
+		r.counters.skipped.Add(1)
+		r.l.Infow(athleteURL, "type", "athlete visited")
+		return
+	}
 
-	fields.Find("div.stats-records:last-of-type div.stats-records-inner .c-stat-3bar__group").Each(func(index int, bioField *goquery.Selection) {
-		fieldLabel := strings.TrimSpace(bioField.Find("div.c-stat-3bar__label").Text())
-		fieldValue := strings.TrimSpace(bioField.Find("div.c-stat-3bar__value").Text())
+	r.listings.add(r.current)
 
-		switch fieldLabel {
-		case "KO/TKO":
-			v, err := strconv.Atoi(strings.Split(fieldValue, " ")[0])
-			if err != nil {
-				l.Error("KO/TKO data conversion error:", err)
-			} else {
-				f.Stats.WinByKO = v
-			}
-		case "DEC":
-			v, err := strconv.Atoi(strings.Split(fieldValue, " ")[0])
-			if err != nil {
-				l.Error("DEC data conversion error:", err)
-			} else {
-				f.Stats.WinByDec = v
-			}
+	select {
+	case r.jobs <- job{url: athleteURL, listing: r.current}:
+	case <-r.ctx.Done():
+		r.listings.done(r.current, false)
+	}
+}
 
-		case "SUB":
-			v, err := strconv.Atoi(strings.Split(fieldValue, " ")[0])
-			if err != nil {
-				l.Error("SUB data conversion error:", err)
-			} else {
-				f.Stats.WinBySub = v
-			}
+// scrapeAthlete visits the athlete page of the job and records it in the checkpoint.
+// The listing page of the job is recorded as done with its last athlete.
+func (r *run) scrapeAthlete(j job) {
+	ok := r.visitAthlete(j.url)
+
+	if r.listings.done(j.listing, ok) {
+		r.completeListing(j.listing)
+	}
+}
+
+// visitAthlete scrapes the athlete page and records the athlete as visited once its fighter is written
+// or didn't change. It returns false if the athlete has to be visited again by a resumed run.
+func (r *run) visitAthlete(athleteURL string) bool {
+	if r.ctx.Err() != nil {
+		return false
+	}
+
+	fmt.Fprintln(os.Stderr, "Athlete link:", athleteURL)
+	r.l.Infow(athleteURL, "type", "athlete link")
+
+	pageCtx := colly.NewContext()
+	err := r.details.Request(http.MethodGet, athleteURL, nil, pageCtx, nil)
+	switch {
+	case isNotModified(pageCtx, err):
+		r.counters.unchanged.Add(1)
+		r.l.Infow(athleteURL, "type", "athlete not modified")
+	case errors.Is(err, fetch.ErrDisallowed):
+		// the page won't be allowed by the next run either, so it doesn't keep its listing page unfinished
+		r.counters.disallowed.Add(1)
+		r.l.Infow(athleteURL, "type", "athlete disallowed")
+		return true
+	case err != nil:
+		r.counters.failed.Add(1)
+		r.l.Errorw("Failed to visit athlete page", "url", athleteURL, "error", err)
+		return false
+	case !isScraped(pageCtx):
+		// the fighter wasn't written, the athlete stays unvisited for the resumed run
+		r.counters.athletes.Add(1)
+		if pageCtx.GetAny(scrapedKey) == nil {
+			r.counters.failed.Add(1)
+			r.l.Errorw("Athlete page not parsed", "url", athleteURL, "source", r.opts.Source.Name())
 		}
-	})
+		return false
+	}
+
+	r.counters.athletes.Add(1)
+
+	if err := r.cp.CompleteAthlete(athleteURL); err != nil {
+		r.l.Errorw("Failed to save checkpoint", "error", err)
+	}
+
+	return true
+}
+
+func (r *run) completeListing(url string) {
+	if err := r.cp.CompleteListing(url); err != nil {
+		r.l.Errorw("Failed to save checkpoint", "error", err)
+	}
 }
 
-// moveNextPage navigates to the next page using colly.
-// It extracts the "href" attribute from the provided HTML element,
-// converts it to an absolute URL, prints it, and visits the next page.
-func moveNextPage(e *colly.HTMLElement) {
-	wg.Add(1)
-	defer wg.Done()
+// getData is a callback function used with colly that extracts fighter data of an athlete page with the source
+// and writes the resulting Fighter instance to the output sink. The fighter URL is the page URL, a relative image URL
+// is converted to an absolute one.
+// In the incremental mode the fighter isn't written if the profile content hash matches the previous run.
+// Validators of the page are staged only after the fighter is written.
+// The outcome is stored in the request context, visitAthlete completes the athlete only if it was scraped.
+func (r *run) getData(e *colly.HTMLElement) {
+	pageURL := e.Request.URL.String()
+
+	fighterEl := r.opts.Source.Profile(e.DOM)
+	if fighterEl.Length() == 0 {
+		r.counters.failed.Add(1)
+		r.l.Errorw("Athlete page without profile", "pageURL", pageURL, "source", r.opts.Source.Name())
+		e.Request.Ctx.Put(scrapedKey, false)
+		return
+	}
 
-	nextUrl := e.Attr("href")
-	nextUrl = e.Request.AbsoluteURL(nextUrl)
+	page := r.page(pageURL, e.Response, fighterEl)
+	if r.opts.Incremental && page.Hash != "" && !r.cp.PageChanged(pageURL, page.Hash) {
+		r.counters.unchanged.Add(1)
+		r.l.Infow(pageURL, "type", "athlete not changed")
+		e.Request.Ctx.Put(scrapedKey, true)
+		return
+	}
 
-	fmt.Println("Next page:", nextUrl)
-	l.Infow(nextUrl, "type", "next page")
+	fighter := r.opts.Source.Fighter(fighterEl)
+	fighter.FighterUrl = pageURL
+	if fighter.ImageUrl != "" {
+		fighter.ImageUrl = e.Request.AbsoluteURL(fighter.ImageUrl)
+	}
 
-	e.Request.Visit(nextUrl)
+	if err := r.out.Write(fighter); err != nil {
+		r.counters.failed.Add(1)
+		r.l.Errorf("[%s] Failed to write fighter: %s", fighter.Name, err)
+		e.Request.Ctx.Put(scrapedKey, false)
+		return
+	}
+
+	if page.Hash != "" {
+		r.cp.StagePage(pageURL, page)
+	}
+
+	r.counters.written.Add(1)
+	e.Request.Ctx.Put(scrapedKey, true)
 }
 
-// saveToJSON marshals the provided FightersCollection to JSON format
-// and writes it to the file. If there is an error during
-// marshalling, file opening, or file writing, it logs the error. This function
-// is used to store fighter data in JSON format.
-func saveToJSON(c model.FightersCollection, toAdd bool) {
-	if toAdd {
-		scraperutil.AddToExistedCollection(c)
+// setValidators adds conditional request headers with validators of the previous run in the incremental mode,
+// so the server can answer 304 Not Modified without sending the page.
+func (r *run) setValidators(req *colly.Request) {
+	if !r.opts.Incremental {
+		return
+	}
+
+	page, ok := r.cp.Page(req.URL.String())
+	if !ok {
+		return
+	}
+
+	if page.ETag != "" {
+		req.Headers.Set("If-None-Match", page.ETag)
+	}
+	if page.LastModified != "" {
+		req.Headers.Set("If-Modified-Since", page.LastModified)
+	}
+}
+
+// page returns validators of the athlete page. The hash is empty if the profile can't be hashed,
+// such a page is always treated as changed.
+func (r *run) page(url string, resp *colly.Response, fighterEl *goquery.Selection) checkpoint.Page {
+	html, err := goquery.OuterHtml(fighterEl)
+	if err != nil {
+		r.l.Errorw("Failed to hash athlete page", "url", url, "error", err)
+		return checkpoint.Page{}
+	}
+
+	return checkpoint.Page{
+		Hash:         checkpoint.Hash([]byte(html)),
+		ETag:         resp.Headers.Get("ETag"),
+		LastModified: resp.Headers.Get("Last-Modified"),
+	}
+}
+
+// statusKey is the key of the response status in the colly context of a failed request.
+const statusKey = "status"
+
+// keepStatus stores the status code of a failed request in its context,
+// colly returns non 2xx responses as errors with the status text only.
+func (r *run) keepStatus(resp *colly.Response, err error) {
+	resp.Ctx.Put(statusKey, resp.StatusCode)
+}
+
+// isNotModified reports whether the request of the context was answered with 304 Not Modified.
+func isNotModified(ctx *colly.Context, err error) bool {
+	status, _ := ctx.GetAny(statusKey).(int)
+
+	return err != nil && status == http.StatusNotModified
+}
+
+// scrapedKey is the key of the outcome of an athlete page in the colly context set by getData, it is true
+// if the fighter was written to the sink or didn't change. A page without the html element never reaches getData.
+const scrapedKey = "scraped"
+
+// isScraped reports whether the fighter of the athlete page of the context was written or didn't change.
+func isScraped(ctx *colly.Context) bool {
+	scraped, ok := ctx.GetAny(scrapedKey).(bool)
+
+	return ok && scraped
+}
+
+// transport returns the transport of the run. Pages come from a replayer, a recorder or the default transport
+// with the proxy pool, every attempt counts in the budget of the run, failed attempts are retried and with robots
+// the rules are checked before the retries, so a disallowed page is never requested.
+func (r *run) transport() http.RoundTripper {
+	var t http.RoundTripper
+
+	if r.opts.ReplayDir != "" {
+		t = replay.NewReplayer(r.opts.ReplayDir)
 	} else {
-		scraperutil.CreateNewCollection(c)
+		base := http.DefaultTransport.(*http.Transport).Clone()
+		t = base
+
+		if proxies := r.proxies(); len(proxies) > 0 {
+			t = fetch.NewProxyPool(proxies, r.opts.ProxyHealth, base, r.l)
+		}
+
+		if r.opts.RecordDir != "" {
+			t = replay.NewRecorder(r.opts.RecordDir, t)
+		}
+	}
+
+	r.budget = fetch.NewBudget(r.opts.Budget, t, r.cancel)
+	t = fetch.NewRetry(r.opts.Retry, r.budget, r.l)
+
+	if r.opts.Robots {
+		t = fetch.NewRobots(t)
+	}
+
+	return contextTransport{ctx: r.ctx, next: t}
+}
+
+// proxies returns URLs of the configured proxies, invalid addresses are skipped.
+func (r *run) proxies() []*url.URL {
+	p := r.opts.Proxy
+	if p == nil {
+		return nil
+	}
+
+	urls := make([]*url.URL, 0, len(p.Proxys))
+	for _, addr := range p.Proxys {
+		u, err := url.Parse(fmt.Sprintf("socks5h://%s:%s@%s", p.Login, p.Password, addr))
+		if err != nil {
+			r.l.Errorw("Invalid proxy address", "proxy", addr, "error", err)
+			continue
+		}
+
+		urls = append(urls, u)
+	}
+
+	return urls
+}
+
+// contextTransport binds requests to the run context, colly doesn't take a context,
+// so it is the way to abort requests in progress when the run is cancelled.
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(t.ctx))
+}
+
+// listingTracker counts athletes of listing pages in progress. A page is done when it was visited
+// and all its athletes were scraped, a page with a failed athlete is never done, so a resumed run visits it again.
+type listingTracker struct {
+	mu    sync.Mutex
+	pages map[string]*listingPage
+}
+
+type listingPage struct {
+	pending   int
+	scheduled bool
+	failed    bool
+}
+
+func newListingTracker() *listingTracker {
+	return &listingTracker{
+		pages: make(map[string]*listingPage),
 	}
 }
 
-func getProxy() string {
-	proxys := viper.GetStringSlice("Proxys")
-	if len(proxys) == 0 {
-		return ""
+// start registers the listing page before its athletes are queued.
+func (t *listingTracker) start(url string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.pages[url]; !ok {
+		t.pages[url] = &listingPage{}
 	}
+}
+
+// add counts a queued athlete of the page.
+func (t *listingTracker) add(url string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pages[url].pending++
+}
 
-	rand.Seed(time.Now().UnixNano())
-	idx := rand.Intn(len(proxys))
+// schedule marks that all athletes of the page were queued and reports whether the page is done.
+func (t *listingTracker) schedule(url string, ok bool) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-	return proxys[idx]
+	p := t.pages[url]
+	p.scheduled = true
+	p.failed = p.failed || !ok
+
+	return t.release(url, p)
+}
+
+// done counts a scraped athlete of the page and reports whether the page is done.
+func (t *listingTracker) done(url string, ok bool) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p := t.pages[url]
+	p.pending--
+	p.failed = p.failed || !ok
+
+	return t.release(url, p)
+}
+
+// release forgets a page without pending athletes, it must be called with mu held.
+func (t *listingTracker) release(url string, p *listingPage) bool {
+	if !p.scheduled || p.pending > 0 {
+		return false
+	}
+
+	delete(t.pages, url)
+
+	return !p.failed
+}
+
+// counters are updated by the workers concurrently.
+type counters struct {
+	listings   atomic.Int64
+	athletes   atomic.Int64
+	written    atomic.Int64
+	unchanged  atomic.Int64
+	skipped    atomic.Int64
+	disallowed atomic.Int64
+	failed     atomic.Int64
+}
+
+func (c *counters) result() Result {
+	return Result{
+		Listings:   c.listings.Load(),
+		Athletes:   c.athletes.Load(),
+		Written:    c.written.Load(),
+		Unchanged:  c.unchanged.Load(),
+		Skipped:    c.skipped.Load(),
+		Disallowed: c.disallowed.Load(),
+		Failed:     c.failed.Load(),
+	}
 }
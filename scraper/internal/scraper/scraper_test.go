@@ -0,0 +1,391 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"pickfighter.com/scraper/internal/checkpoint"
+	"pickfighter.com/scraper/internal/fetch"
+	"pickfighter.com/scraper/pkg/model"
+)
+
+const (
+	testPages    = 3
+	testAthletes = 4
+)
+
+// memorySink collects fighters, onWrite is called after every fighter. Writes of fighters whose URL
+// has the suffix failSuffix fail.
+type memorySink struct {
+	mu         sync.Mutex
+	fighters   []model.Fighter
+	onWrite    func(n int)
+	failSuffix string
+}
+
+func (s *memorySink) Write(f model.Fighter) error {
+	if s.failSuffix != "" && strings.HasSuffix(f.FighterUrl, s.failSuffix) {
+		return errors.New("sink unavailable")
+	}
+
+	s.mu.Lock()
+	s.fighters = append(s.fighters, f)
+	n := len(s.fighters)
+	s.mu.Unlock()
+
+	if s.onWrite != nil {
+		s.onWrite(n)
+	}
+
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	return nil
+}
+
+func (s *memorySink) urls() map[string]bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	urls := make(map[string]bool, len(s.fighters))
+	for _, f := range s.fighters {
+		urls[f.FighterUrl] = true
+	}
+
+	return urls
+}
+
+// newTestServer serves a listing of testPages pages with testAthletes athletes each.
+func newTestServer(t *testing.T) *httptest.Server {
+	return startTestServer(t, newTestMux())
+}
+
+func newTestMux() *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/athletes/all", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		fmt.Fprint(w, "<html><body>")
+		for i := 0; i < testAthletes; i++ {
+			fmt.Fprintf(w, `<div class="c-listing-athlete-flipcard__action"><a href="/athlete/%d-%d">Athlete</a></div>`, page, i)
+		}
+		if page < testPages-1 {
+			fmt.Fprintf(w, `<ul><li class="pager__item"><a href="/athletes/all?page=%d">Load more</a></li></ul>`, page+1)
+		}
+		fmt.Fprint(w, "</body></html>")
+	})
+
+	mux.HandleFunc("/athlete/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `<html><body><div class="hero"><div class="hero-profile-wrap">
+			<p class="hero-profile__division-title">Lightweight Division</p>
+			<h1 class="hero-profile__name">Athlete %s</h1>
+			<p class="hero-profile__division-body">10-2-0 (W-L-D)</p>
+			</div></div></body></html>`, r.URL.Path)
+	})
+
+	return mux
+}
+
+func startTestServer(t *testing.T, h http.Handler) *httptest.Server {
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newTestScraper(t *testing.T, srv *httptest.Server, out *memorySink, cp *checkpoint.Checkpoint, startURL string) *Scraper {
+	if startURL == "" {
+		startURL = srv.URL + "/athletes/all"
+	}
+
+	return New(Options{
+		StartURL:    startURL,
+		Workers:     4,
+		Parallelism: 3,
+		Retry:       fetch.RetryConfig{BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond},
+	}, out, cp, zap.NewNop().Sugar())
+}
+
+func TestScraperRun(t *testing.T) {
+	srv := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	out := &memorySink{}
+	res, err := newTestScraper(t, srv, out, cp, "").Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Len(t, out.urls(), testPages*testAthletes)
+	assert.Equal(t, Result{
+		Listings: testPages,
+		Athletes: testPages * testAthletes,
+		Written:  testPages * testAthletes,
+		Requests: testPages + testPages*testAthletes,
+	}, res)
+
+	for _, f := range out.fighters {
+		assert.Equal(t, 10, f.Wins)
+		assert.Equal(t, 2, f.Loses)
+	}
+
+	cp, err = checkpoint.Open(path, true)
+	require.NoError(t, err)
+	assert.Empty(t, cp.Current(), "finished run must not be resumed")
+}
+
+func TestScraperRunResume(t *testing.T) {
+	srv := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	out := &memorySink{
+		onWrite: func(n int) {
+			if n == testAthletes+1 {
+				cancel()
+			}
+		},
+	}
+
+	_, err = newTestScraper(t, srv, out, cp, "").Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	cp, err = checkpoint.Open(path, true)
+	require.NoError(t, err)
+	require.NotEmpty(t, cp.Current())
+
+	visited := cp.VisitedAthletes()
+	require.Less(t, visited, testPages*testAthletes)
+
+	resumed := &memorySink{}
+	res, err := newTestScraper(t, srv, resumed, cp, cp.Current()).Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Less(t, res.Athletes, int64(testPages*testAthletes), "visited athletes must not be scraped again")
+
+	urls := out.urls()
+	for url := range resumed.urls() {
+		urls[url] = true
+	}
+	assert.Len(t, urls, testPages*testAthletes)
+}
+
+func TestScraperRunWriteFailed(t *testing.T) {
+	srv := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	out := &memorySink{failSuffix: "/athlete/1-2"}
+	res, err := newTestScraper(t, srv, out, cp, "").Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), res.Failed)
+	assert.Equal(t, int64(testPages*testAthletes-1), res.Written)
+
+	// the fighter wasn't written, a resumed run has to visit the athlete again
+	assert.False(t, cp.AthleteDone(srv.URL+"/athlete/1-2"))
+	assert.True(t, cp.AthleteDone(srv.URL+"/athlete/1-1"))
+	assert.Equal(t, testPages*testAthletes-1, cp.VisitedAthletes())
+}
+
+func TestScraperRunIncremental(t *testing.T) {
+	srv := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	_, err = newTestScraper(t, srv, &memorySink{}, cp, "").Run(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, cp.CommitPages())
+
+	cp, err = checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	s := newTestScraper(t, srv, &memorySink{}, cp, "")
+	s.opts.Incremental = true
+
+	res, err := s.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(0), res.Written)
+	assert.Equal(t, int64(testPages*testAthletes), res.Unchanged)
+}
+
+func TestScraperRunIncrementalNotCommitted(t *testing.T) {
+	srv := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	// the sink was never flushed, e.g. the process crashed before the json sink was closed
+	_, err = newTestScraper(t, srv, &memorySink{}, cp, "").Run(context.Background())
+	require.NoError(t, err)
+
+	cp, err = checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	s := newTestScraper(t, srv, &memorySink{}, cp, "")
+	s.opts.Incremental = true
+
+	res, err := s.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(testPages*testAthletes), res.Written, "fighters lost before the flush must be written again")
+	assert.Equal(t, int64(0), res.Unchanged)
+}
+
+func TestScraperRunIncrementalNotModified(t *testing.T) {
+	base := newTestMux()
+
+	var notModified atomic.Int64
+	mux := http.NewServeMux()
+	mux.Handle("/athletes/all", base)
+	mux.HandleFunc("/athlete/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		base.ServeHTTP(w, r)
+	})
+	srv := startTestServer(t, mux)
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	_, err = newTestScraper(t, srv, &memorySink{}, cp, "").Run(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, cp.CommitPages())
+
+	cp, err = checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	s := newTestScraper(t, srv, &memorySink{}, cp, "")
+	s.opts.Incremental = true
+
+	res, err := s.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(testPages*testAthletes), notModified.Load())
+	assert.Equal(t, int64(0), res.Written)
+	assert.Equal(t, int64(0), res.Failed)
+	assert.Equal(t, int64(testPages*testAthletes), res.Unchanged)
+}
+
+func TestScraperRunRetry(t *testing.T) {
+	mux := newTestMux()
+
+	var mu sync.Mutex
+	failed := make(map[string]bool)
+
+	// every athlete page fails once with 503 or 429
+	srv := startTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		first := strings.HasPrefix(r.URL.Path, "/athlete/") && !failed[r.URL.Path]
+		failed[r.URL.Path] = true
+		mu.Unlock()
+
+		if first && strings.HasSuffix(r.URL.Path, "-0") {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		} else if first {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	}))
+
+	cp, err := checkpoint.Open(filepath.Join(t.TempDir(), "checkpoint.json"), false)
+	require.NoError(t, err)
+
+	out := &memorySink{}
+	res, err := newTestScraper(t, srv, out, cp, "").Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Len(t, out.urls(), testPages*testAthletes)
+	assert.Equal(t, int64(0), res.Failed)
+	assert.Equal(t, int64(testPages*testAthletes), res.Errors)
+	assert.Equal(t, int64(testPages+2*testPages*testAthletes), res.Requests)
+}
+
+func TestScraperRunRobots(t *testing.T) {
+	mux := newTestMux()
+	mux.HandleFunc("/robots.txt", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "User-agent: *\nDisallow: /athlete/1-\n")
+	})
+	srv := startTestServer(t, mux)
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	cp, err := checkpoint.Open(path, false)
+	require.NoError(t, err)
+
+	s := newTestScraper(t, srv, &memorySink{}, cp, "")
+	s.opts.Robots = true
+
+	res, err := s.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(testAthletes), res.Disallowed)
+	assert.Equal(t, int64((testPages-1)*testAthletes), res.Written)
+	assert.Equal(t, int64(0), res.Failed)
+
+	cp, err = checkpoint.Open(path, true)
+	require.NoError(t, err)
+	assert.Empty(t, cp.Current(), "disallowed athletes must not keep the run unfinished")
+}
+
+func TestScraperRunBudget(t *testing.T) {
+	srv := newTestServer(t)
+
+	cp, err := checkpoint.Open(filepath.Join(t.TempDir(), "checkpoint.json"), false)
+	require.NoError(t, err)
+
+	s := newTestScraper(t, srv, &memorySink{}, cp, "")
+	s.opts.Budget = fetch.BudgetConfig{MaxRequests: testAthletes}
+
+	res, err := s.Run(context.Background())
+	require.ErrorIs(t, err, fetch.ErrBudgetExceeded)
+
+	assert.Equal(t, int64(testAthletes), res.Requests)
+	assert.Less(t, res.Written, int64(testPages*testAthletes))
+}
+
+func TestScrapeResumeSeveralSources(t *testing.T) {
+	viper.Set("checkpoint.resume", true)
+	viper.Set("scraper.sources", []string{"ufc", "sherdog"})
+	t.Cleanup(viper.Reset)
+
+	// nothing is scraped, the athletes of a merged run would be skipped by the next resume
+	_, err := Scrape(context.Background(), Job{}, zap.NewNop().Sugar())
+	assert.ErrorContains(t, err, "--resume can't be used with several sources")
+}
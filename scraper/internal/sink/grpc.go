@@ -0,0 +1,66 @@
+package sink
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"pickfighter.com/gen"
+	"pickfighter.com/internal/grpcutil"
+	"pickfighter.com/pkg/discovery"
+	"pickfighter.com/scraper/pkg/logger"
+	"pickfighter.com/scraper/pkg/model"
+)
+
+// GRPC pushes fighters to the fighters service with the UpsertFighters client stream,
+// so scraped data is stored without intermediate files.
+type GRPC struct {
+	mu     sync.Mutex
+	conn   *grpc.ClientConn
+	stream gen.FightersService_UpsertFightersClient
+}
+
+// NewGRPC finds the fighters service in the registry and opens an UpsertFighters stream.
+// The stream lives as long as ctx, fighters are stored by the service as they arrive.
+func NewGRPC(ctx context.Context, registry discovery.Registry) (*GRPC, error) {
+	conn, err := grpcutil.ServiceConnection(ctx, "fighters-service", registry)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := gen.NewFightersServiceClient(conn).UpsertFighters(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &GRPC{
+		conn:   conn,
+		stream: stream,
+	}, nil
+}
+
+// Write sends the fighter to the fighters service.
+func (s *GRPC) Write(f model.Fighter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stream.Send(model.FighterToProto(&f))
+}
+
+// Close finishes the stream, logs counts of stored fighters and closes the connection.
+func (s *GRPC) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.conn.Close()
+
+	resp, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	logger.Get().Infow("Fighters stored", "type", "result",
+		"created", resp.Created, "updated", resp.Updated, "failed", resp.Failed)
+
+	return nil
+}
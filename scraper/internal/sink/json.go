@@ -0,0 +1,110 @@
+package sink
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"strconv"
+	"sync"
+
+	"pickfighter.com/scraper/pkg/model"
+)
+
+// JSON collects fighters and writes them as a single FightersCollection document on Close.
+// This is the file read by the update command of the fighters service.
+type JSON struct {
+	path   string
+	append bool
+
+	mu         sync.Mutex
+	collection model.FightersCollection
+}
+
+// NewJSON creates a JSON sink writing to the file at path. If toAdd is set, fighters are added to
+// the collection already stored in the file and duplicates are dropped.
+func NewJSON(path string, toAdd bool) *JSON {
+	return &JSON{
+		path:   path,
+		append: toAdd,
+	}
+}
+
+// Write adds the fighter to the collection.
+func (s *JSON) Write(f model.Fighter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collection.Fighters = append(s.collection.Fighters, f)
+
+	return nil
+}
+
+// Close writes the collection to the file.
+func (s *JSON) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	collection := s.collection
+
+	if s.append {
+		existing, err := readCollection(s.path)
+		if err != nil {
+			return err
+		}
+
+		existing.Fighters = append(existing.Fighters, collection.Fighters...)
+		collection = uniqueCollection(existing)
+	}
+
+	file, err := os.Create(s.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(collection); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// readCollection reads a collection from the file, a missing file is an empty collection.
+func readCollection(path string) (model.FightersCollection, error) {
+	var c model.FightersCollection
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	} else if err != nil {
+		return c, err
+	}
+	defer file.Close()
+
+	err = json.NewDecoder(file).Decode(&c)
+
+	return c, err
+}
+
+// uniqueCollection drops fighters with the same name, nickname and debut, the last scraped one replaces
+// the earlier ones in their place, so a refreshed fighter updates the stored collection.
+func uniqueCollection(c model.FightersCollection) model.FightersCollection {
+	seen := make(map[string]int, len(c.Fighters))
+	fighters := make([]model.Fighter, 0, len(c.Fighters))
+
+	for _, fighter := range c.Fighters {
+		key := fighter.Name + fighter.NickName + strconv.Itoa(fighter.DebutTimestamp)
+		if i, ok := seen[key]; ok {
+			fighters[i] = fighter
+			continue
+		}
+
+		seen[key] = len(fighters)
+		fighters = append(fighters, fighter)
+	}
+
+	return model.FightersCollection{
+		Fighters: fighters,
+	}
+}
@@ -0,0 +1,228 @@
+package sink
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"pickfighter.com/pkg/domain"
+	"pickfighter.com/scraper/pkg/model"
+)
+
+// MergePolicy defines how fields of a fighter found by several sources are combined.
+type MergePolicy string
+
+// Supported merge policies. Sources are ranked in the order they are given to the scraper.
+const (
+	// MergeFill keeps fields of the highest ranked source and fills empty ones from the next sources.
+	MergeFill MergePolicy = "fill"
+	// MergeOverride replaces fields with values set by lower ranked sources.
+	MergeOverride MergePolicy = "override"
+)
+
+var ErrUnknownMergePolicy = errors.New("unknown merge policy")
+
+// ParseMergePolicy converts a string into a supported MergePolicy. An empty string means fill.
+func ParseMergePolicy(s string) (MergePolicy, error) {
+	switch p := MergePolicy(strings.ToLower(s)); p {
+	case "":
+		return MergeFill, nil
+	case MergeFill, MergeOverride:
+		return p, nil
+	default:
+		return "", ErrUnknownMergePolicy
+	}
+}
+
+// Merge combines fighters scraped from several sources and writes them to the next sink on Close.
+// Fighters are the same if they have the same fighter URL, or the same normalized name if it is not shared
+// by namesakes, see mergeFighters. The fighter URL of the highest ranked source is kept with any policy,
+// it identifies the fighter in the fighters service. Zero values marked as parsed by a source, e.g. the
+// flyweight division or 0 losses, are set fields, other zero values are empty ones.
+type Merge struct {
+	next   Sink
+	policy MergePolicy
+
+	mu       sync.Mutex
+	scraped  []ranked
+	closeErr error
+	closed   bool
+}
+
+type ranked struct {
+	rank    int
+	fighter model.Fighter
+}
+
+// NewMerge creates a Merge writing merged fighters to next.
+func NewMerge(next Sink, policy MergePolicy) *Merge {
+	return &Merge{
+		next:   next,
+		policy: policy,
+	}
+}
+
+// Source returns the sink of the source with the given rank, 0 is the highest rank.
+// Closing the returned sink does nothing, fighters are merged when the Merge is closed.
+func (m *Merge) Source(rank int) Sink {
+	return &mergeSource{
+		merge: m,
+		rank:  rank,
+	}
+}
+
+// Close merges the fighters, writes them to the next sink and closes it.
+func (m *Merge) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return m.closeErr
+	}
+	m.closed = true
+
+	for _, f := range mergeFighters(m.scraped, m.policy) {
+		if err := m.next.Write(f); err != nil {
+			m.closeErr = errors.Join(m.closeErr, err)
+		}
+	}
+
+	m.closeErr = errors.Join(m.closeErr, m.next.Close())
+
+	return m.closeErr
+}
+
+func (m *Merge) add(rank int, f model.Fighter) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.scraped = append(m.scraped, ranked{rank: rank, fighter: f})
+
+	return nil
+}
+
+type mergeSource struct {
+	merge *Merge
+	rank  int
+}
+
+func (s *mergeSource) Write(f model.Fighter) error {
+	return s.merge.add(s.rank, f)
+}
+
+func (s *mergeSource) Close() error {
+	return nil
+}
+
+// mergeFighters groups fighters by URL or normalized name and merges every group in the rank order.
+// A fighter joins a group by name only if the name is not shared by several fighters of one source
+// and the group has no fighter of the same source yet, so namesakes are kept apart.
+// Groups are returned in the order of their first fighter.
+func mergeFighters(scraped []ranked, policy MergePolicy) []model.Fighter {
+	ordered := make([]ranked, len(scraped))
+	copy(ordered, scraped)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].rank < ordered[j].rank
+	})
+
+	// names shared by different fighters of one source can't be used to match fighters of other sources
+	namesakes := make(map[string]bool)
+	seen := make(map[int]map[string]string)
+	for _, r := range ordered {
+		name := domain.NormalizeName(r.fighter.Name)
+		if name == "" {
+			continue
+		}
+
+		if seen[r.rank] == nil {
+			seen[r.rank] = make(map[string]string)
+		}
+		if url, ok := seen[r.rank][name]; ok && (url != r.fighter.FighterUrl || url == "") {
+			namesakes[name] = true
+		}
+		seen[r.rank][name] = r.fighter.FighterUrl
+	}
+
+	var merged []*group
+	byURL := make(map[string]*group)
+	byName := make(map[string]*group)
+
+	for _, r := range ordered {
+		f := r.fighter
+		name := domain.NormalizeName(f.Name)
+
+		target := byURL[f.FighterUrl]
+		if target == nil && name != "" && !namesakes[name] {
+			if g := byName[name]; g != nil && !g.ranks[r.rank] {
+				target = g
+			}
+		}
+
+		if target == nil {
+			target = &group{fighter: f, ranks: make(map[int]bool)}
+			target.fighter.Parsed = make(model.Fields, len(f.Parsed))
+			for field := range f.Parsed {
+				target.fighter.Parsed[field] = true
+			}
+			merged = append(merged, target)
+		} else {
+			url := target.fighter.FighterUrl
+			mergeValue(reflect.ValueOf(&target.fighter).Elem(), reflect.ValueOf(f), "", target.fighter.Parsed, f.Parsed, policy == MergeOverride)
+			target.fighter.FighterUrl = url
+		}
+		target.ranks[r.rank] = true
+
+		if f.FighterUrl != "" {
+			byURL[f.FighterUrl] = target
+		}
+		if name != "" && byName[name] == nil {
+			byName[name] = target
+		}
+	}
+
+	fighters := make([]model.Fighter, 0, len(merged))
+	for _, g := range merged {
+		fighters = append(fighters, g.fighter)
+	}
+
+	return fighters
+}
+
+// group is a merged fighter with the ranks of sources it was found by.
+type group struct {
+	fighter model.Fighter
+	ranks   map[int]bool
+}
+
+// mergeValue copies fields set in src into dst, nested structs are merged field by field.
+// A field is set if its source marked it as parsed or its value is not zero, see model.Fields.
+// Without override only fields not set in dst are set. Parsed fields of src are added to dstParsed.
+func mergeValue(dst, src reflect.Value, prefix string, dstParsed, srcParsed model.Fields, override bool) {
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		if field.Name == "Parsed" {
+			continue
+		}
+
+		d, s := dst.Field(i), src.Field(i)
+		path := prefix + field.Name
+
+		if d.Kind() == reflect.Struct {
+			mergeValue(d, s, path+".", dstParsed, srcParsed, override)
+			continue
+		}
+
+		if !srcParsed[path] && s.IsZero() {
+			continue
+		}
+
+		if override || (!dstParsed[path] && d.IsZero()) {
+			d.Set(s)
+			if srcParsed[path] {
+				dstParsed[path] = true
+			}
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package sink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pickfighter.com/pkg/domain"
+	"pickfighter.com/scraper/pkg/model"
+)
+
+// collect keeps fighters written by the merge sink.
+type collect struct {
+	fighters []model.Fighter
+	closed   bool
+}
+
+func (c *collect) Write(f model.Fighter) error {
+	c.fighters = append(c.fighters, f)
+	return nil
+}
+
+func (c *collect) Close() error {
+	c.closed = true
+	return nil
+}
+
+func merge(t *testing.T, policy MergePolicy, sources ...[]model.Fighter) []model.Fighter {
+	t.Helper()
+
+	out := &collect{}
+	m := NewMerge(out, policy)
+
+	for rank, fighters := range sources {
+		src := m.Source(rank)
+		for _, f := range fighters {
+			require.NoError(t, src.Write(f))
+		}
+		require.NoError(t, src.Close())
+	}
+
+	require.NoError(t, m.Close())
+	require.True(t, out.closed)
+
+	return out.fighters
+}
+
+func ufcFighter() model.Fighter {
+	f := model.Fighter{
+		Name:       "Alexandre Pantoja",
+		Division:   domain.Flyweight,
+		Wins:       28,
+		Loses:      0,
+		Draw:       0,
+		FighterUrl: "https://www.ufc.com/athlete/alexandre-pantoja",
+	}
+	f.MarkParsed("Division", "Wins", "Loses", "Draw")
+
+	return f
+}
+
+func sherdogFighter() model.Fighter {
+	f := model.Fighter{
+		Name:       "Alexandre Pantoja",
+		NickName:   "The Cannibal",
+		Division:   domain.Bantamweight,
+		TrainsAt:   "American Top Team",
+		Wins:       29,
+		Loses:      5,
+		Draw:       1,
+		FighterUrl: "https://www.sherdog.com/fighter/Alexandre-Pantoja-82003",
+	}
+	f.MarkParsed("Division", "Wins", "Loses", "Draw")
+
+	return f
+}
+
+func TestMergeFill(t *testing.T) {
+	fighters := merge(t, MergeFill, []model.Fighter{ufcFighter()}, []model.Fighter{sherdogFighter()})
+	require.Len(t, fighters, 1)
+
+	f := fighters[0]
+	// parsed zero values of the highest ranked source are kept
+	assert.Equal(t, domain.Flyweight, f.Division)
+	assert.Equal(t, 28, f.Wins)
+	assert.Equal(t, 0, f.Loses)
+	assert.Equal(t, 0, f.Draw)
+	// missing fields are filled
+	assert.Equal(t, "The Cannibal", f.NickName)
+	assert.Equal(t, "American Top Team", f.TrainsAt)
+	assert.Equal(t, "https://www.ufc.com/athlete/alexandre-pantoja", f.FighterUrl)
+}
+
+func TestMergeFillUnparsed(t *testing.T) {
+	ufc := ufcFighter()
+	ufc.Parsed = nil
+
+	// zero values not parsed by the source are empty fields
+	fighters := merge(t, MergeFill, []model.Fighter{ufc}, []model.Fighter{sherdogFighter()})
+	require.Len(t, fighters, 1)
+
+	assert.Equal(t, domain.Bantamweight, fighters[0].Division)
+	assert.Equal(t, 5, fighters[0].Loses)
+	assert.True(t, fighters[0].Parsed["Division"])
+}
+
+func TestMergeOverride(t *testing.T) {
+	sherdog := sherdogFighter()
+	sherdog.Division = domain.Flyweight
+	sherdog.Loses = 0
+	sherdog.Wins = 0
+	sherdog.Parsed = model.Fields{"Division": true, "Loses": true}
+
+	ufc := ufcFighter()
+	ufc.Division = domain.Bantamweight
+	ufc.Loses = 2
+
+	fighters := merge(t, MergeOverride, []model.Fighter{ufc}, []model.Fighter{sherdog})
+	require.Len(t, fighters, 1)
+
+	f := fighters[0]
+	// parsed zero values of lower ranked sources override
+	assert.Equal(t, domain.Flyweight, f.Division)
+	assert.Equal(t, 0, f.Loses)
+	// zero values not parsed do not
+	assert.Equal(t, 28, f.Wins)
+	assert.Equal(t, "The Cannibal", f.NickName)
+	assert.Equal(t, "https://www.ufc.com/athlete/alexandre-pantoja", f.FighterUrl)
+}
+
+func TestMergeByURL(t *testing.T) {
+	renamed := ufcFighter()
+	renamed.Name = "Alexandre Pantoja Jr"
+	renamed.NickName = "The Cannibal"
+
+	// a fighter found twice by URL is merged even if the name differs
+	fighters := merge(t, MergeFill, []model.Fighter{ufcFighter()}, []model.Fighter{renamed})
+	require.Len(t, fighters, 1)
+	assert.Equal(t, "Alexandre Pantoja", fighters[0].Name)
+	assert.Equal(t, "The Cannibal", fighters[0].NickName)
+}
+
+func TestMergeNamesakes(t *testing.T) {
+	first := model.Fighter{Name: "Bruno Silva", Division: domain.Middleweight, FighterUrl: "https://www.ufc.com/athlete/bruno-silva"}
+	second := model.Fighter{Name: "Bruno Silva", Division: domain.Flyweight, FighterUrl: "https://www.ufc.com/athlete/bruno-silva-0"}
+	sherdog := model.Fighter{Name: "Bruno Silva", TrainsAt: "Tata Fight Team", FighterUrl: "https://www.sherdog.com/fighter/Bruno-Silva-1"}
+
+	// two fighters of one source with the same name are different fighters, another source can't be matched by the name
+	fighters := merge(t, MergeFill, []model.Fighter{first, second}, []model.Fighter{sherdog})
+	require.Len(t, fighters, 3)
+	for _, f := range fighters {
+		if f.FighterUrl != sherdog.FighterUrl {
+			assert.Empty(t, f.TrainsAt)
+		}
+	}
+
+	// a fighter is not merged with a namesake of the same source found through another source
+	other := model.Fighter{Name: "Bruno Silva", FighterUrl: "https://www.sherdog.com/fighter/Bruno-Silva-2"}
+	fighters = merge(t, MergeFill, []model.Fighter{first}, []model.Fighter{sherdog, other})
+	require.Len(t, fighters, 3)
+
+	// a unique name matches fighters of different sources
+	fighters = merge(t, MergeFill, []model.Fighter{first}, []model.Fighter{sherdog})
+	require.Len(t, fighters, 1)
+	assert.Equal(t, "Tata Fight Team", fighters[0].TrainsAt)
+	assert.Equal(t, first.FighterUrl, fighters[0].FighterUrl)
+}
@@ -0,0 +1,80 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"pickfighter.com/scraper/pkg/model"
+)
+
+// NDJSON writes every fighter as a separate JSON line as soon as it is scraped,
+// so the output can be piped to another process or inspected while the scraper runs.
+type NDJSON struct {
+	mu     sync.Mutex
+	w      *bufio.Writer
+	enc    *json.Encoder
+	closer io.Closer
+}
+
+// NewNDJSON creates an NDJSON sink writing to w.
+func NewNDJSON(w io.Writer) *NDJSON {
+	bw := bufio.NewWriter(w)
+
+	return &NDJSON{
+		w:   bw,
+		enc: json.NewEncoder(bw),
+	}
+}
+
+// OpenNDJSON creates an NDJSON sink writing to the file at path, "-" means stdout.
+// If toAdd is set, lines are appended to the existing file.
+func OpenNDJSON(path string, toAdd bool) (*NDJSON, error) {
+	if path == "-" {
+		return NewNDJSON(os.Stdout), nil
+	}
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if toAdd {
+		flag = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	}
+
+	file, err := os.OpenFile(path, flag, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewNDJSON(file)
+	s.closer = file
+
+	return s, nil
+}
+
+// Write encodes the fighter as a single line and flushes it.
+func (s *NDJSON) Write(f model.Fighter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.enc.Encode(f); err != nil {
+		return err
+	}
+
+	return s.w.Flush()
+}
+
+// Close flushes the output and closes the file.
+func (s *NDJSON) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.w.Flush()
+	if s.closer != nil {
+		if closeErr := s.closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,89 @@
+// Package sink defines outputs of scraped fighters.
+package sink
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"pickfighter.com/pkg/discovery/consul"
+	"pickfighter.com/scraper/pkg/model"
+)
+
+// Sink receives fighters as soon as they are scraped.
+// Close must be called after the last fighter, some sinks write or send data only on Close.
+type Sink interface {
+	Write(f model.Fighter) error
+	Close() error
+}
+
+// Type defines a sink type.
+type Type string
+
+// Supported sink types.
+const (
+	TypeJSON   Type = "json"
+	TypeNDJSON Type = "ndjson"
+	TypeGRPC   Type = "grpc"
+)
+
+// Default output paths of file sinks.
+const (
+	DefaultJSONPath   = "./collection/fighters.json"
+	DefaultNDJSONPath = "./collection/fighters.ndjson"
+)
+
+var ErrUnknownType = errors.New("unknown sink type")
+
+// Config defines a sink configuration.
+type Config struct {
+	Type Type
+	// Output is a file path of the json and ndjson sinks, "-" writes ndjson to stdout.
+	// An empty output means the default path of the sink type.
+	Output string
+	// Append adds scraped fighters to the existing output instead of replacing it.
+	Append bool
+	// ConsulAddress is the address of the service registry used by the grpc sink.
+	ConsulAddress string
+}
+
+// ParseType converts a string into a supported sink Type. An empty string means json.
+func ParseType(s string) (Type, error) {
+	switch t := Type(strings.ToLower(s)); t {
+	case "":
+		return TypeJSON, nil
+	case TypeJSON, TypeNDJSON, TypeGRPC:
+		return t, nil
+	default:
+		return "", ErrUnknownType
+	}
+}
+
+// New creates a Sink of the configured type.
+func New(ctx context.Context, cfg Config) (Sink, error) {
+	switch cfg.Type {
+	case TypeJSON, "":
+		output := cfg.Output
+		if output == "" {
+			output = DefaultJSONPath
+		}
+
+		return NewJSON(output, cfg.Append), nil
+	case TypeNDJSON:
+		output := cfg.Output
+		if output == "" {
+			output = DefaultNDJSONPath
+		}
+
+		return OpenNDJSON(output, cfg.Append)
+	case TypeGRPC:
+		registry, err := consul.NewRegistry(cfg.ConsulAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewGRPC(ctx, registry)
+	default:
+		return nil, ErrUnknownType
+	}
+}
@@ -0,0 +1,178 @@
+package source
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	data "pickfighter.com/scraper/pkg"
+	"pickfighter.com/scraper/pkg/model"
+
+	"github.com/PuerkitoBio/goquery"
+	"go.uber.org/zap"
+)
+
+const sherdogListingURL = "https://www.sherdog.com/stats/fightfinder?SearchTxt=&weight=&association=Ultimate+Fighting+Championship"
+
+// Sherdog scrapes fighter pages with the sherdog.com layout: a bio table, a win / loss holder with
+// win method meters and no octagon stats. It is mostly used to fill fields missing on ufc.com, e.g. the gym.
+type Sherdog struct {
+	l *zap.SugaredLogger
+}
+
+// NewSherdog creates the sherdog.com source.
+func NewSherdog(l *zap.SugaredLogger) *Sherdog {
+	return &Sherdog{l: l}
+}
+
+// Name returns NameSherdog.
+func (s *Sherdog) Name() string {
+	return NameSherdog
+}
+
+// ListingURL returns a page of the fight finder results, its pages are numbered from 1.
+func (s *Sherdog) ListingURL(page int) string {
+	if page > 0 {
+		return fmt.Sprintf("%s&page=%d", sherdogListingURL, page+1)
+	}
+
+	return sherdogListingURL
+}
+
+// Listing returns fighter links of the results table and the "next" pagination link.
+func (s *Sherdog) Listing(page *goquery.Selection) ([]string, string) {
+	var athletes []string
+
+	page.Find("table.fightfinder_result a[href*='/fighter/']").Each(func(_ int, a *goquery.Selection) {
+		athletes = append(athletes, a.AttrOr("href", ""))
+	})
+
+	next := page.Find("span.pagination a[href]").FilterFunction(func(_ int, a *goquery.Selection) bool {
+		return strings.Contains(strings.ToLower(a.Text()), "next")
+	}).First().AttrOr("href", "")
+
+	return athletes, next
+}
+
+// Profile returns the fighter info block with the title, bio table and record.
+func (s *Sherdog) Profile(page *goquery.Selection) *goquery.Selection {
+	return page.Find("div.fighter-info").First()
+}
+
+// Fighter maps the fighter info block into a fighter.
+func (s *Sherdog) Fighter(profile *goquery.Selection) model.Fighter {
+	fighter := model.Fighter{
+		Name:     strings.TrimSpace(profile.Find(".fighter-title h1 .fn").Text()),
+		NickName: strings.Trim(strings.TrimSpace(profile.Find(".fighter-title .nickname").Text()), `"`),
+		Hometown: strings.TrimSpace(profile.Find(".fighter-nationality [itemprop='addressLocality']").Text()),
+		ImageUrl: profile.Find("img.profile-image").AttrOr("src", ""),
+	}
+
+	s.parseBio(&fighter, profile)
+	s.parseRecord(&fighter, profile)
+
+	return fighter
+}
+
+// parseBio parses the bio table, values are given in imperial units first, e.g. `5'10" / 177.80 cm`.
+func (s *Sherdog) parseBio(f *model.Fighter, profile *goquery.Selection) {
+	profile.Find(".bio-holder table tr").Each(func(_ int, row *goquery.Selection) {
+		cells := row.Find("td")
+		label := strings.ToUpper(strings.TrimSpace(cells.Eq(0).Text()))
+		valueEl := cells.Eq(1)
+		value := strings.TrimSpace(valueEl.Find("b").First().Text())
+		if value == "" {
+			value = strings.TrimSpace(valueEl.Text())
+		}
+
+		switch label {
+		case "AGE":
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				s.l.Errorf("[%s] Age conversion error: %s", f.Name, err)
+			} else {
+				f.Age = int8(v)
+			}
+		case "HEIGHT":
+			v, err := parseFeetInches(value)
+			if err != nil {
+				s.l.Errorf("[%s] Height conversion error: %s", f.Name, err)
+			} else {
+				f.Height = v
+			}
+		case "WEIGHT":
+			v, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, "lbs")), 32)
+			if err != nil {
+				s.l.Errorf("[%s] Weight conversion error: %s", f.Name, err)
+			} else {
+				f.Weight = float32(v)
+			}
+		case "ASSOCIATION":
+			f.TrainsAt = strings.Join(strings.Fields(valueEl.Text()), " ")
+		case "CLASS":
+			data.SetDivision(f, value)
+		}
+	})
+}
+
+// parseRecord parses wins, losses, draws and win methods of the record holder.
+func (s *Sherdog) parseRecord(f *model.Fighter, profile *goquery.Selection) {
+	holder := profile.Find(".winsloses-holder")
+
+	s.setRecordValue(f, "Wins", &f.Wins, holder.Find(".winloses.win span").Eq(1).Text())
+	s.setRecordValue(f, "Loses", &f.Loses, holder.Find(".winloses.lose span").Eq(1).Text())
+	s.setRecordValue(f, "Draw", &f.Draw, holder.Find(".winloses.draws span").Eq(1).Text())
+
+	holder.Find(".wins .meter").Each(func(_ int, meter *goquery.Selection) {
+		value := meter.Find(".pl").Text()
+
+		switch strings.ToUpper(strings.TrimSpace(meter.Find(".meter-title").Text())) {
+		case "KO/TKO":
+			s.setRecordValue(f, "Stats.WinByKO", &f.Stats.WinByKO, value)
+		case "SUBMISSIONS":
+			s.setRecordValue(f, "Stats.WinBySub", &f.Stats.WinBySub, value)
+		case "DECISIONS":
+			s.setRecordValue(f, "Stats.WinByDec", &f.Stats.WinByDec, value)
+		}
+	})
+}
+
+// setRecordValue converts a record number and marks the field as parsed, a missing number is left unset.
+func (s *Sherdog) setRecordValue(f *model.Fighter, field string, dst *int, value string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		s.l.Errorf("[%s] Record conversion error: %s", f.Name, err)
+		return
+	}
+
+	*dst = v
+	f.MarkParsed(field)
+}
+
+// parseFeetInches converts a height like 5'10" into inches, the unit of ufc.com heights.
+func parseFeetInches(s string) (float32, error) {
+	feet, inches, ok := strings.Cut(strings.TrimSuffix(strings.TrimSpace(s), `"`), "'")
+	if !ok {
+		return 0, fmt.Errorf("unexpected height %q", s)
+	}
+
+	ft, err := strconv.Atoi(strings.TrimSpace(feet))
+	if err != nil {
+		return 0, err
+	}
+
+	in := 0
+	if inches = strings.TrimSpace(inches); inches != "" {
+		in, err = strconv.Atoi(inches)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return float32(ft*12 + in), nil
+}
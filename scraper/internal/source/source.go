@@ -0,0 +1,52 @@
+// Package source defines sites the scraper collects fighters from.
+package source
+
+import (
+	"errors"
+	"strings"
+
+	"pickfighter.com/scraper/pkg/model"
+
+	"github.com/PuerkitoBio/goquery"
+	"go.uber.org/zap"
+)
+
+// Source describes the page layout of a site with fighter profiles. The scraper visits listing pages
+// one by one, athlete pages found on them are parsed by Profile and Fighter.
+type Source interface {
+	// Name is the source name used by the --source flag.
+	Name() string
+	// ListingURL returns the listing page with the given number, 0 is the first page.
+	ListingURL(page int) string
+	// Listing discovers athlete pages and the next listing page of a listing page, links may be relative.
+	// The next page is empty on the last page.
+	Listing(page *goquery.Selection) (athletes []string, next string)
+	// Profile finds the fighter profile of an athlete page, an empty selection means the page has no profile.
+	// The incremental mode hashes the profile, so it shouldn't include parts of the page changed on every request.
+	Profile(page *goquery.Selection) *goquery.Selection
+	// Fighter maps the profile into a fighter. Fighter and image URLs are set by the scraper.
+	Fighter(profile *goquery.Selection) model.Fighter
+}
+
+// Supported source names.
+const (
+	NameUFC       = "ufc"
+	NameUFCEvents = "ufc-events"
+	NameSherdog   = "sherdog"
+)
+
+var ErrUnknownSource = errors.New("unknown source")
+
+// New creates the source with the given name.
+func New(name string, l *zap.SugaredLogger) (Source, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case NameUFC:
+		return NewUFC(l), nil
+	case NameUFCEvents:
+		return NewUFCEvents(l), nil
+	case NameSherdog:
+		return NewSherdog(l), nil
+	default:
+		return nil, ErrUnknownSource
+	}
+}
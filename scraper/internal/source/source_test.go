@@ -0,0 +1,209 @@
+package source
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"pickfighter.com/scraper/internal/replay"
+	"pickfighter.com/scraper/pkg/model"
+)
+
+// Pages are recorded with `scraper scrape --record internal/source/testdata/pages`,
+// golden files are rewritten with `go test ./internal/source -update`.
+var update = flag.Bool("update", false, "update golden files")
+
+const (
+	pagesDir  = "testdata/pages"
+	goldenDir = "testdata/golden"
+)
+
+var ufcPages = []struct {
+	name string
+	url  string
+}{
+	{"khabib-nurmagomedov", "https://www.ufc.com/athlete/khabib-nurmagomedov"},
+	{"marek-nowak", "https://www.ufc.com/athlete/marek-nowak"},
+}
+
+// ufcParsers are checked one by one, so a markup change points to the block that broke.
+var ufcParsers = []struct {
+	name  string
+	parse func(s *UFC, f *model.Fighter, el *goquery.Selection)
+}{
+	{"bio", (*UFC).parseBioFields},
+	{"main_stats", (*UFC).parseMainStats},
+	{"special_stats", (*UFC).parseSpecialStats},
+	{"win_methods", (*UFC).parseWinMethodStats},
+}
+
+func TestUFCParse(t *testing.T) {
+	for _, page := range ufcPages {
+		t.Run(page.name, func(t *testing.T) {
+			s, logs := newObservedUFC()
+			profile := s.Profile(loadPage(t, page.url))
+			require.NotZero(t, profile.Length(), "profile not found")
+
+			got := make(map[string]model.Fighter, len(ufcParsers)+1)
+
+			for _, p := range ufcParsers {
+				var f model.Fighter
+				p.parse(s, &f, profile)
+
+				assert.False(t, reflect.ValueOf(f).IsZero(), "%s parsed nothing, the markup may have changed", p.name)
+				got[p.name] = f
+			}
+
+			got["fighter"] = s.Fighter(profile)
+
+			assert.Zero(t, logs.FilterLevelExact(zapcore.ErrorLevel).Len(), "conversion errors: %v", logs.All())
+
+			assertGolden(t, filepath.Join(goldenDir, "ufc", page.name+".json"), got)
+		})
+	}
+}
+
+func TestUFCListing(t *testing.T) {
+	html := `<div class="c-listing-athlete-flipcard__action"><a href="/athlete/khabib-nurmagomedov">Athlete Profile</a></div>
+		<div class="c-listing-athlete-flipcard__action"><a href="/athlete/marek-nowak">Athlete Profile</a></div>
+		<ul class="pager"><li class="pager__item"><a href="?gender=All&page=1" rel="next">Load more</a></li></ul>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	athletes, next := NewUFC(zap.NewNop().Sugar()).Listing(doc.Selection)
+
+	assert.Equal(t, []string{"/athlete/khabib-nurmagomedov", "/athlete/marek-nowak"}, athletes)
+	assert.Equal(t, "?gender=All&page=1", next)
+}
+
+func TestUFCEventsListing(t *testing.T) {
+	s := NewUFCEvents(zap.NewNop().Sugar())
+
+	events := `<div id="events-list-upcoming">
+			<h3 class="c-card-event--result__headline"><a href="/event/ufc-fight-night-october-17-2026">Nowak vs Smith</a></h3>
+			<h3 class="c-card-event--result__headline"><a href="/event/ufc-310">UFC 310</a></h3>
+		</div>
+		<div id="events-list-past">
+			<h3 class="c-card-event--result__headline"><a href="/event/ufc-309">UFC 309</a></h3>
+		</div>`
+
+	card := `<div class="c-listing-fight">
+			<div class="c-listing-fight__corner-name c-listing-fight__corner-name--red"><a href="https://www.ufc.com/athlete/marek-nowak">Marek Nowak</a></div>
+			<div class="c-listing-fight__corner-name c-listing-fight__corner-name--blue"><a href="https://www.ufc.com/athlete/john-smith">John Smith</a></div>
+		</div>
+		<h3 class="c-card-event--result__headline"><a href="/event/ufc-310">UFC 310</a></h3>`
+
+	testCases := []struct {
+		name     string
+		html     string
+		athletes []string
+		next     string
+	}{
+		{"events", events, nil, "/event/ufc-fight-night-october-17-2026"},
+		{"no upcoming events", `<div id="events-list-past">
+			<h3 class="c-card-event--result__headline"><a href="/event/ufc-309">UFC 309</a></h3></div>`, nil, "/event/ufc-309"},
+		{"event card", card, []string{"https://www.ufc.com/athlete/marek-nowak", "https://www.ufc.com/athlete/john-smith"}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tc.html))
+			require.NoError(t, err)
+
+			athletes, next := s.Listing(doc.Selection)
+
+			assert.Equal(t, tc.athletes, athletes)
+			assert.Equal(t, tc.next, next)
+		})
+	}
+}
+
+func TestSherdogParse(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	s := NewSherdog(zap.New(core).Sugar())
+
+	profile := s.Profile(loadPage(t, "https://www.sherdog.com/fighter/Khabib-Nurmagomedov-56035"))
+	require.NotZero(t, profile.Length(), "profile not found")
+
+	got := s.Fighter(profile)
+
+	assert.Zero(t, logs.FilterLevelExact(zapcore.ErrorLevel).Len(), "conversion errors: %v", logs.All())
+	assertGolden(t, filepath.Join(goldenDir, "sherdog", "khabib-nurmagomedov.json"), got)
+}
+
+func TestParseFeetInches(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected float32
+		err      bool
+	}{
+		{`5'10"`, 70, false},
+		{`6'0"`, 72, false},
+		{`6'`, 72, false},
+		{`177.80 cm`, 0, true},
+		{``, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			v, err := parseFeetInches(tc.input)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, v)
+		})
+	}
+}
+
+func newObservedUFC() (*UFC, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	return NewUFC(zap.New(core).Sugar()), logs
+}
+
+// loadPage parses a page recorded in pagesDir.
+func loadPage(t *testing.T, url string) *goquery.Selection {
+	t.Helper()
+
+	body, err := replay.ReadPage(pagesDir, url)
+	require.NoError(t, err)
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	require.NoError(t, err)
+
+	return doc.Selection
+}
+
+// assertGolden compares v encoded as JSON with the golden file, the file is rewritten with -update.
+func assertGolden(t *testing.T, path string, v any) {
+	t.Helper()
+
+	got, err := json.MarshalIndent(v, "", "  ")
+	require.NoError(t, err)
+	got = append(got, '\n')
+
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err, "run the test with -update to create the golden file")
+
+	assert.JSONEq(t, string(want), string(got))
+}
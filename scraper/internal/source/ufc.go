@@ -0,0 +1,331 @@
+package source
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"pickfighter.com/scraper/internal/scraperutil"
+	data "pickfighter.com/scraper/pkg"
+	"pickfighter.com/scraper/pkg/model"
+
+	"github.com/PuerkitoBio/goquery"
+	"go.uber.org/zap"
+)
+
+const ufcListingURL = "https://www.ufc.com/athletes/all"
+
+// UFCActiveListingURL is the athletes listing filtered by the active status, the pager keeps the filter.
+const UFCActiveListingURL = ufcListingURL + "?filters%5B0%5D=status%3A23"
+
+// UFC scrapes athlete profiles of ufc.com.
+type UFC struct {
+	l *zap.SugaredLogger
+}
+
+// NewUFC creates the ufc.com source.
+func NewUFC(l *zap.SugaredLogger) *UFC {
+	return &UFC{l: l}
+}
+
+// Name returns NameUFC.
+func (s *UFC) Name() string {
+	return NameUFC
+}
+
+// ListingURL returns a page of the athletes listing.
+func (s *UFC) ListingURL(page int) string {
+	if page > 0 {
+		return fmt.Sprintf("%s?page=%d", ufcListingURL, page)
+	}
+
+	return ufcListingURL
+}
+
+// Listing returns athlete links of the flipcards and the "Load more" pager link.
+func (s *UFC) Listing(page *goquery.Selection) ([]string, string) {
+	var athletes []string
+
+	page.Find("div[class*='flipcard__action'] a[href]").Each(func(_ int, a *goquery.Selection) {
+		athletes = append(athletes, a.AttrOr("href", ""))
+	})
+
+	next := page.Find("li.pager__item a[href]").First().AttrOr("href", "")
+
+	return athletes, next
+}
+
+// Profile returns the parent of the hero profile, it holds the biography and stats blocks as well.
+func (s *UFC) Profile(page *goquery.Selection) *goquery.Selection {
+	return page.Find("div[class='hero-profile-wrap']").First().Parent()
+}
+
+// Fighter initializes a Fighter model, sets basic information such as name, nickname and image, and then calls
+// SetDivision and SetStatistic functions to update division and general statistics. Finally, it calls parseData
+// to extract additional details about the fighter.
+func (s *UFC) Fighter(fighterEl *goquery.Selection) model.Fighter {
+	profileEl := fighterEl.Find("div.hero-profile-wrap")
+	statString := profileEl.Find("p.hero-profile__division-body").Text()
+
+	fighter := model.Fighter{
+		Name:     strings.TrimSpace(profileEl.Find("h1.hero-profile__name").Text()),
+		NickName: strings.TrimSpace(profileEl.Find("p.hero-profile__nickname").Text()),
+		ImageUrl: profileEl.Find(".hero-profile__image-wrap img").AttrOr("src", ""),
+	}
+
+	data.SetDivision(&fighter, profileEl.Find("p.hero-profile__division-title").Text())
+	data.SetStatistic(&fighter, statString)
+
+	s.parseData(&fighter, fighterEl)
+
+	return fighter
+}
+
+// parseData a unifying function for parsing data from different blocks of information
+func (s *UFC) parseData(f *model.Fighter, fighterEl *goquery.Selection) {
+	s.parseBioFields(f, fighterEl)
+	s.parseMainStats(f, fighterEl)
+	s.parseSpecialStats(f, fighterEl)
+	s.parseWinMethodStats(f, fighterEl)
+}
+
+// parseBioFields parses fighter's data from biography block and sets values to model.Fighter
+func (s *UFC) parseBioFields(f *model.Fighter, fighterEl *goquery.Selection) {
+	fields := fighterEl.Find("div.c-bio__info-details")
+	fields.Find("div.c-bio__info-details .c-bio__field").Each(func(index int, bioField *goquery.Selection) {
+		fieldLabel := bioField.Find(".c-bio__label").Text()
+		fieldValue := strings.TrimSpace(bioField.Find(".c-bio__text").Text())
+
+		switch fieldLabel {
+		case "Age":
+			v, err := strconv.Atoi(fieldValue)
+			if err != nil {
+				s.l.Errorf("Age conversion error: %s", err)
+			} else {
+				f.Age = int8(v)
+			}
+		case "Status":
+			data.SetStatus(f, fieldValue)
+		case "Hometown":
+			f.Hometown = fieldValue
+		case "Trains at":
+			f.TrainsAt = fieldValue
+		case "Fighting style":
+			f.FightingStyle = fieldValue
+		case "Height":
+			v, err := strconv.ParseFloat(fieldValue, 32)
+			if err != nil {
+				s.l.Errorf("Height conversion error: %s", err)
+			} else {
+				f.Height = float32(v)
+			}
+		case "Weight":
+			v, err := strconv.ParseFloat(fieldValue, 32)
+			if err != nil {
+				s.l.Errorf("Weight conversion error:", err)
+			} else {
+				f.Weight = float32(v)
+			}
+		case "Octagon Debut":
+			f.OctagonDebut = fieldValue
+			f.DebutTimestamp = scraperutil.GetDebutTimestamp(fieldValue)
+		case "Reach":
+			v, err := strconv.ParseFloat(fieldValue, 32)
+			if err != nil {
+				s.l.Error("Reach conversion error:", err)
+			} else {
+				f.Reach = float32(v)
+			}
+		case "Leg reach":
+			v, err := strconv.ParseFloat(fieldValue, 32)
+			if err != nil {
+				s.l.Error("Leg Reach conversion error:", err)
+			} else {
+				f.LegReach = float32(v)
+			}
+		}
+	})
+}
+
+// parseMainStats parses stats from main fighter block and sets values to fighter.Stats
+func (s *UFC) parseMainStats(f *model.Fighter, fighterEl *goquery.Selection) {
+	reg := regexp.MustCompile("[^0-9]+")
+	fields := fighterEl.Find("div.stats-records-inner-wrap")
+	fields.Find("div.c-stat-compare__group").Each(func(index int, bioField *goquery.Selection) {
+		fieldLabel := bioField.Find(".c-stat-compare__label").Text()
+		fieldValue := strings.TrimSpace(bioField.Find(".c-stat-compare__number").Text())
+
+		switch fieldLabel {
+		case "Sig. Str. Landed":
+			if fieldValue != "" {
+				v, err := strconv.ParseFloat(fieldValue, 32)
+				if err != nil {
+					s.l.Error("Sig. Str. Landed conversion error:", err)
+				} else {
+					f.Stats.SigStrLanded = float32(v)
+					f.MarkParsed("Stats.SigStrLanded")
+				}
+			}
+		case "Sig. Str. Absorbed":
+			if fieldValue != "" {
+				v, err := strconv.ParseFloat(fieldValue, 32)
+				if err != nil {
+					s.l.Error("Sig. Str. Absorbed conversion error:", err)
+				} else {
+					f.Stats.SigStrAbs = float32(v)
+					f.MarkParsed("Stats.SigStrAbs")
+				}
+			}
+		case "Sig. Str. Defense":
+			numericString := reg.ReplaceAllString(fieldValue, "")
+			if numericString != "" {
+				v, err := strconv.Atoi(numericString)
+				if err != nil {
+					s.l.Error("Sig. Str. Defense conversion error:", err)
+				} else {
+					f.Stats.SigStrDefense = int8(v)
+					f.MarkParsed("Stats.SigStrDefense")
+				}
+			}
+		case "Takedown Defense":
+			numericString := reg.ReplaceAllString(fieldValue, "")
+			v, err := strconv.Atoi(numericString)
+			if err != nil {
+				if fieldValue != "" {
+					s.l.Error("Takedown Defense conversion error:", err)
+				}
+			} else {
+				f.Stats.TakedownDefense = int8(v)
+				f.MarkParsed("Stats.TakedownDefense")
+			}
+		case "Takedown avg":
+			if fieldValue != "" {
+				v, err := strconv.ParseFloat(fieldValue, 32)
+				if err != nil {
+					s.l.Error("Takedown avg conversion error:", err)
+				} else {
+					f.Stats.TakedownAvg = float32(v)
+					f.MarkParsed("Stats.TakedownAvg")
+				}
+			}
+		case "Submission avg":
+			if fieldValue != "" {
+				v, err := strconv.ParseFloat(fieldValue, 32)
+				if err != nil {
+					s.l.Error("Submission avg conversion error:", err)
+				} else {
+					f.Stats.SubmissionAvg = float32(v)
+					f.MarkParsed("Stats.SubmissionAvg")
+				}
+			}
+		case "Knockdown Avg":
+			if fieldValue != "" {
+				v, err := strconv.ParseFloat(fieldValue, 32)
+				if err != nil {
+					s.l.Error("Knockdown Avg conversion error:", err)
+				} else {
+					f.Stats.KnockdownAvg = float32(v)
+					f.MarkParsed("Stats.KnockdownAvg")
+				}
+			}
+		case "Average fight time":
+			f.Stats.AvgFightTime = fieldValue
+		}
+	})
+}
+
+// parseSpecialStats parses stats from special fighter block and sets values to fighter.Stats.
+func (s *UFC) parseSpecialStats(f *model.Fighter, fighterEl *goquery.Selection) {
+	fields := fighterEl.Find("div.stats-records-inner-wrap")
+
+	fields.Find("div.c-overlap__inner .c-overlap__stats").Each(func(index int, bioField *goquery.Selection) {
+		fieldLabel := bioField.Find("dt.c-overlap__stats-text").Text()
+		fieldValue := strings.TrimSpace(bioField.Find("dd.c-overlap__stats-value").Text())
+
+		switch fieldLabel {
+		case "Sig. Strikes Landed":
+			v, err := strconv.Atoi(fieldValue)
+			if err != nil {
+				s.l.Error("Total Sig. Strikes Landed conversion error:", err)
+			} else {
+				f.Stats.TotalSigStrLanded = v
+				f.MarkParsed("Stats.TotalSigStrLanded")
+			}
+		case "Sig. Strikes Attempted":
+			v, err := strconv.Atoi(fieldValue)
+			if err != nil {
+				s.l.Error("Total Sig. Strikes Attempted conversion error:", err)
+			} else {
+				f.Stats.TotalSigStrAttempted = v
+				f.MarkParsed("Stats.TotalSigStrAttempted")
+			}
+		case "Takedowns Landed":
+			v, err := strconv.Atoi(fieldValue)
+			if err != nil {
+				if fieldValue != "" {
+					s.l.Error("Total Takedowns Landed conversion error:", err)
+				}
+			} else {
+				f.Stats.TotalTkdLanded = v
+				f.MarkParsed("Stats.TotalTkdLanded")
+			}
+		case "Takedowns Attempted":
+			v, err := strconv.Atoi(fieldValue)
+			if err != nil {
+				s.l.Error("Total Takedowns Attempted conversion error:", err)
+			} else {
+				f.Stats.TotalTkdAttempted = v
+				f.MarkParsed("Stats.TotalTkdAttempted")
+			}
+		}
+	})
+
+	if f.Stats.TotalTkdAttempted != 0 {
+		f.Stats.TkdAccuracy = int(float64(f.Stats.TotalTkdLanded) / float64(f.Stats.TotalTkdAttempted) * 100)
+		f.MarkParsed("Stats.TkdAccuracy")
+	}
+
+	if f.Stats.TotalSigStrAttempted != 0 {
+		f.Stats.StrAccuracy = int(float64(f.Stats.TotalSigStrLanded) / float64(f.Stats.TotalSigStrAttempted) * 100)
+		f.MarkParsed("Stats.StrAccuracy")
+	}
+}
+
+// parseWinMethodStats parses stats from the win methods block and sets values to fighter.Stats
+func (s *UFC) parseWinMethodStats(f *model.Fighter, el *goquery.Selection) {
+	fields := el.Find("div.stats-records-inner-wrap")
+
+	fields.Find("div.stats-records:last-of-type div.stats-records-inner .c-stat-3bar__group").Each(func(index int, bioField *goquery.Selection) {
+		fieldLabel := strings.TrimSpace(bioField.Find("div.c-stat-3bar__label").Text())
+		fieldValue := strings.TrimSpace(bioField.Find("div.c-stat-3bar__value").Text())
+
+		switch fieldLabel {
+		case "KO/TKO":
+			v, err := strconv.Atoi(strings.Split(fieldValue, " ")[0])
+			if err != nil {
+				s.l.Error("KO/TKO data conversion error:", err)
+			} else {
+				f.Stats.WinByKO = v
+				f.MarkParsed("Stats.WinByKO")
+			}
+		case "DEC":
+			v, err := strconv.Atoi(strings.Split(fieldValue, " ")[0])
+			if err != nil {
+				s.l.Error("DEC data conversion error:", err)
+			} else {
+				f.Stats.WinByDec = v
+				f.MarkParsed("Stats.WinByDec")
+			}
+
+		case "SUB":
+			v, err := strconv.Atoi(strings.Split(fieldValue, " ")[0])
+			if err != nil {
+				s.l.Error("SUB data conversion error:", err)
+			} else {
+				f.Stats.WinBySub = v
+				f.MarkParsed("Stats.WinBySub")
+			}
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package source
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"go.uber.org/zap"
+)
+
+const ufcEventsURL = "https://www.ufc.com/events"
+
+// UFCEvents scrapes fighters of the current ufc.com event card. The events page is the first listing page,
+// its next page is the first upcoming event, on fight nights it is the event in progress.
+// Fighters on the card of the event page are athlete pages parsed like the UFC source does.
+type UFCEvents struct {
+	*UFC
+}
+
+// NewUFCEvents creates the ufc.com events source.
+func NewUFCEvents(l *zap.SugaredLogger) *UFCEvents {
+	return &UFCEvents{UFC: NewUFC(l)}
+}
+
+// Name returns NameUFCEvents.
+func (s *UFCEvents) Name() string {
+	return NameUFCEvents
+}
+
+// ListingURL returns the events page, events aren't paged.
+func (s *UFCEvents) ListingURL(int) string {
+	return ufcEventsURL
+}
+
+// Listing returns athlete links of the fight card on an event page. On the events page there are no athletes,
+// the next page is the first upcoming event or the first listed one if nothing is upcoming.
+func (s *UFCEvents) Listing(page *goquery.Selection) ([]string, string) {
+	if fights := page.Find(".c-listing-fight"); fights.Length() > 0 {
+		var athletes []string
+
+		fights.Find(".c-listing-fight__corner-name a[href*='/athlete/']").Each(func(_ int, a *goquery.Selection) {
+			athletes = append(athletes, a.AttrOr("href", ""))
+		})
+
+		return athletes, ""
+	}
+
+	const headline = ".c-card-event--result__headline a[href]"
+
+	next := page.Find("#events-list-upcoming "+headline).First().AttrOr("href", "")
+	if next == "" {
+		next = page.Find(headline).First().AttrOr("href", "")
+	}
+
+	return nil, next
+}
@@ -0,0 +1,178 @@
+package validate
+
+import (
+	"fmt"
+	"time"
+
+	"pickfighter.com/scraper/pkg/model"
+)
+
+// Severity of a failed rule. A fighter with an error is invalid, warnings are reported only.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Rule checks a fighter, Check returns an empty string if the fighter passes
+// or a message describing the problem.
+type Rule struct {
+	Name     string
+	Severity Severity
+	Check    func(f *model.Fighter) string
+}
+
+// Plausible ranges of fighter attributes. Heights and reaches are in inches, weights in pounds.
+var (
+	ageRange      = limits{18, 60}
+	heightRange   = limits{58, 90}
+	weightRange   = limits{100, 300}
+	reachRange    = limits{55, 95}
+	legReachRange = limits{30, 50}
+
+	// firstEvent is the date of UFC 1, no fighter debuted earlier.
+	firstEvent = time.Date(1993, time.November, 12, 0, 0, 0, 0, time.UTC)
+)
+
+type limits struct {
+	min, max float64
+}
+
+func (l limits) check(field string, v float64) string {
+	if v < l.min || v > l.max {
+		return fmt.Sprintf("%s %g is out of range [%g, %g]", field, v, l.min, l.max)
+	}
+
+	return ""
+}
+
+// DefaultRules are the rules used by the scraper. Missing values are warnings, some sources don't have them,
+// values out of range and inconsistent records are errors.
+var DefaultRules = []Rule{
+	{"name.required", SeverityError, func(f *model.Fighter) string {
+		return required("name", f.Name)
+	}},
+	{"fighter_url.required", SeverityError, func(f *model.Fighter) string {
+		return required("fighter url", f.FighterUrl)
+	}},
+	{"status.required", SeverityWarning, func(f *model.Fighter) string {
+		return required("status", string(f.Status))
+	}},
+	{"image_url.required", SeverityWarning, func(f *model.Fighter) string {
+		return required("image url", f.ImageUrl)
+	}},
+	{"age.missing", SeverityWarning, func(f *model.Fighter) string {
+		return missing("age", float64(f.Age))
+	}},
+	{"age.range", SeverityError, func(f *model.Fighter) string {
+		return inRange("age", float64(f.Age), ageRange)
+	}},
+	{"height.missing", SeverityWarning, func(f *model.Fighter) string {
+		return missing("height", float64(f.Height))
+	}},
+	{"height.range", SeverityError, func(f *model.Fighter) string {
+		return inRange("height", float64(f.Height), heightRange)
+	}},
+	{"weight.missing", SeverityWarning, func(f *model.Fighter) string {
+		return missing("weight", float64(f.Weight))
+	}},
+	{"weight.range", SeverityError, func(f *model.Fighter) string {
+		return inRange("weight", float64(f.Weight), weightRange)
+	}},
+	{"reach.missing", SeverityWarning, func(f *model.Fighter) string {
+		return missing("reach", float64(f.Reach))
+	}},
+	{"reach.range", SeverityError, func(f *model.Fighter) string {
+		return inRange("reach", float64(f.Reach), reachRange)
+	}},
+	{"leg_reach.range", SeverityError, func(f *model.Fighter) string {
+		return inRange("leg reach", float64(f.LegReach), legReachRange)
+	}},
+	{"debut.missing", SeverityWarning, func(f *model.Fighter) string {
+		return missing("debut", float64(f.DebutTimestamp))
+	}},
+	{"debut.range", SeverityError, func(f *model.Fighter) string {
+		if f.DebutTimestamp == 0 {
+			return ""
+		}
+
+		debut := time.Unix(int64(f.DebutTimestamp), 0)
+		if debut.Before(firstEvent) || debut.After(time.Now()) {
+			return fmt.Sprintf("debut %s is before the first event or in the future", debut.UTC().Format(time.DateOnly))
+		}
+
+		return ""
+	}},
+	{"record.negative", SeverityError, func(f *model.Fighter) string {
+		if f.Wins < 0 || f.Loses < 0 || f.Draw < 0 {
+			return fmt.Sprintf("record %d-%d-%d has negative values", f.Wins, f.Loses, f.Draw)
+		}
+
+		return ""
+	}},
+	{"win_methods.total", SeverityError, func(f *model.Fighter) string {
+		methods := f.Stats.WinByKO + f.Stats.WinBySub + f.Stats.WinByDec
+		if methods > f.Wins {
+			return fmt.Sprintf("wins by method %d exceed total wins %d", methods, f.Wins)
+		}
+
+		return ""
+	}},
+	{"strikes.landed", SeverityError, func(f *model.Fighter) string {
+		return landed("significant strikes", f.Stats.TotalSigStrLanded, f.Stats.TotalSigStrAttempted)
+	}},
+	{"takedowns.landed", SeverityError, func(f *model.Fighter) string {
+		return landed("takedowns", f.Stats.TotalTkdLanded, f.Stats.TotalTkdAttempted)
+	}},
+	{"percentages.range", SeverityError, func(f *model.Fighter) string {
+		for _, p := range []struct {
+			name string
+			v    int
+		}{
+			{"striking accuracy", f.Stats.StrAccuracy},
+			{"takedown accuracy", f.Stats.TkdAccuracy},
+			{"significant strike defense", int(f.Stats.SigStrDefense)},
+			{"takedown defense", int(f.Stats.TakedownDefense)},
+		} {
+			if p.v < 0 || p.v > 100 {
+				return fmt.Sprintf("%s %d%% is out of range [0, 100]", p.name, p.v)
+			}
+		}
+
+		return ""
+	}},
+}
+
+func required(field, v string) string {
+	if v == "" {
+		return field + " is empty"
+	}
+
+	return ""
+}
+
+func missing(field string, v float64) string {
+	if v == 0 {
+		return field + " is missing"
+	}
+
+	return ""
+}
+
+// inRange checks a value set by the source, a missing value is reported by the missing rule of the field.
+func inRange(field string, v float64, l limits) string {
+	if v == 0 {
+		return ""
+	}
+
+	return l.check(field, v)
+}
+
+func landed(field string, landed, attempted int) string {
+	if landed > attempted {
+		return fmt.Sprintf("%s landed %d exceed attempted %d", field, landed, attempted)
+	}
+
+	return ""
+}
@@ -0,0 +1,251 @@
+// Package validate checks scraped fighters against data-quality rules and reports the results.
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"pickfighter.com/scraper/internal/sink"
+	"pickfighter.com/scraper/pkg/model"
+)
+
+// Defaults of the scraper validation.
+const (
+	DefaultReportPath = "./collection/validation.json"
+	// DefaultMaxInvalidRate lets a few odd profiles through, a markup change breaks most of them.
+	DefaultMaxInvalidRate = 0.05
+)
+
+var ErrThresholdExceeded = errors.New("validation threshold exceeded")
+
+// Issue is a rule failed by a fighter.
+type Issue struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// FighterIssues lists the issues of a fighter.
+type FighterIssues struct {
+	Name       string  `json:"name"`
+	FighterUrl string  `json:"fighter_url"`
+	Issues     []Issue `json:"issues"`
+}
+
+// RuleSummary counts fighters failing a rule.
+type RuleSummary struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Failed   int      `json:"failed"`
+}
+
+// Report is the machine-readable result of a validation. A fighter failing any error rule is invalid,
+// Dropped counts invalid fighters not written to the sink in the strict mode.
+type Report struct {
+	GeneratedAt  time.Time       `json:"generated_at"`
+	Fighters     int             `json:"fighters"`
+	Invalid      int             `json:"invalid"`
+	WithWarnings int             `json:"with_warnings"`
+	Dropped      int             `json:"dropped"`
+	Rules        []RuleSummary   `json:"rules"`
+	Fails        []FighterIssues `json:"fighters_with_issues"`
+}
+
+// InvalidRate returns the share of invalid fighters, 0 if nothing was validated.
+func (r Report) InvalidRate() float64 {
+	if r.Fighters == 0 {
+		return 0
+	}
+
+	return float64(r.Invalid) / float64(r.Fighters)
+}
+
+// Thresholds are limits of invalid fighters accepted in the strict mode, zero values disable a limit.
+type Thresholds struct {
+	MaxInvalid     int     `mapstructure:"max_invalid"`
+	MaxInvalidRate float64 `mapstructure:"max_invalid_rate"`
+}
+
+// Check returns ErrThresholdExceeded if the report has more invalid fighters than the thresholds allow.
+func (r Report) Check(t Thresholds) error {
+	if t.MaxInvalid > 0 && r.Invalid > t.MaxInvalid {
+		return fmt.Errorf("%w: %d invalid fighters, max %d", ErrThresholdExceeded, r.Invalid, t.MaxInvalid)
+	}
+
+	if t.MaxInvalidRate > 0 && r.InvalidRate() > t.MaxInvalidRate {
+		return fmt.Errorf("%w: %.1f%% invalid fighters, max %.1f%%", ErrThresholdExceeded, r.InvalidRate()*100, t.MaxInvalidRate*100)
+	}
+
+	return nil
+}
+
+// WriteFile writes the report as indented JSON to path, creating its directory.
+func (r Report) WriteFile(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// WriteTable writes a summary table of failed rules followed by the totals.
+func (r Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "RULE\tSEVERITY\tFAILED")
+	for _, s := range r.Rules {
+		if s.Failed > 0 {
+			fmt.Fprintf(tw, "%s\t%s\t%d\n", s.Rule, s.Severity, s.Failed)
+		}
+	}
+
+	fmt.Fprintf(tw, "fighters: %d, invalid: %d (%.1f%%), with warnings: %d, dropped: %d\n",
+		r.Fighters, r.Invalid, r.InvalidRate()*100, r.WithWarnings, r.Dropped)
+
+	return tw.Flush()
+}
+
+// Validator checks fighters and collects the report, it is safe for concurrent use.
+type Validator struct {
+	rules []Rule
+
+	mu     sync.Mutex
+	report Report
+	failed map[string]int
+}
+
+// New creates a Validator with the rules, DefaultRules are used if rules is empty.
+func New(rules []Rule) *Validator {
+	if len(rules) == 0 {
+		rules = DefaultRules
+	}
+
+	return &Validator{
+		rules:  rules,
+		failed: make(map[string]int),
+	}
+}
+
+// Validate checks the fighter, adds it to the report and returns its issues.
+func (v *Validator) Validate(f model.Fighter) []Issue {
+	var issues []Issue
+	for _, rule := range v.rules {
+		if msg := rule.Check(&f); msg != "" {
+			issues = append(issues, Issue{Rule: rule.Name, Severity: rule.Severity, Message: msg})
+		}
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.report.Fighters++
+	if len(issues) == 0 {
+		return nil
+	}
+
+	invalid, warned := false, false
+	for _, issue := range issues {
+		v.failed[issue.Rule]++
+
+		if issue.Severity == SeverityError {
+			invalid = true
+		} else {
+			warned = true
+		}
+	}
+
+	if invalid {
+		v.report.Invalid++
+	}
+	if warned {
+		v.report.WithWarnings++
+	}
+
+	v.report.Fails = append(v.report.Fails, FighterIssues{
+		Name:       f.Name,
+		FighterUrl: f.FighterUrl,
+		Issues:     issues,
+	})
+
+	return issues
+}
+
+// Report returns the report of fighters validated so far. Rules are listed in their order, fighters
+// with issues are sorted by URL, workers write them in any order.
+func (v *Validator) Report() Report {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	r := v.report
+	r.GeneratedAt = time.Now().UTC()
+
+	r.Rules = make([]RuleSummary, 0, len(v.rules))
+	for _, rule := range v.rules {
+		r.Rules = append(r.Rules, RuleSummary{Rule: rule.Name, Severity: rule.Severity, Failed: v.failed[rule.Name]})
+	}
+
+	r.Fails = append([]FighterIssues(nil), v.report.Fails...)
+	sort.SliceStable(r.Fails, func(i, j int) bool {
+		return r.Fails[i].FighterUrl < r.Fails[j].FighterUrl
+	})
+
+	return r
+}
+
+// Sink returns a sink validating fighters before writing them to next. Invalid fighters are written too,
+// in the strict mode they are dropped and counted in Report.Dropped, so a failed run doesn't persist them.
+func (v *Validator) Sink(next sink.Sink, strict bool) sink.Sink {
+	return &validatingSink{
+		validator: v,
+		next:      next,
+		strict:    strict,
+	}
+}
+
+type validatingSink struct {
+	validator *Validator
+	next      sink.Sink
+	strict    bool
+}
+
+func (s *validatingSink) Write(f model.Fighter) error {
+	issues := s.validator.Validate(f)
+
+	if s.strict && invalid(issues) {
+		s.validator.mu.Lock()
+		s.validator.report.Dropped++
+		s.validator.mu.Unlock()
+
+		return nil
+	}
+
+	return s.next.Write(f)
+}
+
+func (s *validatingSink) Close() error {
+	return s.next.Close()
+}
+
+// invalid reports whether any of the issues is an error.
+func invalid(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,156 @@
+package validate
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pickfighter.com/pkg/domain"
+	"pickfighter.com/scraper/pkg/model"
+)
+
+func validFighter() model.Fighter {
+	return model.Fighter{
+		Name:           "Khabib Nurmagomedov",
+		Status:         domain.StatusRetired,
+		Age:            35,
+		Height:         70,
+		Weight:         155,
+		Reach:          70,
+		LegReach:       40,
+		DebutTimestamp: int(time.Date(2012, time.January, 20, 0, 0, 0, 0, time.UTC).Unix()),
+		Wins:           29,
+		FighterUrl:     "https://www.ufc.com/athlete/khabib-nurmagomedov",
+		ImageUrl:       "https://www.ufc.com/images/khabib.png",
+		Stats: model.FighterStats{
+			TotalSigStrLanded:    1000,
+			TotalSigStrAttempted: 2000,
+			StrAccuracy:          50,
+			TotalTkdLanded:       60,
+			TotalTkdAttempted:    120,
+			TkdAccuracy:          50,
+			SigStrDefense:        65,
+			TakedownDefense:      84,
+			WinByKO:              8,
+			WinBySub:             11,
+			WinByDec:             10,
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(f *model.Fighter)
+		rules  []string
+	}{
+		{"valid", func(f *model.Fighter) {}, nil},
+		{"no name", func(f *model.Fighter) { f.Name = "" }, []string{"name.required"}},
+		{"missing age", func(f *model.Fighter) { f.Age = 0 }, []string{"age.missing"}},
+		{"age out of range", func(f *model.Fighter) { f.Age = 99 }, []string{"age.range"}},
+		{"height out of range", func(f *model.Fighter) { f.Height = 177.8 }, []string{"height.range"}},
+		{"debut before the first event", func(f *model.Fighter) { f.DebutTimestamp = 1 }, []string{"debut.range"}},
+		{"win methods exceed wins", func(f *model.Fighter) { f.Wins = 20 }, []string{"win_methods.total"}},
+		{"landed exceed attempted", func(f *model.Fighter) { f.Stats.TotalTkdLanded = 121 }, []string{"takedowns.landed"}},
+		{"percentage out of range", func(f *model.Fighter) { f.Stats.StrAccuracy = 150 }, []string{"percentages.range"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := validFighter()
+			tc.modify(&f)
+
+			var rules []string
+			for _, issue := range New(nil).Validate(f) {
+				rules = append(rules, issue.Rule)
+			}
+
+			assert.Equal(t, tc.rules, rules)
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	v := New(nil)
+
+	invalid := validFighter()
+	invalid.FighterUrl = "https://www.ufc.com/athlete/a"
+	invalid.Wins = 1
+
+	warned := validFighter()
+	warned.FighterUrl = "https://www.ufc.com/athlete/b"
+	warned.Reach = 0
+
+	for _, f := range []model.Fighter{warned, validFighter(), invalid, validFighter()} {
+		v.Validate(f)
+	}
+
+	r := v.Report()
+
+	assert.Equal(t, 4, r.Fighters)
+	assert.Equal(t, 1, r.Invalid)
+	assert.Equal(t, 1, r.WithWarnings)
+	assert.Equal(t, 0.25, r.InvalidRate())
+	assert.Len(t, r.Rules, len(DefaultRules))
+	require.Len(t, r.Fails, 2)
+	assert.Equal(t, invalid.FighterUrl, r.Fails[0].FighterUrl, "fighters with issues are sorted by URL")
+
+	assert.NoError(t, r.Check(Thresholds{}))
+	assert.NoError(t, r.Check(Thresholds{MaxInvalid: 1, MaxInvalidRate: 0.25}))
+	assert.True(t, errors.Is(r.Check(Thresholds{MaxInvalidRate: 0.2}), ErrThresholdExceeded))
+
+	var table bytes.Buffer
+	require.NoError(t, r.WriteTable(&table))
+	assert.Contains(t, table.String(), "win_methods.total")
+	assert.Contains(t, table.String(), "invalid: 1 (25.0%)")
+}
+
+// collect keeps fighters written by the validating sink.
+type collect struct {
+	fighters []model.Fighter
+}
+
+func (c *collect) Write(f model.Fighter) error {
+	c.fighters = append(c.fighters, f)
+	return nil
+}
+
+func (c *collect) Close() error {
+	return nil
+}
+
+func TestSink(t *testing.T) {
+	invalid := validFighter()
+	invalid.FighterUrl = "https://www.ufc.com/athlete/a"
+	invalid.Name = ""
+
+	warned := validFighter()
+	warned.Reach = 0
+
+	for _, strict := range []bool{false, true} {
+		v := New(nil)
+		out := &collect{}
+		s := v.Sink(out, strict)
+
+		for _, f := range []model.Fighter{validFighter(), invalid, warned} {
+			require.NoError(t, s.Write(f))
+		}
+		require.NoError(t, s.Close())
+
+		r := v.Report()
+		assert.Equal(t, 3, r.Fighters)
+		assert.Equal(t, 1, r.Invalid)
+
+		if strict {
+			// invalid fighters never reach the next sink, fighters with warnings do
+			assert.Equal(t, []model.Fighter{validFighter(), warned}, out.fighters)
+			assert.Equal(t, 1, r.Dropped)
+		} else {
+			assert.Len(t, out.fighters, 3)
+			assert.Equal(t, 0, r.Dropped)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"strings"
 
+	"pickfighter.com/pkg/domain"
 	"pickfighter.com/scraper/pkg/logger"
 	"pickfighter.com/scraper/pkg/model"
 )
@@ -13,24 +14,30 @@ const defaultStat = "0-0-0 (W-L-D)"
 // SetStatistic sets the statistical data for a Fighter based on the provided string 'stat'.
 // The function splits the input string, extracts individual parts, converts them to integers,
 // and sets the Wins, Loses, and Draw fields of the Fighter accordingly. If conversion errors occur,
-// it logs an error and sets the corresponding value to 0.
+// it logs an error and sets the corresponding value to 0. Only values converted from a non-empty 'stat'
+// are marked as parsed.
 func SetStatistic(f *model.Fighter, stat string) {
 	l := logger.Get()
 
-	if len(stat) == 0 {
+	parsed := len(stat) != 0
+	if !parsed {
 		stat = defaultStat
 	}
 
 	parts := strings.Split(strings.Split(stat, " ")[0], "-")
+	fields := []string{"Wins", "Loses", "Draw"}
 	var scores []int
 
-	for _, part := range parts {
+	for i, part := range parts {
 		num, err := strconv.Atoi(part)
 		if err != nil {
 			l.Errorf("[%s] Conversion error: %s, with part: '%s' of %s", f.Name, err, part, parts)
 			scores = append(scores, 0)
 		} else {
 			scores = append(scores, num)
+			if parsed && i < len(fields) {
+				f.MarkParsed(fields[i])
+			}
 		}
 
 	}
@@ -40,32 +47,28 @@ func SetStatistic(f *model.Fighter, stat string) {
 	f.Draw = scores[2]
 }
 
-// SetDivision sets division based on Division type.
+// SetDivision sets the fighter division parsed from the profile division title, e.g. "Flyweight Division".
+// An unknown title is logged and the division is left unchanged.
 func SetDivision(f *model.Fighter, d string) {
-	switch d {
-	case "Flyweight Division":
-		f.Division = model.Flyweight
-	case "Bantamweight Division":
-		f.Division = model.Bantamweight
-	case "Featherweight Division":
-		f.Division = model.Featherweight
-	case "Lightweight Division":
-		f.Division = model.Lightweight
-	case "Welterweight Division":
-		f.Division = model.Welterweight
-	case "Middleweight Division":
-		f.Division = model.Middleweight
-	case "Light Heavyweight Division":
-		f.Division = model.Lightheavyweight
-	case "Heavyweight Division":
-		f.Division = model.Heavyweight
-	case "Women's Strawweight Division":
-		f.Division = model.WomensStrawweight
-	case "Women's Flyweight Division":
-		f.Division = model.WomensFlyweight
-	case "Women's Bantamweight Division":
-		f.Division = model.WomensBantamweight
-	case "Women's Featerweight Division":
-		f.Division = model.WomensFeatherweight
+	division, err := domain.ParseDivision(d)
+	if err != nil {
+		logger.Get().Errorf("[%s] Division parsing error: %s", f.Name, err)
+		return
 	}
+
+	f.Division = division
+	f.MarkParsed("Division")
+}
+
+// SetStatus sets the fighter status parsed from the biography block.
+// An unknown status is logged and the status is left empty.
+func SetStatus(f *model.Fighter, s string) {
+	status, err := domain.ParseStatus(s)
+	if err != nil {
+		logger.Get().Errorf("[%s] Status parsing error: %s", f.Name, err)
+		return
+	}
+
+	f.Status = status
+	f.MarkParsed("Status")
 }
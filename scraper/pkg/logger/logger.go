@@ -47,6 +47,11 @@ func Initialize(flag int) error {
 	return nil
 }
 
+// Get returns the logger, a no-op logger before Initialize.
 func Get() *zap.SugaredLogger {
+	if logger == nil {
+		return zap.NewNop().Sugar()
+	}
+
 	return logger
 }
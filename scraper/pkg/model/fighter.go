@@ -1,54 +1,6 @@
 package model
 
-// Division represents weight divisions
-type Division int
-
-// String returns the string representation of a Division.
-func (d Division) String() string {
-	switch d {
-	case Flyweight:
-		return "Flyweight"
-	case Bantamweight:
-		return "Bantamweight"
-	case Featherweight:
-		return "Featherweight"
-	case Lightweight:
-		return "Lightweight"
-	case Welterweight:
-		return "Welterweight"
-	case Middleweight:
-		return "Middleweight"
-	case Lightheavyweight:
-		return "Light Heavyweight"
-	case Heavyweight:
-		return "Heavyweight"
-	case WomensStrawweight:
-		return "Women's Strawweight"
-	case WomensFlyweight:
-		return "Women's Flyweight"
-	case WomensBantamweight:
-		return "Women's Bantamweight"
-	case WomensFeatherweight:
-		return "Women's Featherweight"
-	default:
-		return "Unknown"
-	}
-}
-
-const (
-	Flyweight Division = iota
-	Bantamweight
-	Featherweight
-	Lightweight
-	Welterweight
-	Middleweight
-	Lightheavyweight
-	Heavyweight
-	WomensStrawweight
-	WomensFlyweight
-	WomensBantamweight
-	WomensFeatherweight
-)
+import "pickfighter.com/pkg/domain"
 
 // FighterStats represents statistical information for a fighter
 type FighterStats struct {
@@ -74,27 +26,46 @@ type FighterStats struct {
 
 // Fighter represents fighter information
 type Fighter struct {
-	FighterId      int32        `json:"fighter_id,omitempty"`
-	Name           string       `json:"name"`
-	NickName       string       `json:"nickName"`
-	Division       Division     `json:"division"`
-	Status         string       `json:"status"`
-	Hometown       string       `json:"hometown"`
-	TrainsAt       string       `json:"trainsAt"`
-	FightingStyle  string       `json:"fightingStyle"`
-	Age            int8         `json:"age"`
-	Height         float32      `json:"height"`
-	Weight         float32      `json:"weight"`
-	OctagonDebut   string       `json:"octagonDebut"`
-	DebutTimestamp int          `json:"debutTimestamp"`
-	Reach          float32      `json:"reach"`
-	LegReach       float32      `json:"legReach"`
-	Wins           int          `json:"wins"`
-	Loses          int          `json:"loses"`
-	Draw           int          `json:"draw"`
-	FighterUrl     string       `json:"fighterUrl"`
-	ImageUrl       string       `json:"imageUrl"`
-	Stats          FighterStats `json:"stats"`
+	FighterId      int32           `json:"fighter_id,omitempty"`
+	Name           string          `json:"name"`
+	NickName       string          `json:"nickName"`
+	Division       domain.Division `json:"division"`
+	Status         domain.Status   `json:"status"`
+	Hometown       string          `json:"hometown"`
+	TrainsAt       string          `json:"trainsAt"`
+	FightingStyle  string          `json:"fightingStyle"`
+	Age            int8            `json:"age"`
+	Height         float32         `json:"height"`
+	Weight         float32         `json:"weight"`
+	OctagonDebut   string          `json:"octagonDebut"`
+	DebutTimestamp int             `json:"debutTimestamp"`
+	Reach          float32         `json:"reach"`
+	LegReach       float32         `json:"legReach"`
+	Wins           int             `json:"wins"`
+	Loses          int             `json:"loses"`
+	Draw           int             `json:"draw"`
+	FighterUrl     string          `json:"fighterUrl"`
+	ImageUrl       string          `json:"imageUrl"`
+	Stats          FighterStats    `json:"stats"`
+
+	// Parsed holds fields the source parsed from the page, see Fields.
+	Parsed Fields `json:"-"`
+}
+
+// Fields is a set of fighter fields by their path, e.g. "Division" or "Stats.WinByKO".
+// Sources mark fields whose parsed value may be zero, so a flyweight division or 0 losses
+// can be told from a field missing on the page. Fields with a non-zero value are parsed anyway.
+type Fields map[string]bool
+
+// MarkParsed adds fields parsed from the page to f.Parsed.
+func (f *Fighter) MarkParsed(fields ...string) {
+	if f.Parsed == nil {
+		f.Parsed = make(Fields, len(fields))
+	}
+
+	for _, field := range fields {
+		f.Parsed[field] = true
+	}
 }
 
 // FightersCollection represents a collection of fighters as a slice
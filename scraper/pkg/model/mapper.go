@@ -0,0 +1,52 @@
+package model
+
+import "pickfighter.com/gen"
+
+// FighterToProto converts a scraped Fighter into a generated proto counterpart.
+func FighterToProto(f *Fighter) *gen.Fighter {
+	return &gen.Fighter{
+		Name:           f.Name,
+		NickName:       f.NickName,
+		Division:       f.Division.Proto(),
+		Status:         f.Status.String(),
+		Hometown:       f.Hometown,
+		TrainsAt:       f.TrainsAt,
+		FightingStyle:  f.FightingStyle,
+		Age:            int32(f.Age),
+		Height:         f.Height,
+		Weight:         f.Weight,
+		OctagonDebut:   f.OctagonDebut,
+		DebutTimestamp: int32(f.DebutTimestamp),
+		Reach:          f.Reach,
+		LegReach:       f.LegReach,
+		Wins:           int32(f.Wins),
+		Loses:          int32(f.Loses),
+		Draw:           int32(f.Draw),
+		FighterUrl:     f.FighterUrl,
+		ImageUrl:       f.ImageUrl,
+		Stats:          FighterStatsToProto(&f.Stats),
+	}
+}
+
+// FighterStatsToProto converts scraped FighterStats into a generated proto counterpart.
+func FighterStatsToProto(s *FighterStats) *gen.FighterStats {
+	return &gen.FighterStats{
+		TotalSigStrLanded:    int32(s.TotalSigStrLanded),
+		TotalSigStrAttempted: int32(s.TotalSigStrAttempted),
+		StrAccuracy:          int32(s.StrAccuracy),
+		TotalTkdLanded:       int32(s.TotalTkdLanded),
+		TotalTkdAttempted:    int32(s.TotalTkdAttempted),
+		TkdAccuracy:          int32(s.TkdAccuracy),
+		SigStrLanded:         s.SigStrLanded,
+		SigStrAbs:            s.SigStrAbs,
+		SigStrDefense:        int32(s.SigStrDefense),
+		TakedownDefense:      int32(s.TakedownDefense),
+		TakedownAvg:          s.TakedownAvg,
+		SubmissionAvg:        s.SubmissionAvg,
+		KnockdownAvg:         s.KnockdownAvg,
+		AvgFightTime:         s.AvgFightTime,
+		WinByKO:              int32(s.WinByKO),
+		WinBySub:             int32(s.WinBySub),
+		WinByDec:             int32(s.WinByDec),
+	}
+}
@@ -10,7 +10,7 @@ if [ "$(docker ps -a -q -f name=${CONTAINER_NAME})" ]; then
 fi
 
 echo "Starting new container: ${CONTAINER_NAME}..."
-docker build -t ${POSTGRES_IMAGE} ./tests
+docker build -t ${POSTGRES_IMAGE} -f ./tests/Dockerfile .
 docker run -d --name=${CONTAINER_NAME} -e POSTGRES_PASSWORD=${POSTGRES_PASSWORD} -p 5433:5432 ${POSTGRES_IMAGE}
 
 docker ps -a | grep ${CONTAINER_NAME}
\ No newline at end of file
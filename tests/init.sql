@@ -2,6 +2,8 @@ SELECT 'CREATE DATABASE fighters_db' WHERE NOT EXISTS (SELECT FROM pg_database W
 
 \c fighters_db;
 
+-- base schema with seed data, later changes are applied by the migrations in fighters/migrations
+
 --- pf_fighters table
 
 CREATE TABLE IF NOT EXISTS public.pf_fighters (
@@ -78,4 +80,5 @@ ALTER TABLE ONLY public.pf_fighter_stats
     ADD CONSTRAINT pf_fighter_stats_pkey PRIMARY KEY (stat_id);
 
 ALTER TABLE ONLY public.pf_fighter_stats
-    ADD CONSTRAINT pf_fighter_stats_fighter_id_fkey FOREIGN KEY (fighter_id) REFERENCES public.pf_fighters(fighter_id);
\ No newline at end of file
+    ADD CONSTRAINT pf_fighter_stats_fighter_id_fkey FOREIGN KEY (fighter_id) REFERENCES public.pf_fighters(fighter_id);
+